@@ -0,0 +1,517 @@
+package json
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// Canonicalize returns the canonical form of the JSON-encoded src defined by
+// RFC 8785 (JSON Canonicalization Scheme): object members sorted by the UTF-16
+// code units of their keys, numbers serialized like ECMAScript does, strings
+// with the minimal escaping and no insignificant whitespace.
+//
+// Numbers are IEEE 754 double precision values in the canonical form, so
+// integers beyond ±2^53 lose precision. Duplicate object keys, invalid UTF-8
+// and lone surrogates are rejected.
+func Canonicalize(src []byte) ([]byte, error) {
+	return appendCanonical(nil, src)
+}
+
+func appendCanonical(dst, src []byte) ([]byte, error) {
+	c := &canonicalizer{src: src}
+	c.skipWhiteSpace()
+	dst, err := c.value(dst)
+	if err != nil {
+		return nil, err
+	}
+	c.skipWhiteSpace()
+	if c.cursor < int64(len(src)) {
+		return nil, errInvalidCharacter(src[c.cursor], "after top-level value", c.cursor)
+	}
+	return dst, nil
+}
+
+type canonicalizer struct {
+	src    []byte
+	cursor int64
+}
+
+type canonicalMember struct {
+	key        string
+	keyCursor  int64 // position of the key in the source
+	start, end int   // member in the destination buffer
+}
+
+func (c *canonicalizer) char() byte {
+	if c.cursor < int64(len(c.src)) {
+		return c.src[c.cursor]
+	}
+	return nul
+}
+
+func (c *canonicalizer) skipWhiteSpace() {
+	for c.cursor < int64(len(c.src)) {
+		switch c.src[c.cursor] {
+		case ' ', '\t', '\n', '\r':
+			c.cursor++
+		default:
+			return
+		}
+	}
+}
+
+func (c *canonicalizer) value(dst []byte) ([]byte, error) {
+	switch ch := c.char(); ch {
+	case '{':
+		return c.object(dst)
+	case '[':
+		return c.array(dst)
+	case '"':
+		s, err := c.string()
+		if err != nil {
+			return nil, err
+		}
+		return appendCanonicalString(dst, s), nil
+	case 't':
+		return c.literal(dst, "true")
+	case 'f':
+		return c.literal(dst, "false")
+	case 'n':
+		return c.literal(dst, "null")
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return c.number(dst)
+	case nul:
+		return nil, errUnexpectedEndOfJSON("value", c.cursor)
+	default:
+		return nil, errInvalidCharacter(ch, "value", c.cursor)
+	}
+}
+
+func (c *canonicalizer) object(dst []byte) ([]byte, error) {
+	c.cursor++
+	c.skipWhiteSpace()
+	if c.char() == '}' {
+		c.cursor++
+		return append(dst, '{', '}'), nil
+	}
+	start := len(dst)
+	members := []canonicalMember{}
+	for {
+		c.skipWhiteSpace()
+		if ch := c.char(); ch != '"' {
+			if ch == nul {
+				return nil, errUnexpectedEndOfJSON("object key", c.cursor)
+			}
+			return nil, errInvalidCharacter(ch, "object key", c.cursor)
+		}
+		keyCursor := c.cursor
+		key, err := c.string()
+		if err != nil {
+			return nil, err
+		}
+		c.skipWhiteSpace()
+		if c.char() != ':' {
+			return nil, errExpected("colon after object key", c.cursor)
+		}
+		c.cursor++
+		c.skipWhiteSpace()
+		memberStart := len(dst)
+		dst = appendCanonicalString(dst, key)
+		dst = append(dst, ':')
+		dst, err = c.value(dst)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, canonicalMember{key: key, keyCursor: keyCursor, start: memberStart, end: len(dst)})
+		c.skipWhiteSpace()
+		switch ch := c.char(); ch {
+		case ',':
+			c.cursor++
+			continue
+		case '}':
+			c.cursor++
+		case nul:
+			return nil, errUnexpectedEndOfJSON("object", c.cursor)
+		default:
+			return nil, errInvalidCharacter(ch, "after object key:value pair", c.cursor)
+		}
+		break
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return canonicalKeyLess(members[i].key, members[j].key)
+	})
+	if err := canonicalDuplicateKeyError(members); err != nil {
+		return nil, err
+	}
+	sorted := make([]byte, 0, len(dst)-start+len(members)+1)
+	sorted = append(sorted, '{')
+	for i, m := range members {
+		if i > 0 {
+			sorted = append(sorted, ',')
+		}
+		sorted = append(sorted, dst[m.start:m.end]...)
+	}
+	sorted = append(sorted, '}')
+	return append(dst[:start], sorted...), nil
+}
+
+// canonicalDuplicateKeyError returns the error of the first repeated key of the sorted members
+// in the order of the source, whose duplicates are next to each other.
+func canonicalDuplicateKeyError(members []canonicalMember) error {
+	var dup *canonicalMember
+	for i := 1; i < len(members); i++ {
+		prev, m := &members[i-1], &members[i]
+		if prev.key != m.key {
+			continue
+		}
+		if prev.keyCursor > m.keyCursor {
+			m = prev
+		}
+		if dup == nil || m.keyCursor < dup.keyCursor {
+			dup = m
+		}
+	}
+	if dup == nil {
+		return nil
+	}
+	return &SyntaxError{msg: fmt.Sprintf("json: duplicate object key %q", dup.key), Offset: dup.keyCursor}
+}
+
+func (c *canonicalizer) array(dst []byte) ([]byte, error) {
+	c.cursor++
+	dst = append(dst, '[')
+	c.skipWhiteSpace()
+	if c.char() == ']' {
+		c.cursor++
+		return append(dst, ']'), nil
+	}
+	for {
+		c.skipWhiteSpace()
+		var err error
+		dst, err = c.value(dst)
+		if err != nil {
+			return nil, err
+		}
+		c.skipWhiteSpace()
+		switch ch := c.char(); ch {
+		case ',':
+			c.cursor++
+			dst = append(dst, ',')
+		case ']':
+			c.cursor++
+			return append(dst, ']'), nil
+		case nul:
+			return nil, errUnexpectedEndOfJSON("array", c.cursor)
+		default:
+			return nil, errInvalidCharacter(ch, "after array element", c.cursor)
+		}
+	}
+}
+
+func (c *canonicalizer) literal(dst []byte, lit string) ([]byte, error) {
+	end := c.cursor + int64(len(lit))
+	if end > int64(len(c.src)) || string(c.src[c.cursor:end]) != lit {
+		return nil, errInvalidCharacter(c.char(), "value", c.cursor)
+	}
+	c.cursor = end
+	return append(dst, lit...), nil
+}
+
+func (c *canonicalizer) number(dst []byte) ([]byte, error) {
+	start := c.cursor
+	if c.char() == '-' {
+		c.cursor++
+	}
+	switch ch := c.char(); {
+	case ch == '0':
+		c.cursor++
+	case '1' <= ch && ch <= '9':
+		c.digits()
+	default:
+		return nil, errInvalidCharacter(ch, "number", c.cursor)
+	}
+	if c.char() == '.' {
+		c.cursor++
+		if n := c.digits(); n == 0 {
+			return nil, errInvalidCharacter(c.char(), "number", c.cursor)
+		}
+	}
+	if ch := c.char(); ch == 'e' || ch == 'E' {
+		c.cursor++
+		if ch := c.char(); ch == '+' || ch == '-' {
+			c.cursor++
+		}
+		if n := c.digits(); n == 0 {
+			return nil, errInvalidCharacter(c.char(), "number", c.cursor)
+		}
+	}
+	f, err := strconv.ParseFloat(string(c.src[start:c.cursor]), 64)
+	if err != nil {
+		return nil, &SyntaxError{msg: fmt.Sprintf("json: number %s out of range", c.src[start:c.cursor]), Offset: start}
+	}
+	return appendCanonicalNumber(dst, f, 64), nil
+}
+
+func (c *canonicalizer) digits() int {
+	n := 0
+	for ch := c.char(); '0' <= ch && ch <= '9'; ch = c.char() {
+		c.cursor++
+		n++
+	}
+	return n
+}
+
+// string returns the decoded string at the cursor.
+func (c *canonicalizer) string() (string, error) {
+	c.cursor++
+	buf := []byte{}
+	for {
+		if c.cursor >= int64(len(c.src)) {
+			return "", errUnexpectedEndOfJSON("string", c.cursor)
+		}
+		ch := c.src[c.cursor]
+		switch {
+		case ch == '"':
+			c.cursor++
+			return string(buf), nil
+		case ch == '\\':
+			c.cursor++
+			r, err := c.escape()
+			if err != nil {
+				return "", err
+			}
+			buf = append(buf, string(r)...)
+		case ch < 0x20:
+			return "", errInvalidCharacter(ch, "string", c.cursor)
+		case ch < utf8.RuneSelf:
+			c.cursor++
+			buf = append(buf, ch)
+		default:
+			r, size := utf8.DecodeRune(c.src[c.cursor:])
+			if r == utf8.RuneError && size == 1 {
+				return "", &SyntaxError{msg: "json: invalid UTF-8 in string", Offset: c.cursor}
+			}
+			buf = append(buf, c.src[c.cursor:c.cursor+int64(size)]...)
+			c.cursor += int64(size)
+		}
+	}
+}
+
+// escape returns the character of the escape sequence after the backslash at the cursor.
+func (c *canonicalizer) escape() (rune, error) {
+	ch := c.char()
+	c.cursor++
+	switch ch {
+	case '"', '\\', '/':
+		return rune(ch), nil
+	case 'b':
+		return '\b', nil
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'u':
+		r, err := c.hex4()
+		if err != nil {
+			return 0, err
+		}
+		if !utf16.IsSurrogate(r) {
+			return r, nil
+		}
+		if c.char() == '\\' && c.cursor+1 < int64(len(c.src)) && c.src[c.cursor+1] == 'u' {
+			cursor := c.cursor
+			c.cursor += 2
+			r2, err := c.hex4()
+			if err != nil {
+				return 0, err
+			}
+			if dec := utf16.DecodeRune(r, r2); dec != utf8.RuneError {
+				return dec, nil
+			}
+			c.cursor = cursor
+		}
+		return 0, &SyntaxError{msg: "json: lone surrogate in string", Offset: c.cursor - 6}
+	case nul:
+		return 0, errUnexpectedEndOfJSON("string", c.cursor)
+	}
+	return 0, errInvalidCharacter(ch, "escape sequence", c.cursor-1)
+}
+
+func (c *canonicalizer) hex4() (rune, error) {
+	if c.cursor+4 > int64(len(c.src)) {
+		return 0, errUnexpectedEndOfJSON("string", int64(len(c.src)))
+	}
+	var r rune
+	for _, ch := range c.src[c.cursor : c.cursor+4] {
+		r <<= 4
+		switch {
+		case '0' <= ch && ch <= '9':
+			r |= rune(ch - '0')
+		case 'a' <= ch && ch <= 'f':
+			r |= rune(ch - 'a' + 10)
+		case 'A' <= ch && ch <= 'F':
+			r |= rune(ch - 'A' + 10)
+		default:
+			return 0, errInvalidCharacter(ch, "escape sequence", c.cursor)
+		}
+	}
+	c.cursor += 4
+	return r, nil
+}
+
+// appendCanonicalString escapes only '"', '\\' and control characters like ECMAScript's JSON.stringify.
+// Invalid UTF-8 is written as U+FFFD like the encoder does for the other strings.
+func appendCanonicalString(b []byte, s string) []byte {
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ch >= utf8.RuneSelf {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				b = append(b, s[start:i]...)
+				b = append(b, "\ufffd"...)
+				start = i + 1
+			}
+			i += size - 1
+			continue
+		}
+		if ch >= 0x20 && ch != '"' && ch != '\\' {
+			continue
+		}
+		b = append(b, s[start:i]...)
+		switch ch {
+		case '"', '\\':
+			b = append(b, '\\', ch)
+		case '\b':
+			b = append(b, '\\', 'b')
+		case '\f':
+			b = append(b, '\\', 'f')
+		case '\n':
+			b = append(b, '\\', 'n')
+		case '\r':
+			b = append(b, '\\', 'r')
+		case '\t':
+			b = append(b, '\\', 't')
+		default:
+			b = append(b, '\\', 'u', '0', '0', hex[ch>>4], hex[ch&0xF])
+		}
+		start = i + 1
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+// appendCanonicalNumber serializes f like ECMAScript's Number.prototype.toString.
+// A float32 value of bitSize 32 is serialized with its shortest digits,
+// which are the ones of the float64 parsed from them.
+func appendCanonicalNumber(b []byte, f float64, bitSize int) []byte {
+	if f == 0 {
+		// -0 is serialized as 0
+		return append(b, '0')
+	}
+	abs := math.Abs(f)
+	small, large := abs < 1e-6, abs >= 1e21
+	if bitSize == 32 {
+		// like encodeFloat32, float32 comparisons get the cutoffs of the float32 digits right
+		small, large = float32(abs) < 1e-6, float32(abs) >= 1e21
+	}
+	fmt := byte('f')
+	if small || large {
+		fmt = 'e'
+	}
+	b = strconv.AppendFloat(b, f, fmt, -1, bitSize)
+	if fmt == 'e' {
+		// clean up e-09 to e-9
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b
+}
+
+// canonicalKeyLess compares a and b by their UTF-16 code units.
+func canonicalKeyLess(a, b string) bool {
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if ra != rb {
+			ua, ub := canonicalFirstUnit(ra), canonicalFirstUnit(rb)
+			if ua != ub {
+				return ua < ub
+			}
+			// both are surrogate pairs with the same high surrogate
+			return ra < rb
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return len(a) < len(b)
+}
+
+// canonicalEncodedKey returns the key of the member of an object encoded in b,
+// a string followed by the colon or the comma written after it.
+func canonicalEncodedKey(b []byte) string {
+	quoted := b[:len(b)-1]
+	if bytes.IndexByte(quoted, '\\') < 0 {
+		unquoted := quoted[1 : len(quoted)-1]
+		return *(*string)(unsafe.Pointer(&unquoted))
+	}
+	c := &canonicalizer{src: quoted}
+	key, _ := c.string()
+	return key
+}
+
+// encodeSortObjectMembers sorts the members of the object written in b from start, followed by a comma,
+// in the order of the canonical form. b keeps its length.
+func encodeSortObjectMembers(b []byte, start int) []byte {
+	if b[start] != '{' {
+		// a nil pointer
+		return b
+	}
+	s := newScanner(b[start : len(b)-1])
+	mapCtx := newMapContext(0)
+	mapCtx.slice.canonical = true
+	for cursor := int64(1); s.char(cursor) == '"'; {
+		keyEnd, _ := s.scanString(cursor)
+		valueEnd, _ := s.scanValue(keyEnd + 1)
+		// the key keeps its colon and the value the comma or the brace after it
+		mapCtx.slice.items = append(mapCtx.slice.items, mapItem{
+			key:   s.src[cursor : keyEnd+1],
+			value: s.src[keyEnd+1 : valueEnd+1],
+		})
+		cursor = valueEnd + 1
+	}
+	if len(mapCtx.slice.items) > 1 {
+		sort.Sort(mapCtx.slice)
+		buf := append(mapCtx.buf, '{')
+		for _, item := range mapCtx.slice.items {
+			buf = append(buf, item.key...)
+			buf = append(buf, item.value...)
+			buf[len(buf)-1] = ','
+		}
+		buf[len(buf)-1] = '}'
+		copy(b[start:], buf)
+		mapCtx.buf = buf
+	}
+	releaseMapContext(mapCtx)
+	return b
+}
+
+func canonicalFirstUnit(r rune) rune {
+	if r >= 0x10000 {
+		r, _ = utf16.EncodeRune(r)
+	}
+	return r
+}
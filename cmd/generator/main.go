@@ -2,9 +2,11 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"go/format"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -169,7 +171,7 @@ func (t opType) headToPtrHead() opType {
   }
   suffix := "Ptr"+t.String()[idx+len("Field"):]
 
-  const toPtrOffset = 12
+  const toPtrOffset = 16
   if strings.Contains(opType(int(t) + toPtrOffset).String(), suffix) {
     return opType(int(t) + toPtrOffset)
   }
@@ -190,7 +192,7 @@ func (t opType) headToNPtrHead() opType {
   }
   suffix := "NPtr"+t.String()[idx+len("Field"):]
 
-  const toPtrOffset = 24
+  const toPtrOffset = 32
   if strings.Contains(opType(int(t) + toPtrOffset).String(), suffix) {
     return opType(int(t) + toPtrOffset)
   }
@@ -198,7 +200,7 @@ func (t opType) headToNPtrHead() opType {
 }
 
 func (t opType) headToAnonymousHead() opType {
-  const toAnonymousOffset = 6
+  const toAnonymousOffset = 8
   if strings.Contains(opType(int(t) + toAnonymousOffset).String(), "Anonymous") {
     return opType(int(t) + toAnonymousOffset)
   }
@@ -223,7 +225,7 @@ func (t opType) headToStringTagHead() opType {
 }
 
 func (t opType) headToOnlyHead() opType {
-  if strings.HasSuffix(t.String(), "Head") || strings.HasSuffix(t.String(), "HeadOmitEmpty") || strings.HasSuffix(t.String(), "HeadStringTag") {
+  if strings.HasSuffix(t.String(), "Head") || strings.HasSuffix(t.String(), "HeadOmitEmpty") || strings.HasSuffix(t.String(), "HeadStringTag") || strings.HasSuffix(t.String(), "HeadOmitEmptyStringTag") {
     return t
   }
 
@@ -241,7 +243,7 @@ func (t opType) ptrHeadToHead() opType {
   }
   suffix := t.String()[idx+len("Ptr"):]
 
-  const toPtrOffset = 12
+  const toPtrOffset = 16
   if strings.Contains(opType(int(t) - toPtrOffset).String(), suffix) {
     return opType(int(t) - toPtrOffset)
   }
@@ -330,8 +332,12 @@ func (t opType) fieldToStringTagField() opType {
 		createOpType("MapKey", "MapKey"),
 		createOpType("MapValue", "MapValue"),
 		createOpType("MapEnd", "Op"),
+		createOpType("SortedObject", "Op"),
+		createOpType("SortedObjectEnd", "Op"),
 		createOpType("StructFieldRecursiveEnd", "Op"),
 		createOpType("StructAnonymousEnd", "StructEnd"),
+		createOpType("Custom", "Op"),
+		createOpType("StructFieldUnknown", "StructField"),
 	}
 	for _, typ := range primitiveTypesUpper {
 		typ := typ
@@ -340,7 +346,7 @@ func (t opType) fieldToStringTagField() opType {
 	for _, typ := range append(primitiveTypesUpper, "") {
 		for _, ptrOrNot := range []string{"", "Ptr", "NPtr"} {
 			for _, headType := range []string{"", "Anonymous"} {
-				for _, opt := range []string{"", "OmitEmpty", "StringTag"} {
+				for _, opt := range []string{"", "OmitEmpty", "StringTag", "OmitEmptyStringTag"} {
 					for _, onlyOrNot := range []string{"", "Only"} {
 						ptrOrNot := ptrOrNot
 						headType := headType
@@ -439,7 +445,7 @@ func (t opType) fieldToStringTagField() opType {
 		}
 	}
 	for _, typ := range append(primitiveTypesUpper, "") {
-		for _, opt := range []string{"", "OmitEmpty", "StringTag"} {
+		for _, opt := range []string{"", "OmitEmpty", "StringTag", "OmitEmptyStringTag"} {
 			opt := opt
 			typ := typ
 
@@ -485,7 +491,7 @@ func (t opType) fieldToStringTagField() opType {
 		}
 	}
 	for _, typ := range append(primitiveTypesUpper, "") {
-		for _, opt := range []string{"", "OmitEmpty", "StringTag"} {
+		for _, opt := range []string{"", "OmitEmpty", "StringTag", "OmitEmptyStringTag"} {
 			opt := opt
 			typ := typ
 
@@ -537,7 +543,23 @@ func repoRoot() string {
 }
 
 func main() {
-	if err := _main(); err != nil {
-		panic(err)
+	var (
+		types  = flag.String("type", "", "comma separated list of the types to generate encoders and decoders for")
+		output = flag.String("o", "json_gen.go", "output file of -type, relative to the package directory")
+	)
+	flag.Parse()
+	if *types == "" {
+		if err := _main(); err != nil {
+			panic(err)
+		}
+		return
+	}
+	path := "."
+	if flag.NArg() > 0 {
+		path = flag.Arg(0)
+	}
+	if err := generateTypes(path, strings.Split(*types, ","), *output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/goccy/go-json/internal/gen"
+	"github.com/goccy/go-json/internal/gocmd"
+)
+
+// typesMainTmpl is the temporary program printing the descriptions of the types as the JSON of []*gen.Type.
+// It can't import internal/gen from the module of the types, so it declares the fields of gen.Type it sets.
+var typesMainTmpl = template.Must(template.New("main").Parse(`package main
+
+import (
+	"encoding"
+	stdjson "encoding/json"
+	"os"
+	"reflect"
+
+	json "github.com/goccy/go-json"
+	pkg {{ printf "%q" .ImportPath }}
+)
+
+type genType struct {
+	Name        string
+	String      string
+	Kind        reflect.Kind
+	Elem        *genType
+	Fields      []*genField
+	MarshalJSON bool
+	MarshalText bool
+	Unmarshaler bool
+	Zeroer      bool
+	PtrZeroer   bool
+}
+
+type genField struct {
+	Name      string
+	Tag       string
+	Exported  bool
+	Anonymous bool
+	Type      *genType
+}
+
+var (
+	marshalJSONTypes = []reflect.Type{
+		reflect.TypeOf((*json.Marshaler)(nil)).Elem(),
+		reflect.TypeOf((*json.MarshalerContext)(nil)).Elem(),
+	}
+	marshalTextTypes = []reflect.Type{reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()}
+	unmarshalerTypes = []reflect.Type{
+		reflect.TypeOf((*json.Unmarshaler)(nil)).Elem(),
+		reflect.TypeOf((*json.UnmarshalerContext)(nil)).Elem(),
+		reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem(),
+	}
+	zeroerType = reflect.TypeOf((*interface{ IsZero() bool })(nil)).Elem()
+)
+
+func implements(typ reflect.Type, ifaces []reflect.Type) bool {
+	for _, iface := range ifaces {
+		if typ.Implements(iface) {
+			return true
+		}
+	}
+	return false
+}
+
+// describe describes typ with its fields if withFields is set and the elements of pointers and slices.
+func describe(typ reflect.Type, withFields bool, describing map[reflect.Type]bool) *genType {
+	ptr := reflect.PtrTo(typ)
+	t := &genType{
+		String:      typ.String(),
+		Kind:        typ.Kind(),
+		MarshalJSON: implements(typ, marshalJSONTypes) || implements(ptr, marshalJSONTypes),
+		MarshalText: implements(typ, marshalTextTypes) || implements(ptr, marshalTextTypes),
+		Unmarshaler: implements(ptr, unmarshalerTypes),
+		Zeroer:      typ.Implements(zeroerType),
+		PtrZeroer:   ptr.Implements(zeroerType),
+	}
+	if typ.PkgPath() == {{ printf "%q" .ImportPath }} {
+		t.Name = typ.Name()
+	}
+	switch {
+	case withFields:
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			t.Fields = append(t.Fields, &genField{
+				Name:      field.Name,
+				Tag:       field.Tag.Get("json"),
+				Exported:  field.PkgPath == "",
+				Anonymous: field.Anonymous,
+				Type:      describe(field.Type, false, describing),
+			})
+		}
+	case typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice:
+		// the element of a recursive type isn't described again
+		if !describing[typ] {
+			describing[typ] = true
+			t.Elem = describe(typ.Elem(), false, describing)
+			delete(describing, typ)
+		}
+	}
+	return t
+}
+
+func main() {
+	var types []*genType
+	for _, typ := range []reflect.Type{ {{- range .Types }}
+		reflect.TypeOf((*pkg.{{ . }})(nil)).Elem(),{{ end }}
+	} {
+		types = append(types, describe(typ, typ.Kind() == reflect.Struct, map[reflect.Type]bool{}))
+	}
+	if err := stdjson.NewEncoder(os.Stdout).Encode(types); err != nil {
+		os.Stderr.WriteString(err.Error() + "\n")
+		os.Exit(1)
+	}
+}
+`))
+
+type typesTarget struct {
+	*gocmd.Package
+	Types []string
+}
+
+// generateTypes writes the encoders and decoders for the types of the package path to output.
+// The types must be exported because they are described by a temporary program which references them.
+func generateTypes(path string, types []string, output string) error {
+	pkg, err := gocmd.LoadPackage(path)
+	if err != nil {
+		return err
+	}
+	for _, typ := range types {
+		if typ == "" || strings.ToUpper(typ[:1]) != typ[:1] {
+			return fmt.Errorf("type %q is not an exported type", typ)
+		}
+	}
+	if !filepath.IsAbs(output) {
+		output = filepath.Join(pkg.Dir, output)
+	}
+
+	var src bytes.Buffer
+	if err := typesMainTmpl.Execute(&src, &typesTarget{Package: pkg, Types: types}); err != nil {
+		return err
+	}
+	// the previous output can't be compiled when the types have changed, so it's left out of the build
+	var stdout, stderr bytes.Buffer
+	if err := pkg.Run(src.Bytes(), &stdout, &stderr, output); err != nil {
+		return fmt.Errorf("failed to describe the types of %s: %s", pkg.ImportPath, stderr.String())
+	}
+	var described []*gen.Type
+	if err := json.Unmarshal(stdout.Bytes(), &described); err != nil {
+		return err
+	}
+	var generated bytes.Buffer
+	if err := gen.Generate(&generated, pkg.Name, described); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(output, generated.Bytes(), 0644)
+}
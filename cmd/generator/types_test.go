@@ -0,0 +1,38 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateTypes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	output := filepath.Join(dir, "json_gen.go")
+	if err := generateTypes("../../internal/gentest", []string{"Address", "User", "Event"}, output); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ioutil.ReadFile("../../internal/gentest/json_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(expected) != string(got) {
+		t.Fatalf("the generated code is different from internal/gentest/json_gen.go:\n%s", got)
+	}
+
+	if err := generateTypes("../../internal/gentest", []string{"Level"}, output); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := generateTypes("../../internal/gentest", []string{"hidden"}, output); err == nil {
+		t.Fatal("expected error")
+	}
+}
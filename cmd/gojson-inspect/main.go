@@ -0,0 +1,86 @@
+// gojson-inspect prints the opcodes of the encoder and the decoders of a type as compiled by go-json.
+//
+//	gojson-inspect -type T [package]
+//
+// The package defaults to the package of the current directory and T must be an exported type of it.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/goccy/go-json/internal/gocmd"
+)
+
+var inspectMainTmpl = template.Must(template.New("main").Parse(`package main
+
+import (
+	"fmt"
+	"reflect"
+
+	json "github.com/goccy/go-json"
+	pkg {{ printf "%q" .ImportPath }}
+)
+
+func main() {
+	typ := reflect.TypeOf((*pkg.{{ .Type }})(nil)).Elem()
+{{- if .Encoder }}
+	fmt.Printf("encoder of %s:\n%s\n", typ, json.DescribeEncoder(typ))
+{{- end }}
+{{- if .Decoder }}
+	fmt.Printf("decoder of %s:\n%s\n", typ, json.DescribeDecoder(typ))
+{{- end }}
+}
+`))
+
+type inspectTarget struct {
+	*gocmd.Package
+	Type    string
+	Encoder bool
+	Decoder bool
+}
+
+func inspect(target *inspectTarget) error {
+	if target.Type == "" || strings.ToUpper(target.Type[:1]) != target.Type[:1] {
+		return fmt.Errorf("type %q is not an exported type", target.Type)
+	}
+	var src bytes.Buffer
+	if err := inspectMainTmpl.Execute(&src, target); err != nil {
+		return err
+	}
+	return target.Run(src.Bytes(), os.Stdout, os.Stderr)
+}
+
+func _main() error {
+	var (
+		typ     = flag.String("type", "", "the type to inspect")
+		encoder = flag.Bool("encoder", true, "print the opcodes of the encoder")
+		decoder = flag.Bool("decoder", true, "print the decoders")
+	)
+	flag.Parse()
+	path := "."
+	if flag.NArg() > 0 {
+		path = flag.Arg(0)
+	}
+	pkg, err := gocmd.LoadPackage(path)
+	if err != nil {
+		return err
+	}
+	return inspect(&inspectTarget{
+		Package: pkg,
+		Type:    *typ,
+		Encoder: *encoder,
+		Decoder: *decoder,
+	})
+}
+
+func main() {
+	if err := _main(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -18,6 +18,9 @@ var (
 	cachedDecoderMap unsafe.Pointer // map[uintptr]decoder
 	baseTypeAddr     uintptr
 	maxTypeAddr      uintptr
+
+	cachedOptionOpcodeMap   unsafe.Pointer // map[opcodeSetCacheKey]*opcodeSet
+	cachedFlaggedDecoderMap unsafe.Pointer // map[decoderCacheKey]decoder
 )
 
 //go:linkname typelinks reflect.typelinks
@@ -93,6 +96,22 @@ func storeOpcodeSet(typ uintptr, set *opcodeSet, m map[uintptr]*opcodeSet) {
 	atomic.StorePointer(&cachedOpcodeMap, *(*unsafe.Pointer)(unsafe.Pointer(&newOpcodeMap)))
 }
 
+func loadOptionOpcodeMap() map[opcodeSetCacheKey]*opcodeSet {
+	p := atomic.LoadPointer(&cachedOptionOpcodeMap)
+	return *(*map[opcodeSetCacheKey]*opcodeSet)(unsafe.Pointer(&p))
+}
+
+func storeOptionOpcodeSet(key opcodeSetCacheKey, set *opcodeSet, m map[opcodeSetCacheKey]*opcodeSet) {
+	newOpcodeMap := make(map[opcodeSetCacheKey]*opcodeSet, len(m)+1)
+	newOpcodeMap[key] = set
+
+	for k, v := range m {
+		newOpcodeMap[k] = v
+	}
+
+	atomic.StorePointer(&cachedOptionOpcodeMap, *(*unsafe.Pointer)(unsafe.Pointer(&newOpcodeMap)))
+}
+
 func loadDecoderMap() map[uintptr]decoder {
 	p := atomic.LoadPointer(&cachedDecoderMap)
 	return *(*map[uintptr]decoder)(unsafe.Pointer(&p))
@@ -108,3 +127,19 @@ func storeDecoder(typ uintptr, dec decoder, m map[uintptr]decoder) {
 
 	atomic.StorePointer(&cachedDecoderMap, *(*unsafe.Pointer)(unsafe.Pointer(&newDecoderMap)))
 }
+
+func loadFlaggedDecoderMap() map[decoderCacheKey]decoder {
+	p := atomic.LoadPointer(&cachedFlaggedDecoderMap)
+	return *(*map[decoderCacheKey]decoder)(unsafe.Pointer(&p))
+}
+
+func storeFlaggedDecoder(key decoderCacheKey, dec decoder, m map[decoderCacheKey]decoder) {
+	newDecoderMap := make(map[decoderCacheKey]decoder, len(m)+1)
+	newDecoderMap[key] = dec
+
+	for k, v := range m {
+		newDecoderMap[k] = v
+	}
+
+	atomic.StorePointer(&cachedFlaggedDecoderMap, *(*unsafe.Pointer)(unsafe.Pointer(&newDecoderMap)))
+}
@@ -4,7 +4,24 @@ import (
 	"bytes"
 )
 
+// compactWithOption is like compact without escaping but writes the canonical form of src
+// for EncodeOptionCanonical.
+func compactWithOption(dst *bytes.Buffer, src []byte, opt EncodeOption) error {
+	if opt&EncodeOptionCanonical != 0 {
+		canonical, err := appendCanonical(nil, src)
+		if err != nil {
+			return err
+		}
+		_, err = dst.Write(canonical)
+		return err
+	}
+	return compact(dst, src, false)
+}
+
 func compact(dst *bytes.Buffer, src []byte, escape bool) error {
+	if err := validate(src); err != nil {
+		return err
+	}
 	length := len(src)
 	for cursor := 0; cursor < length; cursor++ {
 		c := src[cursor]
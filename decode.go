@@ -1,10 +1,10 @@
 package json
 
 import (
+	"context"
 	"encoding"
 	"io"
 	"reflect"
-	"strconv"
 	"unsafe"
 )
 
@@ -15,20 +15,113 @@ func (d Delim) String() string {
 }
 
 type decoder interface {
-	decode([]byte, int64, unsafe.Pointer) (int64, error)
-	decodeStream(*stream, unsafe.Pointer) error
+	decode([]byte, int64, int64, *DecodeOption, unsafe.Pointer) (int64, error)
+	decodeStream(*stream, int64, *DecodeOption, unsafe.Pointer) error
 }
 
 type Decoder struct {
 	s                   *stream
+	opt                 DecodeOption
 	structTypeToDecoder map[uintptr]decoder
+	tokenState          tokenState
+	tokenStack          []tokenState
+	decoderFuncs        map[uintptr]DecoderFunc     // decoders registered on the Decoder
+	decoders            map[decoderCacheKey]decoder // decoders compiled with decoderFuncs
+	compileFlags        DecodeOptionFlag            // decodeCompileFlags of the decoders being compiled
+}
+
+type DecodeOptionFlag int
+
+const (
+	DecodeOptionUseNumber DecodeOptionFlag = 1 << iota
+	DecodeOptionDisallowUnknownFields
+	DecodeOptionRequireFields        // treat all non-pointer struct fields as required
+	DecodeOptionCaseSensitive        // match object keys to struct fields by exact bytes
+	DecodeOptionNonFiniteFloatString // accept "NaN", "Infinity" and "-Infinity" as floats
+	DecodeOptionSnakeCaseKeys        // use snake_case keys for untagged struct fields
+	DecodeOptionCamelCaseKeys        // use camelCase keys for untagged struct fields
+	DecodeOptionKebabCaseKeys        // use kebab-case keys for untagged struct fields
+)
+
+// decodeOptionKeyNaming is the set of naming strategies for untagged struct fields.
+const decodeOptionKeyNaming = DecodeOptionSnakeCaseKeys | DecodeOptionCamelCaseKeys | DecodeOptionKebabCaseKeys
+
+// decodeCompileFlags are the flags which change the compiled decoders.
+// Decoders compiled with any of them are cached separately from the default ones.
+const decodeCompileFlags = DecodeOptionCaseSensitive | decodeOptionKeyNaming
+
+func decodeKeyNaming(flags DecodeOptionFlag) keyNaming {
+	switch {
+	case flags&DecodeOptionSnakeCaseKeys != 0:
+		return keyNamingSnakeCase
+	case flags&DecodeOptionCamelCaseKeys != 0:
+		return keyNamingCamelCase
+	case flags&DecodeOptionKebabCaseKeys != 0:
+		return keyNamingKebabCase
+	}
+	return keyNamingFieldName
+}
+
+// DecodeOption holds the settings used while decoding a single value.
+type DecodeOption struct {
+	Flags DecodeOptionFlag
+
+	// The following limits protect against hostile input. Zero means no limit.
+	MaxDepth        int64 // nesting depth of objects and arrays
+	MaxInputBytes   int64 // size of the input of a single value
+	MaxStringLength int64 // length in bytes of a string value or an object key
+	MaxElements     int64 // number of elements in an array or entries in a map
+	MaxObjectKeys   int64 // number of keys in an object decoded into a struct
+
+	// Context is passed to the UnmarshalJSONContext method of values implementing UnmarshalerContext.
+	Context context.Context
+}
+
+func (opt *DecodeOption) checkDepth(depth, cursor int64) error {
+	if opt.MaxDepth > 0 && depth > opt.MaxDepth {
+		return errExceededLimit("depth", opt.MaxDepth, cursor)
+	}
+	return nil
+}
+
+func (opt *DecodeOption) checkStringLength(length int, cursor int64) error {
+	if opt.MaxStringLength > 0 && int64(length) > opt.MaxStringLength {
+		return errExceededLimit("string length", opt.MaxStringLength, cursor)
+	}
+	return nil
+}
+
+func (opt *DecodeOption) checkElements(n int, cursor int64) error {
+	if opt.MaxElements > 0 && int64(n) > opt.MaxElements {
+		return errExceededLimit("elements", opt.MaxElements, cursor)
+	}
+	return nil
+}
+
+func (opt *DecodeOption) checkObjectKeys(n int, cursor int64) error {
+	if opt.MaxObjectKeys > 0 && int64(n) > opt.MaxObjectKeys {
+		return errExceededLimit("object keys", opt.MaxObjectKeys, cursor)
+	}
+	return nil
+}
+
+func (opt *DecodeOption) context() context.Context {
+	if opt.Context == nil {
+		return context.Background()
+	}
+	return opt.Context
 }
 
 var (
-	unmarshalJSONType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
-	unmarshalTextType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	unmarshalJSONType        = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	unmarshalJSONContextType = reflect.TypeOf((*UnmarshalerContext)(nil)).Elem()
+	unmarshalTextType        = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
 )
 
+func implementsUnmarshalJSON(typ *rtype) bool {
+	return typ.Implements(unmarshalJSONType) || typ.Implements(unmarshalJSONContextType)
+}
+
 const (
 	nul = '\000'
 )
@@ -57,7 +150,7 @@ func (d *Decoder) validateType(typ *rtype, p uintptr) error {
 	return nil
 }
 
-func (d *Decoder) decode(src []byte, header *interfaceHeader) error {
+func (d *Decoder) decode(src []byte, path string, header *interfaceHeader) error {
 	typ := header.typ
 	typeptr := uintptr(unsafe.Pointer(typ))
 
@@ -68,44 +161,53 @@ func (d *Decoder) decode(src []byte, header *interfaceHeader) error {
 	if err := d.validateType(copiedType, ptr); err != nil {
 		return err
 	}
-	dec, err := d.compileToGetDecoder(typeptr, typ)
+	if max := d.opt.MaxInputBytes; max > 0 && int64(len(src)-1) > max {
+		return errExceededLimit("input bytes", max, max)
+	}
+	tokens, err := parseJSONPointer(path)
+	if err != nil {
+		return err
+	}
+	dec, err := d.compileToGetDecoder(typeptr, typ, &d.opt)
 	if err != nil {
 		return err
 	}
-	if _, err := dec.decode(src, 0, header.ptr); err != nil {
+	cursor, err := seekPath(src, 0, tokens, path, &d.opt)
+	if err != nil {
 		return err
 	}
+	if _, err := dec.decode(src, cursor, int64(len(tokens)), &d.opt, header.ptr); err != nil {
+		return annotateErrorPointer(err, tokens)
+	}
 	return nil
 }
 
 func (d *Decoder) decodeForUnmarshal(src []byte, v interface{}) error {
 	header := (*interfaceHeader)(unsafe.Pointer(&v))
 	header.typ.escape()
-	return d.decode(src, header)
+	return d.decode(src, "", header)
+}
+
+func (d *Decoder) decodePathForUnmarshal(src []byte, path string, v interface{}) error {
+	header := (*interfaceHeader)(unsafe.Pointer(&v))
+	header.typ.escape()
+	return d.decode(src, path, header)
 }
 
 func (d *Decoder) decodeForUnmarshalNoEscape(src []byte, v interface{}) error {
 	header := (*interfaceHeader)(unsafe.Pointer(&v))
-	return d.decode(src, header)
+	return d.decode(src, "", header)
 }
 
 func (d *Decoder) prepareForDecode() error {
-	s := d.s
-	for {
-		switch s.char() {
-		case ' ', '\t', '\r', '\n':
-			s.cursor++
-			continue
-		case ',', ':':
-			s.cursor++
-			return nil
-		case nul:
-			if s.read() {
-				continue
-			}
-			return io.EOF
-		}
-		break
+	if _, err := d.peek(); err != nil {
+		return err
+	}
+	if err := d.tokenPrepareForDecode(); err != nil {
+		return err
+	}
+	if !d.tokenValueAllowed() {
+		return errNotAtBeginningOfValue(d.s.totalOffset())
 	}
 	return nil
 }
@@ -116,6 +218,35 @@ func (d *Decoder) prepareForDecode() error {
 // See the documentation for Unmarshal for details about
 // the conversion of JSON into a Go value.
 func (d *Decoder) Decode(v interface{}) error {
+	return d.decodeStream(v, "", &d.opt)
+}
+
+// DecodeWithOption is like Decode but applies optFuncs on top of the
+// settings of the Decoder for this call only.
+func (d *Decoder) DecodeWithOption(v interface{}, optFuncs ...DecodeOptionFunc) error {
+	opt := d.opt
+	for _, optFunc := range optFuncs {
+		opt = optFunc(opt)
+	}
+	return d.decodeStream(v, "", &opt)
+}
+
+// DecodeContext is like Decode but passes ctx to the UnmarshalJSONContext method
+// of values implementing UnmarshalerContext.
+func (d *Decoder) DecodeContext(ctx context.Context, v interface{}) error {
+	opt := d.opt
+	opt.Context = ctx
+	return d.decodeStream(v, "", &opt)
+}
+
+// DecodePath reads the next JSON-encoded value from its input and stores
+// only the value addressed by path, a JSON Pointer ( RFC 6901 ) such as "/items/3/name",
+// in the value pointed to by v. The rest of the input value is skipped.
+func (d *Decoder) DecodePath(path string, v interface{}) error {
+	return d.decodeStream(v, path, &d.opt)
+}
+
+func (d *Decoder) decodeStream(v interface{}, path string, opt *DecodeOption) error {
 	header := (*interfaceHeader)(unsafe.Pointer(&v))
 	typ := header.typ
 	ptr := uintptr(header.ptr)
@@ -127,7 +258,11 @@ func (d *Decoder) Decode(v interface{}) error {
 		return err
 	}
 
-	dec, err := d.compileToGetDecoder(typeptr, typ)
+	tokens, err := parseJSONPointer(path)
+	if err != nil {
+		return err
+	}
+	dec, err := d.compileToGetDecoder(typeptr, typ, opt)
 	if err != nil {
 		return err
 	}
@@ -135,12 +270,44 @@ func (d *Decoder) Decode(v interface{}) error {
 		return err
 	}
 	s := d.s
-	if err := dec.decodeStream(s, header.ptr); err != nil {
+	s.setInputLimit(opt.MaxInputBytes)
+	err = d.decodeStreamPath(s, dec, tokens, path, opt, header.ptr)
+	s.maxInputBytes = 0
+	if s.limitErr != nil {
+		return s.limitErr
+	}
+	if err != nil {
 		return err
 	}
+	if max := opt.MaxInputBytes; max > 0 && s.totalOffset()-s.inputStart > max {
+		// the value was already buffered before the limit was set
+		return errExceededLimit("input bytes", max, s.inputStart+max)
+	}
+	d.tokenValueEnd()
 	return nil
 }
 
+func (d *Decoder) decodeStreamPath(s *stream, dec decoder, tokens []string, path string, opt *DecodeOption, p unsafe.Pointer) error {
+	if len(tokens) == 0 {
+		return dec.decodeStream(s, 0, opt, p)
+	}
+	containers, err := s.seekPath(tokens, path, opt)
+	if err != nil {
+		if _, ok := err.(*PathError); ok {
+			// the next value is read after the one which does not have the path
+			if err := s.skipPathValue(containers, opt); err != nil {
+				return err
+			}
+			d.tokenValueEnd()
+		}
+		return err
+	}
+	if err := dec.decodeStream(s, int64(len(tokens)), opt, p); err != nil {
+		return annotateErrorPointer(err, tokens)
+	}
+	return s.unwindPath(containers, opt)
+}
+
 func (d *Decoder) More() bool {
 	s := d.s
 	for {
@@ -161,73 +328,26 @@ func (d *Decoder) More() bool {
 	return true
 }
 
-func (d *Decoder) Token() (Token, error) {
-	s := d.s
-	for {
-		c := s.char()
-		switch c {
-		case ' ', '\n', '\r', '\t':
-			s.cursor++
-		case '{', '[', ']', '}':
-			s.cursor++
-			return Delim(c), nil
-		case ',', ':':
-			s.cursor++
-		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-			bytes := floatBytes(s)
-			s := *(*string)(unsafe.Pointer(&bytes))
-			f64, err := strconv.ParseFloat(s, 64)
-			if err != nil {
-				return nil, err
-			}
-			return f64, nil
-		case '"':
-			bytes, err := stringBytes(s)
-			if err != nil {
-				return nil, err
-			}
-			return string(bytes), nil
-		case 't':
-			if err := trueBytes(s); err != nil {
-				return nil, err
-			}
-			return true, nil
-		case 'f':
-			if err := falseBytes(s); err != nil {
-				return nil, err
-			}
-			return false, nil
-		case 'n':
-			if err := nullBytes(s); err != nil {
-				return nil, err
-			}
-			return nil, nil
-		case nul:
-			if s.read() {
-				continue
-			}
-			goto END
-		default:
-			return nil, errInvalidCharacter(s.char(), "token", s.totalOffset())
-		}
-	}
-END:
-	return nil, io.EOF
-}
-
 // DisallowUnknownFields causes the Decoder to return an error when the destination
 // is a struct and the input contains object keys which do not match any
-// non-ignored, exported fields in the destination.
+// non-ignored, exported fields in the destination. The keys stored into a field
+// with the "unknown" option are not reported.
 func (d *Decoder) DisallowUnknownFields() {
-	d.s.disallowUnknownFields = true
+	d.opt.Flags |= DecodeOptionDisallowUnknownFields
 }
 
 func (d *Decoder) InputOffset() int64 {
 	return d.s.totalOffset()
 }
 
+// CaseSensitive causes the Decoder to match object keys to struct fields
+// only if they are exactly equal instead of equal under case folding.
+func (d *Decoder) CaseSensitive() {
+	d.opt.Flags |= DecodeOptionCaseSensitive
+}
+
 // UseNumber causes the Decoder to unmarshal a number into an interface{} as a
 // Number instead of as a float64.
 func (d *Decoder) UseNumber() {
-	d.s.useNumber = true
+	d.opt.Flags |= DecodeOptionUseNumber
 }
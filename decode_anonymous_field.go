@@ -18,18 +18,18 @@ func newAnonymousFieldDecoder(structType *rtype, offset uintptr, dec decoder) *a
 	}
 }
 
-func (d *anonymousFieldDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *anonymousFieldDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	if *(*unsafe.Pointer)(p) == nil {
 		*(*unsafe.Pointer)(p) = unsafe_New(d.structType)
 	}
 	p = *(*unsafe.Pointer)(p)
-	return d.dec.decodeStream(s, unsafe.Pointer(uintptr(p)+d.offset))
+	return d.dec.decodeStream(s, depth, opt, unsafe.Pointer(uintptr(p)+d.offset))
 }
 
-func (d *anonymousFieldDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *anonymousFieldDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	if *(*unsafe.Pointer)(p) == nil {
 		*(*unsafe.Pointer)(p) = unsafe_New(d.structType)
 	}
 	p = *(*unsafe.Pointer)(p)
-	return d.dec.decode(buf, cursor, unsafe.Pointer(uintptr(p)+d.offset))
+	return d.dec.decode(buf, cursor, depth, opt, unsafe.Pointer(uintptr(p)+d.offset))
 }
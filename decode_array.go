@@ -1,6 +1,7 @@
 package json
 
 import (
+	"strconv"
 	"unsafe"
 )
 
@@ -24,7 +25,7 @@ func newArrayDecoder(dec decoder, elemType *rtype, alen int, structName, fieldNa
 	}
 }
 
-func (d *arrayDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *arrayDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	for {
 		switch s.char() {
 		case ' ', '\n', '\t', '\r':
@@ -34,23 +35,33 @@ func (d *arrayDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 			}
 			return nil
 		case '[':
+			depth++
+			if err := opt.checkDepth(depth, s.totalOffset()); err != nil {
+				return err
+			}
+			var missing *MissingFieldsError
 			idx := 0
 			for {
 				s.cursor++
+				if err := opt.checkElements(idx+1, s.totalOffset()); err != nil {
+					return err
+				}
 				if idx < d.alen {
-					if err := d.valueDecoder.decodeStream(s, unsafe.Pointer(uintptr(p)+uintptr(idx)*d.size)); err != nil {
-						return err
+					if err := d.valueDecoder.decodeStream(s, depth, opt, unsafe.Pointer(uintptr(p)+uintptr(idx)*d.size)); err != nil {
+						if missing, err = collectMissingFields(missing, err, strconv.Itoa(idx)); err != nil {
+							return annotateErrorPath(err, strconv.Itoa(idx))
+						}
 					}
 				} else {
-					if err := s.skipValue(); err != nil {
-						return err
+					if err := s.skipValue(depth, opt); err != nil {
+						return annotateErrorPath(err, strconv.Itoa(idx))
 					}
 				}
 				s.skipWhiteSpace()
 				switch s.char() {
 				case ']':
 					s.cursor++
-					return nil
+					return missingFieldsError(missing)
 				case ',':
 					idx++
 				case nul:
@@ -76,7 +87,7 @@ ERROR:
 	return errUnexpectedEndOfJSON("array", s.totalOffset())
 }
 
-func (d *arrayDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *arrayDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	buflen := int64(len(buf))
 	for ; cursor < buflen; cursor++ {
 		switch buf[cursor] {
@@ -99,19 +110,29 @@ func (d *arrayDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64
 			cursor += 4
 			return cursor, nil
 		case '[':
+			depth++
+			if err := opt.checkDepth(depth, cursor); err != nil {
+				return 0, err
+			}
+			var missing *MissingFieldsError
 			idx := 0
 			for {
 				cursor++
+				if err := opt.checkElements(idx+1, cursor); err != nil {
+					return 0, err
+				}
 				if idx < d.alen {
-					c, err := d.valueDecoder.decode(buf, cursor, unsafe.Pointer(uintptr(p)+uintptr(idx)*d.size))
+					c, err := d.valueDecoder.decode(buf, cursor, depth, opt, unsafe.Pointer(uintptr(p)+uintptr(idx)*d.size))
 					if err != nil {
-						return 0, err
+						if missing, err = collectMissingFields(missing, err, strconv.Itoa(idx)); err != nil {
+							return 0, annotateErrorPath(err, strconv.Itoa(idx))
+						}
 					}
 					cursor = c
 				} else {
-					c, err := skipValue(buf, cursor)
+					c, err := skipValue(buf, cursor, depth, opt)
 					if err != nil {
-						return 0, err
+						return 0, annotateErrorPath(err, strconv.Itoa(idx))
 					}
 					cursor = c
 				}
@@ -119,7 +140,7 @@ func (d *arrayDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64
 				switch buf[cursor] {
 				case ']':
 					cursor++
-					return cursor, nil
+					return cursor, missingFieldsError(missing)
 				case ',':
 					idx++
 					continue
@@ -61,7 +61,7 @@ func falseBytes(s *stream) error {
 	return nil
 }
 
-func (d *boolDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *boolDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	s.skipWhiteSpace()
 	for {
 		switch s.char() {
@@ -94,7 +94,7 @@ ERROR:
 	return errUnexpectedEndOfJSON("bool", s.totalOffset())
 }
 
-func (d *boolDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *boolDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	buflen := int64(len(buf))
 	cursor = skipWhiteSpace(buf, cursor)
 	switch buf[cursor] {
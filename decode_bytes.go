@@ -15,7 +15,7 @@ type bytesDecoder struct {
 func byteUnmarshalerSliceDecoder(typ *rtype, structName string, fieldName string) decoder {
 	var unmarshalDecoder decoder
 	switch {
-	case rtype_ptrTo(typ).Implements(unmarshalJSONType):
+	case implementsUnmarshalJSON(rtype_ptrTo(typ)):
 		unmarshalDecoder = newUnmarshalJSONDecoder(rtype_ptrTo(typ), structName, fieldName)
 	case rtype_ptrTo(typ).Implements(unmarshalTextType):
 		unmarshalDecoder = newUnmarshalTextDecoder(rtype_ptrTo(typ), structName, fieldName)
@@ -35,8 +35,8 @@ func newBytesDecoder(typ *rtype, structName string, fieldName string) *bytesDeco
 	}
 }
 
-func (d *bytesDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
-	bytes, err := d.decodeStreamBinary(s, p)
+func (d *bytesDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
+	bytes, err := d.decodeStreamBinary(s, depth, opt, p)
 	if err != nil {
 		return err
 	}
@@ -54,8 +54,8 @@ func (d *bytesDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	return nil
 }
 
-func (d *bytesDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
-	bytes, c, err := d.decodeBinary(buf, cursor, p)
+func (d *bytesDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
+	bytes, c, err := d.decodeBinary(buf, cursor, depth, opt, p)
 	if err != nil {
 		return 0, err
 	}
@@ -73,7 +73,10 @@ func (d *bytesDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64
 	return cursor, nil
 }
 
-func binaryBytes(s *stream) ([]byte, error) {
+// binaryBytes returns the base64 text of the string at the cursor.
+// It fails once the text is longer than a positive maxLength, without buffering the rest of the string.
+func binaryBytes(s *stream, maxLength int64) ([]byte, error) {
+	offset := s.totalOffset()
 	s.cursor++
 	start := s.cursor
 	for {
@@ -89,19 +92,22 @@ func binaryBytes(s *stream) ([]byte, error) {
 			goto ERROR
 		}
 		s.cursor++
+		if maxLength > 0 && s.cursor-start > maxLength {
+			return nil, errExceededLimit("string length", maxLength, offset)
+		}
 	}
 ERROR:
 	return nil, errUnexpectedEndOfJSON("[]byte", s.totalOffset())
 }
 
-func (d *bytesDecoder) decodeStreamBinary(s *stream, p unsafe.Pointer) ([]byte, error) {
+func (d *bytesDecoder) decodeStreamBinary(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) ([]byte, error) {
 	for {
 		switch s.char() {
 		case ' ', '\n', '\t', '\r':
 			s.cursor++
 			continue
 		case '"':
-			return binaryBytes(s)
+			return binaryBytes(s, opt.MaxStringLength)
 		case 'n':
 			if err := nullBytes(s); err != nil {
 				return nil, err
@@ -114,7 +120,7 @@ func (d *bytesDecoder) decodeStreamBinary(s *stream, p unsafe.Pointer) ([]byte,
 					Offset: s.totalOffset(),
 				}
 			}
-			if err := d.sliceDecoder.decodeStream(s, p); err != nil {
+			if err := d.sliceDecoder.decodeStream(s, depth, opt, p); err != nil {
 				return nil, err
 			}
 			return nil, nil
@@ -128,18 +134,22 @@ func (d *bytesDecoder) decodeStreamBinary(s *stream, p unsafe.Pointer) ([]byte,
 	return nil, errNotAtBeginningOfValue(s.totalOffset())
 }
 
-func (d *bytesDecoder) decodeBinary(buf []byte, cursor int64, p unsafe.Pointer) ([]byte, int64, error) {
+func (d *bytesDecoder) decodeBinary(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) ([]byte, int64, error) {
 	for {
 		switch buf[cursor] {
 		case ' ', '\n', '\t', '\r':
 			cursor++
 		case '"':
+			offset := cursor
 			cursor++
 			start := cursor
 			for {
 				switch buf[cursor] {
 				case '"':
 					literal := buf[start:cursor]
+					if err := opt.checkStringLength(len(literal), offset); err != nil {
+						return nil, 0, err
+					}
 					cursor++
 					return literal, cursor, nil
 				case nul:
@@ -154,7 +164,7 @@ func (d *bytesDecoder) decodeBinary(buf []byte, cursor int64, p unsafe.Pointer)
 					Offset: cursor,
 				}
 			}
-			c, err := d.sliceDecoder.decode(buf, cursor, p)
+			c, err := d.sliceDecoder.decode(buf, cursor, depth, opt, p)
 			if err != nil {
 				return nil, 0, err
 			}
@@ -12,7 +12,7 @@ func (d *Decoder) compileToGetDecoderSlowPath(typeptr uintptr, typ *rtype) (deco
 		return dec, nil
 	}
 
-	d.structTypeToDecoder = map[uintptr]decoder{}
+	d.initCompile(0)
 	dec, err := d.compileHead(typ)
 	if err != nil {
 		return nil, err
@@ -21,9 +21,75 @@ func (d *Decoder) compileToGetDecoderSlowPath(typeptr uintptr, typ *rtype) (deco
 	return dec, nil
 }
 
+// decoderCacheKey identifies the decoder of a type compiled with decodeCompileFlags.
+type decoderCacheKey struct {
+	typeptr uintptr
+	flags   DecodeOptionFlag
+}
+
+func (d *Decoder) compileToGetDecoderWithFlags(typeptr uintptr, typ *rtype, flags DecodeOptionFlag) (decoder, error) {
+	key := decoderCacheKey{typeptr: typeptr, flags: flags}
+	decoderMap := loadFlaggedDecoderMap()
+	if dec, exists := decoderMap[key]; exists {
+		return dec, nil
+	}
+
+	d.initCompile(flags)
+	dec, err := d.compileHead(typ)
+	if err != nil {
+		return nil, err
+	}
+	storeFlaggedDecoder(key, dec, decoderMap)
+	return dec, nil
+}
+
+// compileToGetRegisteredDecoder compiles the decoders using the decoders registered on d
+// and caches them in d instead of the global cache.
+func (d *Decoder) compileToGetRegisteredDecoder(typeptr uintptr, typ *rtype, flags DecodeOptionFlag) (decoder, error) {
+	key := decoderCacheKey{typeptr: typeptr, flags: flags}
+	if dec, exists := d.decoders[key]; exists {
+		return dec, nil
+	}
+
+	d.initCompile(flags)
+	dec, err := d.compileHead(typ)
+	if err != nil {
+		return nil, err
+	}
+	d.decoders[key] = dec
+	return dec, nil
+}
+
+// initCompile resets the state of d for compiling the decoders of a type with flags.
+func (d *Decoder) initCompile(flags DecodeOptionFlag) {
+	d.structTypeToDecoder = map[uintptr]decoder{}
+	d.compileFlags = flags
+}
+
+// compiler returns a Decoder to compile the decoders of a type found while decoding with the decoders registered on d.
+// The compiled decoders may be cached and shared by concurrent decodes, so the state of a compile isn't kept in d.
+func (d *Decoder) compiler() *Decoder {
+	return &Decoder{
+		decoderFuncs: d.decoderFuncs,
+		decoders:     d.decoders,
+	}
+}
+
+// foldKey returns the alias which also matches the struct field of key k.
+// It is k itself if keys are matched case sensitively.
+func (d *Decoder) foldKey(k string) string {
+	if d.compileFlags&DecodeOptionCaseSensitive != 0 {
+		return k
+	}
+	return strings.ToLower(k)
+}
+
 func (d *Decoder) compileHead(typ *rtype) (decoder, error) {
+	if fn := d.decoderFunc(typ.Elem()); fn != nil {
+		return newCustomDecoder(typ, fn, "", ""), nil
+	}
 	switch {
-	case rtype_ptrTo(typ).Implements(unmarshalJSONType):
+	case implementsUnmarshalJSON(rtype_ptrTo(typ)):
 		return newUnmarshalJSONDecoder(rtype_ptrTo(typ), "", ""), nil
 	case rtype_ptrTo(typ).Implements(unmarshalTextType):
 		return newUnmarshalTextDecoder(rtype_ptrTo(typ), "", ""), nil
@@ -32,8 +98,11 @@ func (d *Decoder) compileHead(typ *rtype) (decoder, error) {
 }
 
 func (d *Decoder) compile(typ *rtype, structName, fieldName string) (decoder, error) {
+	if fn := d.decoderFunc(typ); fn != nil {
+		return newCustomDecoder(rtype_ptrTo(typ), fn, structName, fieldName), nil
+	}
 	switch {
-	case rtype_ptrTo(typ).Implements(unmarshalJSONType):
+	case implementsUnmarshalJSON(rtype_ptrTo(typ)):
 		return newUnmarshalJSONDecoder(rtype_ptrTo(typ), structName, fieldName), nil
 	case rtype_ptrTo(typ).Implements(unmarshalTextType):
 		return newUnmarshalTextDecoder(rtype_ptrTo(typ), structName, fieldName), nil
@@ -43,7 +112,16 @@ func (d *Decoder) compile(typ *rtype, structName, fieldName string) (decoder, er
 	case reflect.Ptr:
 		return d.compilePtr(typ, structName, fieldName)
 	case reflect.Struct:
-		return d.compileStruct(typ, structName, fieldName)
+		dec, err := d.compileStruct(typ, structName, fieldName)
+		if err != nil {
+			return nil, err
+		}
+		if fn := loadGeneratedDecoder(uintptr(unsafe.Pointer(typ))); fn != nil {
+			if structDec, ok := dec.(*structDecoder); ok && structDec.unknown == nil && !structDec.hasPromotedFields() {
+				return newGeneratedDecoder(rtype_ptrTo(typ), fn, structDec), nil
+			}
+		}
+		return dec, nil
 	case reflect.Slice:
 		elem := typ.Elem()
 		if elem.Kind() == reflect.Uint8 {
@@ -83,9 +161,9 @@ func (d *Decoder) compile(typ *rtype, structName, fieldName string) (decoder, er
 	case reflect.Bool:
 		return d.compileBool(structName, fieldName)
 	case reflect.Float32:
-		return d.compileFloat32(structName, fieldName)
+		return d.compileFloat32(typ, structName, fieldName)
 	case reflect.Float64:
-		return d.compileFloat64(structName, fieldName)
+		return d.compileFloat64(typ, structName, fieldName)
 	}
 	return nil, &UnmarshalTypeError{
 		Value:  "object",
@@ -190,14 +268,14 @@ func (d *Decoder) compileUint64(typ *rtype, structName, fieldName string) (decod
 	}), nil
 }
 
-func (d *Decoder) compileFloat32(structName, fieldName string) (decoder, error) {
-	return newFloatDecoder(structName, fieldName, func(p unsafe.Pointer, v float64) {
+func (d *Decoder) compileFloat32(typ *rtype, structName, fieldName string) (decoder, error) {
+	return newFloatDecoder(typ, structName, fieldName, func(p unsafe.Pointer, v float64) {
 		*(*float32)(p) = float32(v)
 	}), nil
 }
 
-func (d *Decoder) compileFloat64(structName, fieldName string) (decoder, error) {
-	return newFloatDecoder(structName, fieldName, func(p unsafe.Pointer, v float64) {
+func (d *Decoder) compileFloat64(typ *rtype, structName, fieldName string) (decoder, error) {
+	return newFloatDecoder(typ, structName, fieldName, func(p unsafe.Pointer, v float64) {
 		*(*float64)(p) = v
 	}), nil
 }
@@ -248,7 +326,9 @@ func (d *Decoder) compileInterface(typ *rtype, structName, fieldName string) (de
 	return newInterfaceDecoder(d, typ, structName, fieldName), nil
 }
 
-func (d *Decoder) removeConflictFields(fieldMap map[string]*structFieldSet, conflictedMap map[string]struct{}, dec *structDecoder, baseOffset uintptr) {
+func (d *Decoder) removeConflictFields(structDec *structDecoder, conflictedMap map[string]struct{}, dec *structDecoder, baseOffset uintptr) {
+	fieldMap := structDec.fieldMap
+	fieldIdxMap := structDec.promoteFields(dec)
 	for k, v := range dec.fieldMap {
 		if _, exists := conflictedMap[k]; exists {
 			// already conflicted key
@@ -262,9 +342,12 @@ func (d *Decoder) removeConflictFields(fieldMap map[string]*structFieldSet, conf
 				isTaggedKey: v.isTaggedKey,
 				key:         k,
 				keyLen:      int64(len(k)),
+				fieldIdx:    fieldIdxMap[v.fieldIdx],
+				isRequired:  v.isRequired,
+				isPtr:       v.isPtr,
 			}
 			fieldMap[k] = fieldSet
-			lower := strings.ToLower(k)
+			lower := d.foldKey(k)
 			if _, exists := fieldMap[lower]; !exists {
 				fieldMap[lower] = fieldSet
 			}
@@ -274,9 +357,9 @@ func (d *Decoder) removeConflictFields(fieldMap map[string]*structFieldSet, conf
 			if v.isTaggedKey {
 				// conflict tag key
 				delete(fieldMap, k)
-				delete(fieldMap, strings.ToLower(k))
+				delete(fieldMap, d.foldKey(k))
 				conflictedMap[k] = struct{}{}
-				conflictedMap[strings.ToLower(k)] = struct{}{}
+				conflictedMap[d.foldKey(k)] = struct{}{}
 			}
 		} else {
 			if v.isTaggedKey {
@@ -286,18 +369,21 @@ func (d *Decoder) removeConflictFields(fieldMap map[string]*structFieldSet, conf
 					isTaggedKey: v.isTaggedKey,
 					key:         k,
 					keyLen:      int64(len(k)),
+					fieldIdx:    fieldIdxMap[v.fieldIdx],
+					isRequired:  v.isRequired,
+					isPtr:       v.isPtr,
 				}
 				fieldMap[k] = fieldSet
-				lower := strings.ToLower(k)
+				lower := d.foldKey(k)
 				if _, exists := fieldMap[lower]; !exists {
 					fieldMap[lower] = fieldSet
 				}
 			} else {
 				// conflict tag key
 				delete(fieldMap, k)
-				delete(fieldMap, strings.ToLower(k))
+				delete(fieldMap, d.foldKey(k))
 				conflictedMap[k] = struct{}{}
-				conflictedMap[strings.ToLower(k)] = struct{}{}
+				conflictedMap[d.foldKey(k)] = struct{}{}
 			}
 		}
 	}
@@ -312,25 +398,50 @@ func (d *Decoder) compileStruct(typ *rtype, structName, fieldName string) (decod
 		return dec, nil
 	}
 	structDec := newStructDecoder(structName, fieldName, fieldMap)
+	structDec.isCaseSensitive = d.compileFlags&DecodeOptionCaseSensitive != 0
 	d.structTypeToDecoder[typeptr] = structDec
 	structName = typ.Name()
+	var (
+		unknownTag      *structTag
+		promotedUnknown *unknownFieldDecoder
+	)
 	for i := 0; i < fieldNum; i++ {
 		field := typ.Field(i)
 		if isIgnoredStructField(field) {
 			continue
 		}
-		tag := structTagFromField(field)
+		tag := structTagFromField(field, decodeKeyNaming(d.compileFlags))
+		if tag.isUnknown {
+			if err := checkUnknownField(rtype2type(typ), unknownTag, tag); err != nil {
+				return nil, err
+			}
+			unknownTag = tag
+			mapType := type2rtype(field.Type)
+			valueDec, err := d.compile(mapType.Elem(), structName, field.Name)
+			if err != nil {
+				return nil, err
+			}
+			structDec.unknown = newUnknownFieldDecoder(mapType, valueDec, field.Offset)
+			continue
+		}
 		dec, err := d.compile(type2rtype(field.Type), structName, field.Name)
 		if err != nil {
 			return nil, err
 		}
-		if field.Anonymous && !tag.isTaggedKey {
+		if tag.isPromoted() {
+			if genDec, ok := dec.(*generatedDecoder); ok {
+				// the fields are promoted to the decoder of typ
+				dec = genDec.dec
+			}
 			if stDec, ok := dec.(*structDecoder); ok {
 				if type2rtype(field.Type) == typ {
 					// recursive definition
 					continue
 				}
-				d.removeConflictFields(fieldMap, conflictedMap, stDec, field.Offset)
+				d.removeConflictFields(structDec, conflictedMap, stDec, field.Offset)
+				if promotedUnknown == nil && stDec.unknown != nil {
+					promotedUnknown = stDec.unknown.promote(nil, field.Offset)
+				}
 			} else if pdec, ok := dec.(*ptrDecoder); ok {
 				contentDec := pdec.contentDecoder()
 				if pdec.typ == typ {
@@ -338,6 +449,10 @@ func (d *Decoder) compileStruct(typ *rtype, structName, fieldName string) (decod
 					continue
 				}
 				if dec, ok := contentDec.(*structDecoder); ok {
+					if promotedUnknown == nil && dec.unknown != nil {
+						promotedUnknown = dec.unknown.promote(pdec.typ, field.Offset)
+					}
+					fieldIdxMap := structDec.promoteFields(dec)
 					for k, v := range dec.fieldMap {
 						if _, exists := conflictedMap[k]; exists {
 							// already conflicted key
@@ -351,9 +466,12 @@ func (d *Decoder) compileStruct(typ *rtype, structName, fieldName string) (decod
 								isTaggedKey: v.isTaggedKey,
 								key:         k,
 								keyLen:      int64(len(k)),
+								fieldIdx:    fieldIdxMap[v.fieldIdx],
+								isRequired:  v.isRequired,
+								isPtr:       v.isPtr,
 							}
 							fieldMap[k] = fieldSet
-							lower := strings.ToLower(k)
+							lower := d.foldKey(k)
 							if _, exists := fieldMap[lower]; !exists {
 								fieldMap[lower] = fieldSet
 							}
@@ -363,9 +481,9 @@ func (d *Decoder) compileStruct(typ *rtype, structName, fieldName string) (decod
 							if v.isTaggedKey {
 								// conflict tag key
 								delete(fieldMap, k)
-								delete(fieldMap, strings.ToLower(k))
+								delete(fieldMap, d.foldKey(k))
 								conflictedMap[k] = struct{}{}
-								conflictedMap[strings.ToLower(k)] = struct{}{}
+								conflictedMap[d.foldKey(k)] = struct{}{}
 							}
 						} else {
 							if v.isTaggedKey {
@@ -375,25 +493,28 @@ func (d *Decoder) compileStruct(typ *rtype, structName, fieldName string) (decod
 									isTaggedKey: v.isTaggedKey,
 									key:         k,
 									keyLen:      int64(len(k)),
+									fieldIdx:    fieldIdxMap[v.fieldIdx],
+									isRequired:  v.isRequired,
+									isPtr:       v.isPtr,
 								}
 								fieldMap[k] = fieldSet
-								lower := strings.ToLower(k)
+								lower := d.foldKey(k)
 								if _, exists := fieldMap[lower]; !exists {
 									fieldMap[lower] = fieldSet
 								}
 							} else {
 								// conflict tag key
 								delete(fieldMap, k)
-								delete(fieldMap, strings.ToLower(k))
+								delete(fieldMap, d.foldKey(k))
 								conflictedMap[k] = struct{}{}
-								conflictedMap[strings.ToLower(k)] = struct{}{}
+								conflictedMap[d.foldKey(k)] = struct{}{}
 							}
 						}
 					}
 				}
 			}
 		} else {
-			if tag.isString {
+			if tag.isString && decodeIsStringTagType(type2rtype(field.Type)) {
 				dec = newWrappedStringDecoder(dec, structName, field.Name)
 			}
 			var key string
@@ -408,15 +529,42 @@ func (d *Decoder) compileStruct(typ *rtype, structName, fieldName string) (decod
 				isTaggedKey: tag.isTaggedKey,
 				key:         key,
 				keyLen:      int64(len(key)),
+				fieldIdx:    len(structDec.fields),
+				isRequired:  tag.isRequired,
+				isPtr:       field.Type.Kind() == reflect.Ptr,
 			}
+			structDec.fields = append(structDec.fields, fieldSet)
 			fieldMap[key] = fieldSet
-			lower := strings.ToLower(key)
+			lower := d.foldKey(key)
 			if _, exists := fieldMap[lower]; !exists {
 				fieldMap[lower] = fieldSet
 			}
 		}
 	}
+	if structDec.unknown == nil {
+		// the members without a field are stored by an embedded struct unless the struct has its own field
+		structDec.unknown = promotedUnknown
+	}
 	delete(d.structTypeToDecoder, typeptr)
+	structDec.indexFields()
 	structDec.tryOptimize()
 	return structDec, nil
 }
+
+// decodeIsStringTagType reports whether the string option of a field of typ applies,
+// which is the case for the kinds of values encoded as JSON strings by the option.
+// Like encoding/json, the option is ignored for the other kinds.
+func decodeIsStringTagType(typ *rtype) bool {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	}
+	return false
+}
@@ -8,7 +8,14 @@ import (
 
 var decMu sync.RWMutex
 
-func (d *Decoder) compileToGetDecoder(typeptr uintptr, typ *rtype) (decoder, error) {
+func (d *Decoder) compileToGetDecoder(typeptr uintptr, typ *rtype, opt *DecodeOption) (decoder, error) {
+	flags := opt.Flags & decodeCompileFlags
+	if d.decoderFuncs != nil {
+		return d.compileToGetRegisteredDecoder(typeptr, typ, flags)
+	}
+	if flags != 0 {
+		return d.compileToGetDecoderWithFlags(typeptr, typ, flags)
+	}
 	if typeptr > maxTypeAddr {
 		return d.compileToGetDecoderSlowPath(typeptr, typ)
 	}
@@ -21,7 +28,7 @@ func (d *Decoder) compileToGetDecoder(typeptr uintptr, typ *rtype) (decoder, err
 	}
 	decMu.RUnlock()
 
-	d.structTypeToDecoder = map[uintptr]decoder{}
+	d.initCompile(0)
 	dec, err := d.compileHead(typ)
 	if err != nil {
 		return nil, err
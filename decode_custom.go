@@ -0,0 +1,68 @@
+package json
+
+import (
+	"unsafe"
+)
+
+// customDecoder calls the DecoderFunc registered for the element type of typ.
+type customDecoder struct {
+	typ        *rtype // pointer to the registered type
+	fn         DecoderFunc
+	structName string
+	fieldName  string
+}
+
+func newCustomDecoder(typ *rtype, fn DecoderFunc, structName, fieldName string) *customDecoder {
+	return &customDecoder{
+		typ:        typ,
+		fn:         fn,
+		structName: structName,
+		fieldName:  fieldName,
+	}
+}
+
+func (d *customDecoder) annotateError(cursor int64, err error) {
+	switch e := err.(type) {
+	case *UnmarshalTypeError:
+		e.Struct = d.structName
+		e.Field = d.fieldName
+	case *SyntaxError:
+		e.Offset = cursor
+	}
+}
+
+func (d *customDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
+	s.skipWhiteSpace()
+	start := s.cursor
+	if err := s.skipValue(depth, opt); err != nil {
+		return err
+	}
+	src := s.buf[start:s.cursor:s.cursor]
+	v := *(*interface{})(unsafe.Pointer(&interfaceHeader{
+		typ: d.typ,
+		ptr: p,
+	}))
+	if err := d.fn(src, v); err != nil {
+		d.annotateError(s.cursor, err)
+		return err
+	}
+	return nil
+}
+
+func (d *customDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
+	cursor = skipWhiteSpace(buf, cursor)
+	start := cursor
+	end, err := skipValue(buf, cursor, depth, opt)
+	if err != nil {
+		return 0, err
+	}
+	v := *(*interface{})(unsafe.Pointer(&interfaceHeader{
+		typ: d.typ,
+		ptr: p,
+	}))
+	if err := d.fn(buf[start:end:end], v); err != nil {
+		d.annotateError(cursor, err)
+		return 0, err
+	}
+	return end, nil
+}
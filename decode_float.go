@@ -1,18 +1,31 @@
 package json
 
 import (
+	"math"
 	"strconv"
 	"unsafe"
 )
 
 type floatDecoder struct {
+	typ        *rtype
 	op         func(unsafe.Pointer, float64)
 	structName string
 	fieldName  string
 }
 
-func newFloatDecoder(structName, fieldName string, op func(unsafe.Pointer, float64)) *floatDecoder {
-	return &floatDecoder{op: op, structName: structName, fieldName: fieldName}
+func newFloatDecoder(typ *rtype, structName, fieldName string, op func(unsafe.Pointer, float64)) *floatDecoder {
+	return &floatDecoder{typ: typ, op: op, structName: structName, fieldName: fieldName}
+}
+
+// nonFiniteError returns the error of a string other than "NaN", "Infinity" and "-Infinity" decoded into a float.
+func (d *floatDecoder) nonFiniteError(offset int64) *UnmarshalTypeError {
+	return &UnmarshalTypeError{
+		Value:  "string",
+		Type:   rtype2type(d.typ),
+		Struct: d.structName,
+		Field:  d.fieldName,
+		Offset: offset,
+	}
 }
 
 var (
@@ -109,7 +122,54 @@ func (d *floatDecoder) decodeByte(buf []byte, cursor int64) ([]byte, int64, erro
 	return nil, 0, errUnexpectedEndOfJSON("float", cursor)
 }
 
-func (d *floatDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+// parseNonFiniteFloat returns the value of the content of a string accepted by DecodeOptionNonFiniteFloatString.
+func parseNonFiniteFloat(b []byte) (float64, bool) {
+	switch string(b) {
+	case "NaN":
+		return math.NaN(), true
+	case "Infinity":
+		return math.Inf(1), true
+	case "-Infinity":
+		return math.Inf(-1), true
+	}
+	return 0, false
+}
+
+func (d *floatDecoder) decodeStreamNonFinite(s *stream, p unsafe.Pointer) error {
+	start := s.totalOffset()
+	bytes, err := stringBytes(s)
+	if err != nil {
+		return err
+	}
+	f64, ok := parseNonFiniteFloat(bytes)
+	if !ok {
+		return d.nonFiniteError(start)
+	}
+	d.op(p, f64)
+	return nil
+}
+
+func (d *floatDecoder) decodeNonFinite(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+	scanner := newBufferScanner(buf)
+	end, ok := scanner.scanString(cursor)
+	if !ok {
+		return 0, scanner.err()
+	}
+	f64, ok := parseNonFiniteFloat(buf[cursor+1 : end-1])
+	if !ok {
+		return 0, d.nonFiniteError(cursor)
+	}
+	d.op(p, f64)
+	return end, nil
+}
+
+func (d *floatDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
+	if opt.Flags&DecodeOptionNonFiniteFloatString != 0 {
+		s.skipWhiteSpace()
+		if s.char() == '"' {
+			return d.decodeStreamNonFinite(s, p)
+		}
+	}
 	bytes, err := d.decodeStreamByte(s)
 	if err != nil {
 		return err
@@ -123,7 +183,13 @@ func (d *floatDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	return nil
 }
 
-func (d *floatDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *floatDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
+	if opt.Flags&DecodeOptionNonFiniteFloatString != 0 {
+		cursor = skipWhiteSpace(buf, cursor)
+		if buf[cursor] == '"' {
+			return d.decodeNonFinite(buf, cursor, p)
+		}
+	}
 	bytes, c, err := d.decodeByte(buf, cursor)
 	if err != nil {
 		return 0, err
@@ -0,0 +1,72 @@
+package json
+
+import (
+	"unsafe"
+)
+
+// generatedDecoder calls the GeneratedDecoderFunc registered for a struct type.
+// The function reads the keys with the decoder compiled for the struct.
+type generatedDecoder struct {
+	typ *rtype // pointer to the registered type
+	fn  GeneratedDecoderFunc
+	dec *structDecoder
+}
+
+func newGeneratedDecoder(typ *rtype, fn GeneratedDecoderFunc, dec *structDecoder) *generatedDecoder {
+	return &generatedDecoder{
+		typ: typ,
+		fn:  fn,
+		dec: dec,
+	}
+}
+
+// hasPromotedFields reports whether some fields of d are promoted from embedded structs.
+// A DecodeContext finds the decoder of a field by its index in d.fields, where they have none.
+func (d *structDecoder) hasPromotedFields() bool {
+	for _, field := range d.fields {
+		if field.dec == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *generatedDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
+	s.skipWhiteSpace()
+	start := s.cursor
+	if err := s.skipValue(depth, opt); err == nil {
+		// the function decodes a copy of the value terminated like the input of Unmarshal,
+		// which the decoded strings may refer to
+		src := make([]byte, s.cursor-start+1)
+		copy(src, s.buf[start:s.cursor])
+		if _, err := d.decode(src, 0, depth, opt, p); err == nil {
+			return nil
+		}
+	}
+	// the struct decoder reads the invalid value again to return the errors of the stream
+	s.cursor = start
+	return d.dec.decodeStream(s, depth, opt, p)
+}
+
+func (d *generatedDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
+	// noescape trick for the context, which fn doesn't retain, so it stays on the stack.
+	// buf is set through the pointer since the values decoded by fn may refer to it
+	var ctx DecodeContext
+	ctxptr := uintptr(unsafe.Pointer(&ctx))
+	c := *(**DecodeContext)(unsafe.Pointer(&ctxptr))
+	c.buf = buf
+	c.cursor = cursor
+	c.depth = depth
+	c.opt = opt
+	c.dec = d.dec
+	c.field = -1
+	v := *(*interface{})(unsafe.Pointer(&interfaceHeader{
+		typ: d.typ,
+		ptr: p,
+	}))
+	if err := d.fn(c, v); err != nil {
+		return 0, err
+	}
+	cursor = c.cursor
+	return cursor, nil
+}
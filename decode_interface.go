@@ -22,13 +22,13 @@ func newInterfaceDecoder(dec *Decoder, typ *rtype, structName, fieldName string)
 	}
 }
 
-func (d *interfaceDecoder) numDecoder(s *stream) decoder {
-	if s.useNumber {
+func (d *interfaceDecoder) numDecoder(opt *DecodeOption) decoder {
+	if (opt.Flags & DecodeOptionUseNumber) != 0 {
 		return newNumberDecoder(d.structName, d.fieldName, func(p unsafe.Pointer, v Number) {
 			*(*interface{})(p) = v
 		})
 	}
-	return newFloatDecoder(d.structName, d.fieldName, func(p unsafe.Pointer, v float64) {
+	return newFloatDecoder(float64Type, d.structName, d.fieldName, func(p unsafe.Pointer, v float64) {
 		*(*interface{})(p) = v
 	})
 }
@@ -40,26 +40,29 @@ var (
 	stringType = type2rtype(
 		reflect.TypeOf(""),
 	)
+	float64Type = type2rtype(
+		reflect.TypeOf(float64(0)),
+	)
 )
 
-func decodeStreamUnmarshaler(s *stream, unmarshaler Unmarshaler) error {
+func decodeStreamUnmarshaler(s *stream, depth int64, opt *DecodeOption, unmarshaler interface{}) error {
 	start := s.cursor
-	if err := s.skipValue(); err != nil {
+	if err := s.skipValue(depth, opt); err != nil {
 		return err
 	}
 	src := s.buf[start:s.cursor]
 	dst := make([]byte, len(src))
 	copy(dst, src)
 
-	if err := unmarshaler.UnmarshalJSON(dst); err != nil {
+	if err := unmarshalJSON(opt, unmarshaler, dst); err != nil {
 		return err
 	}
 	return nil
 }
 
-func decodeStreamTextUnmarshaler(s *stream, unmarshaler encoding.TextUnmarshaler) error {
+func decodeStreamTextUnmarshaler(s *stream, depth int64, opt *DecodeOption, unmarshaler encoding.TextUnmarshaler) error {
 	start := s.cursor
-	if err := s.skipValue(); err != nil {
+	if err := s.skipValue(depth, opt); err != nil {
 		return err
 	}
 	src := s.buf[start:s.cursor]
@@ -72,7 +75,7 @@ func decodeStreamTextUnmarshaler(s *stream, unmarshaler encoding.TextUnmarshaler
 	return nil
 }
 
-func (d *interfaceDecoder) decodeStreamEmptyInterface(s *stream, p unsafe.Pointer) error {
+func (d *interfaceDecoder) decodeStreamEmptyInterface(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	s.skipWhiteSpace()
 	for {
 		switch s.char() {
@@ -87,7 +90,7 @@ func (d *interfaceDecoder) decodeStreamEmptyInterface(s *stream, p unsafe.Pointe
 				newInterfaceDecoder(d.dec, d.typ, d.structName, d.fieldName),
 				d.structName,
 				d.fieldName,
-			).decodeStream(s, ptr); err != nil {
+			).decodeStream(s, depth, opt, ptr); err != nil {
 				return err
 			}
 			*(*interface{})(p) = v
@@ -101,35 +104,20 @@ func (d *interfaceDecoder) decodeStreamEmptyInterface(s *stream, p unsafe.Pointe
 				d.typ.Size(),
 				d.structName,
 				d.fieldName,
-			).decodeStream(s, ptr); err != nil {
+			).decodeStream(s, depth, opt, ptr); err != nil {
 				return err
 			}
 			*(*interface{})(p) = v
 			return nil
 		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-			return d.numDecoder(s).decodeStream(s, p)
+			return d.numDecoder(opt).decodeStream(s, depth, opt, p)
 		case '"':
-			s.cursor++
-			start := s.cursor
-			for {
-				switch s.char() {
-				case '\\':
-					if err := decodeEscapeString(s); err != nil {
-						return err
-					}
-				case '"':
-					literal := s.buf[start:s.cursor]
-					s.cursor++
-					*(*interface{})(p) = string(literal)
-					return nil
-				case nul:
-					if s.read() {
-						continue
-					}
-					return errUnexpectedEndOfJSON("string", s.totalOffset())
-				}
-				s.cursor++
+			literal, err := limitedStringBytes(s, opt.MaxStringLength)
+			if err != nil {
+				return err
 			}
+			*(*interface{})(p) = string(literal)
+			return nil
 		case 't':
 			if err := trueBytes(s); err != nil {
 				return err
@@ -158,18 +146,21 @@ func (d *interfaceDecoder) decodeStreamEmptyInterface(s *stream, p unsafe.Pointe
 	return errNotAtBeginningOfValue(s.totalOffset())
 }
 
-func (d *interfaceDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *interfaceDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	runtimeInterfaceValue := *(*interface{})(unsafe.Pointer(&interfaceHeader{
 		typ: d.typ,
 		ptr: p,
 	}))
 	rv := reflect.ValueOf(runtimeInterfaceValue)
 	if rv.NumMethod() > 0 && rv.CanInterface() {
+		if u, ok := rv.Interface().(UnmarshalerContext); ok {
+			return decodeStreamUnmarshaler(s, depth, opt, u)
+		}
 		if u, ok := rv.Interface().(Unmarshaler); ok {
-			return decodeStreamUnmarshaler(s, u)
+			return decodeStreamUnmarshaler(s, depth, opt, u)
 		}
 		if u, ok := rv.Interface().(encoding.TextUnmarshaler); ok {
-			return decodeStreamTextUnmarshaler(s, u)
+			return decodeStreamTextUnmarshaler(s, depth, opt, u)
 		}
 		return nil
 	}
@@ -178,10 +169,10 @@ func (d *interfaceDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	typ := ifaceHeader.typ
 	if d.typ == typ || typ == nil {
 		// concrete type is empty interface
-		return d.decodeStreamEmptyInterface(s, p)
+		return d.decodeStreamEmptyInterface(s, depth, opt, p)
 	}
 	if typ.Kind() == reflect.Ptr && typ.Elem() == d.typ || typ.Kind() != reflect.Ptr {
-		return d.decodeStreamEmptyInterface(s, p)
+		return d.decodeStreamEmptyInterface(s, depth, opt, p)
 	}
 	if s.char() == 'n' {
 		if err := nullBytes(s); err != nil {
@@ -190,14 +181,14 @@ func (d *interfaceDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 		*(*interface{})(p) = nil
 		return nil
 	}
-	decoder, err := d.dec.compileToGetDecoder(uintptr(unsafe.Pointer(typ)), typ)
+	decoder, err := d.dec.compiler().compileToGetDecoder(uintptr(unsafe.Pointer(typ)), typ, opt)
 	if err != nil {
 		return err
 	}
-	return decoder.decodeStream(s, ifaceHeader.ptr)
+	return decoder.decodeStream(s, depth, opt, ifaceHeader.ptr)
 }
 
-func (d *interfaceDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *interfaceDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	runtimeInterfaceValue := *(*interface{})(unsafe.Pointer(&interfaceHeader{
 		typ: d.typ,
 		ptr: p,
@@ -208,10 +199,10 @@ func (d *interfaceDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (i
 	typ := ifaceHeader.typ
 	if d.typ == typ || typ == nil {
 		// concrete type is empty interface
-		return d.decodeEmptyInterface(buf, cursor, p)
+		return d.decodeEmptyInterface(buf, cursor, depth, opt, p)
 	}
 	if typ.Kind() == reflect.Ptr && typ.Elem() == d.typ || typ.Kind() != reflect.Ptr {
-		return d.decodeEmptyInterface(buf, cursor, p)
+		return d.decodeEmptyInterface(buf, cursor, depth, opt, p)
 	}
 	if buf[cursor] == 'n' {
 		if cursor+3 >= int64(len(buf)) {
@@ -230,14 +221,14 @@ func (d *interfaceDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (i
 		**(**interface{})(unsafe.Pointer(&p)) = nil
 		return cursor, nil
 	}
-	decoder, err := d.dec.compileToGetDecoder(uintptr(unsafe.Pointer(typ)), typ)
+	decoder, err := d.dec.compiler().compileToGetDecoder(uintptr(unsafe.Pointer(typ)), typ, opt)
 	if err != nil {
 		return 0, err
 	}
-	return decoder.decode(buf, cursor, ifaceHeader.ptr)
+	return decoder.decode(buf, cursor, depth, opt, ifaceHeader.ptr)
 }
 
-func (d *interfaceDecoder) decodeEmptyInterface(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *interfaceDecoder) decodeEmptyInterface(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	cursor = skipWhiteSpace(buf, cursor)
 	switch buf[cursor] {
 	case '{':
@@ -251,7 +242,7 @@ func (d *interfaceDecoder) decodeEmptyInterface(buf []byte, cursor int64, p unsa
 			newInterfaceDecoder(d.dec, d.typ, d.structName, d.fieldName),
 			d.structName, d.fieldName,
 		)
-		cursor, err := dec.decode(buf, cursor, ptr)
+		cursor, err := dec.decode(buf, cursor, depth, opt, ptr)
 		if err != nil {
 			return 0, err
 		}
@@ -266,16 +257,14 @@ func (d *interfaceDecoder) decodeEmptyInterface(buf []byte, cursor int64, p unsa
 			d.typ.Size(),
 			d.structName, d.fieldName,
 		)
-		cursor, err := dec.decode(buf, cursor, ptr)
+		cursor, err := dec.decode(buf, cursor, depth, opt, ptr)
 		if err != nil {
 			return 0, err
 		}
 		**(**interface{})(unsafe.Pointer(&p)) = v
 		return cursor, nil
 	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-		return newFloatDecoder(d.structName, d.fieldName, func(p unsafe.Pointer, v float64) {
-			*(*interface{})(p) = v
-		}).decode(buf, cursor, p)
+		return d.numDecoder(opt).decode(buf, cursor, depth, opt, p)
 	case '"':
 		cursor++
 		start := cursor
@@ -286,6 +275,9 @@ func (d *interfaceDecoder) decodeEmptyInterface(buf []byte, cursor int64, p unsa
 				continue
 			case '"':
 				literal := buf[start:cursor]
+				if err := opt.checkStringLength(len(literal), start); err != nil {
+					return 0, err
+				}
 				cursor++
 				**(**interface{})(unsafe.Pointer(&p)) = *(*string)(unsafe.Pointer(&literal))
 				return cursor, nil
@@ -1,6 +1,7 @@
 package json
 
 import (
+	"fmt"
 	"unsafe"
 )
 
@@ -33,17 +34,19 @@ func makemap(*rtype, int) unsafe.Pointer
 //go:noescape
 func mapassign(t *rtype, m unsafe.Pointer, key, val unsafe.Pointer)
 
-func (d *mapDecoder) setKey(buf []byte, cursor int64, key interface{}) (int64, error) {
+func (d *mapDecoder) setKey(buf []byte, cursor, depth int64, opt *DecodeOption, key interface{}) (int64, error) {
 	header := (*interfaceHeader)(unsafe.Pointer(&key))
-	return d.keyDecoder.decode(buf, cursor, header.ptr)
+	return d.keyDecoder.decode(buf, cursor, depth, opt, header.ptr)
 }
 
-func (d *mapDecoder) setValue(buf []byte, cursor int64, key interface{}) (int64, error) {
-	header := (*interfaceHeader)(unsafe.Pointer(&key))
-	return d.valueDecoder.decode(buf, cursor, header.ptr)
+func (d *mapDecoder) keyString(k unsafe.Pointer) string {
+	return fmt.Sprint(*(*interface{})(unsafe.Pointer(&interfaceHeader{
+		typ: d.keyType,
+		ptr: k,
+	})))
 }
 
-func (d *mapDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *mapDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	s.skipWhiteSpace()
 	switch s.char() {
 	case 'n':
@@ -55,6 +58,10 @@ func (d *mapDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	default:
 		return errExpected("{ character for map value", s.totalOffset())
 	}
+	depth++
+	if err := opt.checkDepth(depth, s.totalOffset()); err != nil {
+		return err
+	}
 	s.skipWhiteSpace()
 	mapValue := makemap(d.mapType, 0)
 	if s.buf[s.cursor+1] == '}' {
@@ -62,10 +69,14 @@ func (d *mapDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 		s.cursor += 2
 		return nil
 	}
-	for {
+	var missing *MissingFieldsError
+	for n := 1; ; n++ {
+		if err := opt.checkElements(n, s.totalOffset()); err != nil {
+			return err
+		}
 		s.cursor++
 		k := unsafe_New(d.keyType)
-		if err := d.keyDecoder.decodeStream(s, k); err != nil {
+		if err := d.keyDecoder.decodeStream(s, depth, opt, k); err != nil {
 			return err
 		}
 		s.skipWhiteSpace()
@@ -77,8 +88,10 @@ func (d *mapDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 		}
 		s.cursor++
 		v := unsafe_New(d.valueType)
-		if err := d.valueDecoder.decodeStream(s, v); err != nil {
-			return err
+		if err := d.valueDecoder.decodeStream(s, depth, opt, v); err != nil {
+			if missing, err = collectMissingFields(missing, err, d.keyString(k)); err != nil {
+				return annotateErrorPath(err, d.keyString(k))
+			}
 		}
 		mapassign(d.mapType, mapValue, k, v)
 		s.skipWhiteSpace()
@@ -88,7 +101,7 @@ func (d *mapDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 		if s.char() == '}' {
 			**(**unsafe.Pointer)(unsafe.Pointer(&p)) = mapValue
 			s.cursor++
-			return nil
+			return missingFieldsError(missing)
 		}
 		if s.char() != ',' {
 			return errExpected("comma after object value", s.totalOffset())
@@ -96,7 +109,7 @@ func (d *mapDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	}
 }
 
-func (d *mapDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *mapDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	cursor = skipWhiteSpace(buf, cursor)
 	buflen := int64(len(buf))
 	if buflen < 2 {
@@ -122,6 +135,10 @@ func (d *mapDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64,
 	default:
 		return 0, errExpected("{ character for map value", cursor)
 	}
+	depth++
+	if err := opt.checkDepth(depth, cursor); err != nil {
+		return 0, err
+	}
 	cursor++
 	cursor = skipWhiteSpace(buf, cursor)
 	mapValue := makemap(d.mapType, 0)
@@ -130,9 +147,13 @@ func (d *mapDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64,
 		cursor++
 		return cursor, nil
 	}
-	for ; cursor < buflen; cursor++ {
+	var missing *MissingFieldsError
+	for n := 1; cursor < buflen; n, cursor = n+1, cursor+1 {
+		if err := opt.checkElements(n, cursor); err != nil {
+			return 0, err
+		}
 		var key interface{}
-		keyCursor, err := d.setKey(buf, cursor, &key)
+		keyCursor, err := d.setKey(buf, cursor, depth, opt, &key)
 		if err != nil {
 			return 0, err
 		}
@@ -145,17 +166,19 @@ func (d *mapDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64,
 		if cursor >= buflen {
 			return 0, errUnexpectedEndOfJSON("map", cursor)
 		}
-		var value interface{}
-		valueCursor, err := d.setValue(buf, cursor, &value)
+		v := unsafe_New(d.valueType)
+		valueCursor, err := d.valueDecoder.decode(buf, cursor, depth, opt, v)
 		if err != nil {
-			return 0, err
+			if missing, err = collectMissingFields(missing, err, d.keyString(unsafe.Pointer(&key))); err != nil {
+				return 0, annotateErrorPath(err, d.keyString(unsafe.Pointer(&key)))
+			}
 		}
-		mapassign(d.mapType, mapValue, unsafe.Pointer(&key), unsafe.Pointer(&value))
+		mapassign(d.mapType, mapValue, unsafe.Pointer(&key), v)
 		cursor = skipWhiteSpace(buf, valueCursor)
 		if buf[cursor] == '}' {
 			**(**unsafe.Pointer)(unsafe.Pointer(&p)) = mapValue
 			cursor++
-			return cursor, nil
+			return cursor, missingFieldsError(missing)
 		}
 		if buf[cursor] != ',' {
 			return 0, errExpected("comma after object value", cursor)
@@ -13,14 +13,14 @@ type numberDecoder struct {
 
 func newNumberDecoder(structName, fieldName string, op func(unsafe.Pointer, Number)) *numberDecoder {
 	return &numberDecoder{
-		floatDecoder: newFloatDecoder(structName, fieldName, nil),
+		floatDecoder: newFloatDecoder(float64Type, structName, fieldName, nil),
 		op:           op,
 		structName:   structName,
 		fieldName:    fieldName,
 	}
 }
 
-func (d *numberDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *numberDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	bytes, err := d.floatDecoder.decodeStreamByte(s)
 	if err != nil {
 		return err
@@ -30,7 +30,7 @@ func (d *numberDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	return nil
 }
 
-func (d *numberDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *numberDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	bytes, c, err := d.floatDecoder.decodeByte(buf, cursor)
 	if err != nil {
 		return 0, err
@@ -0,0 +1,309 @@
+package json
+
+import (
+	"strconv"
+	"strings"
+)
+
+var (
+	pathKeyDecoder       = newStringDecoder("", "")
+	jsonPointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// parseJSONPointer splits path written as a JSON Pointer ( RFC 6901 ) into unescaped reference tokens.
+func parseJSONPointer(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if path[0] != '/' {
+		return nil, &PathError{Path: path, msg: "invalid JSON Pointer"}
+	}
+	tokens := strings.Split(path[1:], "/")
+	for i, token := range tokens {
+		if strings.IndexByte(token, '~') < 0 {
+			continue
+		}
+		for j := 0; j < len(token); j++ {
+			if token[j] != '~' {
+				continue
+			}
+			if j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1') {
+				return nil, &PathError{Path: path, msg: "invalid JSON Pointer"}
+			}
+		}
+		tokens[i] = jsonPointerUnescaper.Replace(token)
+	}
+	return tokens, nil
+}
+
+// parseJSONPointerIndex returns the array index referenced by token.
+func parseJSONPointerIndex(token string) (int, bool) {
+	if len(token) > 1 && token[0] == '0' {
+		return 0, false
+	}
+	for i := 0; i < len(token); i++ {
+		if token[i] < '0' || '9' < token[i] {
+			return 0, false
+		}
+	}
+	idx, err := strconv.Atoi(token)
+	return idx, err == nil
+}
+
+// annotateErrorPointer prepends the reference tokens of a JSON Pointer to the path of err.
+func annotateErrorPointer(err error, tokens []string) error {
+	for i := len(tokens) - 1; i >= 0; i-- {
+		err = annotateErrorPath(err, tokens[i])
+	}
+	return err
+}
+
+func errPathNotFound(path string, cursor int64) *PathError {
+	return &PathError{Path: path, Offset: cursor, msg: "value not found"}
+}
+
+// seekPath returns the cursor of the value addressed by tokens.
+func seekPath(buf []byte, cursor int64, tokens []string, path string, opt *DecodeOption) (int64, error) {
+	for i, token := range tokens {
+		cursor = skipWhiteSpace(buf, cursor)
+		depth := int64(i + 1)
+		switch buf[cursor] {
+		case '{', '[':
+			if err := opt.checkDepth(depth, cursor); err != nil {
+				return 0, err
+			}
+		}
+		switch buf[cursor] {
+		case '{':
+			c, err := seekObjectKey(buf, cursor, depth, opt, token, path)
+			if err != nil {
+				return 0, err
+			}
+			cursor = c
+		case '[':
+			idx, ok := parseJSONPointerIndex(token)
+			if !ok {
+				return 0, errPathNotFound(path, cursor)
+			}
+			c, err := seekArrayIndex(buf, cursor, depth, opt, idx, path)
+			if err != nil {
+				return 0, err
+			}
+			cursor = c
+		case nul:
+			return 0, errUnexpectedEndOfJSON("value", cursor)
+		default:
+			return 0, errPathNotFound(path, cursor)
+		}
+	}
+	return cursor, nil
+}
+
+func seekObjectKey(buf []byte, cursor, depth int64, opt *DecodeOption, token, path string) (int64, error) {
+	start := cursor
+	cursor = skipWhiteSpace(buf, cursor+1)
+	if buf[cursor] == '}' {
+		return 0, errPathNotFound(path, start)
+	}
+	for {
+		key, c, err := pathKeyDecoder.decodeByte(buf, cursor)
+		if err != nil {
+			return 0, err
+		}
+		cursor = skipWhiteSpace(buf, c)
+		if buf[cursor] != ':' {
+			return 0, errExpected("colon after object key", cursor)
+		}
+		cursor++
+		if string(key) == token {
+			return cursor, nil
+		}
+		c, err = skipValue(buf, cursor, depth, opt)
+		if err != nil {
+			return 0, err
+		}
+		cursor = skipWhiteSpace(buf, c)
+		switch buf[cursor] {
+		case ',':
+			cursor++
+		case '}':
+			return 0, errPathNotFound(path, start)
+		default:
+			return 0, errExpected("comma after object element", cursor)
+		}
+	}
+}
+
+func seekArrayIndex(buf []byte, cursor, depth int64, opt *DecodeOption, idx int, path string) (int64, error) {
+	start := cursor
+	cursor = skipWhiteSpace(buf, cursor+1)
+	if buf[cursor] == ']' {
+		return 0, errPathNotFound(path, start)
+	}
+	for i := 0; i < idx; i++ {
+		c, err := skipValue(buf, cursor, depth, opt)
+		if err != nil {
+			return 0, err
+		}
+		cursor = skipWhiteSpace(buf, c)
+		switch buf[cursor] {
+		case ',':
+			cursor++
+		case ']':
+			return 0, errPathNotFound(path, start)
+		default:
+			return 0, errExpected("comma after array element", cursor)
+		}
+	}
+	return cursor, nil
+}
+
+// seekPath moves the cursor to the value addressed by tokens.
+// It returns the kinds of the containers entered on the way ( '{' or '[' ),
+// which are needed to skip the rest of the input value by unwindPath.
+func (s *stream) seekPath(tokens []string, path string, opt *DecodeOption) ([]byte, error) {
+	containers := make([]byte, 0, len(tokens))
+	for i, token := range tokens {
+		s.skipWhiteSpace()
+		depth := int64(i + 1)
+		c := s.char()
+		switch c {
+		case '{', '[':
+			if err := opt.checkDepth(depth, s.totalOffset()); err != nil {
+				return containers, err
+			}
+		}
+		switch c {
+		case '{':
+			if err := s.seekObjectKey(depth, opt, token, path); err != nil {
+				return containers, err
+			}
+		case '[':
+			idx, ok := parseJSONPointerIndex(token)
+			if !ok {
+				return containers, errPathNotFound(path, s.totalOffset())
+			}
+			if err := s.seekArrayIndex(depth, opt, idx, path); err != nil {
+				return containers, err
+			}
+		case nul:
+			return containers, errUnexpectedEndOfJSON("value", s.totalOffset())
+		default:
+			return containers, errPathNotFound(path, s.totalOffset())
+		}
+		containers = append(containers, c)
+	}
+	return containers, nil
+}
+
+func (s *stream) seekObjectKey(depth int64, opt *DecodeOption, token, path string) error {
+	start := s.totalOffset()
+	s.cursor++
+	s.skipWhiteSpace()
+	if s.char() == '}' {
+		return errPathNotFound(path, start)
+	}
+	for {
+		s.reset()
+		key, err := pathKeyDecoder.decodeStreamByte(s)
+		if err != nil {
+			return err
+		}
+		found := string(key) == token
+		s.skipWhiteSpace()
+		if s.char() != ':' {
+			return errExpected("colon after object key", s.totalOffset())
+		}
+		s.cursor++
+		if found {
+			return nil
+		}
+		if err := s.skipValue(depth, opt); err != nil {
+			return err
+		}
+		s.skipWhiteSpace()
+		switch s.char() {
+		case ',':
+			s.cursor++
+		case '}':
+			return errPathNotFound(path, start)
+		default:
+			return errExpected("comma after object element", s.totalOffset())
+		}
+	}
+}
+
+func (s *stream) seekArrayIndex(depth int64, opt *DecodeOption, idx int, path string) error {
+	start := s.totalOffset()
+	s.cursor++
+	s.skipWhiteSpace()
+	if s.char() == ']' {
+		return errPathNotFound(path, start)
+	}
+	for i := 0; i < idx; i++ {
+		s.reset()
+		if err := s.skipValue(depth, opt); err != nil {
+			return err
+		}
+		s.skipWhiteSpace()
+		switch s.char() {
+		case ',':
+			s.cursor++
+		case ']':
+			return errPathNotFound(path, start)
+		default:
+			return errExpected("comma after array element", s.totalOffset())
+		}
+	}
+	return nil
+}
+
+// skipPathValue skips the rest of the input value after seekPath did not find the addressed value.
+// The cursor is either at the end of the container searched last or at the value which could not be entered.
+func (s *stream) skipPathValue(containers []byte, opt *DecodeOption) error {
+	s.skipWhiteSpace()
+	switch s.char() {
+	case '}', ']':
+		s.cursor++
+	default:
+		if err := s.skipValue(int64(len(containers)), opt); err != nil {
+			return err
+		}
+	}
+	return s.unwindPath(containers, opt)
+}
+
+// unwindPath skips the rest of the containers entered by seekPath
+// so that the stream is positioned after the whole input value.
+func (s *stream) unwindPath(containers []byte, opt *DecodeOption) error {
+	for i := len(containers) - 1; i >= 0; i-- {
+		isObject := containers[i] == '{'
+		for {
+			s.skipWhiteSpace()
+			c := s.char()
+			if (isObject && c == '}') || (!isObject && c == ']') {
+				s.cursor++
+				break
+			}
+			if c != ',' {
+				return errExpected("comma after element", s.totalOffset())
+			}
+			s.cursor++
+			if isObject {
+				if _, err := pathKeyDecoder.decodeStreamByte(s); err != nil {
+					return err
+				}
+				s.skipWhiteSpace()
+				if s.char() != ':' {
+					return errExpected("colon after object key", s.totalOffset())
+				}
+				s.cursor++
+			}
+			if err := s.skipValue(int64(i+1), opt); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -21,18 +21,20 @@ func newPtrDecoder(dec decoder, typ *rtype, structName, fieldName string) *ptrDe
 }
 
 func (d *ptrDecoder) contentDecoder() decoder {
-	dec, ok := d.dec.(*ptrDecoder)
-	if !ok {
-		return d.dec
+	switch dec := d.dec.(type) {
+	case *ptrDecoder:
+		return dec.contentDecoder()
+	case *generatedDecoder:
+		return dec.dec
 	}
-	return dec.contentDecoder()
+	return d.dec
 }
 
 //nolint:golint
 //go:linkname unsafe_New reflect.unsafe_New
 func unsafe_New(*rtype) unsafe.Pointer
 
-func (d *ptrDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *ptrDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	s.skipWhiteSpace()
 	if s.char() == nul {
 		s.read()
@@ -46,13 +48,13 @@ func (d *ptrDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	}
 	newptr := unsafe_New(d.typ)
 	*(*unsafe.Pointer)(p) = newptr
-	if err := d.dec.decodeStream(s, newptr); err != nil {
+	if err := d.dec.decodeStream(s, depth, opt, newptr); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (d *ptrDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *ptrDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	cursor = skipWhiteSpace(buf, cursor)
 	if buf[cursor] == 'n' {
 		buflen := int64(len(buf))
@@ -76,9 +78,10 @@ func (d *ptrDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64,
 	}
 	newptr := unsafe_New(d.typ)
 	*(*unsafe.Pointer)(p) = newptr
-	c, err := d.dec.decode(buf, cursor, newptr)
+	c, err := d.dec.decode(buf, cursor, depth, opt, newptr)
 	if err != nil {
-		return 0, err
+		// keep the cursor, the value is consumed if err is a MissingFieldsError
+		return c, err
 	}
 	cursor = c
 	return cursor, nil
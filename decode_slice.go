@@ -2,6 +2,7 @@ package json
 
 import (
 	"reflect"
+	"strconv"
 	"sync"
 	"unsafe"
 )
@@ -73,7 +74,7 @@ func (d *sliceDecoder) errNumber(offset int64) *UnmarshalTypeError {
 	}
 }
 
-func (d *sliceDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *sliceDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	for {
 		switch s.char() {
 		case ' ', '\n', '\t', '\r':
@@ -85,6 +86,10 @@ func (d *sliceDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 			}
 			return nil
 		case '[':
+			depth++
+			if err := opt.checkDepth(depth, s.totalOffset()); err != nil {
+				return err
+			}
 			s.cursor++
 			s.skipWhiteSpace()
 			if s.char() == ']' {
@@ -96,6 +101,7 @@ func (d *sliceDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 				s.cursor++
 				return nil
 			}
+			var missing *MissingFieldsError
 			idx := 0
 			slice := d.newSlice()
 			capacity := slice.cap
@@ -108,9 +114,17 @@ func (d *sliceDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 					dst := sliceHeader{data: data, len: idx, cap: capacity}
 					copySlice(d.elemType, dst, src)
 				}
-				if err := d.valueDecoder.decodeStream(s, unsafe.Pointer(uintptr(data)+uintptr(idx)*d.size)); err != nil {
+				if err := opt.checkElements(idx+1, s.totalOffset()); err != nil {
+					slice.cap = capacity
+					slice.data = data
+					d.releaseSlice(slice)
 					return err
 				}
+				if err := d.valueDecoder.decodeStream(s, depth, opt, unsafe.Pointer(uintptr(data)+uintptr(idx)*d.size)); err != nil {
+					if missing, err = collectMissingFields(missing, err, strconv.Itoa(idx)); err != nil {
+						return annotateErrorPath(err, strconv.Itoa(idx))
+					}
+				}
 				s.skipWhiteSpace()
 			RETRY:
 				switch s.char() {
@@ -132,7 +146,7 @@ func (d *sliceDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 					*(*sliceHeader)(p) = dst
 					d.releaseSlice(slice)
 					s.cursor++
-					return nil
+					return missingFieldsError(missing)
 				case ',':
 					idx++
 				case nul:
@@ -166,7 +180,7 @@ ERROR:
 	return errUnexpectedEndOfJSON("slice", s.totalOffset())
 }
 
-func (d *sliceDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *sliceDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	buflen := int64(len(buf))
 	for ; cursor < buflen; cursor++ {
 		switch buf[cursor] {
@@ -189,6 +203,10 @@ func (d *sliceDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64
 			cursor += 4
 			return cursor, nil
 		case '[':
+			depth++
+			if err := opt.checkDepth(depth, cursor); err != nil {
+				return 0, err
+			}
 			cursor++
 			cursor = skipWhiteSpace(buf, cursor)
 			if buf[cursor] == ']' {
@@ -200,6 +218,7 @@ func (d *sliceDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64
 				cursor++
 				return cursor, nil
 			}
+			var missing *MissingFieldsError
 			idx := 0
 			slice := d.newSlice()
 			capacity := slice.cap
@@ -212,10 +231,18 @@ func (d *sliceDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64
 					dst := sliceHeader{data: data, len: idx, cap: capacity}
 					copySlice(d.elemType, dst, src)
 				}
-				c, err := d.valueDecoder.decode(buf, cursor, unsafe.Pointer(uintptr(data)+uintptr(idx)*d.size))
-				if err != nil {
+				if err := opt.checkElements(idx+1, cursor); err != nil {
+					slice.cap = capacity
+					slice.data = data
+					d.releaseSlice(slice)
 					return 0, err
 				}
+				c, err := d.valueDecoder.decode(buf, cursor, depth, opt, unsafe.Pointer(uintptr(data)+uintptr(idx)*d.size))
+				if err != nil {
+					if missing, err = collectMissingFields(missing, err, strconv.Itoa(idx)); err != nil {
+						return 0, annotateErrorPath(err, strconv.Itoa(idx))
+					}
+				}
 				cursor = c
 				cursor = skipWhiteSpace(buf, cursor)
 				switch buf[cursor] {
@@ -237,7 +264,7 @@ func (d *sliceDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64
 					**(**sliceHeader)(unsafe.Pointer(&p)) = dst
 					d.releaseSlice(slice)
 					cursor++
-					return cursor, nil
+					return cursor, missingFieldsError(missing)
 				case ',':
 					idx++
 				default:
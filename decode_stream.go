@@ -2,7 +2,9 @@ package json
 
 import (
 	"bytes"
+	"encoding/binary"
 	"io"
+	"math/bits"
 )
 
 const (
@@ -10,15 +12,18 @@ const (
 )
 
 type stream struct {
-	buf                   []byte
-	bufSize               int64
-	length                int64
-	r                     io.Reader
-	offset                int64
-	cursor                int64
-	allRead               bool
-	useNumber             bool
-	disallowUnknownFields bool
+	buf     []byte
+	bufSize int64
+	length  int64
+	r       io.Reader
+	offset  int64
+	cursor  int64
+	allRead bool
+
+	// inputStart and maxInputBytes bound the bytes read for the current value.
+	inputStart    int64
+	maxInputBytes int64
+	limitErr      error
 }
 
 func newStream(r io.Reader) *stream {
@@ -58,6 +63,12 @@ func (s *stream) reset() {
 	s.length = int64(len(s.buf))
 }
 
+func (s *stream) setInputLimit(max int64) {
+	s.inputStart = s.totalOffset()
+	s.maxInputBytes = max
+	s.limitErr = nil
+}
+
 func (s *stream) readBuf() []byte {
 	s.bufSize *= 2
 	remainBuf := s.buf
@@ -73,6 +84,17 @@ func (s *stream) read() bool {
 	buf := s.readBuf()
 	last := len(buf) - 1
 	buf[last] = nul
+	if s.maxInputBytes > 0 {
+		// allow reading one byte more than the limit to detect a value exceeding it
+		remain := s.inputStart + s.maxInputBytes + 1 - s.totalOffset()
+		if remain <= 0 {
+			s.limitErr = errExceededLimit("input bytes", s.maxInputBytes, s.inputStart+s.maxInputBytes)
+			return false
+		}
+		if remain < int64(last) {
+			last = int(remain)
+		}
+	}
 	n, err := s.r.Read(buf[:last])
 	s.length = s.cursor + int64(n)
 	if n < last || err == io.EOF {
@@ -96,107 +118,261 @@ LOOP:
 	}
 }
 
-func (s *stream) skipValue() error {
+// skipValue validates the value at the cursor, which is nested at depth, and moves the cursor after it.
+// Like decoding the value, it fails if the value nests arrays or objects deeper than opt.MaxDepth.
+func (s *stream) skipValue(depth int64, opt *DecodeOption) error {
+	var (
+		stackBuf   [scannerStackSize]byte
+		stack      = stackBuf[:0] // opening brackets of the arrays and the objects being skipped
+		membersBuf [scannerStackSize]int64
+		members    = membersBuf[:0] // current element of each array and position of the current key of each object in stack
+	)
 	s.skipWhiteSpace()
-	braceCount := 0
-	bracketCount := 0
-	start := s.cursor
-	for {
+VALUE:
+	switch s.char() {
+	case '{':
+		if err := opt.checkDepth(depth+int64(len(stack))+1, s.totalOffset()); err != nil {
+			return s.skippedValueError(err, stack, members)
+		}
+		s.cursor++
+		s.skipWhiteSpace()
+		if s.char() == '}' {
+			s.cursor++
+			goto END_VALUE
+		}
+		stack = append(stack, '{')
+		members = append(members, -1)
+		goto KEY
+	case '[':
+		if err := opt.checkDepth(depth+int64(len(stack))+1, s.totalOffset()); err != nil {
+			return s.skippedValueError(err, stack, members)
+		}
+		s.cursor++
+		s.skipWhiteSpace()
+		if s.char() == ']' {
+			s.cursor++
+			goto END_VALUE
+		}
+		stack = append(stack, '[')
+		members = append(members, 0)
+		goto VALUE
+	case '"':
+		if err := s.skipString(opt.MaxStringLength); err != nil {
+			return s.skippedValueError(err, stack, members)
+		}
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		if err := s.skipNumber(); err != nil {
+			return err
+		}
+	case 't':
+		if err := s.skipLiteral("true"); err != nil {
+			return err
+		}
+	case 'f':
+		if err := s.skipLiteral("false"); err != nil {
+			return err
+		}
+	case 'n':
+		if err := s.skipLiteral("null"); err != nil {
+			return err
+		}
+	default:
+		return s.syntaxError("looking for beginning of value")
+	}
+END_VALUE:
+	if len(stack) == 0 {
+		return nil
+	}
+	s.skipWhiteSpace()
+	if stack[len(stack)-1] == '{' {
 		switch s.char() {
-		case nul:
-			if s.read() {
-				continue
-			}
-			if start == s.cursor {
-				return errUnexpectedEndOfJSON("value of object", s.totalOffset())
-			}
-			if braceCount == 0 && bracketCount == 0 {
-				return nil
-			}
-			return errUnexpectedEndOfJSON("value of object", s.totalOffset())
-		case '{':
-			braceCount++
-		case '[':
-			bracketCount++
-		case '}':
-			braceCount--
-			if braceCount == -1 && bracketCount == 0 {
-				return nil
-			}
-		case ']':
-			bracketCount--
-			if braceCount == 0 && bracketCount == -1 {
-				return nil
-			}
 		case ',':
-			if bracketCount == 0 && braceCount == 0 {
-				return nil
+			s.cursor++
+			s.skipWhiteSpace()
+			goto KEY
+		case '}':
+			stack = stack[:len(stack)-1]
+			members = members[:len(members)-1]
+			s.cursor++
+			goto END_VALUE
+		}
+		return s.syntaxError("after object key:value pair")
+	}
+	switch s.char() {
+	case ',':
+		members[len(members)-1]++
+		s.cursor++
+		s.skipWhiteSpace()
+		goto VALUE
+	case ']':
+		stack = stack[:len(stack)-1]
+		members = members[:len(members)-1]
+		s.cursor++
+		goto END_VALUE
+	}
+	return s.syntaxError("after array element")
+KEY:
+	if s.char() != '"' {
+		return s.syntaxError("looking for beginning of object key string")
+	}
+	members[len(members)-1] = -1
+	keyStart := s.cursor
+	if err := s.skipString(opt.MaxStringLength); err != nil {
+		return s.skippedValueError(err, stack, members)
+	}
+	// like the struct decoder, the length of a key is the one in the input
+	if err := opt.checkStringLength(int(s.cursor-keyStart)-2, s.offset+keyStart); err != nil {
+		return s.skippedValueError(err, stack, members)
+	}
+	members[len(members)-1] = keyStart
+	s.skipWhiteSpace()
+	if s.char() != ':' {
+		return s.syntaxError("after object key")
+	}
+	s.cursor++
+	s.skipWhiteSpace()
+	goto VALUE
+}
+
+// skippedValueError sets the path of the value being skipped inside stack to err if it's a LimitError.
+func (s *stream) skippedValueError(err error, stack []byte, members []int64) error {
+	if e, ok := err.(*LimitError); ok {
+		e.Path = skippedValuePath(s.buf[:s.length], stack, members)
+	}
+	return err
+}
+
+// peek returns the character at the cursor, reading more data at the end of the buffer.
+// It returns nul at the end of the input.
+func (s *stream) peek() byte {
+	c := s.char()
+	if c == nul && s.read() {
+		c = s.char()
+	}
+	return c
+}
+
+// syntaxError returns the SyntaxError of the character at the cursor in the format of the scanner.
+func (s *stream) syntaxError(context string) error {
+	c := s.peek()
+	if c == nul {
+		return errSyntaxEnd(s.totalOffset())
+	}
+	return errSyntax(c, context, s.totalOffset())
+}
+
+// skipString skips the string at the cursor.
+// It fails with a LimitError as soon as the string is unescaped to more than maxLength bytes, unless maxLength is zero.
+func (s *stream) skipString(maxLength int64) error {
+	var (
+		start = s.cursor
+		saved int64 // bytes saved by unescaping the escape sequences
+	)
+	s.cursor++
+	for {
+		// skip 8 bytes at a time up to the first quote, backslash or control character.
+		// the buffer has a nul after the read data, which stops the scan
+		for s.cursor+8 <= s.length {
+			if m := stringStopMask(binary.LittleEndian.Uint64(s.buf[s.cursor:])); m != 0 {
+				s.cursor += int64(bits.TrailingZeros64(m) >> 3)
+				goto STOP
 			}
+			s.cursor += 8
+		}
+		for !isStringStop[s.buf[s.cursor]] {
+			s.cursor++
+		}
+	STOP:
+		switch s.char() {
 		case '"':
-			for {
-				s.cursor++
-				c := s.char()
-				if c == nul {
-					if !s.read() {
-						return errUnexpectedEndOfJSON("value of string", s.totalOffset())
-					}
-					c = s.char()
-				}
-				if c != '"' {
-					continue
-				}
-				if s.prevChar() == '\\' {
-					continue
-				}
-				if bracketCount == 0 && braceCount == 0 {
-					s.cursor++
-					return nil
-				}
-				break
+			if maxLength > 0 && s.cursor-start-1-saved > maxLength {
+				return errExceededLimit("string length", maxLength, s.offset+start)
 			}
-		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-			for {
-				s.cursor++
-				c := s.char()
-				if floatTable[c] {
-					continue
-				} else if c == nul {
-					if s.read() {
-						s.cursor-- // for retry current character
-						continue
+			s.cursor++
+			return nil
+		case '\\':
+			s.cursor++
+			switch s.peek() {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				saved++
+			case 'u':
+				for i := 0; i < 4; i++ {
+					s.cursor++
+					if !isHexChar(s.peek()) {
+						return s.syntaxError("in \\u hexadecimal character escape")
 					}
 				}
-				break
-			}
-			if bracketCount == 0 && braceCount == 0 {
-				return nil
-			}
-			continue
-		case 't':
-			if err := trueBytes(s); err != nil {
-				return err
+				saved += 6 - unescapedRuneLength(s.buf[s.cursor-3:s.cursor+1])
+			default:
+				return s.syntaxError("in string escape code")
 			}
-			if bracketCount == 0 && braceCount == 0 {
-				return nil
-			}
-			continue
-		case 'f':
-			if err := falseBytes(s); err != nil {
-				return err
-			}
-			if bracketCount == 0 && braceCount == 0 {
-				return nil
-			}
-			continue
-		case 'n':
-			if err := nullBytes(s); err != nil {
-				return err
+			s.cursor++
+		case nul:
+			// fail before reading more of a string which is already too long
+			if maxLength > 0 && s.cursor-start-1-saved > maxLength {
+				return errExceededLimit("string length", maxLength, s.offset+start)
 			}
-			if bracketCount == 0 && braceCount == 0 {
-				return nil
+			if s.read() {
+				continue
 			}
-			continue
+			return s.syntaxError("in string literal")
+		default:
+			return s.syntaxError("in string literal")
 		}
+	}
+}
+
+func (s *stream) skipDigits() {
+	for {
+		for isDigitChar(s.char()) {
+			s.cursor++
+		}
+		if s.char() != nul || !s.read() {
+			return
+		}
+	}
+}
+
+func (s *stream) skipNumber() error {
+	if s.char() == '-' {
+		s.cursor++
+	}
+	switch c := s.peek(); {
+	case c == '0':
+		s.cursor++
+	case '1' <= c && c <= '9':
 		s.cursor++
+		s.skipDigits()
+	default:
+		return s.syntaxError("in numeric literal")
+	}
+	if s.peek() == '.' {
+		s.cursor++
+		if !isDigitChar(s.peek()) {
+			return s.syntaxError("after decimal point in numeric literal")
+		}
+		s.skipDigits()
+	}
+	if c := s.peek(); c == 'e' || c == 'E' {
+		s.cursor++
+		if c := s.peek(); c == '+' || c == '-' {
+			s.cursor++
+		}
+		if !isDigitChar(s.peek()) {
+			return s.syntaxError("in exponent of numeric literal")
+		}
+		s.skipDigits()
+	}
+	return nil
+}
+
+func (s *stream) skipLiteral(literal string) error {
+	for i := 1; i < len(literal); i++ {
+		s.cursor++
+		if s.peek() != literal[i] {
+			return s.syntaxError(errLiteralContext(literal, literal[i]))
+		}
 	}
+	s.cursor++
+	return nil
 }
@@ -30,8 +30,8 @@ func (d *stringDecoder) errUnmarshalType(typeName string, offset int64) *Unmarsh
 	}
 }
 
-func (d *stringDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
-	bytes, err := d.decodeStreamByte(s)
+func (d *stringDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
+	bytes, err := d.decodeStreamLimitedByte(s, opt.MaxStringLength)
 	if err != nil {
 		return err
 	}
@@ -40,11 +40,14 @@ func (d *stringDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	return nil
 }
 
-func (d *stringDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *stringDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	bytes, c, err := d.decodeByte(buf, cursor)
 	if err != nil {
 		return 0, err
 	}
+	if err := opt.checkStringLength(len(bytes), cursor); err != nil {
+		return 0, err
+	}
 	cursor = c
 	**(**string)(unsafe.Pointer(&p)) = *(*string)(unsafe.Pointer(&bytes))
 	return cursor, nil
@@ -143,7 +146,7 @@ RETRY:
 		}
 		goto RETRY
 	default:
-		return errUnexpectedEndOfJSON("string", s.totalOffset())
+		return errInvalidCharacter(s.char(), "escaped string", s.totalOffset())
 	}
 	s.buf = append(s.buf[:s.cursor-1], s.buf[s.cursor:]...)
 	s.cursor--
@@ -162,6 +165,13 @@ func appendCoerceInvalidUTF8(b []byte, s []byte) []byte {
 }
 
 func stringBytes(s *stream) ([]byte, error) {
+	return limitedStringBytes(s, 0)
+}
+
+// limitedStringBytes is like stringBytes but fails once the unescaped string is longer than a positive maxLength,
+// without buffering the rest of the string.
+func limitedStringBytes(s *stream, maxLength int64) ([]byte, error) {
+	offset := s.totalOffset()
 	s.cursor++
 	start := s.cursor
 	for {
@@ -183,6 +193,9 @@ func stringBytes(s *stream) ([]byte, error) {
 			goto ERROR
 		}
 		s.cursor++
+		if maxLength > 0 && s.cursor-start > maxLength {
+			return nil, errExceededLimit("string length", maxLength, offset)
+		}
 	}
 ERROR:
 	return nil, errUnexpectedEndOfJSON("string", s.totalOffset())
@@ -211,6 +224,10 @@ func nullBytes(s *stream) error {
 }
 
 func (d *stringDecoder) decodeStreamByte(s *stream) ([]byte, error) {
+	return d.decodeStreamLimitedByte(s, 0)
+}
+
+func (d *stringDecoder) decodeStreamLimitedByte(s *stream, maxLength int64) ([]byte, error) {
 	for {
 		switch s.char() {
 		case ' ', '\n', '\t', '\r':
@@ -221,7 +238,7 @@ func (d *stringDecoder) decodeStreamByte(s *stream) ([]byte, error) {
 		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			return nil, d.errUnmarshalType("number", s.totalOffset())
 		case '"':
-			return stringBytes(s)
+			return limitedStringBytes(s, maxLength)
 		case 'n':
 			if err := nullBytes(s); err != nil {
 				return nil, err
@@ -14,6 +14,9 @@ type structFieldSet struct {
 	isTaggedKey bool
 	key         string
 	keyLen      int64
+	fieldIdx    int // index in structDecoder.fields. the lower case alias of a key shares it
+	isRequired  bool
+	isPtr       bool
 }
 
 type structDecoder struct {
@@ -27,11 +30,43 @@ type structDecoder struct {
 	sortedFieldSets  []*structFieldSet
 	keyDecoder       func(*structDecoder, []byte, int64) (int64, *structFieldSet, error)
 	keyStreamDecoder func(*structDecoder, *stream) (*structFieldSet, string, error)
+	fields           []*structFieldSet // a field set per decodable field in declaration order
+	hasRequired      bool
+	isCaseSensitive  bool
+	keyTable         *[256]byte // maps a key byte to the byte indexed by the key bitmaps
+	unknown          *unknownFieldDecoder
+}
+
+// fieldMask is the set of indexes of the fields found in an object.
+type fieldMask struct {
+	bits uint64
+	more []uint64
+}
+
+func (m *fieldMask) set(idx int) {
+	if idx < 64 {
+		m.bits |= 1 << uint(idx)
+		return
+	}
+	idx -= 64
+	for len(m.more) <= idx/64 {
+		m.more = append(m.more, 0)
+	}
+	m.more[idx/64] |= 1 << uint(idx%64)
+}
+
+func (m *fieldMask) has(idx int) bool {
+	if idx < 64 {
+		return m.bits&(1<<uint(idx)) != 0
+	}
+	idx -= 64
+	return idx/64 < len(m.more) && m.more[idx/64]&(1<<uint(idx%64)) != 0
 }
 
 var (
 	bitHashTable      [64]int
 	largeToSmallTable [256]byte
+	sameByteTable     [256]byte
 )
 
 func init() {
@@ -46,6 +81,7 @@ func init() {
 			c += 'a' - 'A'
 		}
 		largeToSmallTable[i] = byte(c)
+		sameByteTable[i] = byte(i)
 	}
 }
 
@@ -57,7 +93,60 @@ func newStructDecoder(structName, fieldName string, fieldMap map[string]*structF
 		fieldName:        fieldName,
 		keyDecoder:       decodeKey,
 		keyStreamDecoder: decodeKeyStream,
+		keyTable:         &largeToSmallTable,
+	}
+}
+
+// promoteFields adds the fields of the embedded struct decoder dec to d.fields
+// and returns the indexes they got in d.fields by their index in dec.fields.
+func (d *structDecoder) promoteFields(dec *structDecoder) []int {
+	fieldIdxMap := make([]int, len(dec.fields))
+	for i, field := range dec.fields {
+		fieldIdxMap[i] = len(d.fields)
+		d.fields = append(d.fields, &structFieldSet{
+			key:        field.key,
+			keyLen:     field.keyLen,
+			fieldIdx:   fieldIdxMap[i],
+			isRequired: field.isRequired,
+			isPtr:      field.isPtr,
+		})
+	}
+	return fieldIdxMap
+}
+
+// indexFields drops the fields which are no longer reachable by a key
+// ( e.g. conflicted embedded fields ) and renumbers the rest.
+func (d *structDecoder) indexFields() {
+	reachable := make([]bool, len(d.fields))
+	for _, set := range d.fieldMap {
+		reachable[set.fieldIdx] = true
+	}
+	fieldIdxMap := make([]int, len(d.fields))
+	fields := make([]*structFieldSet, 0, len(d.fields))
+	for i, field := range d.fields {
+		if !reachable[i] {
+			continue
+		}
+		fieldIdxMap[i] = len(fields)
+		fields = append(fields, field)
+		if field.isRequired {
+			d.hasRequired = true
+		}
 	}
+	renumbered := map[*structFieldSet]struct{}{}
+	for _, set := range d.fieldMap {
+		if _, exists := renumbered[set]; exists {
+			continue
+		}
+		renumbered[set] = struct{}{}
+		set.fieldIdx = fieldIdxMap[set.fieldIdx]
+	}
+	for _, field := range fields {
+		if _, exists := renumbered[field]; !exists {
+			field.fieldIdx = fieldIdxMap[field.fieldIdx]
+		}
+	}
+	d.fields = fields
 }
 
 const (
@@ -71,8 +160,14 @@ func (d *structDecoder) tryOptimize() {
 	}
 	fieldMap := map[string]*structFieldSet{}
 	conflicted := map[string]struct{}{}
+	if d.isCaseSensitive {
+		d.keyTable = &sameByteTable
+	}
 	for k, v := range d.fieldMap {
-		key := strings.ToLower(k)
+		key := k
+		if !d.isCaseSensitive {
+			key = strings.ToLower(k)
+		}
 		if key != k {
 			// already exists same key (e.g. Hello and HELLO has same lower case key
 			if _, exists := conflicted[key]; exists {
@@ -158,6 +253,7 @@ func decodeKeyByBitmapInt8(d *structDecoder, buf []byte, cursor int64) (int64, *
 			}
 			keyIdx := 0
 			bitmap := d.keyBitmapInt8
+			keyTable := d.keyTable
 			keyBitmapLen := len(bitmap)
 			start := cursor
 			for {
@@ -194,7 +290,7 @@ func decodeKeyByBitmapInt8(d *structDecoder, buf []byte, cursor int64) (int64, *
 							}
 						}
 					}
-					curBit &= bitmap[keyIdx][largeToSmallTable[c]]
+					curBit &= bitmap[keyIdx][keyTable[c]]
 					if curBit == 0 {
 						for {
 							cursor++
@@ -244,6 +340,7 @@ func decodeKeyByBitmapInt16(d *structDecoder, buf []byte, cursor int64) (int64,
 			}
 			keyIdx := 0
 			bitmap := d.keyBitmapInt16
+			keyTable := d.keyTable
 			keyBitmapLen := len(bitmap)
 			start := cursor
 			for {
@@ -280,7 +377,7 @@ func decodeKeyByBitmapInt16(d *structDecoder, buf []byte, cursor int64) (int64,
 							}
 						}
 					}
-					curBit &= bitmap[keyIdx][largeToSmallTable[c]]
+					curBit &= bitmap[keyIdx][keyTable[c]]
 					if curBit == 0 {
 						for {
 							cursor++
@@ -352,6 +449,7 @@ func decodeKeyByBitmapInt8Stream(d *structDecoder, s *stream) (*structFieldSet,
 			}
 			keyIdx := 0
 			bitmap := d.keyBitmapInt8
+			keyTable := d.keyTable
 			keyBitmapLen := len(bitmap)
 			for {
 				c := s.char()
@@ -396,7 +494,7 @@ func decodeKeyByBitmapInt8Stream(d *structDecoder, s *stream) (*structFieldSet,
 							}
 						}
 					}
-					curBit &= bitmap[keyIdx][largeToSmallTable[c]]
+					curBit &= bitmap[keyIdx][keyTable[c]]
 					if curBit == 0 {
 						for {
 							s.cursor++
@@ -460,6 +558,7 @@ func decodeKeyByBitmapInt16Stream(d *structDecoder, s *stream) (*structFieldSet,
 			}
 			keyIdx := 0
 			bitmap := d.keyBitmapInt16
+			keyTable := d.keyTable
 			keyBitmapLen := len(bitmap)
 			for {
 				c := s.char()
@@ -504,7 +603,7 @@ func decodeKeyByBitmapInt16Stream(d *structDecoder, s *stream) (*structFieldSet,
 							}
 						}
 					}
-					curBit &= bitmap[keyIdx][largeToSmallTable[c]]
+					curBit &= bitmap[keyIdx][keyTable[c]]
 					if curBit == 0 {
 						for {
 							s.cursor++
@@ -547,7 +646,26 @@ func decodeKeyStream(d *structDecoder, s *stream) (*structFieldSet, string, erro
 	return d.fieldMap[k], k, nil
 }
 
-func (d *structDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *structDecoder) tracksRequiredFields(opt *DecodeOption) bool {
+	return d.hasRequired || (opt.Flags&DecodeOptionRequireFields) != 0
+}
+
+// missingFields adds the required fields which are not in found to missing.
+func (d *structDecoder) missingFields(found *fieldMask, opt *DecodeOption, missing *MissingFieldsError) error {
+	requireAll := (opt.Flags & DecodeOptionRequireFields) != 0
+	for idx, field := range d.fields {
+		if found.has(idx) || !(field.isRequired || (requireAll && !field.isPtr)) {
+			continue
+		}
+		if missing == nil {
+			missing = &MissingFieldsError{}
+		}
+		missing.Paths = append(missing.Paths, "/"+jsonPointerEscaper.Replace(field.key))
+	}
+	return missingFieldsError(missing)
+}
+
+func (d *structDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	s.skipWhiteSpace()
 	switch s.char() {
 	case 'n':
@@ -562,17 +680,44 @@ func (d *structDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 			return errNotAtBeginningOfValue(s.totalOffset())
 		}
 	}
+	depth++
+	if err := opt.checkDepth(depth, s.totalOffset()); err != nil {
+		return err
+	}
+	var (
+		found   fieldMask
+		missing *MissingFieldsError
+	)
+	track := d.tracksRequiredFields(opt)
 	s.cursor++
+	s.skipWhiteSpace()
 	if s.char() == '}' {
 		s.cursor++
+		if track {
+			return d.missingFields(&found, opt, nil)
+		}
 		return nil
 	}
-	for {
+	for keys := 1; ; keys++ {
+		if err := opt.checkObjectKeys(keys, s.totalOffset()); err != nil {
+			return err
+		}
 		s.reset()
+		s.skipWhiteSpace()
+		keyStart := s.cursor
 		field, key, err := d.keyStreamDecoder(d, s)
 		if err != nil {
 			return err
 		}
+		keyEnd := s.cursor
+		if err := opt.checkStringLength(int(keyEnd-keyStart)-2, s.offset+keyStart); err != nil {
+			return err
+		}
+		if field == nil && d.unknown != nil {
+			if key, err = d.unknownFieldKey(s.buf[keyStart:s.cursor]); err != nil {
+				return err
+			}
+		}
 		s.skipWhiteSpace()
 		if s.char() != ':' {
 			return errExpected("colon after object key", s.totalOffset())
@@ -584,20 +729,34 @@ func (d *structDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 			}
 		}
 		if field != nil {
-			if err := field.dec.decodeStream(s, unsafe.Pointer(uintptr(p)+field.offset)); err != nil {
-				return err
+			if track {
+				found.set(field.fieldIdx)
 			}
-		} else if s.disallowUnknownFields {
+			if err := field.dec.decodeStream(s, depth, opt, unsafe.Pointer(uintptr(p)+field.offset)); err != nil {
+				if missing, err = collectMissingFields(missing, err, field.key); err != nil {
+					return annotateErrorPath(err, field.key)
+				}
+			}
+		} else if d.unknown != nil {
+			if err := d.unknown.decodeStream(s, depth, opt, key, p); err != nil {
+				if missing, err = collectMissingFields(missing, err, key); err != nil {
+					return annotateErrorPath(err, key)
+				}
+			}
+		} else if (opt.Flags & DecodeOptionDisallowUnknownFields) != 0 {
 			return fmt.Errorf("json: unknown field %q", key)
 		} else {
-			if err := s.skipValue(); err != nil {
-				return err
+			if err := s.skipValue(depth, opt); err != nil {
+				return d.skippedValueError(err, s.buf[keyStart:keyEnd])
 			}
 		}
 		s.skipWhiteSpace()
 		c := s.char()
 		if c == '}' {
 			s.cursor++
+			if track || missing != nil {
+				return d.missingFields(&found, opt, missing)
+			}
 			return nil
 		}
 		if c != ',' {
@@ -607,7 +766,7 @@ func (d *structDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	}
 }
 
-func (d *structDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *structDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	buflen := int64(len(buf))
 	cursor = skipWhiteSpace(buf, cursor)
 	b := (*sliceHeader)(unsafe.Pointer(&buf)).data
@@ -634,12 +793,35 @@ func (d *structDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int6
 	if buflen < 2 {
 		return 0, errUnexpectedEndOfJSON("object", cursor)
 	}
-	cursor++
-	for {
+	depth++
+	if err := opt.checkDepth(depth, cursor); err != nil {
+		return 0, err
+	}
+	var (
+		found   fieldMask
+		missing *MissingFieldsError
+	)
+	track := d.tracksRequiredFields(opt)
+	cursor = skipWhiteSpace(buf, cursor+1)
+	if char(b, cursor) == '}' {
+		cursor++
+		if track {
+			return cursor, d.missingFields(&found, opt, nil)
+		}
+		return cursor, nil
+	}
+	for keys := 1; ; keys++ {
+		if err := opt.checkObjectKeys(keys, cursor); err != nil {
+			return 0, err
+		}
+		keyStart := skipWhiteSpace(buf, cursor)
 		c, field, err := d.keyDecoder(d, buf, cursor)
 		if err != nil {
 			return 0, err
 		}
+		if err := opt.checkStringLength(int(c-keyStart)-2, keyStart); err != nil {
+			return 0, err
+		}
 		cursor = skipWhiteSpace(buf, c)
 		if char(b, cursor) != ':' {
 			return 0, errExpected("colon after object key", cursor)
@@ -649,21 +831,44 @@ func (d *structDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int6
 			return 0, errExpected("object value after colon", cursor)
 		}
 		if field != nil {
-			c, err := field.dec.decode(buf, cursor, unsafe.Pointer(uintptr(p)+field.offset))
+			if track {
+				found.set(field.fieldIdx)
+			}
+			c, err := field.dec.decode(buf, cursor, depth, opt, unsafe.Pointer(uintptr(p)+field.offset))
 			if err != nil {
-				return 0, err
+				if missing, err = collectMissingFields(missing, err, field.key); err != nil {
+					return 0, annotateErrorPath(err, field.key)
+				}
 			}
 			cursor = c
-		} else {
-			c, err := skipValue(buf, cursor)
+		} else if d.unknown != nil {
+			key, err := d.unknownFieldKey(buf[keyStart:c])
 			if err != nil {
 				return 0, err
 			}
+			c, err := d.unknown.decode(buf, cursor, depth, opt, key, p)
+			if err != nil {
+				if missing, err = collectMissingFields(missing, err, key); err != nil {
+					return 0, annotateErrorPath(err, key)
+				}
+			}
 			cursor = c
+		} else if (opt.Flags & DecodeOptionDisallowUnknownFields) != 0 {
+			key := buf[keyStart+1 : c-1]
+			return 0, fmt.Errorf("json: unknown field %q", key)
+		} else {
+			end, err := skipValue(buf, cursor, depth, opt)
+			if err != nil {
+				return 0, d.skippedValueError(err, buf[keyStart:c])
+			}
+			cursor = end
 		}
 		cursor = skipWhiteSpace(buf, cursor)
 		if char(b, cursor) == '}' {
 			cursor++
+			if track || missing != nil {
+				return cursor, d.missingFields(&found, opt, missing)
+			}
 			return cursor, nil
 		}
 		if char(b, cursor) != ',' {
@@ -2,6 +2,7 @@ package json_test
 
 import (
 	"bytes"
+	"context"
 	"encoding"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -257,6 +259,382 @@ func Test_Decoder_DisallowUnknownFields(t *testing.T) {
 	}
 }
 
+func TestUnmarshalWithOption(t *testing.T) {
+	t.Run("use number", func(t *testing.T) {
+		var v map[string]interface{}
+		assertErr(t, json.UnmarshalWithOption([]byte(`{"a": 3.14, "b": [1]}`), &v, json.DecodeUseNumber()))
+		assertEq(t, "a", json.Number("3.14"), v["a"])
+		assertEq(t, "b", json.Number("1"), v["b"].([]interface{})[0])
+	})
+	t.Run("disallow unknown fields", func(t *testing.T) {
+		var v struct {
+			A int `json:"a"`
+		}
+		err := json.UnmarshalWithOption([]byte(`{"a": 1, "x": 2}`), &v, json.DecodeDisallowUnknownFields())
+		if err == nil {
+			t.Fatal("expected unknown field error")
+		}
+		assertEq(t, "error", `json: unknown field "x"`, err.Error())
+	})
+	t.Run("default", func(t *testing.T) {
+		var v struct {
+			A int `json:"a"`
+		}
+		assertErr(t, json.UnmarshalWithOption([]byte(`{"a": 1, "x": 2}`), &v))
+		assertEq(t, "a", 1, v.A)
+	})
+}
+
+func TestDecoder_DecodeWithOption(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`{"a": 1} {"a": 2}`))
+	var v1 map[string]interface{}
+	assertErr(t, dec.DecodeWithOption(&v1, json.DecodeUseNumber()))
+	assertEq(t, "a", json.Number("1"), v1["a"])
+	var v2 map[string]interface{}
+	assertErr(t, dec.Decode(&v2))
+	assertEq(t, "a", float64(2), v2["a"])
+}
+
+func TestDecodeLimits(t *testing.T) {
+	type T struct {
+		A []map[string]string `json:"a"`
+	}
+	tests := []struct {
+		name   string
+		data   string
+		opt    json.DecodeOptionFunc
+		limit  string
+		offset int64
+		path   string
+	}{
+		{
+			name:   "depth",
+			data:   `{"a":[{"b":"c"}]}`,
+			opt:    json.DecodeMaxDepth(2),
+			limit:  "depth",
+			offset: 6,
+			path:   "/a/0",
+		},
+		{
+			name:   "input bytes",
+			data:   `{"a":[{"b":"c"}]}`,
+			opt:    json.DecodeMaxInputBytes(10),
+			limit:  "input bytes",
+			offset: 10,
+		},
+		{
+			name:   "string length",
+			data:   `{"a":[{"b":"c"},{"d":"efgh"}]}`,
+			opt:    json.DecodeMaxStringLength(3),
+			limit:  "string length",
+			offset: 21,
+			path:   "/a/1/d",
+		},
+		{
+			name:   "elements",
+			data:   `{"a":[{},{},{}]}`,
+			opt:    json.DecodeMaxElements(2),
+			limit:  "elements",
+			offset: 12,
+			path:   "/a",
+		},
+		{
+			name:   "object keys",
+			data:   `{"a":[],"b":1,"c":2}`,
+			opt:    json.DecodeMaxObjectKeys(2),
+			limit:  "object keys",
+			offset: 14,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			check := func(t *testing.T, err error) {
+				t.Helper()
+				lerr, ok := err.(*json.LimitError)
+				if !ok {
+					t.Fatalf("expected LimitError but got %v", err)
+				}
+				assertEq(t, "limit", test.limit, lerr.Limit)
+				assertEq(t, "path", test.path, lerr.Path)
+			}
+			t.Run("unmarshal", func(t *testing.T) {
+				var v T
+				err := json.UnmarshalWithOption([]byte(test.data), &v, test.opt)
+				check(t, err)
+				assertEq(t, "offset", test.offset, err.(*json.LimitError).Offset)
+			})
+			t.Run("stream", func(t *testing.T) {
+				var v T
+				check(t, json.NewDecoder(strings.NewReader(test.data)).DecodeWithOption(&v, test.opt))
+			})
+			t.Run("interface", func(t *testing.T) {
+				if test.name == "object keys" {
+					t.Skip("object keys limit applies to structs")
+				}
+				var v interface{}
+				check(t, json.UnmarshalWithOption([]byte(test.data), &v, test.opt))
+			})
+		})
+	}
+	t.Run("within limits", func(t *testing.T) {
+		data := `{"a":[{"b":"c"}]}`
+		opts := []json.DecodeOptionFunc{
+			json.DecodeMaxDepth(3),
+			json.DecodeMaxInputBytes(int64(len(data))),
+			json.DecodeMaxStringLength(1),
+			json.DecodeMaxElements(1),
+			json.DecodeMaxObjectKeys(1),
+		}
+		var v1 T
+		assertErr(t, json.UnmarshalWithOption([]byte(data), &v1, opts...))
+		assertEq(t, "value", "c", v1.A[0]["b"])
+		var v2 T
+		assertErr(t, json.NewDecoder(strings.NewReader(data)).DecodeWithOption(&v2, opts...))
+		assertEq(t, "value", "c", v2.A[0]["b"])
+	})
+	t.Run("stream input bytes per value", func(t *testing.T) {
+		dec := json.NewDecoder(strings.NewReader(`12345 123 1234`))
+		var v int
+		assertErr(t, dec.DecodeWithOption(&v, json.DecodeMaxInputBytes(5)))
+		assertEq(t, "value", 12345, v)
+		assertErr(t, dec.DecodeWithOption(&v, json.DecodeMaxInputBytes(3)))
+		assertEq(t, "value", 123, v)
+		if _, ok := dec.DecodeWithOption(&v, json.DecodeMaxInputBytes(3)).(*json.LimitError); !ok {
+			t.Fatal("expected LimitError")
+		}
+	})
+	t.Run("stream input bytes larger than buffer", func(t *testing.T) {
+		dec := json.NewDecoder(strings.NewReader(`"` + strings.Repeat("a", 1<<20) + `"`))
+		var v string
+		err := dec.DecodeWithOption(&v, json.DecodeMaxInputBytes(1000))
+		lerr, ok := err.(*json.LimitError)
+		if !ok {
+			t.Fatalf("expected LimitError but got %v", err)
+		}
+		assertEq(t, "offset", int64(1000), lerr.Offset)
+	})
+	t.Run("stream string length while reading", func(t *testing.T) {
+		for _, v := range []interface{}{new(string), new(interface{})} {
+			dec := json.NewDecoder(&endlessStringReader{})
+			err := dec.DecodeWithOption(v, json.DecodeMaxStringLength(10))
+			lerr, ok := err.(*json.LimitError)
+			if !ok {
+				t.Fatalf("expected LimitError but got %v", err)
+			}
+			assertEq(t, "limit", "string length", lerr.Limit)
+			assertEq(t, "offset", int64(0), lerr.Offset)
+		}
+	})
+	t.Run("values which are not decoded", func(t *testing.T) {
+		type A struct{}
+		type B struct {
+			Z json.RawMessage
+		}
+		type C struct {
+			Z []byte
+		}
+		type D struct {
+			Z [][][][]int `json:"unk"`
+		}
+		tests := []struct {
+			name  string
+			data  string
+			v     func() interface{}
+			opt   json.DecodeOptionFunc
+			limit string
+			path  string
+		}{
+			{"skipped depth", `{"Z":[[[[[1]]]]]}`, func() interface{} { return new(A) }, json.DecodeMaxDepth(2), "depth", "/Z/0"},
+			{"skipped depth like decoded depth", `{"unk":[[[[1]]]]}`, func() interface{} { return new(A) }, json.DecodeMaxDepth(3), "depth", "/unk/0/0"},
+			{"decoded depth", `{"unk":[[[[1]]]]}`, func() interface{} { return new(D) }, json.DecodeMaxDepth(3), "depth", "/unk/0/0"},
+			{"raw message depth", `{"Z":[[[[[1]]]]]}`, func() interface{} { return new(B) }, json.DecodeMaxDepth(2), "depth", "/Z/0"},
+			{"array element depth", `[1,[[1]]]`, func() interface{} { return new([1]int) }, json.DecodeMaxDepth(2), "depth", "/1/0"},
+			{"base64 length", `{"Z":"aGVsbG8gd29ybGQ="}`, func() interface{} { return new(C) }, json.DecodeMaxStringLength(3), "string length", "/Z"},
+			{"unknown key length", `{"` + strings.Repeat("k", 50) + `":1}`, func() interface{} { return new(A) }, json.DecodeMaxStringLength(3), "string length", ""},
+			{"skipped string length", `{"unk":"abcdef"}`, func() interface{} { return new(A) }, json.DecodeMaxStringLength(3), "string length", "/unk"},
+			{"skipped nested string length", `{"unk":[{"k":"a"},{"k~/":"abcdef"}]}`, func() interface{} { return new(A) }, json.DecodeMaxStringLength(3), "string length", "/unk/1/k~0~1"},
+			{"skipped escaped string length", `{"unk":"\u00e9\u00e9\n"}`, func() interface{} { return new(A) }, json.DecodeMaxStringLength(4), "string length", "/unk"},
+			{"skipped key length", `{"unk":{"abcdef":1}}`, func() interface{} { return new(A) }, json.DecodeMaxStringLength(3), "string length", "/unk"},
+			{"raw message string length", `{"Z":["abcdef"]}`, func() interface{} { return new(B) }, json.DecodeMaxStringLength(3), "string length", "/Z/0"},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				for _, err := range []error{
+					json.UnmarshalWithOption([]byte(test.data), test.v(), test.opt),
+					json.NewDecoder(strings.NewReader(test.data)).DecodeWithOption(test.v(), test.opt),
+				} {
+					lerr, ok := err.(*json.LimitError)
+					if !ok {
+						t.Fatalf("expected LimitError but got %v", err)
+					}
+					assertEq(t, "limit", test.limit, lerr.Limit)
+					assertEq(t, "path", test.path, lerr.Path)
+				}
+			})
+		}
+		var v B
+		assertErr(t, json.UnmarshalWithOption([]byte(`{"Z":[[1]]}`), &v, json.DecodeMaxDepth(3)))
+		assertEq(t, "raw message", `[[1]]`, string(v.Z))
+		// the length of an unescaped string is counted like the string decoder does
+		for _, data := range []string{`{"unk":"\u00e9\u00e9"}`, `{"unk":"\ud83d\ude00"}`, `{"unk":"\n\n\n\n"}`} {
+			assertErr(t, json.UnmarshalWithOption([]byte(data), new(A), json.DecodeMaxStringLength(4)))
+			assertErr(t, json.NewDecoder(strings.NewReader(data)).DecodeWithOption(new(A), json.DecodeMaxStringLength(4)))
+		}
+	})
+	t.Run("stream skipped string length while reading", func(t *testing.T) {
+		type A struct{}
+		dec := json.NewDecoder(&endlessStringReader{prefix: `{"unk":`})
+		err := dec.DecodeWithOption(new(A), json.DecodeMaxStringLength(10))
+		lerr, ok := err.(*json.LimitError)
+		if !ok {
+			t.Fatalf("expected LimitError but got %v", err)
+		}
+		assertEq(t, "limit", "string length", lerr.Limit)
+		assertEq(t, "offset", int64(7), lerr.Offset)
+		assertEq(t, "path", "/unk", lerr.Path)
+	})
+}
+
+// endlessStringReader reads prefix followed by a string value which never ends.
+type endlessStringReader struct {
+	prefix  string
+	started bool
+}
+
+func (r *endlessStringReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'a'
+	}
+	if !r.started && len(p) > 0 {
+		r.started = true
+		copy(p, r.prefix+`"`)
+	}
+	return len(p), nil
+}
+
+func TestUnmarshalPath(t *testing.T) {
+	data := `{"id":1,"items":[{"name":"a"},{"name":"b","tags":["x","y"]},{"name":"c"}],"a/b":{"m~n":true},"esc\"aped":"ok"}`
+	tests := []struct {
+		path     string
+		expected interface{}
+	}{
+		{path: "/id", expected: float64(1)},
+		{path: "/items/1/name", expected: "b"},
+		{path: "/items/1/tags/1", expected: "y"},
+		{path: "/items/2", expected: map[string]interface{}{"name": "c"}},
+		{path: "/a~1b/m~0n", expected: true},
+		{path: `/esc"aped`, expected: "ok"},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			var v1 interface{}
+			assertErr(t, json.UnmarshalPath([]byte(data), test.path, &v1))
+			if !reflect.DeepEqual(test.expected, v1) {
+				t.Fatalf("unmarshal: expected %v but got %v", test.expected, v1)
+			}
+			dec := json.NewDecoder(strings.NewReader(data + ` {"next":2}`))
+			var v2 interface{}
+			assertErr(t, dec.DecodePath(test.path, &v2))
+			if !reflect.DeepEqual(test.expected, v2) {
+				t.Fatalf("decoder: expected %v but got %v", test.expected, v2)
+			}
+			var next struct {
+				Next int `json:"next"`
+			}
+			assertErr(t, dec.Decode(&next))
+			assertEq(t, "next", 2, next.Next)
+		})
+	}
+	t.Run("typed", func(t *testing.T) {
+		var v struct {
+			Name string   `json:"name"`
+			Tags []string `json:"tags"`
+		}
+		assertErr(t, json.UnmarshalPath([]byte(data), "/items/1", &v))
+		assertEq(t, "name", "b", v.Name)
+		assertEq(t, "tags", 2, len(v.Tags))
+	})
+	t.Run("whole document", func(t *testing.T) {
+		var v map[string]interface{}
+		assertErr(t, json.UnmarshalPath([]byte(data), "", &v))
+		assertEq(t, "id", float64(1), v["id"])
+	})
+	for _, path := range []string{"/missing", "/items/3", "/items/01", "/items/name", "/id/x", "/a~1b/x", "/items/0/name/x", "id", "/a~2b"} {
+		t.Run("error "+path, func(t *testing.T) {
+			var v interface{}
+			err := json.UnmarshalPath([]byte(data), path, &v)
+			if _, ok := err.(*json.PathError); !ok {
+				t.Fatalf("expected PathError but got %v", err)
+			}
+			dec := json.NewDecoder(strings.NewReader(data + ` {"next":2}`))
+			err = dec.DecodePath(path, &v)
+			if _, ok := err.(*json.PathError); !ok {
+				t.Fatalf("expected PathError but got %v", err)
+			}
+			if path == "id" || path == "/a~2b" {
+				// the input is not read with an invalid JSON Pointer
+				return
+			}
+			var next struct {
+				Next int `json:"next"`
+			}
+			assertErr(t, dec.Decode(&next))
+			assertEq(t, "next", 2, next.Next)
+		})
+	}
+	t.Run("limit error path", func(t *testing.T) {
+		var v interface{}
+		err := json.UnmarshalPath([]byte(data), "/items/1", &v, json.DecodeMaxDepth(3))
+		lerr, ok := err.(*json.LimitError)
+		if !ok {
+			t.Fatalf("expected LimitError but got %v", err)
+		}
+		assertEq(t, "path", "/items/1/tags", lerr.Path)
+	})
+}
+
+type unmarshalerContextKey struct{}
+
+type unmarshalerContext struct {
+	v string
+}
+
+func (u *unmarshalerContext) UnmarshalJSONContext(ctx context.Context, b []byte) error {
+	v, ok := ctx.Value(unmarshalerContextKey{}).(string)
+	if !ok {
+		return fmt.Errorf("missing context value")
+	}
+	u.v = v + string(b)
+	return nil
+}
+
+func TestUnmarshalContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), unmarshalerContextKey{}, "hello")
+	t.Run("unmarshaler", func(t *testing.T) {
+		var v unmarshalerContext
+		assertErr(t, json.UnmarshalContext(ctx, []byte(`1`), &v))
+		assertEq(t, "unmarshal", "hello1", v.v)
+	})
+	t.Run("field", func(t *testing.T) {
+		var v struct {
+			A unmarshalerContext `json:"a"`
+		}
+		assertErr(t, json.UnmarshalContext(ctx, []byte(`{"a":true}`), &v))
+		assertEq(t, "unmarshal", "hellotrue", v.A.v)
+	})
+	t.Run("decoder", func(t *testing.T) {
+		var v unmarshalerContext
+		assertErr(t, json.NewDecoder(strings.NewReader(`"x"`)).DecodeContext(ctx, &v))
+		assertEq(t, "decode", `hello"x"`, v.v)
+	})
+	t.Run("without context", func(t *testing.T) {
+		var v unmarshalerContext
+		if err := json.Unmarshal([]byte(`1`), &v); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
 type unmarshalJSON struct {
 	v int
 }
@@ -774,11 +1152,11 @@ var unmarshalTests = []unmarshalTest{
 	{in: `{"alphabet": "xyz"}`, ptr: new(U), err: fmt.Errorf("json: unknown field \"alphabet\""), disallowUnknownFields: true},                 // 34
 
 	// syntax errors
-	{in: `{"X": "foo", "Y"}`, err: json.NewSyntaxError("invalid character '}' after object key", 17)},                                              // 35
-	{in: `[1, 2, 3+]`, err: json.NewSyntaxError("invalid character '+' after array element", 9)},                                                   // 36
-	{in: `{"X":12x}`, err: json.NewSyntaxError("invalid character 'x' after object key:value pair", 8), useNumber: true},                           // 37
-	{in: `[2, 3`, err: json.NewSyntaxError("unexpected end of JSON input", 5)},                                                                     // 38
-	{in: `{"F3": -}`, ptr: new(V), out: V{F3: json.Number("-")}, err: json.NewSyntaxError("strconv.ParseFloat: parsing \"-\": invalid syntax", 9)}, // 39
+	{in: `{"X": "foo", "Y"}`, err: json.NewSyntaxError("invalid character '}' after object key", 17)},                                     // 35
+	{in: `[1, 2, 3+]`, err: json.NewSyntaxError("invalid character '+' after array element", 9)},                                          // 36
+	{in: `{"X":12x}`, err: json.NewSyntaxError("invalid character 'x' after object key:value pair", 8), useNumber: true},                  // 37
+	{in: `[2, 3`, err: json.NewSyntaxError("unexpected end of JSON input", 5)},                                                            // 38
+	{in: `{"F3": -}`, ptr: new(V), out: V{F3: json.Number("-")}, err: json.NewSyntaxError("invalid character '}' in numeric literal", 9)}, // 39
 
 	// raw value errors
 	{in: "\x01 42", err: json.NewSyntaxError("invalid character '\\x01' looking for beginning of value", 1)},         // 40
@@ -1246,7 +1624,7 @@ var unmarshalTests = []unmarshalTest{
 		in:  `invalid`, // 143
 		ptr: new(json.Number),
 		err: json.NewSyntaxError(
-			`json: invalid character v as null`,
+			`invalid character 'i' looking for beginning of value`,
 			1,
 		),
 	},
@@ -1265,7 +1643,7 @@ var unmarshalTests = []unmarshalTest{
 		ptr: new(struct {
 			A json.Number `json:",string"`
 		}),
-		err: fmt.Errorf(`json: null unexpected end of JSON input`),
+		err: fmt.Errorf(`invalid character 'i' looking for beginning of value`),
 	},
 	{
 		in:  `{"A":"invalid"}`, // 147
@@ -2046,6 +2424,72 @@ func TestEmptyString(t *testing.T) {
 	}
 }
 
+func TestOmitEmptyStringUnmarshal(t *testing.T) {
+	type T struct {
+		I  int     `json:"i,omitempty,string"`
+		B  bool    `json:"b,string,omitempty"`
+		S  string  `json:"s,omitempty,string"`
+		IP *int    `json:"ip,omitempty,string"`
+		SP *string `json:"sp,string,omitempty"`
+	}
+	type C struct {
+		F []int           `json:"f,omitempty,string"`
+		G [1]int          `json:"g,omitempty,string"`
+		M map[string]int  `json:"m,omitempty,string"`
+		S struct{ X int } `json:"s,omitempty,string"`
+		P *[]int          `json:"p,omitempty,string"`
+	}
+	tests := []struct {
+		name     string
+		data     string
+		v        func() interface{}
+		expected interface{}
+	}{
+		{
+			name:     "scalar",
+			data:     `{"i":"-1","b":"true","s":"\"x\"","ip":"3","sp":"\"y\""}`,
+			v:        func() interface{} { return new(T) },
+			expected: &T{I: -1, B: true, S: "x", IP: intp(3), SP: func() *string { s := "y"; return &s }()},
+		},
+		{
+			name:     "null",
+			data:     `{"ip":null,"sp":null}`,
+			v:        func() interface{} { return new(T) },
+			expected: &T{},
+		},
+		{
+			name:     "composite",
+			data:     `{"f":[1],"g":[2],"m":{"a":1},"s":{"X":3},"p":[4]}`,
+			v:        func() interface{} { return new(C) },
+			expected: &C{F: []int{1}, G: [1]int{2}, M: map[string]int{"a": 1}, S: struct{ X int }{3}, P: &[]int{4}},
+		},
+		{
+			name:     "composite null",
+			data:     `{"f":null,"g":null,"m":null,"s":null,"p":null}`,
+			v:        func() interface{} { return new(C) },
+			expected: &C{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v1 := test.v()
+			if err := json.Unmarshal([]byte(test.data), v1); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(test.expected, v1) {
+				t.Fatalf("Unmarshal: got %+v, want %+v", v1, test.expected)
+			}
+			v2 := test.v()
+			if err := json.NewDecoder(strings.NewReader(test.data)).Decode(v2); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(test.expected, v2) {
+				t.Fatalf("Decode: got %+v, want %+v", v2, test.expected)
+			}
+		})
+	}
+}
+
 /*
 // Test that a null for ,string is not replaced with the previous quoted string (issue 7046).
 // It should also not be an error (issue 2540, issue 8587).
@@ -2417,6 +2861,89 @@ func TestSkipArrayObjects(t *testing.T) {
 	}
 }
 
+// The values of unknown fields are validated while they are skipped.
+func TestSkipUnknownFieldValue(t *testing.T) {
+	// the padding makes the stream read the skipped value across several buffers
+	padding := strings.Repeat(" ", 1024)
+	tests := []struct {
+		in  string
+		err string
+	}{
+		{in: `{"unknown": {"a": [1, -2.5e+3, 0, true, false, null, "\u00e9\"\\", {}], "b": []}, "A": 1}`},
+		{in: `{"unknown": "\\", "A": 1}`},
+		{in: `{"unknown": ["\\", "\\\""], "A": 1}`},
+		{in: `{"unknown": [` + padding + `"` + padding + `\\"` + padding + `], "A": 1}`},
+		{in: `{"unknown": ["ünïcödé \"ünïcödé\" ünïcödé", "ünïcödé"], "A": 1}`},
+		{in: `{"unknown": [1 2 }x], "A": 1}`, err: "invalid character '2' after array element"},
+		{in: `{"unknown": [1,], "A": 1}`, err: "invalid character ']' looking for beginning of value"},
+		{in: `{"unknown": {"a" 1}, "A": 1}`, err: "invalid character '1' after object key"},
+		{in: `{"unknown": {1: 2}, "A": 1}`, err: "invalid character '1' looking for beginning of object key string"},
+		{in: `{"unknown": {"a": 1 "b": 2}, "A": 1}`, err: "invalid character '\"' after object key:value pair"},
+		{in: `{"unknown": "\x", "A": 1}`, err: "invalid character 'x' in string escape code"},
+		{in: `{"unknown": "\u12G4", "A": 1}`, err: "invalid character 'G' in \\u hexadecimal character escape"},
+		{in: "{\"unknown\": \"a\nb\", \"A\": 1}", err: "invalid character '\\n' in string literal"},
+		{in: "{\"unknown\": \"ünïcödé ü\x01\", \"A\": 1}", err: "invalid character '\\x01' in string literal"},
+		{in: `{"unknown": -, "A": 1}`, err: "invalid character ',' in numeric literal"},
+		{in: `{"unknown": 1., "A": 1}`, err: "invalid character ',' after decimal point in numeric literal"},
+		{in: `{"unknown": 1e+, "A": 1}`, err: "invalid character ',' in exponent of numeric literal"},
+		{in: `{"unknown": tru, "A": 1}`, err: "invalid character ',' in literal true (expecting 'e')"},
+		{in: `{"unknown": [` + padding + `nul`, err: "unexpected end of JSON input"},
+		{in: `{"unknown": ["\\"` + padding + `x], "A": 1}`, err: "invalid character 'x' after array element"},
+	}
+	for _, test := range tests {
+		var v struct{ A int }
+		err := json.Unmarshal([]byte(test.in), &v)
+		if test.err == "" {
+			assertErr(t, err)
+			assertEq(t, "unmarshal", 1, v.A)
+		} else if err == nil || err.Error() != test.err {
+			t.Errorf("Unmarshal(%q): got error %v, want %s", test.in, err, test.err)
+		}
+
+		var w struct{ A int }
+		err = json.NewDecoder(strings.NewReader(test.in)).Decode(&w)
+		if test.err == "" {
+			assertErr(t, err)
+			assertEq(t, "decode", 1, w.A)
+		} else if err == nil || err.Error() != test.err {
+			t.Errorf("Decode(%q): got error %v, want %s", test.in, err, test.err)
+		}
+	}
+}
+
+func BenchmarkSkipUnknownFieldValue(b *testing.B) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"unknown": [`)
+	for i := 0; buf.Len() < 200*1024; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, `{"id": %d, "name": "user %d", "text": "a longer text with an \"escaped\" quote and caf\u00e9", "score": -%d.5e-3, "tags": ["a", "b"], "ok": true, "next": null}`, i, i, i)
+	}
+	buf.WriteString(`], "A": 1}`)
+	data := buf.Bytes()
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var v struct{ A int }
+			if err := json.Unmarshal(data, &v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Decoder", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var v struct{ A int }
+			if err := json.NewDecoder(bytes.NewReader(data)).Decode(&v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
 /*
 // Test semantics of pre-filled data, such as struct fields, map elements,
 // slices, and arrays.
@@ -2908,3 +3435,714 @@ func TestUnmarshalMaxDepth(t *testing.T) {
 	}
 }
 */
+
+func TestParse(t *testing.T) {
+	doc, err := json.Parse([]byte(` {"id": 12, "name": "a\"b", "owner": {"email": "x@y", "tags": ["a", {}, [], 1e-3]}, "ok": true, "none": null, "k\u0065y": -1} `))
+	assertErr(t, err)
+	assertEq(t, "kind", json.ValueObject, doc.Kind())
+	assertEq(t, "len", 6, doc.Len())
+
+	id, err := doc.Get("id").Int()
+	assertErr(t, err)
+	assertEq(t, "id", int64(12), id)
+
+	name, err := doc.Get("name").String()
+	assertErr(t, err)
+	assertEq(t, "name", `a"b`, name)
+
+	email, err := doc.Get("owner", "email").String()
+	assertErr(t, err)
+	assertEq(t, "email", "x@y", email)
+
+	tags := doc.Get("owner", "tags")
+	assertEq(t, "tags len", 4, tags.Len())
+	assertEq(t, "tags raw", `["a", {}, [], 1e-3]`, string(tags.Raw()))
+	assertEq(t, "empty object", `{}`, string(tags.Index(1).Raw()))
+	assertEq(t, "empty array len", 0, tags.Get("2").Len())
+	f, err := tags.Get("3").Float()
+	assertErr(t, err)
+	assertEq(t, "float", 1e-3, f)
+
+	ok, err := doc.Get("ok").Bool()
+	assertErr(t, err)
+	assertEq(t, "ok", true, ok)
+	assertEq(t, "null", json.ValueNull, doc.Get("none").Kind())
+	assertEq(t, "escaped key", `-1`, string(doc.Get("key").Raw()))
+
+	assertEq(t, "missing key", false, doc.Get("owner", "missing").Exists())
+	assertEq(t, "missing index", false, tags.Index(4).Exists())
+	assertEq(t, "through scalar", false, doc.Get("id", "x").Exists())
+	if _, err := doc.Get("name").Int(); err == nil {
+		t.Fatal("expected error for string as int")
+	}
+
+	var keys []string
+	doc.ForEach(func(key, value json.Value) bool {
+		k, err := key.String()
+		assertErr(t, err)
+		keys = append(keys, k)
+		return k != "ok"
+	})
+	assertEq(t, "keys", "id,name,owner,ok", strings.Join(keys, ","))
+
+	var tagKinds []string
+	tags.ForEach(func(key, value json.Value) bool {
+		assertEq(t, "array key", false, key.Exists())
+		tagKinds = append(tagKinds, value.Kind().String())
+		return true
+	})
+	assertEq(t, "tag kinds", "string,object,array,number", strings.Join(tagKinds, ","))
+
+	var owner struct {
+		Email string `json:"email"`
+	}
+	assertErr(t, doc.Get("owner").Decode(&owner))
+	assertEq(t, "decode", "x@y", owner.Email)
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, src := range []string{``, `{`, `[1 2]`, `{"a" 1}`, `{"a":1,}`, `{1:2}`, `"abc`, `1 2`, `[}`} {
+			if _, err := json.Parse([]byte(src)); err == nil {
+				t.Errorf("%q: expected error", src)
+			}
+		}
+	})
+}
+
+func TestRequiredFields(t *testing.T) {
+	type Item struct {
+		Name  string `json:"name,required"`
+		Count int    `json:"count"`
+	}
+	type Owner struct {
+		Email string `json:"email,required"`
+	}
+	type Embedded struct {
+		Tag string `json:"tag,required"`
+	}
+	type T struct {
+		Embedded
+		ID    int             `json:"id,required"`
+		Owner *Owner          `json:"owner"`
+		Items []Item          `json:"items"`
+		ByKey map[string]Item `json:"by_key"`
+		Note  string          `json:"note"`
+	}
+
+	decoders := map[string]func(string, interface{}, ...json.DecodeOptionFunc) error{
+		"unmarshal": func(src string, v interface{}, optFuncs ...json.DecodeOptionFunc) error {
+			return json.UnmarshalWithOption([]byte(src), v, optFuncs...)
+		},
+		"stream": func(src string, v interface{}, optFuncs ...json.DecodeOptionFunc) error {
+			return json.NewDecoder(strings.NewReader(src)).DecodeWithOption(v, optFuncs...)
+		},
+	}
+	for name, decode := range decoders {
+		t.Run(name, func(t *testing.T) {
+			t.Run("all present", func(t *testing.T) {
+				var v T
+				assertErr(t, decode(`{"tag":"x","id":1,"owner":{"email":"a"},"items":[{"name":"a"}]}`, &v))
+				assertEq(t, "id", 1, v.ID)
+				assertEq(t, "email", "a", v.Owner.Email)
+			})
+			t.Run("missing", func(t *testing.T) {
+				var v T
+				err := decode(`{"owner":{},"items":[{"name":"a"},{"count":2},{}],"by_key":{"k":{"count":1}},"note":"n"}`, &v)
+				missing, ok := err.(*json.MissingFieldsError)
+				if !ok {
+					t.Fatalf("expected MissingFieldsError but got %v", err)
+				}
+				assertEq(t, "paths", "/owner/email,/items/1/name,/items/2/name,/by_key/k/name,/tag,/id", strings.Join(missing.Paths, ","))
+				assertEq(t, "decoded after missing field", "n", v.Note)
+				assertEq(t, "count", 2, v.Items[1].Count)
+			})
+			t.Run("empty object", func(t *testing.T) {
+				var v Owner
+				err := decode(`{ }`, &v)
+				if _, ok := err.(*json.MissingFieldsError); !ok {
+					t.Fatalf("expected MissingFieldsError but got %v", err)
+				}
+			})
+			t.Run("require fields option", func(t *testing.T) {
+				var v struct {
+					A int
+					B *int
+					C string
+				}
+				err := decode(`{"A":1}`, &v, json.DecodeRequireFields())
+				missing, ok := err.(*json.MissingFieldsError)
+				if !ok {
+					t.Fatalf("expected MissingFieldsError but got %v", err)
+				}
+				assertEq(t, "paths", "/C", strings.Join(missing.Paths, ","))
+				assertEq(t, "error", "json: missing required fields /C", err.Error())
+			})
+		})
+	}
+}
+
+type registeredHex int
+
+func TestRegisterDecoder(t *testing.T) {
+	json.RegisterDecoder(reflect.TypeOf(registeredHex(0)), func(data []byte, v interface{}) error {
+		s, err := strconv.Unquote(string(data))
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseInt(s, 16, 64)
+		if err != nil {
+			return err
+		}
+		*v.(*registeredHex) = registeredHex(n)
+		return nil
+	})
+
+	type T struct {
+		A registeredHex
+		B *registeredHex
+		C []registeredHex
+	}
+	const src = `{"A":"ff","B":"10","C":["1","a"]}`
+	t.Run("unmarshal", func(t *testing.T) {
+		var v T
+		assertErr(t, json.Unmarshal([]byte(src), &v))
+		assertEq(t, "A", registeredHex(255), v.A)
+		assertEq(t, "B", registeredHex(16), *v.B)
+		assertEq(t, "C", fmt.Sprint([]registeredHex{1, 10}), fmt.Sprint(v.C))
+
+		var h registeredHex
+		assertErr(t, json.Unmarshal([]byte(`"20"`), &h))
+		assertEq(t, "root", registeredHex(32), h)
+	})
+	t.Run("stream", func(t *testing.T) {
+		var v T
+		assertErr(t, json.NewDecoder(strings.NewReader(src)).Decode(&v))
+		assertEq(t, "A", registeredHex(255), v.A)
+		assertEq(t, "B", registeredHex(16), *v.B)
+		assertEq(t, "C", fmt.Sprint([]registeredHex{1, 10}), fmt.Sprint(v.C))
+	})
+	t.Run("error", func(t *testing.T) {
+		var v T
+		if err := json.Unmarshal([]byte(`{"A":"zz"}`), &v); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+	t.Run("decoder", func(t *testing.T) {
+		const src = `{"T":100}`
+		var v struct{ T time.Time }
+		dec := json.NewDecoder(strings.NewReader(src))
+		dec.RegisterDecoder(reflect.TypeOf(time.Time{}), func(data []byte, v interface{}) error {
+			sec, err := strconv.ParseInt(string(data), 10, 64)
+			if err != nil {
+				return err
+			}
+			*v.(*time.Time) = time.Unix(sec, 0).UTC()
+			return nil
+		})
+		assertErr(t, dec.Decode(&v))
+		assertEq(t, "decoder", time.Unix(100, 0).UTC(), v.T)
+
+		if err := json.Unmarshal([]byte(src), &v); err == nil {
+			t.Fatal("expected Unmarshal not to be affected")
+		}
+	})
+}
+
+func TestDecodeCaseSensitive(t *testing.T) {
+	type Embedded struct {
+		Name string `json:"name"`
+	}
+	type T struct {
+		ID    int
+		Lower int `json:"id"`
+		Embedded
+	}
+	const src = `{"ID":1,"id":2,"Name":"x","name":"y","iD":3}`
+	t.Run("unmarshal", func(t *testing.T) {
+		var v T
+		assertErr(t, json.UnmarshalWithOption([]byte(src), &v, json.DecodeCaseSensitive()))
+		assertEq(t, "ID", 1, v.ID)
+		assertEq(t, "id", 2, v.Lower)
+		assertEq(t, "name", "y", v.Name)
+	})
+	t.Run("stream", func(t *testing.T) {
+		var v T
+		dec := json.NewDecoder(strings.NewReader(src))
+		dec.CaseSensitive()
+		assertErr(t, dec.Decode(&v))
+		assertEq(t, "ID", 1, v.ID)
+		assertEq(t, "id", 2, v.Lower)
+		assertEq(t, "name", "y", v.Name)
+	})
+	t.Run("same key in different case", func(t *testing.T) {
+		var v struct{ ID int }
+		assertErr(t, json.UnmarshalWithOption([]byte(`{"ID":1,"id":2}`), &v, json.DecodeCaseSensitive()))
+		assertEq(t, "case sensitive", 1, v.ID)
+		assertErr(t, json.Unmarshal([]byte(`{"ID":1,"id":2}`), &v))
+		assertEq(t, "case insensitive", 2, v.ID)
+	})
+	t.Run("many fields", func(t *testing.T) {
+		type T struct {
+			A, B, C, D, E, F, G, H, I, J int
+			K, L, M, N, O, P, Q, R, S    int
+		}
+		var v T
+		assertErr(t, json.UnmarshalWithOption([]byte(`{"A":1,"b":2,"S":3,"s":4}`), &v, json.DecodeCaseSensitive()))
+		assertEq(t, "A", 1, v.A)
+		assertEq(t, "B", 0, v.B)
+		assertEq(t, "S", 3, v.S)
+	})
+	t.Run("disallow unknown fields", func(t *testing.T) {
+		var v struct{ ID int }
+		err := json.UnmarshalWithOption([]byte(`{"id":1}`), &v, json.DecodeCaseSensitive(), json.DecodeDisallowUnknownFields())
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+	t.Run("interface values compiled concurrently", func(t *testing.T) {
+		type T struct{ V interface{} }
+		var wg sync.WaitGroup
+		errs := make(chan error, 8)
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				caseSensitive := i%2 == 0
+				var opts []json.DecodeOptionFunc
+				expected := int64(1)
+				if caseSensitive {
+					opts = append(opts, json.DecodeCaseSensitive())
+					expected = 0
+				}
+				for j := 0; j < 50; j++ {
+					// a new type is compiled while decoding each value
+					key := fmt.Sprintf("k%d_%d", i, j)
+					typ := reflect.StructOf([]reflect.StructField{
+						{Name: "ID", Type: reflect.TypeOf(int64(0)), Tag: reflect.StructTag(`json:"` + key + `"`)},
+					})
+					v := T{V: reflect.New(typ).Interface()}
+					src := `{"V":{"` + strings.ToUpper(key) + `":1}}`
+					if err := json.UnmarshalWithOption([]byte(src), &v, opts...); err != nil {
+						errs <- err
+						return
+					}
+					if id := reflect.ValueOf(v.V).Elem().Field(0).Int(); id != expected {
+						errs <- fmt.Errorf("case sensitive %v: expected %d but got %d", caseSensitive, expected, id)
+						return
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			t.Error(err)
+		}
+	})
+}
+
+func TestDecodeNonFiniteFloatString(t *testing.T) {
+	type T struct {
+		A float64
+		B float32
+		C float64
+	}
+	const src = `{"A":"NaN","B":"Infinity","C":"-Infinity"}`
+	check := func(t *testing.T, v T) {
+		t.Helper()
+		if !math.IsNaN(v.A) || !math.IsInf(float64(v.B), 1) || !math.IsInf(v.C, -1) {
+			t.Fatalf("unexpected value %+v", v)
+		}
+	}
+	t.Run("unmarshal", func(t *testing.T) {
+		var v T
+		assertErr(t, json.UnmarshalWithOption([]byte(src), &v, json.DecodeNonFiniteFloatString()))
+		check(t, v)
+	})
+	t.Run("stream", func(t *testing.T) {
+		var v T
+		assertErr(t, json.NewDecoder(strings.NewReader(src)).DecodeWithOption(&v, json.DecodeNonFiniteFloatString()))
+		check(t, v)
+	})
+	t.Run("round trip", func(t *testing.T) {
+		b, err := json.MarshalWithOption(T{A: math.NaN(), B: float32(math.Inf(1)), C: math.Inf(-1)}, json.NonFiniteFloatAsString())
+		assertErr(t, err)
+		var v T
+		assertErr(t, json.UnmarshalWithOption(b, &v, json.DecodeNonFiniteFloatString()))
+		check(t, v)
+	})
+	t.Run("invalid string", func(t *testing.T) {
+		check := func(t *testing.T, err error, typ reflect.Type, offset int64) {
+			t.Helper()
+			terr, ok := err.(*json.UnmarshalTypeError)
+			if !ok {
+				t.Fatalf("expected UnmarshalTypeError but got %v", err)
+			}
+			assertEq(t, "value", "string", terr.Value)
+			assertEq(t, "type", typ, terr.Type)
+			assertEq(t, "offset", offset, terr.Offset)
+		}
+		for _, test := range []struct {
+			src    string
+			typ    reflect.Type
+			offset int64
+		}{
+			{`{"A":"Inf"}`, reflect.TypeOf(float64(0)), 5},
+			{`{"A":1, "B":"foo"}`, reflect.TypeOf(float32(0)), 12},
+		} {
+			var v T
+			check(t, json.UnmarshalWithOption([]byte(test.src), &v, json.DecodeNonFiniteFloatString()), test.typ, test.offset)
+			check(t, json.NewDecoder(strings.NewReader(test.src)).DecodeWithOption(&v, json.DecodeNonFiniteFloatString()), test.typ, test.offset)
+		}
+	})
+	t.Run("disabled", func(t *testing.T) {
+		var v T
+		if err := json.Unmarshal([]byte(src), &v); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestDecodeKeyNaming(t *testing.T) {
+	type Embedded struct {
+		LastName string
+	}
+	type T struct {
+		UserID     int
+		HTTPServer string
+		Name       string `json:"NAME"`
+		Embedded
+	}
+	tests := []struct {
+		name string
+		opt  json.DecodeOptionFunc
+		src  string
+	}{
+		{"snake case", json.DecodeSnakeCaseKeys(), `{"user_id":1,"http_server":"a","NAME":"b","last_name":"c"}`},
+		{"camel case", json.DecodeCamelCaseKeys(), `{"userId":1,"httpServer":"a","NAME":"b","lastName":"c"}`},
+		{"kebab case", json.DecodeKebabCaseKeys(), `{"user-id":1,"http-server":"a","NAME":"b","last-name":"c"}`},
+	}
+	expected := T{UserID: 1, HTTPServer: "a", Name: "b", Embedded: Embedded{LastName: "c"}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var v T
+			assertErr(t, json.UnmarshalWithOption([]byte(test.src), &v, test.opt))
+			assertEq(t, "unmarshal", expected, v)
+
+			v = T{}
+			assertErr(t, json.NewDecoder(strings.NewReader(test.src)).DecodeWithOption(&v, test.opt))
+			assertEq(t, "stream", expected, v)
+		})
+	}
+	var v T
+	assertErr(t, json.Unmarshal([]byte(`{"user_id":1,"UserID":2}`), &v))
+	assertEq(t, "default", 2, v.UserID)
+}
+
+func TestUnknownField(t *testing.T) {
+	assertDeepEq := func(t *testing.T, msg string, exp, act interface{}) {
+		t.Helper()
+		if !reflect.DeepEqual(exp, act) {
+			t.Fatalf("%s: expected %v but got %v", msg, exp, act)
+		}
+	}
+	type Proxy struct {
+		ID    int                        `json:"id"`
+		Name  string                     `json:"name,omitempty"`
+		Extra map[string]json.RawMessage `json:",unknown"`
+	}
+	t.Run("round trip", func(t *testing.T) {
+		src := `{"id":1,"x":[1, 2],"name":"n","y":{"z":true},"a\"b":"cé","":1}`
+		expected := Proxy{ID: 1, Name: "n", Extra: map[string]json.RawMessage{
+			"x":   json.RawMessage(`[1, 2]`),
+			"y":   json.RawMessage(`{"z":true}`),
+			`a"b`: json.RawMessage(`"cé"`),
+			"":    json.RawMessage(`1`),
+		}}
+		var v Proxy
+		assertErr(t, json.Unmarshal([]byte(src), &v))
+		assertDeepEq(t, "unmarshal", expected, v)
+
+		v = Proxy{}
+		assertErr(t, json.NewDecoder(strings.NewReader(src)).Decode(&v))
+		assertDeepEq(t, "stream", expected, v)
+
+		b, err := json.Marshal(v)
+		assertErr(t, err)
+		assertDeepEq(t, "marshal", `{"id":1,"name":"n","":1,"a\"b":"cé","x":[1,2],"y":{"z":true}}`, string(b))
+		b, err = json.Marshal(&v)
+		assertErr(t, err)
+		assertDeepEq(t, "marshal pointer", `{"id":1,"name":"n","":1,"a\"b":"cé","x":[1,2],"y":{"z":true}}`, string(b))
+		b, err = json.MarshalIndent(map[string]interface{}{"v": v}, "", "  ")
+		assertErr(t, err)
+		assertDeepEq(t, "indent", `{
+  "v": {
+    "id": 1,
+    "name": "n",
+    "": 1,
+    "a\"b": "cé",
+    "x": [
+      1,
+      2
+    ],
+    "y": {
+      "z": true
+    }
+  }
+}`, string(b))
+		b, err = json.MarshalWithFieldMask(v, json.NewFieldMask("id", "x"))
+		assertErr(t, err)
+		assertDeepEq(t, "fields", `{"id":1,"x":[1,2]}`, string(b))
+	})
+	t.Run("escaped keys", func(t *testing.T) {
+		// the fields with the same lower case key need the fallback key decoder
+		type T struct {
+			Hello int
+			HELLO int
+			Rest  map[string]string `json:",unknown"`
+		}
+		src := `{"Hello":1,"HELLO":2,"a\"bé":"c","<":"d"}`
+		expected := T{Hello: 1, HELLO: 2, Rest: map[string]string{`a"bé`: "c", "<": "d"}}
+		var v T
+		assertErr(t, json.Unmarshal([]byte(src), &v))
+		assertDeepEq(t, "unmarshal", expected, v)
+
+		v = T{}
+		assertErr(t, json.NewDecoder(strings.NewReader(src)).Decode(&v))
+		assertDeepEq(t, "stream", expected, v)
+
+		var p Proxy
+		assertErr(t, json.Unmarshal([]byte(`{"a\"bé":1}`), &p))
+		assertDeepEq(t, "bitmap key decoder", map[string]json.RawMessage{`a"bé`: json.RawMessage(`1`)}, p.Extra)
+	})
+	t.Run("empty", func(t *testing.T) {
+		var v Proxy
+		assertErr(t, json.Unmarshal([]byte(`{"id":2}`), &v))
+		assertDeepEq(t, "nil map", true, v.Extra == nil)
+		b, err := json.Marshal(v)
+		assertErr(t, err)
+		assertDeepEq(t, "marshal", `{"id":2}`, string(b))
+		b, err = json.MarshalIndent(v, "", "  ")
+		assertErr(t, err)
+		assertDeepEq(t, "indent", "{\n  \"id\": 2\n}", string(b))
+	})
+	t.Run("only unknown field", func(t *testing.T) {
+		type T struct {
+			Rest map[string]interface{} `json:",unknown"`
+		}
+		var v T
+		assertErr(t, json.Unmarshal([]byte(`{"b":[1,{"c":null}],"a":1}`), &v))
+		assertDeepEq(t, "unmarshal", T{Rest: map[string]interface{}{"a": float64(1), "b": []interface{}{float64(1), map[string]interface{}{"c": nil}}}}, v)
+		for _, test := range []struct {
+			v        interface{}
+			expected string
+		}{
+			{v, `{"a":1,"b":[1,{"c":null}]}`},
+			{&v, `{"a":1,"b":[1,{"c":null}]}`},
+			{[]T{v}, `[{"a":1,"b":[1,{"c":null}]}]`},
+			{T{}, `{}`},
+			{&T{}, `{}`},
+		} {
+			b, err := json.Marshal(test.v)
+			assertErr(t, err)
+			assertDeepEq(t, "marshal", test.expected, string(b))
+		}
+		b, err := json.MarshalIndent(map[string]T{"k": v}, "", "  ")
+		assertErr(t, err)
+		assertDeepEq(t, "indent", `{
+  "k": {
+    "a": 1,
+    "b": [
+      1,
+      {
+        "c": null
+      }
+    ]
+  }
+}`, string(b))
+	})
+	t.Run("embedded", func(t *testing.T) {
+		type Inner struct {
+			A    int                    `json:"a"`
+			Rest map[string]interface{} `json:",unknown"`
+		}
+		type Value struct {
+			Inner
+			B int `json:"b"`
+		}
+		type Pointer struct {
+			*Inner
+			B int `json:"b"`
+		}
+		src := `{"a":1,"b":2,"c":3}`
+		var v Value
+		assertErr(t, json.Unmarshal([]byte(src), &v))
+		assertDeepEq(t, "value", Value{Inner: Inner{A: 1, Rest: map[string]interface{}{"c": float64(3)}}, B: 2}, v)
+		b, err := json.Marshal(v)
+		assertErr(t, err)
+		assertDeepEq(t, "marshal value", `{"a":1,"c":3,"b":2}`, string(b))
+
+		var p Pointer
+		assertErr(t, json.NewDecoder(strings.NewReader(src)).Decode(&p))
+		assertDeepEq(t, "pointer", Inner{A: 1, Rest: map[string]interface{}{"c": float64(3)}}, *p.Inner)
+		b, err = json.Marshal(p)
+		assertErr(t, err)
+		assertDeepEq(t, "marshal pointer", `{"a":1,"c":3,"b":2}`, string(b))
+	})
+	t.Run("field keys in map", func(t *testing.T) {
+		type Inner struct {
+			A int `json:"a"`
+		}
+		type T struct {
+			Inner
+			Rest map[string]int `json:",unknown"`
+		}
+		v := Proxy{ID: 1, Extra: map[string]json.RawMessage{"id": json.RawMessage(`2`), "name": json.RawMessage(`"x"`), "z": json.RawMessage(`3`)}}
+		b, err := json.Marshal(v)
+		assertErr(t, err)
+		assertDeepEq(t, "marshal", `{"id":1,"z":3}`, string(b))
+		b, err = json.Marshal(T{Inner: Inner{A: 1}, Rest: map[string]int{"a": 2, "b": 3}})
+		assertErr(t, err)
+		assertDeepEq(t, "marshal embedded", `{"a":1,"b":3}`, string(b))
+	})
+	t.Run("disallow unknown fields", func(t *testing.T) {
+		var v Proxy
+		dec := json.NewDecoder(strings.NewReader(`{"id":1,"x":2}`))
+		dec.DisallowUnknownFields()
+		assertErr(t, dec.Decode(&v))
+		assertDeepEq(t, "extra", map[string]json.RawMessage{"x": json.RawMessage(`2`)}, v.Extra)
+	})
+	t.Run("missing fields", func(t *testing.T) {
+		type Required struct {
+			A int `json:"a,required"`
+		}
+		type T struct {
+			Rest map[string]Required `json:",unknown"`
+		}
+		src := `{"x":{},"y":{"a":1}}`
+		var v T
+		err := json.Unmarshal([]byte(src), &v)
+		assertDeepEq(t, "error", "json: missing required fields /x/a", fmt.Sprint(err))
+		assertDeepEq(t, "value", T{Rest: map[string]Required{"x": {}, "y": {A: 1}}}, v)
+
+		v = T{}
+		err = json.NewDecoder(strings.NewReader(src)).Decode(&v)
+		assertDeepEq(t, "stream error", "json: missing required fields /x/a", fmt.Sprint(err))
+		assertDeepEq(t, "stream value", T{Rest: map[string]Required{"x": {}, "y": {A: 1}}}, v)
+	})
+	t.Run("invalid field", func(t *testing.T) {
+		type IntKey struct {
+			Rest map[int]int `json:",unknown"`
+		}
+		type Two struct {
+			A map[string]int `json:",unknown"`
+			B map[string]int `json:",unknown"`
+		}
+		for _, test := range []struct {
+			v        interface{}
+			expected string
+		}{
+			{&IntKey{}, "json: field Rest of json_test.IntKey with the unknown option must be a map with string keys"},
+			{&Two{}, "json: json_test.Two has more than one field with the unknown or inline option"},
+		} {
+			assertDeepEq(t, "unmarshal", test.expected, fmt.Sprint(json.Unmarshal([]byte(`{}`), test.v)))
+			_, err := json.Marshal(test.v)
+			assertDeepEq(t, "marshal", test.expected, fmt.Sprint(err))
+		}
+	})
+}
+
+func TestInlineField(t *testing.T) {
+	assertDeepEq := func(t *testing.T, msg string, exp, act interface{}) {
+		t.Helper()
+		if !reflect.DeepEqual(exp, act) {
+			t.Fatalf("%s: expected %v but got %v", msg, exp, act)
+		}
+	}
+	type Meta struct {
+		Kind string `json:"kind"`
+		Name string `json:"name"`
+	}
+	type Version struct {
+		Ver int `json:"ver"`
+	}
+	type Doc struct {
+		ID      int                    `json:"id"`
+		Meta    Meta                   `json:"meta,inline"`
+		Version *Version               `json:",inline"`
+		Name    string                 `json:"name"`
+		Extra   map[string]interface{} `json:",inline"`
+	}
+	t.Run("round trip", func(t *testing.T) {
+		src := `{"id":1,"kind":"k","name":"n","ver":2,"x":true}`
+		expected := Doc{
+			ID:      1,
+			Meta:    Meta{Kind: "k"},
+			Version: &Version{Ver: 2},
+			Name:    "n",
+			Extra:   map[string]interface{}{"x": true},
+		}
+		var v Doc
+		assertErr(t, json.Unmarshal([]byte(src), &v))
+		assertDeepEq(t, "unmarshal", expected, v)
+
+		v = Doc{}
+		assertErr(t, json.NewDecoder(strings.NewReader(src)).Decode(&v))
+		assertDeepEq(t, "stream", expected, v)
+
+		b, err := json.Marshal(v)
+		assertErr(t, err)
+		assertDeepEq(t, "marshal", `{"id":1,"kind":"k","ver":2,"name":"n","x":true}`, string(b))
+		b, err = json.MarshalIndent(&v, "", "  ")
+		assertErr(t, err)
+		assertDeepEq(t, "indent", `{
+  "id": 1,
+  "kind": "k",
+  "ver": 2,
+  "name": "n",
+  "x": true
+}`, string(b))
+	})
+	t.Run("fields", func(t *testing.T) {
+		type T struct {
+			ID    int               `json:"id"`
+			Meta  Meta              `json:",inline"`
+			Extra map[string]string `json:",inline"`
+		}
+		v := T{ID: 1, Meta: Meta{Kind: "k", Name: "n"}, Extra: map[string]string{"x": "a", "y": "b"}}
+		b, err := json.MarshalWithFieldMask(v, json.NewFieldMask("kind", "x"))
+		assertErr(t, err)
+		assertDeepEq(t, "fields", `{"kind":"k","x":"a"}`, string(b))
+	})
+	t.Run("conflict", func(t *testing.T) {
+		type A struct {
+			X int `json:"x"`
+			Y int
+		}
+		type B struct {
+			X int `json:"x"`
+			Y int `json:"Y"`
+		}
+		type T struct {
+			A A `json:",inline"`
+			B B `json:",inline"`
+		}
+		// the tagged key wins and the conflicting ones of the same level are dropped
+		v := T{A: A{X: 1, Y: 2}, B: B{X: 3, Y: 4}}
+		b, err := json.Marshal(v)
+		assertErr(t, err)
+		assertDeepEq(t, "marshal", `{"Y":4}`, string(b))
+
+		var got T
+		assertErr(t, json.Unmarshal([]byte(`{"x":1,"Y":4}`), &got))
+		assertDeepEq(t, "unmarshal", T{B: B{Y: 4}}, got)
+	})
+	t.Run("invalid field", func(t *testing.T) {
+		type T struct {
+			X int `json:",inline"`
+		}
+		expected := "json: field X of json_test.T with the inline option must be a struct or a map with string keys"
+		assertDeepEq(t, "unmarshal", expected, fmt.Sprint(json.Unmarshal([]byte(`{}`), &T{})))
+		_, err := json.Marshal(T{})
+		assertDeepEq(t, "marshal", expected, fmt.Sprint(err))
+	})
+}
@@ -0,0 +1,278 @@
+package json
+
+import (
+	"io"
+	"strconv"
+	"unsafe"
+)
+
+// tokenState is the position of a Decoder inside the JSON value read by Token.
+// It lets Token and Decode be mixed on the same stream while rejecting misplaced delimiters.
+type tokenState int
+
+const (
+	tokenTopValue tokenState = iota
+	tokenArrayStart
+	tokenArrayValue
+	tokenArrayComma
+	tokenObjectStart
+	tokenObjectKey
+	tokenObjectColon
+	tokenObjectValue
+	tokenObjectComma
+)
+
+// peek skips white space and returns the next byte of the stream without consuming it.
+func (d *Decoder) peek() (byte, error) {
+	s := d.s
+	for {
+		switch s.char() {
+		case ' ', '\n', '\r', '\t':
+			s.cursor++
+			continue
+		case nul:
+			if s.read() {
+				continue
+			}
+			return nul, io.EOF
+		}
+		return s.char(), nil
+	}
+}
+
+// tokenPrepareForDecode consumes the comma or colon which precedes a value decoded by Decode.
+func (d *Decoder) tokenPrepareForDecode() error {
+	s := d.s
+	switch d.tokenState {
+	case tokenArrayComma:
+		if s.char() != ',' {
+			return errExpected("comma after array element", s.totalOffset())
+		}
+		s.cursor++
+		d.tokenState = tokenArrayValue
+	case tokenObjectColon:
+		if s.char() != ':' {
+			return errExpected("colon after object key", s.totalOffset())
+		}
+		s.cursor++
+		d.tokenState = tokenObjectValue
+	}
+	return nil
+}
+
+func (d *Decoder) tokenValueAllowed() bool {
+	switch d.tokenState {
+	case tokenTopValue, tokenArrayStart, tokenArrayValue, tokenObjectValue:
+		return true
+	}
+	return false
+}
+
+func (d *Decoder) tokenValueEnd() {
+	switch d.tokenState {
+	case tokenArrayStart, tokenArrayValue:
+		d.tokenState = tokenArrayComma
+	case tokenObjectValue:
+		d.tokenState = tokenObjectComma
+	}
+}
+
+func (d *Decoder) tokenError(c byte) (Token, error) {
+	var context string
+	switch d.tokenState {
+	case tokenTopValue, tokenArrayStart, tokenArrayValue, tokenObjectValue:
+		context = " looking for beginning of value"
+	case tokenArrayComma:
+		context = " after array element"
+	case tokenObjectStart, tokenObjectKey:
+		context = " looking for beginning of object key string"
+	case tokenObjectColon:
+		context = " after object key"
+	case tokenObjectComma:
+		context = " after object key:value pair"
+	}
+	return nil, &SyntaxError{
+		msg:    "invalid character " + quoteChar(c) + context,
+		Offset: d.s.totalOffset(),
+	}
+}
+
+// tokenValidateString validates the string at the cursor before it's unescaped in place.
+// Like encoding/json, the offset of an invalid character is counted from the beginning of the string.
+// An unterminated string is left to the string decoder.
+func tokenValidateString(s *stream) error {
+	start := s.cursor
+	err := s.skipString(0)
+	if err != nil && s.peek() != nul {
+		serr := err.(*SyntaxError)
+		serr.Offset -= s.offset + start
+		return serr
+	}
+	s.cursor = start
+	return nil
+}
+
+// tokenNumberBytes reads the number at the cursor and validates its syntax like encoding/json.
+// The bytes after the longest valid number are left in the stream for the next token.
+func tokenNumberBytes(s *stream) ([]byte, error) {
+	start := s.cursor
+	bytes := floatBytes(s)
+	scan := newScanner(bytes)
+	cursor, ok := scan.scanNumber(0)
+	if !ok {
+		if scan.cursor < int64(len(bytes)) {
+			return nil, errSyntax(bytes[scan.cursor], scan.context, scan.cursor)
+		}
+		c := s.peek()
+		if c == nul {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, errSyntax(c, scan.context, scan.cursor)
+	}
+	s.cursor = start + cursor
+	return bytes[:cursor], nil
+}
+
+// Token returns the next JSON token in the input stream.
+// At the end of the input stream, Token returns nil, io.EOF.
+//
+// Token guarantees that the delimiters [ ] { } it returns are
+// properly nested and matched: if Token encounters an unexpected
+// delimiter in the input, it will return an error.
+//
+// The input stream consists of basic JSON values—bool, string,
+// number, and null—along with delimiters [ ] { } of type Delim
+// to mark the start and end of arrays and objects.
+// Commas and colons are elided.
+// Numbers are returned as float64, or as Number if UseNumber was called.
+func (d *Decoder) Token() (Token, error) {
+	s := d.s
+	for {
+		c, err := d.peek()
+		if err != nil {
+			return nil, err
+		}
+		switch c {
+		case '[':
+			if !d.tokenValueAllowed() {
+				return d.tokenError(c)
+			}
+			s.cursor++
+			d.tokenStack = append(d.tokenStack, d.tokenState)
+			d.tokenState = tokenArrayStart
+			return Delim(c), nil
+		case ']':
+			if d.tokenState != tokenArrayStart && d.tokenState != tokenArrayComma {
+				return d.tokenError(c)
+			}
+			s.cursor++
+			d.popTokenState()
+			return Delim(c), nil
+		case '{':
+			if !d.tokenValueAllowed() {
+				return d.tokenError(c)
+			}
+			s.cursor++
+			d.tokenStack = append(d.tokenStack, d.tokenState)
+			d.tokenState = tokenObjectStart
+			return Delim(c), nil
+		case '}':
+			if d.tokenState != tokenObjectStart && d.tokenState != tokenObjectComma {
+				return d.tokenError(c)
+			}
+			s.cursor++
+			d.popTokenState()
+			return Delim(c), nil
+		case ':':
+			if d.tokenState != tokenObjectColon {
+				return d.tokenError(c)
+			}
+			s.cursor++
+			d.tokenState = tokenObjectValue
+		case ',':
+			switch d.tokenState {
+			case tokenArrayComma:
+				d.tokenState = tokenArrayValue
+			case tokenObjectComma:
+				d.tokenState = tokenObjectKey
+			default:
+				return d.tokenError(c)
+			}
+			s.cursor++
+		case '"':
+			isKey := d.tokenState == tokenObjectStart || d.tokenState == tokenObjectKey
+			if !isKey && !d.tokenValueAllowed() {
+				return d.tokenError(c)
+			}
+			if err := tokenValidateString(s); err != nil {
+				return nil, err
+			}
+			bytes, err := stringBytes(s)
+			if err != nil {
+				return nil, err
+			}
+			if isKey {
+				d.tokenState = tokenObjectColon
+			} else {
+				d.tokenValueEnd()
+			}
+			return string(bytes), nil
+		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			if !d.tokenValueAllowed() {
+				return d.tokenError(c)
+			}
+			bytes, err := tokenNumberBytes(s)
+			if err != nil {
+				return nil, err
+			}
+			str := *(*string)(unsafe.Pointer(&bytes))
+			f64, err := strconv.ParseFloat(str, 64)
+			if err != nil {
+				return nil, err
+			}
+			d.tokenValueEnd()
+			if d.opt.Flags&DecodeOptionUseNumber != 0 {
+				return Number(string(bytes)), nil
+			}
+			return f64, nil
+		case 't', 'f', 'n':
+			if !d.tokenValueAllowed() {
+				return d.tokenError(c)
+			}
+			tk, err := literalToken(s, c)
+			if err != nil {
+				return nil, err
+			}
+			d.tokenValueEnd()
+			return tk, nil
+		default:
+			return d.tokenError(c)
+		}
+	}
+}
+
+func (d *Decoder) popTokenState() {
+	n := len(d.tokenStack)
+	d.tokenState = d.tokenStack[n-1]
+	d.tokenStack = d.tokenStack[:n-1]
+	d.tokenValueEnd()
+}
+
+func literalToken(s *stream, c byte) (Token, error) {
+	switch c {
+	case 't':
+		if err := trueBytes(s); err != nil {
+			return nil, err
+		}
+		return true, nil
+	case 'f':
+		if err := falseBytes(s); err != nil {
+			return nil, err
+		}
+		return false, nil
+	}
+	if err := nullBytes(s); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
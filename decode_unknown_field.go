@@ -0,0 +1,120 @@
+package json
+
+import (
+	"bytes"
+	"unsafe"
+)
+
+// unknownFieldDecoder decodes the members of an object which have no struct field
+// into the map of the field with the unknown option.
+type unknownFieldDecoder struct {
+	mapType      *rtype
+	valueType    *rtype
+	valueDecoder decoder
+	offset       uintptr              // offset of the map, or of the pointer to the embedded struct which has it
+	structType   *rtype               // type of the embedded struct pointed at offset, nil if the map is at offset
+	field        *unknownFieldDecoder // the field of the embedded struct of structType
+}
+
+func newUnknownFieldDecoder(mapType *rtype, valueDec decoder, offset uintptr) *unknownFieldDecoder {
+	return &unknownFieldDecoder{
+		mapType:      mapType,
+		valueType:    mapType.Elem(),
+		valueDecoder: valueDec,
+		offset:       offset,
+	}
+}
+
+// promote returns the decoder of the field of an embedded struct at offset in the outer struct.
+// structType is the type of the embedded struct if it's embedded by pointer.
+func (d *unknownFieldDecoder) promote(structType *rtype, offset uintptr) *unknownFieldDecoder {
+	if structType == nil {
+		promoted := *d
+		promoted.offset += offset
+		return &promoted
+	}
+	return &unknownFieldDecoder{
+		mapType:      d.mapType,
+		valueType:    d.valueType,
+		valueDecoder: d.valueDecoder,
+		offset:       offset,
+		structType:   structType,
+		field:        d,
+	}
+}
+
+// mapPtr returns the address of the map in the struct at p, allocating the embedded structs on the way.
+func (d *unknownFieldDecoder) mapPtr(p unsafe.Pointer) unsafe.Pointer {
+	p = unsafe.Pointer(uintptr(p) + d.offset)
+	if d.structType == nil {
+		return p
+	}
+	if *(*unsafe.Pointer)(p) == nil {
+		*(*unsafe.Pointer)(p) = unsafe_New(d.structType)
+	}
+	return d.field.mapPtr(*(*unsafe.Pointer)(p))
+}
+
+func (d *unknownFieldDecoder) set(p unsafe.Pointer, key string, v unsafe.Pointer) {
+	m := d.mapPtr(p)
+	if *(*unsafe.Pointer)(m) == nil {
+		*(*unsafe.Pointer)(m) = makemap(d.mapType, 0)
+	}
+	mapassign(d.mapType, *(*unsafe.Pointer)(m), unsafe.Pointer(&key), v)
+}
+
+func (d *unknownFieldDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, key string, p unsafe.Pointer) error {
+	v := unsafe_New(d.valueType)
+	err := d.valueDecoder.decodeStream(s, depth, opt, v)
+	if err != nil && !isMissingFieldsError(err) {
+		return err
+	}
+	d.set(p, key, v)
+	return err
+}
+
+func (d *unknownFieldDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, key string, p unsafe.Pointer) (int64, error) {
+	v := unsafe_New(d.valueType)
+	c, err := d.valueDecoder.decode(buf, cursor, depth, opt, v)
+	if err != nil && !isMissingFieldsError(err) {
+		return 0, err
+	}
+	d.set(p, key, v)
+	return c, err
+}
+
+// isMissingFieldsError reports whether err only reports the missing fields of a decoded value.
+func isMissingFieldsError(err error) bool {
+	_, ok := err.(*MissingFieldsError)
+	return ok
+}
+
+// unknownFieldKey returns the key of an object member which has no struct field from the quoted key in the input.
+// The key decoders using the key bitmaps leave the escape sequences in the input,
+// but the other key decoders unescape the key in place.
+func (d *structDecoder) unknownFieldKey(quoted []byte) (string, error) {
+	key := quoted[1 : len(quoted)-1]
+	if (d.keyBitmapInt8 == nil && d.keyBitmapInt16 == nil) || bytes.IndexByte(key, '\\') < 0 {
+		return string(key), nil
+	}
+	// the string decoder needs the nul terminated buffer
+	buf := make([]byte, len(quoted)+1)
+	copy(buf, quoted)
+	unescaped, _, err := d.stringDecoder.decodeByte(buf, 0)
+	if err != nil {
+		return "", err
+	}
+	return string(unescaped), nil
+}
+
+// skippedValueError prepends the key of the skipped member to the path of err, given the quoted key in the input.
+func (d *structDecoder) skippedValueError(err error, quoted []byte) error {
+	if _, ok := err.(*LimitError); !ok {
+		return err
+	}
+	key, kerr := d.unknownFieldKey(quoted)
+	if kerr != nil {
+		return err
+	}
+	return annotateErrorPath(err, key)
+}
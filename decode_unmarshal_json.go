@@ -28,10 +28,17 @@ func (d *unmarshalJSONDecoder) annotateError(cursor int64, err error) {
 	}
 }
 
-func (d *unmarshalJSONDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func unmarshalJSON(opt *DecodeOption, v interface{}, src []byte) error {
+	if u, ok := v.(UnmarshalerContext); ok {
+		return u.UnmarshalJSONContext(opt.context(), src)
+	}
+	return v.(Unmarshaler).UnmarshalJSON(src)
+}
+
+func (d *unmarshalJSONDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	s.skipWhiteSpace()
 	start := s.cursor
-	if err := s.skipValue(); err != nil {
+	if err := s.skipValue(depth, opt); err != nil {
 		return err
 	}
 	src := s.buf[start:s.cursor]
@@ -42,17 +49,17 @@ func (d *unmarshalJSONDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 		typ: d.typ,
 		ptr: p,
 	}))
-	if err := v.(Unmarshaler).UnmarshalJSON(dst); err != nil {
+	if err := unmarshalJSON(opt, v, dst); err != nil {
 		d.annotateError(s.cursor, err)
 		return err
 	}
 	return nil
 }
 
-func (d *unmarshalJSONDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *unmarshalJSONDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	cursor = skipWhiteSpace(buf, cursor)
 	start := cursor
-	end, err := skipValue(buf, cursor)
+	end, err := skipValue(buf, cursor, depth, opt)
 	if err != nil {
 		return 0, err
 	}
@@ -64,7 +71,7 @@ func (d *unmarshalJSONDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer
 		typ: d.typ,
 		ptr: p,
 	}))
-	if err := v.(Unmarshaler).UnmarshalJSON(dst); err != nil {
+	if err := unmarshalJSON(opt, v, dst); err != nil {
 		d.annotateError(cursor, err)
 		return 0, err
 	}
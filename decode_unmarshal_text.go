@@ -32,10 +32,10 @@ func (d *unmarshalTextDecoder) annotateError(cursor int64, err error) {
 	}
 }
 
-func (d *unmarshalTextDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *unmarshalTextDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
 	s.skipWhiteSpace()
 	start := s.cursor
-	if err := s.skipValue(); err != nil {
+	if err := s.skipValue(depth, opt); err != nil {
 		return err
 	}
 	src := s.buf[start:s.cursor]
@@ -72,10 +72,10 @@ func (d *unmarshalTextDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
 	return nil
 }
 
-func (d *unmarshalTextDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *unmarshalTextDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
 	cursor = skipWhiteSpace(buf, cursor)
 	start := cursor
-	end, err := skipValue(buf, cursor)
+	end, err := skipValue(buf, cursor, depth, opt)
 	if err != nil {
 		return 0, err
 	}
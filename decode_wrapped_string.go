@@ -20,26 +20,36 @@ func newWrappedStringDecoder(dec decoder, structName, fieldName string) *wrapped
 	}
 }
 
-func (d *wrappedStringDecoder) decodeStream(s *stream, p unsafe.Pointer) error {
+func (d *wrappedStringDecoder) decodeStream(s *stream, depth int64, opt *DecodeOption, p unsafe.Pointer) error {
+	s.skipWhiteSpace()
+	if s.char() == 'n' {
+		// null isn't quoted and is decoded as is
+		return d.dec.decodeStream(s, depth, opt, p)
+	}
 	bytes, err := d.stringDecoder.decodeStreamByte(s)
 	if err != nil {
 		return err
 	}
 	b := make([]byte, len(bytes)+1)
 	copy(b, bytes)
-	if _, err := d.dec.decode(b, 0, p); err != nil {
+	if _, err := d.dec.decode(b, 0, depth, opt, p); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (d *wrappedStringDecoder) decode(buf []byte, cursor int64, p unsafe.Pointer) (int64, error) {
+func (d *wrappedStringDecoder) decode(buf []byte, cursor, depth int64, opt *DecodeOption, p unsafe.Pointer) (int64, error) {
+	cursor = skipWhiteSpace(buf, cursor)
+	if buf[cursor] == 'n' {
+		// null isn't quoted and is decoded as is
+		return d.dec.decode(buf, cursor, depth, opt, p)
+	}
 	bytes, c, err := d.stringDecoder.decodeByte(buf, cursor)
 	if err != nil {
 		return 0, err
 	}
 	bytes = append(bytes, nul)
-	if _, err := d.dec.decode(bytes, 0, p); err != nil {
+	if _, err := d.dec.decode(bytes, 0, depth, opt, p); err != nil {
 		return 0, err
 	}
 	return c, nil
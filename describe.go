@@ -0,0 +1,169 @@
+package json
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// DescribeEncoder returns the opcodes which Marshal runs to encode a value of typ, one per line.
+// It returns the error message if typ can't be encoded.
+func DescribeEncoder(typ reflect.Type) string {
+	codeSet, err := encodeCompileToGetCodeSet(uintptr(unsafe.Pointer(type2rtype(typ))))
+	if err != nil {
+		return err.Error()
+	}
+	return codeSet.code.dump()
+}
+
+// DescribeDecoder returns the decoders which Unmarshal runs to decode into a pointer to typ, as an indented tree.
+// The key tables of the struct decoders show the keys which are left after resolving the conflicts of
+// the anonymous fields. It returns the error message if typ can't be decoded.
+func DescribeDecoder(typ reflect.Type) string {
+	var d Decoder
+	d.initCompile(0)
+	dec, err := d.compileHead(rtype_ptrTo(type2rtype(typ)))
+	if err != nil {
+		return err.Error()
+	}
+	var b bytes.Buffer
+	describeLine(&b, 0, "%s", typ)
+	describeDecoder(&b, dec, 1, map[decoder]bool{})
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func describeLine(b *bytes.Buffer, depth int, format string, args ...interface{}) {
+	b.WriteString(strings.Repeat("  ", depth))
+	fmt.Fprintf(b, format, args...)
+	b.WriteByte('\n')
+}
+
+func describeDecoder(b *bytes.Buffer, dec decoder, depth int, seen map[decoder]bool) {
+	switch d := dec.(type) {
+	case *ptrDecoder:
+		describeLine(b, depth, "ptr *%s", d.typ)
+		describeDecoder(b, d.dec, depth+1, seen)
+	case *structDecoder:
+		if seen[d] {
+			describeLine(b, depth, "struct (recursive)")
+			return
+		}
+		seen[d] = true
+		describeStructDecoder(b, d, depth, seen)
+		delete(seen, d)
+	case *anonymousFieldDecoder:
+		describeLine(b, depth, "anonymous *%s offset:%d", d.structType, d.offset)
+		describeDecoder(b, d.dec, depth+1, seen)
+	case *sliceDecoder:
+		describeLine(b, depth, "slice []%s", d.elemType)
+		describeDecoder(b, d.valueDecoder, depth+1, seen)
+	case *arrayDecoder:
+		describeLine(b, depth, "array [%d]%s", d.alen, d.elemType)
+		describeDecoder(b, d.valueDecoder, depth+1, seen)
+	case *mapDecoder:
+		describeLine(b, depth, "map %s", d.mapType)
+		describeDecoder(b, d.keyDecoder, depth+1, seen)
+		describeDecoder(b, d.valueDecoder, depth+1, seen)
+	case *wrappedStringDecoder:
+		describeLine(b, depth, "string tag")
+		describeDecoder(b, d.dec, depth+1, seen)
+	case *customDecoder:
+		describeLine(b, depth, "registered %s", d.typ.Elem())
+	case *generatedDecoder:
+		describeLine(b, depth, "generated %s", d.typ.Elem())
+		describeDecoder(b, d.dec, depth+1, seen)
+	case *unmarshalJSONDecoder:
+		describeLine(b, depth, "UnmarshalJSON %s", d.typ)
+	case *unmarshalTextDecoder:
+		describeLine(b, depth, "UnmarshalText %s", d.typ)
+	case *interfaceDecoder:
+		describeLine(b, depth, "interface %s", d.typ)
+	case *bytesDecoder:
+		describeLine(b, depth, "bytes")
+	case *numberDecoder:
+		describeLine(b, depth, "number")
+	case *intDecoder:
+		describeLine(b, depth, "%s", d.kind)
+	case *uintDecoder:
+		describeLine(b, depth, "%s", d.kind)
+	case *floatDecoder:
+		describeLine(b, depth, "float")
+	case *boolDecoder:
+		describeLine(b, depth, "bool")
+	case *stringDecoder:
+		describeLine(b, depth, "string")
+	default:
+		describeLine(b, depth, "%T", dec)
+	}
+}
+
+func describeStructDecoder(b *bytes.Buffer, d *structDecoder, depth int, seen map[decoder]bool) {
+	describeLine(b, depth, "struct")
+	caseMode := "case insensitive"
+	if d.isCaseSensitive {
+		caseMode = "case sensitive"
+	}
+	switch {
+	case d.keyBitmapInt8 != nil || d.keyBitmapInt16 != nil:
+		bits := make([]string, 0, len(d.sortedFieldSets))
+		for i, set := range d.sortedFieldSets {
+			key := set.key
+			if !d.isCaseSensitive {
+				key = strings.ToLower(key)
+			}
+			bits = append(bits, fmt.Sprintf("%d:%q", i, key))
+		}
+		size := 8
+		if d.keyBitmapInt16 != nil {
+			size = 16
+		}
+		describeLine(b, depth+1, "keys: bitmap%d %s [%s]", size, caseMode, strings.Join(bits, " "))
+	default:
+		describeLine(b, depth+1, "keys: map %s", caseMode)
+	}
+
+	// the keys of a field and its aliases are described together
+	keys := make([]string, 0, len(d.fieldMap))
+	for key := range d.fieldMap {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		si, sj := d.fieldMap[keys[i]], d.fieldMap[keys[j]]
+		if si.fieldIdx != sj.fieldIdx {
+			return si.fieldIdx < sj.fieldIdx
+		}
+		return keys[i] < keys[j]
+	})
+	for i := 0; i < len(keys); {
+		set := d.fieldMap[keys[i]]
+		quoted := []string{}
+		for ; i < len(keys) && d.fieldMap[keys[i]].fieldIdx == set.fieldIdx; i++ {
+			quoted = append(quoted, fmt.Sprintf("%q", keys[i]))
+		}
+		attrs := ""
+		if set.isTaggedKey {
+			attrs += " tagged"
+		}
+		if set.isRequired {
+			attrs += " required"
+		}
+		describeLine(b, depth+1, "%s [%d] offset:%d%s", strings.Join(quoted, ", "), set.fieldIdx, set.offset, attrs)
+		describeDecoder(b, set.dec, depth+2, seen)
+	}
+	if d.unknown != nil {
+		describeUnknownField(b, d.unknown, depth+1, seen)
+	}
+}
+
+func describeUnknownField(b *bytes.Buffer, d *unknownFieldDecoder, depth int, seen map[decoder]bool) {
+	if d.structType != nil {
+		describeLine(b, depth, "anonymous *%s offset:%d", d.structType, d.offset)
+		describeUnknownField(b, d.field, depth+1, seen)
+		return
+	}
+	describeLine(b, depth, "unknown %s offset:%d", d.mapType, d.offset)
+	describeDecoder(b, d.valueDecoder, depth+1, seen)
+}
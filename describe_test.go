@@ -0,0 +1,65 @@
+package json_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+type describeBase struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type describeOther struct {
+	Name string `json:"name"`
+}
+
+type describeT struct {
+	describeBase
+	*describeOther
+	Tags []string         `json:"tags,omitempty"`
+	Next *describeT       `json:"next"`
+	Attr map[string]int64 `json:"attr"`
+	Age  uint8            `json:"age,string,required"`
+}
+
+func TestDescribeEncoder(t *testing.T) {
+	desc := json.DescribeEncoder(reflect.TypeOf(describeT{}))
+	for _, expected := range []string{
+		"StructFieldAnonymousHeadInt ([idx:1][key:id][offset:0]",
+		"StructFieldOmitEmptySlice (",
+		"StructFieldStringTagUint8 (",
+		"StructEnd (",
+	} {
+		if !strings.Contains(desc, expected) {
+			t.Fatalf("failed to describe %s in\n%s", expected, desc)
+		}
+	}
+	assertEq(t, "unsupported", "json: unsupported type: chan int", json.DescribeEncoder(reflect.TypeOf(make(chan int))))
+}
+
+func TestDescribeDecoder(t *testing.T) {
+	expected := `json_test.describeT
+  struct
+    keys: bitmap8 case insensitive [0:"age" 1:"attr" 2:"id" 3:"next" 4:"tags"]
+    "id" [0] offset:0 tagged
+      int
+    "tags" [1] offset:32 tagged
+      slice []string
+        string
+    "next" [2] offset:56 tagged
+      ptr *json_test.describeT
+        struct (recursive)
+    "attr" [3] offset:64 tagged
+      map map[string]int64
+        string
+        int64
+    "age" [4] offset:72 tagged required
+      string tag
+        uint8`
+	assertEq(t, "decoder", expected, json.DescribeDecoder(reflect.TypeOf(describeT{})))
+	assertEq(t, "unsupported", "json: cannot unmarshal object into Go value of type chan int", json.DescribeDecoder(reflect.TypeOf(make(chan int))))
+}
@@ -2,6 +2,7 @@ package json
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"io"
 	"math"
@@ -17,6 +18,11 @@ type Encoder struct {
 	enabledHTMLEscape bool
 	prefix            string
 	indentStr         string
+	tokens            []encodeTokenState
+	tokenBuf          []byte
+	encoders          *encoderSet
+	chunkSize         int
+	fields            *FieldMask
 }
 
 const (
@@ -29,8 +35,52 @@ const (
 	EncodeOptionHTMLEscape EncodeOption = 1 << iota
 	EncodeOptionIndent
 	EncodeOptionUnorderedMap
+	EncodeOptionNonFiniteFloatNull   // encode NaN and ±Inf as null
+	EncodeOptionNonFiniteFloatString // encode NaN and ±Inf as "NaN", "Infinity" and "-Infinity"
+	EncodeOptionNonFiniteFloatClamp  // encode ±Inf as the largest finite value of the float type and NaN as 0
+	EncodeOptionSnakeCaseKeys        // use snake_case keys for untagged struct fields
+	EncodeOptionCamelCaseKeys        // use camelCase keys for untagged struct fields
+	EncodeOptionKebabCaseKeys        // use kebab-case keys for untagged struct fields
+	EncodeOptionCanonical            // produce the canonical form of RFC 8785
+	EncodeOptionNilAsEmpty           // encode nil slices as [] and nil maps as {}
 )
 
+// encodeOptionKeyNaming is the set of naming strategies for untagged struct fields.
+const encodeOptionKeyNaming = EncodeOptionSnakeCaseKeys | EncodeOptionCamelCaseKeys | EncodeOptionKebabCaseKeys
+
+// encodeCompileOptions are the options which change the compiled code.
+// Code compiled with any of them is cached separately from the default one.
+const encodeCompileOptions = encodeOptionKeyNaming | EncodeOptionCanonical
+
+// encodeOptionWithFuncs applies optFuncs to opt.
+func encodeOptionWithFuncs(opt EncodeOption, optFuncs []EncodeOptionFunc) EncodeOption {
+	for _, optFunc := range optFuncs {
+		opt = optFunc(opt)
+	}
+	return opt
+}
+
+func encodeKeyNaming(flags EncodeOption) keyNaming {
+	switch {
+	case flags&EncodeOptionSnakeCaseKeys != 0:
+		return keyNamingSnakeCase
+	case flags&EncodeOptionCamelCaseKeys != 0:
+		return keyNamingCamelCase
+	case flags&EncodeOptionKebabCaseKeys != 0:
+		return keyNamingKebabCase
+	}
+	return keyNamingFieldName
+}
+
+// encodeOptionNonFiniteFloat is the set of policies for NaN and ±Inf.
+// Without any of them, encoding NaN or ±Inf fails with an UnsupportedValueError.
+const encodeOptionNonFiniteFloat = EncodeOptionNonFiniteFloatNull | EncodeOptionNonFiniteFloatString | EncodeOptionNonFiniteFloatClamp
+
+// encodeNilAsEmpty reports whether a nil slice or map is encoded as an empty array or object by code.
+func encodeNilAsEmpty(code *opcode, opt EncodeOption) bool {
+	return code.nonil || opt&EncodeOptionNilAsEmpty != 0
+}
+
 var (
 	encRuntimeContextPool = sync.Pool{
 		New: func() interface{} {
@@ -48,6 +98,12 @@ func takeEncodeRuntimeContext() *encodeRuntimeContext {
 }
 
 func releaseEncodeRuntimeContext(ctx *encodeRuntimeContext) {
+	ctx.context = nil
+	ctx.done = nil
+	ctx.encoders = nil
+	ctx.w = nil
+	ctx.chunkSize = 0
+	ctx.fields = nil
 	encRuntimeContextPool.Put(ctx)
 }
 
@@ -65,7 +121,18 @@ func (e *Encoder) Encode(v interface{}) error {
 
 // EncodeWithOption call Encode with EncodeOption.
 func (e *Encoder) EncodeWithOption(v interface{}, optFuncs ...EncodeOptionFunc) error {
+	return e.EncodeContext(context.Background(), v, optFuncs...)
+}
+
+// EncodeContext call Encode with context.Context and EncodeOption.
+func (e *Encoder) EncodeContext(goctx context.Context, v interface{}, optFuncs ...EncodeOptionFunc) error {
 	ctx := takeEncodeRuntimeContext()
+	ctx.setContext(goctx)
+	ctx.encoders = e.encoders
+	if e.chunkSize > 0 {
+		ctx.w = e.w
+		ctx.chunkSize = e.chunkSize
+	}
 
 	err := e.encodeWithOption(ctx, v, optFuncs...)
 
@@ -74,18 +141,22 @@ func (e *Encoder) EncodeWithOption(v interface{}, optFuncs ...EncodeOptionFunc)
 }
 
 func (e *Encoder) encodeWithOption(ctx *encodeRuntimeContext, v interface{}, optFuncs ...EncodeOptionFunc) error {
+	if len(e.tokens) > 0 {
+		return errTokenIncomplete
+	}
 	var opt EncodeOption
 	if e.enabledHTMLEscape {
 		opt |= EncodeOptionHTMLEscape
 	}
-	for _, optFunc := range optFuncs {
-		opt = optFunc(opt)
-	}
+	opt = encodeOptionWithFuncs(opt, optFuncs)
+	ctx.fields = e.fields
 	var (
 		buf []byte
 		err error
 	)
-	if e.enabledIndent {
+	// canonical JSON has no insignificant whitespace
+	indent := e.enabledIndent && opt&EncodeOptionCanonical == 0
+	if indent {
 		buf, err = encodeIndent(ctx, v, e.prefix, e.indentStr, opt)
 	} else {
 		buf, err = encode(ctx, v, opt)
@@ -93,7 +164,7 @@ func (e *Encoder) encodeWithOption(ctx *encodeRuntimeContext, v interface{}, opt
 	if err != nil {
 		return err
 	}
-	if e.enabledIndent {
+	if indent {
 		buf = buf[:len(buf)-2]
 	} else {
 		buf = buf[:len(buf)-1]
@@ -113,6 +184,21 @@ func (e *Encoder) SetEscapeHTML(on bool) {
 	e.enabledHTMLEscape = on
 }
 
+// SetChunkSize makes Encode write to the stream whenever more than n bytes of the encoding
+// have been buffered instead of buffering the whole value. The chunks are written between
+// the elements of arrays and unordered maps. An error returned by the stream stops the encoding
+// immediately, and a failed Encode may leave a partial value in the stream.
+// SetChunkSize(0) restores the default of writing each value at once.
+func (e *Encoder) SetChunkSize(n int) {
+	e.chunkSize = n
+}
+
+// SetFieldMask restricts the struct fields and map entries written by subsequent calls
+// to Encode to the ones selected by m. SetFieldMask(nil) restores encoding every field.
+func (e *Encoder) SetFieldMask(m *FieldMask) {
+	e.fields = m
+}
+
 // SetIndent instructs the encoder to format each subsequent encoded value as if indented by the package-level function Indent(dst, src, prefix, indent).
 // Calling SetIndent("", "") disables indentation.
 func (e *Encoder) SetIndent(prefix, indent string) {
@@ -125,10 +211,17 @@ func (e *Encoder) SetIndent(prefix, indent string) {
 	e.enabledIndent = true
 }
 
-func marshal(v interface{}, opt EncodeOption) ([]byte, error) {
+func marshal(v interface{}, opt EncodeOption, fields *FieldMask) ([]byte, error) {
+	return marshalContext(context.Background(), v, opt, fields)
+}
+
+func marshalContext(goctx context.Context, v interface{}, opt EncodeOption, fields *FieldMask) ([]byte, error) {
 	ctx := takeEncodeRuntimeContext()
+	ctx.setContext(goctx)
+	ctx.fields = fields
 
-	buf, err := encode(ctx, v, opt|EncodeOptionHTMLEscape)
+	opt |= EncodeOptionHTMLEscape
+	buf, err := encode(ctx, v, opt)
 	if err != nil {
 		releaseEncodeRuntimeContext(ctx)
 		return nil, err
@@ -146,10 +239,12 @@ func marshal(v interface{}, opt EncodeOption) ([]byte, error) {
 	return copied, nil
 }
 
-func marshalNoEscape(v interface{}, opt EncodeOption) ([]byte, error) {
+func marshalNoEscape(v interface{}, opt EncodeOption, fields *FieldMask) ([]byte, error) {
 	ctx := takeEncodeRuntimeContext()
+	ctx.fields = fields
 
-	buf, err := encodeNoEscape(ctx, v, opt|EncodeOptionHTMLEscape)
+	opt |= EncodeOptionHTMLEscape
+	buf, err := encodeNoEscape(ctx, v, opt)
 	if err != nil {
 		releaseEncodeRuntimeContext(ctx)
 		return nil, err
@@ -167,10 +262,16 @@ func marshalNoEscape(v interface{}, opt EncodeOption) ([]byte, error) {
 	return copied, nil
 }
 
-func marshalIndent(v interface{}, prefix, indent string, opt EncodeOption) ([]byte, error) {
+func marshalIndent(v interface{}, prefix, indent string, opt EncodeOption, fields *FieldMask) ([]byte, error) {
+	if opt&EncodeOptionCanonical != 0 {
+		// canonical JSON has no insignificant whitespace
+		return marshal(v, opt, fields)
+	}
 	ctx := takeEncodeRuntimeContext()
+	ctx.fields = fields
 
-	buf, err := encodeIndent(ctx, v, prefix, indent, opt|EncodeOptionHTMLEscape)
+	opt |= EncodeOptionHTMLEscape
+	buf, err := encodeIndent(ctx, v, prefix, indent, opt)
 	if err != nil {
 		releaseEncodeRuntimeContext(ctx)
 		return nil, err
@@ -195,7 +296,7 @@ func encode(ctx *encodeRuntimeContext, v interface{}, opt EncodeOption) ([]byte,
 	typ := header.typ
 
 	typeptr := uintptr(unsafe.Pointer(typ))
-	codeSet, err := encodeCompileToGetCodeSet(typeptr)
+	codeSet, err := ctx.compileToGetCodeSet(typeptr, opt, ctx.fields)
 	if err != nil {
 		return nil, err
 	}
@@ -225,7 +326,7 @@ func encodeNoEscape(ctx *encodeRuntimeContext, v interface{}, opt EncodeOption)
 	typ := header.typ
 
 	typeptr := uintptr(unsafe.Pointer(typ))
-	codeSet, err := encodeCompileToGetCodeSet(typeptr)
+	codeSet, err := ctx.compileToGetCodeSet(typeptr, opt, ctx.fields)
 	if err != nil {
 		return nil, err
 	}
@@ -252,7 +353,7 @@ func encodeIndent(ctx *encodeRuntimeContext, v interface{}, prefix, indent strin
 	typ := header.typ
 
 	typeptr := uintptr(unsafe.Pointer(typ))
-	codeSet, err := encodeCompileToGetCodeSet(typeptr)
+	codeSet, err := ctx.compileToGetCodeSet(typeptr, opt, ctx.fields)
 	if err != nil {
 		return nil, err
 	}
@@ -272,6 +373,10 @@ func encodeIndent(ctx *encodeRuntimeContext, v interface{}, prefix, indent strin
 }
 
 func encodeRunCode(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt EncodeOption) ([]byte, error) {
+	if (opt & EncodeOptionCanonical) != 0 {
+		// the canonical form has the minimal escaping and the sorted members
+		return encodeRun(ctx, b, codeSet, opt&^(EncodeOptionHTMLEscape|EncodeOptionUnorderedMap))
+	}
 	if (opt & EncodeOptionHTMLEscape) != 0 {
 		return encodeRunEscaped(ctx, b, codeSet, opt)
 	}
@@ -313,6 +418,86 @@ func encodeFloat64(b []byte, v float64) []byte {
 	return strconv.AppendFloat(b, v, fmt, -1, 64)
 }
 
+// encodeIsUnsupportedFloat reports whether v is NaN or ±Inf and opt has no policy to encode it.
+func encodeIsUnsupportedFloat(v float64, opt EncodeOption) bool {
+	return opt&encodeOptionNonFiniteFloat == 0 && (math.IsInf(v, 0) || math.IsNaN(v))
+}
+
+func encodeFloat32WithOption(b []byte, v float32, opt EncodeOption) []byte {
+	if f64 := float64(v); opt&encodeOptionNonFiniteFloat != 0 && (math.IsInf(f64, 0) || math.IsNaN(f64)) {
+		if opt&EncodeOptionNonFiniteFloatClamp == 0 {
+			return encodeNonFiniteFloat(b, f64, opt)
+		}
+		v = float32(encodeClampFloat(f64, math.MaxFloat32))
+	}
+	if opt&EncodeOptionCanonical != 0 {
+		return appendCanonicalNumber(b, float64(v), 32)
+	}
+	return encodeFloat32(b, v)
+}
+
+func encodeFloat64WithOption(b []byte, v float64, opt EncodeOption) []byte {
+	if opt&encodeOptionNonFiniteFloat != 0 && (math.IsInf(v, 0) || math.IsNaN(v)) {
+		if opt&EncodeOptionNonFiniteFloatClamp == 0 {
+			return encodeNonFiniteFloat(b, v, opt)
+		}
+		v = encodeClampFloat(v, math.MaxFloat64)
+	}
+	if opt&EncodeOptionCanonical != 0 {
+		return appendCanonicalNumber(b, v, 64)
+	}
+	return encodeFloat64(b, v)
+}
+
+// encodeFloat32StringWithOption encodes v quoted for the string tag option.
+// The null or string written for NaN and ±Inf is not quoted again.
+func encodeFloat32StringWithOption(b []byte, v float32, opt EncodeOption) []byte {
+	if f64 := float64(v); opt&(EncodeOptionNonFiniteFloatNull|EncodeOptionNonFiniteFloatString) != 0 && (math.IsInf(f64, 0) || math.IsNaN(f64)) {
+		return encodeNonFiniteFloat(b, f64, opt)
+	}
+	b = append(b, '"')
+	// like the quoted integers, the string isn't a number of the canonical form
+	b = encodeFloat32WithOption(b, v, opt&^EncodeOptionCanonical)
+	return append(b, '"')
+}
+
+// encodeFloat64StringWithOption encodes v quoted for the string tag option.
+// The null or string written for NaN and ±Inf is not quoted again.
+func encodeFloat64StringWithOption(b []byte, v float64, opt EncodeOption) []byte {
+	if opt&(EncodeOptionNonFiniteFloatNull|EncodeOptionNonFiniteFloatString) != 0 && (math.IsInf(v, 0) || math.IsNaN(v)) {
+		return encodeNonFiniteFloat(b, v, opt)
+	}
+	b = append(b, '"')
+	// like the quoted integers, the string isn't a number of the canonical form
+	b = encodeFloat64WithOption(b, v, opt&^EncodeOptionCanonical)
+	return append(b, '"')
+}
+
+// encodeNonFiniteFloat encodes NaN or ±Inf as null or as a string.
+func encodeNonFiniteFloat(b []byte, v float64, opt EncodeOption) []byte {
+	if opt&EncodeOptionNonFiniteFloatString == 0 {
+		return encodeNull(b)
+	}
+	switch {
+	case math.IsNaN(v):
+		return append(b, `"NaN"`...)
+	case v > 0:
+		return append(b, `"Infinity"`...)
+	}
+	return append(b, `"-Infinity"`...)
+}
+
+// encodeClampFloat returns ±max for ±Inf and 0 for NaN.
+func encodeClampFloat(v, max float64) float64 {
+	switch {
+	case math.IsNaN(v):
+		return 0
+	case v > 0:
+		return max
+	}
+	return -max
+}
+
 func encodeBool(b []byte, v bool) []byte {
 	if v {
 		return append(b, "true"...)
@@ -3,7 +3,9 @@ package json
 import (
 	"encoding"
 	"fmt"
+	"math"
 	"reflect"
+	"sort"
 	"strings"
 	"unsafe"
 )
@@ -21,10 +23,17 @@ type opcodeSet struct {
 }
 
 var (
-	marshalJSONType = reflect.TypeOf((*Marshaler)(nil)).Elem()
-	marshalTextType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	marshalJSONType        = reflect.TypeOf((*Marshaler)(nil)).Elem()
+	marshalJSONContextType = reflect.TypeOf((*MarshalerContext)(nil)).Elem()
+	marshalTextType        = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	isZeroerType           = reflect.TypeOf((*isZeroer)(nil)).Elem()
 )
 
+// isZeroer is implemented by types which define their own zero value for the "omitzero" option.
+type isZeroer interface {
+	IsZero() bool
+}
+
 func encodeCompileToGetCodeSetSlowPath(typeptr uintptr) (*opcodeSet, error) {
 	opcodeMap := loadOpcodeMap()
 	if codeSet, exists := opcodeMap[typeptr]; exists {
@@ -52,12 +61,65 @@ func encodeCompileToGetCodeSetSlowPath(typeptr uintptr) (*opcodeSet, error) {
 	return codeSet, nil
 }
 
+// opcodeSetCacheKey identifies the code of a type compiled with encodeCompileOptions.
+type opcodeSetCacheKey struct {
+	typeptr uintptr
+	flags   EncodeOption
+}
+
+func encodeCompileToGetCodeSetWithOption(typeptr uintptr, flags EncodeOption) (*opcodeSet, error) {
+	key := opcodeSetCacheKey{typeptr: typeptr, flags: flags}
+	opcodeMap := loadOptionOpcodeMap()
+	if codeSet, exists := opcodeMap[key]; exists {
+		return codeSet, nil
+	}
+
+	// noescape trick for header.typ ( reflect.*rtype )
+	copiedType := *(**rtype)(unsafe.Pointer(&typeptr))
+
+	code, err := encodeCompileHead(&encodeCompileContext{
+		typ:                      copiedType,
+		root:                     true,
+		structTypeToCompiledCode: map[uintptr]*compiledCode{},
+		keyNaming:                encodeKeyNaming(flags),
+		canonical:                flags&EncodeOptionCanonical != 0,
+	})
+	if err != nil {
+		return nil, err
+	}
+	code = copyOpcode(code)
+	codeLength := code.totalLength()
+	codeSet := &opcodeSet{
+		code:       code,
+		codeLength: codeLength,
+	}
+	storeOptionOpcodeSet(key, codeSet, opcodeMap)
+	return codeSet, nil
+}
+
 func encodeCompileHead(ctx *encodeCompileContext) (*opcode, error) {
 	typ := ctx.typ
+	if fn := ctx.encoderFunc(typ); fn != nil {
+		return encodeCompileCustom(ctx, fn, false)
+	}
+	if typ.Kind() == reflect.Ptr {
+		if fn := ctx.encoderFunc(typ.Elem()); fn != nil {
+			// the pointer is the address of the value
+			return encodeCompileCustom(ctx.withType(typ.Elem()), fn, true)
+		}
+	}
+	if fn := ctx.generatedEncoderFunc(typ); fn != nil {
+		return encodeCompileGenerated(ctx, fn, false)
+	}
+	if typ.Kind() == reflect.Ptr {
+		if fn := ctx.generatedEncoderFunc(typ.Elem()); fn != nil {
+			return encodeCompileGenerated(ctx.withType(typ.Elem()), fn, true)
+		}
+	}
 	switch {
-	case typ.Implements(marshalJSONType):
+	case encodeImplementsMarshalJSON(typ):
 		return encodeCompileMarshalJSON(ctx)
-	case rtype_ptrTo(typ).Implements(marshalJSONType):
+	case encodeImplementsMarshalJSON(rtype_ptrTo(typ)):
 		return encodeCompileMarshalJSONPtr(ctx)
 	case typ.Implements(marshalTextType):
 		return encodeCompileMarshalText(ctx)
@@ -77,25 +139,69 @@ func encodeCompileHead(ctx *encodeCompileContext) (*opcode, error) {
 		if err != nil {
 			return nil, err
 		}
-		encodeConvertHeadOnlyCode(code, isPtr)
+		head := code
+		if code.op == opSortedObject {
+			head = code.next
+		}
+		if !isPtr && encodeIsDirectIface(typ) {
+			switch head.op {
+			case opStructFieldHeadOmitEmpty, opStructFieldHeadOmitEmptyStringTag:
+				// the generic omitempty operation needs the address of the only field,
+				// which is the slot holding the data word of the struct
+				head.isDirectIface = true
+			default:
+				encodeDirectIfaceCustomCode(head)
+			}
+		}
+		if !isPtr && head.nextField.op == opStructFieldUnknown && encodeIsDirectIface(typ) {
+			// the data word of the struct is the map of its only field, which can be nil
+			head.op = opStructFieldHeadOnly
+			head.nextField.isDirectIface = true
+		}
+		encodeConvertHeadOnlyCode(head, isPtr)
 		encodeOptimizeStructEnd(code)
 		encodeLinkRecursiveCode(code)
 		return code, nil
 	} else if isPtr && typ.Implements(marshalTextType) {
 		typ = orgType
-	} else if isPtr && typ.Implements(marshalJSONType) {
+	} else if isPtr && encodeImplementsMarshalJSON(typ) {
 		typ = orgType
 	}
 	code, err := encodeCompile(ctx.withType(typ))
 	if err != nil {
 		return nil, err
 	}
+	if !isPtr && typ.Kind() == reflect.Array && encodeIsDirectIface(typ) {
+		encodeDirectIfaceCustomCode(code)
+	}
 	encodeConvertHeadOnlyCode(code, isPtr)
 	encodeOptimizeStructEnd(code)
 	encodeLinkRecursiveCode(code)
 	return code, nil
 }
 
+// encodeDirectIfaceCustomCode adjusts the registered encoder of the only value of a root struct or array
+// stored directly in the data word of an interface. The data word is the value itself instead of its address.
+func encodeDirectIfaceCustomCode(c *opcode) {
+	for code := c; code.op != opEnd; {
+		if code.op == opCustom {
+			if code.generated != nil && code.ptrNum == 0 {
+				// the generated encoder takes the address of the value in the data word
+				code.isDirectIface = true
+			} else {
+				code.ptrNum--
+			}
+			return
+		}
+		switch code.op.codeType() {
+		case codeArrayElem, codeSliceElem, codeMapKey:
+			code = code.end
+		default:
+			code = code.next
+		}
+	}
+}
+
 func encodeLinkRecursiveCode(c *opcode) {
 	for code := c; code.op != opEnd && code.op != opStructFieldRecursiveEnd; {
 		switch code.op {
@@ -110,6 +216,12 @@ func encodeLinkRecursiveCode(c *opcode) {
 			c := code.jmp.code
 			c.end.next = newEndOp(&encodeCompileContext{})
 			c.op = c.op.ptrHeadToHead()
+			// a nested value is referenced by its address even if the root value is held in the data word
+			c.isDirectIface = false
+			if c.op == opSortedObject {
+				c.next.op = c.next.op.ptrHeadToHead()
+				c.next.isDirectIface = false
+			}
 
 			beforeLastCode := c.end
 			lastCode := beforeLastCode.next
@@ -188,6 +300,10 @@ func encodeConvertHeadOnlyCode(c *opcode, isPtrHead bool) {
 	if c.nextField.op.codeType() != codeStructEnd {
 		return
 	}
+	if c.isZero != nil {
+		// the generic omitempty operation checks the only field by its predicate
+		return
+	}
 	switch c.op {
 	case opStructFieldHead:
 		encodeConvertHeadOnlyCode(c.next, false)
@@ -207,6 +323,12 @@ func encodeConvertHeadOnlyCode(c *opcode, isPtrHead bool) {
 			return
 		}
 		c.op = opStructFieldHeadStringTagOnly
+	case opStructFieldHeadOmitEmptyStringTag:
+		encodeConvertHeadOnlyCode(c.next, false)
+		if !strings.Contains(c.next.op.String(), "Only") {
+			return
+		}
+		c.op = opStructFieldHeadOmitEmptyStringTagOnly
 	case opStructFieldPtrHead:
 	}
 
@@ -230,11 +352,15 @@ func encodeConvertHeadOnlyCode(c *opcode, isPtrHead bool) {
 	}
 }
 
+func encodeImplementsMarshalJSON(typ *rtype) bool {
+	return typ.Implements(marshalJSONType) || typ.Implements(marshalJSONContextType)
+}
+
 func encodeImplementsMarshaler(typ *rtype) bool {
 	switch {
-	case typ.Implements(marshalJSONType):
+	case encodeImplementsMarshalJSON(typ):
 		return true
-	case rtype_ptrTo(typ).Implements(marshalJSONType):
+	case encodeImplementsMarshalJSON(rtype_ptrTo(typ)):
 		return true
 	case typ.Implements(marshalTextType):
 		return true
@@ -246,10 +372,36 @@ func encodeImplementsMarshaler(typ *rtype) bool {
 
 func encodeCompile(ctx *encodeCompileContext) (*opcode, error) {
 	typ := ctx.typ
+	if fn := ctx.encoderFunc(typ); fn != nil {
+		return encodeCompileCustom(ctx, fn, true)
+	}
+	if typ.Kind() == reflect.Ptr {
+		if fn := ctx.encoderFunc(typ.Elem()); fn != nil {
+			code, err := encodeCompileCustom(ctx.withType(typ.Elem()), fn, true)
+			if err != nil {
+				return nil, err
+			}
+			code.ptrNum++
+			return code, nil
+		}
+	}
+	if fn := ctx.generatedEncoderFunc(typ); fn != nil {
+		return encodeCompileGenerated(ctx, fn, true)
+	}
+	if typ.Kind() == reflect.Ptr {
+		if fn := ctx.generatedEncoderFunc(typ.Elem()); fn != nil {
+			code, err := encodeCompileGenerated(ctx.withType(typ.Elem()), fn, true)
+			if err != nil {
+				return nil, err
+			}
+			code.ptrNum++
+			return code, nil
+		}
+	}
 	switch {
-	case typ.Implements(marshalJSONType):
+	case encodeImplementsMarshalJSON(typ):
 		return encodeCompileMarshalJSON(ctx)
-	case rtype_ptrTo(typ).Implements(marshalJSONType):
+	case encodeImplementsMarshalJSON(rtype_ptrTo(typ)):
 		return encodeCompileMarshalJSONPtr(ctx)
 	case typ.Implements(marshalTextType):
 		return encodeCompileMarshalText(ctx)
@@ -261,7 +413,7 @@ func encodeCompile(ctx *encodeCompileContext) (*opcode, error) {
 		return encodeCompilePtr(ctx)
 	case reflect.Slice:
 		elem := typ.Elem()
-		if !encodeImplementsMarshaler(elem) && elem.Kind() == reflect.Uint8 {
+		if !encodeImplementsMarshaler(elem) && elem.Kind() == reflect.Uint8 && ctx.encoderFunc(elem) == nil {
 			return encodeCompileBytes(ctx)
 		}
 		return encodeCompileSlice(ctx)
@@ -310,7 +462,7 @@ func encodeCompile(ctx *encodeCompileContext) (*opcode, error) {
 func encodeCompileKey(ctx *encodeCompileContext) (*opcode, error) {
 	typ := ctx.typ
 	switch {
-	case rtype_ptrTo(typ).Implements(marshalJSONType):
+	case encodeImplementsMarshalJSON(rtype_ptrTo(typ)):
 		return encodeCompileMarshalJSONPtr(ctx)
 	case rtype_ptrTo(typ).Implements(marshalTextType):
 		return encodeCompileMarshalTextPtr(ctx)
@@ -352,7 +504,9 @@ func encodeCompilePtr(ctx *encodeCompileContext) (*opcode, error) {
 	ptrOpcodeIndex := ctx.opcodeIndex
 	ptrIndex := ctx.ptrIndex
 	ctx.incIndex()
-	code, err := encodeCompile(ctx.withType(ctx.typ.Elem()))
+	elemCtx := ctx.withType(ctx.typ.Elem())
+	elemCtx.promoted = ctx.promoted
+	code, err := encodeCompile(elemCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -369,6 +523,158 @@ func encodeCompilePtr(ctx *encodeCompileContext) (*opcode, error) {
 	return newOpCodeWithNext(c, opPtr, code), nil
 }
 
+// encodeCompileCustom compiles the call of the encoder registered for ctx.typ.
+// indirect reports whether the value is referenced by its address,
+// which is the case except for the root value held like in the data word of an interface.
+func encodeCompileCustom(ctx *encodeCompileContext, fn EncoderFunc, indirect bool) (*opcode, error) {
+	code := newOpCode(ctx, opCustom)
+	code.encoder = fn
+	code.isDirectIface = encodeIsDirectIface(ctx.typ)
+	if indirect && code.isDirectIface {
+		// the interface holding the value needs the value itself as data word
+		code.ptrNum++
+	}
+	ctx.incIndex()
+	return code, nil
+}
+
+// generatedEncoder calls a GeneratedEncoderFunc with the address of the value.
+type generatedEncoder func(e EncodeContext, b []byte, p unsafe.Pointer) ([]byte, error)
+
+// encodeCompileGenerated compiles the call of the generated encoder of ctx.typ like encodeCompileCustom.
+// Unlike a registered encoder, fn takes a pointer to the value, so the pointer of the code is the address
+// of the value, except for a root value held in the data word of an interface.
+func encodeCompileGenerated(ctx *encodeCompileContext, fn GeneratedEncoderFunc, indirect bool) (*opcode, error) {
+	code := newOpCode(ctx, opCustom)
+	ptrType := rtype_ptrTo(ctx.typ)
+	code.generated = func(e EncodeContext, b []byte, p unsafe.Pointer) ([]byte, error) {
+		return fn(e, b, *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: ptrType, ptr: p})))
+	}
+	code.isDirectIface = !indirect && encodeIsDirectIface(ctx.typ)
+	ctx.incIndex()
+	return code, nil
+}
+
+// encodeIsDirectIface reports whether values of typ are stored directly in the data word of an interface.
+func encodeIsDirectIface(typ *rtype) bool {
+	switch typ.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return true
+	case reflect.Struct:
+		return typ.NumField() == 1 && encodeIsDirectIface(type2rtype(typ.Field(0).Type))
+	case reflect.Array:
+		return typ.Len() == 1 && encodeIsDirectIface(typ.Elem())
+	}
+	return false
+}
+
+// encodeIsZeroFunc returns the predicate of an omitzero field of typ checking the value at its address.
+func encodeIsZeroFunc(typ *rtype) func(unsafe.Pointer) bool {
+	switch {
+	case typ.Implements(isZeroerType):
+		isPtr := typ.Kind() == reflect.Ptr
+		isDirectIface := encodeIsDirectIface(typ)
+		return func(p unsafe.Pointer) bool {
+			if isPtr && *(*unsafe.Pointer)(p) == nil {
+				return true
+			}
+			if isDirectIface {
+				p = *(*unsafe.Pointer)(p)
+			}
+			v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: typ, ptr: p}))
+			return v.(isZeroer).IsZero()
+		}
+	case rtype_ptrTo(typ).Implements(isZeroerType):
+		ptrType := rtype_ptrTo(typ)
+		return func(p unsafe.Pointer) bool {
+			v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: ptrType, ptr: p}))
+			return v.(isZeroer).IsZero()
+		}
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		// an empty string can still refer to some bytes
+		return func(p unsafe.Pointer) bool {
+			return len(*(*string)(p)) == 0
+		}
+	case reflect.Float32:
+		// like reflect.Value.IsZero, negative zero isn't zero as it doesn't have all zero bits
+		return func(p unsafe.Pointer) bool {
+			return math.Float32bits(*(*float32)(p)) == 0
+		}
+	case reflect.Float64:
+		return func(p unsafe.Pointer) bool {
+			return math.Float64bits(*(*float64)(p)) == 0
+		}
+	}
+	size := typ.Size()
+	return func(p unsafe.Pointer) bool {
+		return encodeIsZeroMemory(p, size)
+	}
+}
+
+// encodeIsStringTagType reports whether the values of typ are quoted by the string option of their field.
+func encodeIsStringTagType(typ *rtype) bool {
+	if encodeImplementsMarshaler(typ) {
+		return false
+	}
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+		if encodeImplementsMarshaler(typ) {
+			return false
+		}
+	}
+	switch typ.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	}
+	return false
+}
+
+// encodeStringTagValue encodes v as the JSON string of its encoding.
+func encodeStringTagValue(b []byte, v interface{}) ([]byte, error) {
+	bb, err := Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return encodeEscapedString(b, *(*string)(unsafe.Pointer(&bb))), nil
+}
+
+// encodeIsZeroMemory reports whether the size bytes at p are all zero,
+// which is the case for the zero value of any type.
+func encodeIsZeroMemory(p unsafe.Pointer, size uintptr) bool {
+	for i := uintptr(0); i < size; i++ {
+		if *(*byte)(unsafe.Pointer(uintptr(p) + i)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeSetNoNil makes the slice or map code of a "nonil" field, possibly behind pointers,
+// encode a nil value as empty.
+func encodeSetNoNil(code *opcode) {
+	for code.op == opPtr {
+		code = code.next
+	}
+	switch code.op {
+	case opSliceHead, opMapHead, opMapHeadLoad:
+		code.nonil = true
+	}
+}
+
+// encodeIsOmitted reports whether the value at p of a field with a generic omitempty operation is omitted.
+func encodeIsOmitted(code *opcode, p uintptr) bool {
+	if code.isZero != nil {
+		return code.isZero(ptrToUnsafePtr(p))
+	}
+	return ptrToPtr(p) == 0
+}
+
 func encodeCompileMarshalJSON(ctx *encodeCompileContext) (*opcode, error) {
 	code := newOpCode(ctx, opMarshalJSON)
 	ctx.incIndex()
@@ -645,16 +951,24 @@ func encodeCompileMap(ctx *encodeCompileContext, withLoad bool) (*opcode, error)
 		return nil, err
 	}
 
+	if keyCode.op == opString {
+		// entries are selected by their keys
+		header.fields = ctx.fields
+	}
+
 	value := newMapValueCode(ctx, header)
 	ctx.incIndex()
 
 	valueType := typ.Elem()
-	valueCode, err := encodeCompile(ctx.withType(valueType))
+	valueCtx := ctx.withType(valueType)
+	valueCtx.fields = ctx.fields.mapValue()
+	valueCode, err := encodeCompile(valueCtx)
 	if err != nil {
 		return nil, err
 	}
 
 	key := newMapKeyCode(ctx, header)
+	key.fields = header.fields
 	ctx.incIndex()
 
 	ctx = ctx.decIndent()
@@ -930,10 +1244,23 @@ func encodeTypeToFieldType(ctx *encodeCompileContext, code *opcode) opType {
 
 func encodeOptimizeStructHeader(ctx *encodeCompileContext, code *opcode, tag *structTag) opType {
 	headType := encodeTypeToHeaderType(ctx, code)
-	switch {
-	case tag.isOmitEmpty:
+	isString := tag.isString
+	switch headType {
+	case opStructFieldHeadStruct:
+		// the generic head passes the address of the field to the code of the struct,
+		// which is never omitted by omitempty like encoding/json
+		return opStructFieldHead
+	case opStructFieldHeadMap,
+		opStructFieldHeadMapLoad,
+		opStructFieldHeadArray,
+		opStructFieldHeadSlice:
+		// like encoding/json, the string option is ignored for composite values
+		isString = false
+	}
+	if tag.isOmitEmpty || tag.isOmitZero {
 		headType = headType.headToOmitEmptyHead()
-	case tag.isString:
+	}
+	if isString {
 		headType = headType.headToStringTagHead()
 	}
 	return headType
@@ -941,10 +1268,20 @@ func encodeOptimizeStructHeader(ctx *encodeCompileContext, code *opcode, tag *st
 
 func encodeOptimizeStructField(ctx *encodeCompileContext, code *opcode, tag *structTag) opType {
 	fieldType := encodeTypeToFieldType(ctx, code)
-	switch {
-	case tag.isOmitEmpty:
+	isString := tag.isString
+	switch fieldType {
+	case opStructFieldMap,
+		opStructFieldMapLoad,
+		opStructFieldArray,
+		opStructFieldSlice,
+		opStructFieldStruct:
+		// like encoding/json, the string option is ignored for composite values
+		isString = false
+	}
+	if tag.isOmitEmpty || tag.isOmitZero {
 		fieldType = fieldType.fieldToOmitEmptyField()
-	case tag.isString:
+	}
+	if isString {
 		fieldType = fieldType.fieldToStringTagField()
 	}
 	return fieldType
@@ -967,7 +1304,16 @@ func encodeCompiledCode(ctx *encodeCompileContext) *opcode {
 
 func encodeStructHeader(ctx *encodeCompileContext, fieldCode *opcode, valueCode *opcode, tag *structTag) *opcode {
 	fieldCode.indent--
-	op := encodeOptimizeStructHeader(ctx, valueCode, tag)
+	var op opType
+	if fieldCode.isZero != nil {
+		// the value is checked by the generic omitempty operation
+		op = opStructFieldHeadOmitEmpty
+		if tag.isString {
+			op = opStructFieldHeadOmitEmptyStringTag
+		}
+	} else {
+		op = encodeOptimizeStructHeader(ctx, valueCode, tag)
+	}
 	fieldCode.op = op
 	fieldCode.ptrNum = valueCode.ptrNum
 	switch op {
@@ -983,7 +1329,8 @@ func encodeStructHeader(ctx *encodeCompileContext, fieldCode *opcode, valueCode
 		opStructFieldHeadOmitEmptyMap,
 		opStructFieldHeadOmitEmptyMapLoad,
 		opStructFieldHeadOmitEmptyStruct,
-		opStructFieldHeadStringTag:
+		opStructFieldHeadStringTag,
+		opStructFieldHeadOmitEmptyStringTag:
 		return valueCode.beforeLastCode()
 	}
 	ctx.decOpcodeIndex()
@@ -992,7 +1339,16 @@ func encodeStructHeader(ctx *encodeCompileContext, fieldCode *opcode, valueCode
 
 func encodeStructField(ctx *encodeCompileContext, fieldCode *opcode, valueCode *opcode, tag *structTag) *opcode {
 	code := (*opcode)(unsafe.Pointer(fieldCode))
-	op := encodeOptimizeStructField(ctx, valueCode, tag)
+	var op opType
+	if fieldCode.isZero != nil {
+		// the value is checked by the generic omitempty operation
+		op = opStructFieldOmitEmpty
+		if tag.isString {
+			op = opStructFieldOmitEmptyStringTag
+		}
+	} else {
+		op = encodeOptimizeStructField(ctx, valueCode, tag)
+	}
 	fieldCode.op = op
 	fieldCode.ptrNum = valueCode.ptrNum
 	switch op {
@@ -1008,7 +1364,8 @@ func encodeStructField(ctx *encodeCompileContext, fieldCode *opcode, valueCode *
 		opStructFieldOmitEmptyMap,
 		opStructFieldOmitEmptyMapLoad,
 		opStructFieldOmitEmptyStruct,
-		opStructFieldStringTag:
+		opStructFieldStringTag,
+		opStructFieldOmitEmptyStringTag:
 		return valueCode.beforeLastCode()
 	}
 	ctx.decIndex()
@@ -1211,13 +1568,21 @@ func encodeOptimizeConflictAnonymousFields(anonymousFields map[string][]structFi
 
 func encodeCompileStruct(ctx *encodeCompileContext, isPtr bool) (*opcode, error) {
 	ctx.root = false
-	if code := encodeCompiledCode(ctx); code != nil {
-		return code, nil
+	promoted := ctx.promoted
+	// code compiled with a field mask is specific to its node of the mask,
+	// so it neither reuses nor provides the code of recursive types
+	masked := ctx.fields != nil
+	if !masked {
+		if code := encodeCompiledCode(ctx); code != nil {
+			return code, nil
+		}
 	}
 	typ := ctx.typ
 	typeptr := uintptr(unsafe.Pointer(typ))
 	compiled := &compiledCode{}
-	ctx.structTypeToCompiledCode[typeptr] = compiled
+	if !masked {
+		ctx.structTypeToCompiledCode[typeptr] = compiled
+	}
 	// header => code => structField => code => end
 	//                        ^          |
 	//                        |__________|
@@ -1231,34 +1596,78 @@ func encodeCompileStruct(ctx *encodeCompileContext, isPtr bool) (*opcode, error)
 	ctx = ctx.incIndent()
 	tags := structTags{}
 	anonymousFields := map[string][]structFieldPair{}
+	var unknownTag *structTag
 	for i := 0; i < fieldNum; i++ {
 		field := typ.Field(i)
 		if isIgnoredStructField(field) {
 			continue
 		}
-		tags = append(tags, structTagFromField(field))
+		tag := structTagFromField(field, ctx.keyNaming)
+		if tag.isUnknown {
+			if err := checkUnknownField(rtype2type(typ), unknownTag, tag); err != nil {
+				return nil, err
+			}
+			// the entries of the map are written after the other fields
+			unknownTag = tag
+			continue
+		}
+		if _, selected := ctx.fields.structField(tag); !selected {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	if ctx.canonical {
+		// the fields are written in the order of the canonical form
+		sort.SliceStable(tags, func(i, j int) bool {
+			return canonicalKeyLess(tags[i].key, tags[j].key)
+		})
 	}
+	// the members of embedded structs and of the unknown field are mixed with the fields
+	// of the struct, so the canonical form sorts them while encoding
+	sortMembers := ctx.canonical && !promoted && unknownTag != nil
 	for i, tag := range tags {
 		field := tag.field
 		fieldType := type2rtype(field.Type)
-		if isPtr && i == 0 {
+		var isZero func(unsafe.Pointer) bool
+		if tag.isOmitZero && !field.Anonymous && !tag.isInline {
+			isZero = encodeIsZeroFunc(fieldType)
+		}
+		if isPtr && i == 0 && isZero == nil {
 			// head field of pointer structure at top level
 			// if field type is pointer and implements MarshalJSON or MarshalText,
 			// it need to operation of dereference of pointer.
-			if field.Type.Kind() == reflect.Ptr &&
-				(field.Type.Implements(marshalJSONType) || field.Type.Implements(marshalTextType)) {
+			if field.Type.Kind() == reflect.Ptr && ctx.encoderFunc(fieldType) == nil &&
+				(encodeImplementsMarshalJSON(fieldType) || fieldType.Implements(marshalTextType)) {
 				fieldType = rtype_ptrTo(fieldType)
 			}
 		}
 		fieldOpcodeIndex := ctx.opcodeIndex
 		fieldPtrIndex := ctx.ptrIndex
 		ctx.incIndex()
-		valueCode, err := encodeCompile(ctx.withType(fieldType))
+		valueCtx := ctx.withType(fieldType)
+		valueCtx.fields, _ = ctx.fields.structField(tag)
+		valueCtx.promoted = field.Anonymous || tag.isInline
+		var valueCode *opcode
+		var err error
+		if isZero != nil && tag.isString && ctx.encoderFunc(fieldType) == nil && encodeIsStringTagType(fieldType) {
+			// the generic omitempty operation leaves the quoting of the value to its code
+			valueCode, err = encodeCompileCustom(valueCtx, encodeStringTagValue, true)
+		} else if isZero != nil && fieldType.Kind() == reflect.Ptr && ctx.encoderFunc(fieldType) == nil {
+			// the generic omitempty operation passes the address of the pointer
+			valueCode, err = encodeCompilePtr(valueCtx)
+		} else {
+			valueCode, err = encodeCompile(valueCtx)
+		}
 		if err != nil {
 			return nil, err
 		}
+		if tag.isNoNil {
+			encodeSetNoNil(valueCode)
+		}
 
-		if field.Anonymous {
+		isAnonymous := (field.Anonymous || tag.isInline) && valueCode.op != opCustom
+		if isAnonymous {
+			sortMembers = sortMembers || ctx.canonical && !promoted
 			if valueCode.op == opPtr && valueCode.next.op == opStructFieldRecursive {
 				valueCode = valueCode.next
 				valueCode.decOpcodeIndex()
@@ -1266,7 +1675,7 @@ func encodeCompileStruct(ctx *encodeCompileContext, isPtr bool) (*opcode, error)
 				valueCode.op = opStructFieldPtrHeadRecursive
 			}
 			tagKey := ""
-			if tag.isTaggedKey {
+			if tag.isTaggedKey && !tag.isInline {
 				tagKey = tag.key
 			}
 			for k, v := range encodeAnonymousStructFieldPairMap(tags, tagKey, valueCode) {
@@ -1281,13 +1690,14 @@ func encodeCompileStruct(ctx *encodeCompileContext, isPtr bool) (*opcode, error)
 			idx:          opcodeOffset(fieldPtrIndex),
 			next:         valueCode,
 			indent:       ctx.indent,
-			anonymousKey: field.Anonymous,
+			anonymousKey: isAnonymous,
 			key:          []byte(key),
 			escapedKey:   []byte(escapedKey),
 			isTaggedKey:  tag.isTaggedKey,
 			displayKey:   tag.key,
 			offset:       field.Offset,
 		}
+		fieldCode.isZero = isZero
 		if fieldIdx == 0 {
 			fieldCode.headIdx = fieldCode.idx
 			code = encodeStructHeader(ctx, fieldCode, valueCode, tag)
@@ -1328,6 +1738,35 @@ func encodeCompileStruct(ctx *encodeCompileContext, isPtr bool) (*opcode, error)
 		code = head
 	}
 
+	if unknownTag != nil {
+		unknownCode := &opcode{
+			op:         opStructFieldUnknown,
+			typ:        type2rtype(unknownTag.field.Type),
+			displayIdx: ctx.opcodeIndex,
+			idx:        opcodeOffset(ctx.ptrIndex),
+			headIdx:    head.headIdx,
+			indent:     ctx.indent + 1,
+			offset:     unknownTag.field.Offset,
+			fields:     ctx.fields,
+			fieldKeys:  encodeStructFieldKeys(typ, ctx.keyNaming),
+			nextField:  structEndCode,
+		}
+		ctx.incIndex()
+		if prevField == nil {
+			// the head has no key to write
+			head.anonymousKey = true
+			head.nextField = unknownCode
+			unknownCode.prevField = head
+		} else {
+			prevField.nextField = unknownCode
+			unknownCode.prevField = prevField
+		}
+		structEndCode.prevField = unknownCode
+		code.next = unknownCode
+		code = unknownCode
+		prevField = unknownCode
+	}
+
 	structEndCode.displayIdx = ctx.opcodeIndex
 	structEndCode.idx = opcodeOffset(ctx.ptrIndex)
 	ctx.incIndex()
@@ -1342,9 +1781,32 @@ func encodeCompileStruct(ctx *encodeCompileContext, isPtr bool) (*opcode, error)
 	encodeOptimizeConflictAnonymousFields(anonymousFields)
 	encodeOptimizeAnonymousFields(head)
 	ret := (*opcode)(unsafe.Pointer(head))
+	if sortMembers {
+		sortedEndCode := &opcode{
+			op:         opSortedObjectEnd,
+			displayIdx: ctx.opcodeIndex,
+			idx:        opcodeOffset(ctx.ptrIndex),
+			indent:     ctx.indent,
+			next:       structEndCode.next,
+		}
+		ctx.incIndex()
+		structEndCode.next = sortedEndCode
+		// the code of the struct takes the pointer of the head
+		ret = &opcode{
+			op:         opSortedObject,
+			typ:        typ,
+			displayIdx: head.displayIdx,
+			idx:        head.idx,
+			indent:     ctx.indent,
+			next:       head,
+			end:        sortedEndCode,
+		}
+	}
 	compiled.code = ret
 
-	delete(ctx.structTypeToCompiledCode, typeptr)
+	if !masked {
+		delete(ctx.structTypeToCompiledCode, typeptr)
+	}
 
 	return ret, nil
 }
@@ -2,6 +2,9 @@ package json
 
 import (
 	"bytes"
+	"context"
+	"io"
+	"reflect"
 	"sync"
 	"unsafe"
 )
@@ -12,7 +15,8 @@ type mapItem struct {
 }
 
 type mapslice struct {
-	items []mapItem
+	items     []mapItem
+	canonical bool // whether the keys are compared by their UTF-16 code units for EncodeOptionCanonical
 }
 
 func (m *mapslice) Len() int {
@@ -20,6 +24,9 @@ func (m *mapslice) Len() int {
 }
 
 func (m *mapslice) Less(i, j int) bool {
+	if m.canonical {
+		return canonicalKeyLess(canonicalEncodedKey(m.items[i].key), canonicalEncodedKey(m.items[j].key))
+	}
 	return bytes.Compare(m.items[i].key, m.items[j].key) < 0
 }
 
@@ -54,6 +61,7 @@ func newMapContext(mapLen int) *encodeMapContext {
 		ctx.slice.items = ctx.slice.items[:0]
 	}
 	ctx.buf = ctx.buf[:0]
+	ctx.slice.canonical = false
 	return ctx
 }
 
@@ -68,6 +76,11 @@ type encodeCompileContext struct {
 	ptrIndex                 int
 	indent                   int
 	structTypeToCompiledCode map[uintptr]*compiledCode
+	encoders                 map[uintptr]EncoderFunc // encoders registered on the Encoder
+	keyNaming                keyNaming
+	fields                   *FieldMask // fields selected in the value being compiled, nil selects all
+	canonical                bool       // whether the members of structs are sorted for EncodeOptionCanonical
+	promoted                 bool       // whether the fields of the struct being compiled are promoted to the enclosing struct
 
 	parent *encodeCompileContext
 }
@@ -80,6 +93,10 @@ func (c *encodeCompileContext) context() *encodeCompileContext {
 		ptrIndex:                 c.ptrIndex,
 		indent:                   c.indent,
 		structTypeToCompiledCode: c.structTypeToCompiledCode,
+		encoders:                 c.encoders,
+		keyNaming:                c.keyNaming,
+		fields:                   c.fields,
+		canonical:                c.canonical,
 		parent:                   c,
 	}
 }
@@ -90,6 +107,57 @@ func (c *encodeCompileContext) withType(typ *rtype) *encodeCompileContext {
 	return ctx
 }
 
+// encoderFunc returns the encoder registered for typ or nil.
+func (c *encodeCompileContext) encoderFunc(typ *rtype) EncoderFunc {
+	typeptr := uintptr(unsafe.Pointer(typ))
+	if fn, exists := c.encoders[typeptr]; exists {
+		return fn
+	}
+	return loadRegisteredEncoder(typeptr)
+}
+
+// generatedEncoderFunc returns the generated encoder of typ or nil if it doesn't write the bytes of the code compiled with c.
+func (c *encodeCompileContext) generatedEncoderFunc(typ *rtype) GeneratedEncoderFunc {
+	if c.encoders != nil || c.fields != nil || c.keyNaming != keyNamingFieldName || c.canonical || c.promoted {
+		return nil
+	}
+	if encodeImplementsMarshaler(typ) {
+		return nil
+	}
+	fn := loadGeneratedEncoder(uintptr(unsafe.Pointer(typ)))
+	if fn == nil || encodeReachesRegisteredEncoder(typ, map[*rtype]bool{}) {
+		// the generated code doesn't know the encoders registered for the types of the fields
+		return nil
+	}
+	return fn
+}
+
+// encodeReachesRegisteredEncoder reports whether a value of typ can hold a value of a type
+// with a registered encoder. The types in seen are already being checked.
+func encodeReachesRegisteredEncoder(typ *rtype, seen map[*rtype]bool) bool {
+	if seen[typ] {
+		return false
+	}
+	seen[typ] = true
+	if loadRegisteredEncoder(uintptr(unsafe.Pointer(typ))) != nil {
+		return true
+	}
+	switch typ.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return encodeReachesRegisteredEncoder(typ.Elem(), seen)
+	case reflect.Map:
+		return encodeReachesRegisteredEncoder(typ.Key(), seen) || encodeReachesRegisteredEncoder(typ.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !isIgnoredStructField(field) && encodeReachesRegisteredEncoder(type2rtype(field.Type), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (c *encodeCompileContext) incIndent() *encodeCompileContext {
 	ctx := c.context()
 	ctx.indent++
@@ -148,6 +216,47 @@ type encodeRuntimeContext struct {
 	baseIndent int
 	prefix     []byte
 	indentStr  []byte
+	context    context.Context
+	done       <-chan struct{}
+	encoders   *encoderSet
+	w          io.Writer // stream of the Encoder written by flush, nil keeps the whole encoding in buf
+	chunkSize  int
+	sortedMaps int        // number of sorted maps and objects being encoded, whose entries are still moved around in buf
+	fields     *FieldMask // fields selected in the encoded value, nil selects all
+}
+
+func (c *encodeRuntimeContext) compileToGetCodeSet(typeptr uintptr, flags EncodeOption, fields *FieldMask) (*opcodeSet, error) {
+	flags &= encodeCompileOptions
+	if fields != nil {
+		var encoders map[uintptr]EncoderFunc
+		if c.encoders != nil {
+			encoders = c.encoders.funcs
+		}
+		return fields.compileToGetCodeSet(typeptr, flags, encoders)
+	}
+	if c.encoders != nil {
+		return c.encoders.compileToGetCodeSet(typeptr, flags)
+	}
+	if flags != 0 {
+		return encodeCompileToGetCodeSetWithOption(typeptr, flags)
+	}
+	return encodeCompileToGetCodeSet(typeptr)
+}
+
+func (c *encodeRuntimeContext) setContext(ctx context.Context) {
+	c.context = ctx
+	c.done = ctx.Done()
+}
+
+func (c *encodeRuntimeContext) marshalJSON(v interface{}) ([]byte, error) {
+	if m, ok := v.(MarshalerContext); ok {
+		ctx := c.context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		return m.MarshalJSONContext(ctx)
+	}
+	return v.(Marshaler).MarshalJSON()
 }
 
 func (c *encodeRuntimeContext) init(p uintptr, codelen int) {
@@ -158,6 +267,24 @@ func (c *encodeRuntimeContext) init(p uintptr, codelen int) {
 	c.keepRefs = c.keepRefs[:0]
 	c.seenPtr = c.seenPtr[:0]
 	c.baseIndent = 0
+	c.sortedMaps = 0
+}
+
+// encodeChunkTail is the number of bytes flush keeps at the end of the buffer
+// because the VM rewrites them when it closes an array or object.
+const encodeChunkTail = 2
+
+// flush writes b but its tail to the stream of the Encoder once b has grown past the chunk size
+// and returns the tail.
+func (c *encodeRuntimeContext) flush(b []byte) ([]byte, error) {
+	if len(b) <= c.chunkSize || c.sortedMaps > 0 {
+		return b, nil
+	}
+	n := len(b) - encodeChunkTail
+	if _, err := c.w.Write(b[:n]); err != nil {
+		return nil, err
+	}
+	return b[:copy(b, b[n:])], nil
 }
 
 func (c *encodeRuntimeContext) ptr() uintptr {
@@ -0,0 +1,190 @@
+package json
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// FieldMask selects the object keys written by MarshalWithFieldMask and by an Encoder
+// after SetFieldMask, like the fields parameter of a REST API. Struct fields which are
+// not selected are left out of the compiled code, so encoding with a FieldMask costs
+// nothing per call once the code of a type has been compiled with it. Reuse one
+// FieldMask across calls to keep that code.
+//
+// Map entries are selected by their string keys while encoding. The values of
+// a map share one mask: the union of the masks of the selected keys.
+//
+// There is no EncodeOptionFunc selecting fields since an EncodeOption can't carry a mask.
+type FieldMask struct {
+	fields map[string]*FieldMask // selected keys, a nil mask selects the whole value
+
+	valueOnce sync.Once
+	value     *FieldMask // mask of map values
+
+	codeSets sync.Map // opcodeSetCacheKey => *opcodeSet compiled with the mask
+}
+
+// NewFieldMask returns a FieldMask selecting paths. A path is a list of object keys
+// separated by dots such as "owner.email" and selects the value at the last key
+// with everything below it. Arrays and slices are transparent to paths:
+// "items.id" selects the id of every element of items.
+func NewFieldMask(paths ...string) *FieldMask {
+	m := newFieldMask()
+	for _, path := range paths {
+		m.add(strings.Split(path, "."))
+	}
+	return m
+}
+
+func newFieldMask() *FieldMask {
+	return &FieldMask{fields: map[string]*FieldMask{}}
+}
+
+func (m *FieldMask) add(keys []string) {
+	key := keys[0]
+	child, exists := m.fields[key]
+	if len(keys) == 1 {
+		m.fields[key] = nil
+		return
+	}
+	if exists && child == nil {
+		// already selects the whole value
+		return
+	}
+	if !exists {
+		child = newFieldMask()
+		m.fields[key] = child
+	}
+	child.add(keys[1:])
+}
+
+func (m *FieldMask) merge(src *FieldMask) {
+	for key, child := range src.fields {
+		cur, exists := m.fields[key]
+		switch {
+		case !exists:
+			if child != nil {
+				copied := newFieldMask()
+				copied.merge(child)
+				child = copied
+			}
+			m.fields[key] = child
+		case cur == nil:
+		case child == nil:
+			m.fields[key] = nil
+		default:
+			cur.merge(child)
+		}
+	}
+}
+
+func (m *FieldMask) hasKey(key string) bool {
+	_, exists := m.fields[key]
+	return exists
+}
+
+// structField reports whether m selects the struct field of tag and returns the mask of its value.
+func (m *FieldMask) structField(tag *structTag) (*FieldMask, bool) {
+	if m == nil {
+		return nil, true
+	}
+	if tag.isUnknown {
+		// the entries of the map are written as the members of the object
+		return m, true
+	}
+	if tag.isPromoted() {
+		typ := tag.field.Type
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ.Kind() == reflect.Struct {
+			// the fields of an embedded or inlined struct are promoted to the outer object
+			return m, true
+		}
+	}
+	child, exists := m.fields[tag.key]
+	return child, exists
+}
+
+// mapValue returns the mask of the values of a map masked by m.
+func (m *FieldMask) mapValue() *FieldMask {
+	if m == nil {
+		return nil
+	}
+	m.valueOnce.Do(func() {
+		value := newFieldMask()
+		for _, child := range m.fields {
+			if child == nil {
+				return
+			}
+			value.merge(child)
+		}
+		m.value = value
+	})
+	return m.value
+}
+
+// compileToGetCodeSet returns the code of the type of typeptr compiled with m.
+// The code is cached in m unless it is compiled with the encoders registered on an Encoder.
+func (m *FieldMask) compileToGetCodeSet(typeptr uintptr, flags EncodeOption, encoders map[uintptr]EncoderFunc) (*opcodeSet, error) {
+	key := opcodeSetCacheKey{typeptr: typeptr, flags: flags}
+	if encoders == nil {
+		if codeSet, exists := m.codeSets.Load(key); exists {
+			return codeSet.(*opcodeSet), nil
+		}
+	}
+
+	// noescape trick for header.typ ( reflect.*rtype )
+	copiedType := *(**rtype)(unsafe.Pointer(&typeptr))
+
+	code, err := encodeCompileHead(&encodeCompileContext{
+		typ:                      copiedType,
+		root:                     true,
+		structTypeToCompiledCode: map[uintptr]*compiledCode{},
+		encoders:                 encoders,
+		keyNaming:                encodeKeyNaming(flags),
+		canonical:                flags&EncodeOptionCanonical != 0,
+		fields:                   m,
+	})
+	if err != nil {
+		return nil, err
+	}
+	code = copyOpcode(code)
+	codeLength := code.totalLength()
+	codeSet := &opcodeSet{
+		code:       code,
+		codeLength: codeLength,
+	}
+	if encoders == nil {
+		m.codeSets.Store(key, codeSet)
+	}
+	return codeSet, nil
+}
+
+// encodeMapLen returns the number of entries of m written by the map header code.
+func encodeMapLen(code *opcode, m unsafe.Pointer) int {
+	if code.fields == nil {
+		return maplen(m)
+	}
+	n := 0
+	iter := mapiterinit(code.typ, m)
+	for key := mapiterkey(iter); key != nil; key = mapiterkey(iter) {
+		if code.fields.hasKey(*(*string)(key)) {
+			n++
+		}
+		mapiternext(iter)
+	}
+	return n
+}
+
+// encodeMapIterSkip advances iter to the next entry written by the map code.
+func encodeMapIterSkip(code *opcode, iter unsafe.Pointer) {
+	if code.fields == nil {
+		return
+	}
+	for key := mapiterkey(iter); key != nil && !code.fields.hasKey(*(*string)(key)); key = mapiterkey(iter) {
+		mapiternext(iter)
+	}
+}
@@ -57,6 +57,26 @@ func appendUint(b []byte, n uint64) []byte {
 	return formatInteger(b, n, false)
 }
 
+// encodeMaxSafeInteger is the largest integer up to which float64 holds every integer exactly.
+const encodeMaxSafeInteger = 1<<53 - 1
+
+// appendIntWithOption is like appendInt but writes n like ECMAScript does for the float64 nearest to it
+// if it's beyond ±2^53 and opt has EncodeOptionCanonical, like Canonicalize does.
+func appendIntWithOption(b []byte, n int64, opt EncodeOption) []byte {
+	if opt&EncodeOptionCanonical != 0 && (n > encodeMaxSafeInteger || n < -encodeMaxSafeInteger) {
+		return appendCanonicalNumber(b, float64(n), 64)
+	}
+	return appendInt(b, n)
+}
+
+// appendUintWithOption is like appendIntWithOption for unsigned integers.
+func appendUintWithOption(b []byte, n uint64, opt EncodeOption) []byte {
+	if opt&EncodeOptionCanonical != 0 && n > encodeMaxSafeInteger {
+		return appendCanonicalNumber(b, float64(n), 64)
+	}
+	return appendUint(b, n)
+}
+
 func formatInteger(out []byte, n uint64, negative bool) []byte {
 	if !negative {
 		if n < 10 {
@@ -38,6 +38,14 @@ type opcode struct {
 	nextField *opcode       // next struct field
 	next      *opcode       // next opcode
 	jmp       *compiledCode // for recursive call
+
+	encoder       EncoderFunc               // registered encoder of typ
+	generated     generatedEncoder          // generated encoder of typ, called instead of encoder
+	isDirectIface bool                      // whether typ is stored directly in the data word of an interface
+	fields        *FieldMask                // fields selected in a map or interface value, nil selects all
+	fieldKeys     map[string]struct{}       // keys of the struct fields which are not written from the unknown field
+	isZero        func(unsafe.Pointer) bool // whether the value of an omitzero field is omitted
+	nonil         bool                      // whether a nil slice or map is encoded as empty
 }
 
 func newOpCode(ctx *encodeCompileContext, op opType) *opcode {
@@ -77,25 +85,32 @@ func (c *opcode) copy(codeMap map[uintptr]*opcode) *opcode {
 		return code
 	}
 	copied := &opcode{
-		op:           c.op,
-		typ:          c.typ,
-		displayIdx:   c.displayIdx,
-		key:          c.key,
-		escapedKey:   c.escapedKey,
-		displayKey:   c.displayKey,
-		ptrNum:       c.ptrNum,
-		isTaggedKey:  c.isTaggedKey,
-		anonymousKey: c.anonymousKey,
-		root:         c.root,
-		indent:       c.indent,
-		idx:          c.idx,
-		headIdx:      c.headIdx,
-		elemIdx:      c.elemIdx,
-		length:       c.length,
-		mapIter:      c.mapIter,
-		mapPos:       c.mapPos,
-		offset:       c.offset,
-		size:         c.size,
+		op:            c.op,
+		typ:           c.typ,
+		displayIdx:    c.displayIdx,
+		key:           c.key,
+		escapedKey:    c.escapedKey,
+		displayKey:    c.displayKey,
+		ptrNum:        c.ptrNum,
+		isTaggedKey:   c.isTaggedKey,
+		anonymousKey:  c.anonymousKey,
+		root:          c.root,
+		indent:        c.indent,
+		idx:           c.idx,
+		headIdx:       c.headIdx,
+		elemIdx:       c.elemIdx,
+		length:        c.length,
+		mapIter:       c.mapIter,
+		mapPos:        c.mapPos,
+		offset:        c.offset,
+		size:          c.size,
+		encoder:       c.encoder,
+		generated:     c.generated,
+		isDirectIface: c.isDirectIface,
+		fields:        c.fields,
+		fieldKeys:     c.fieldKeys,
+		isZero:        c.isZero,
+		nonil:         c.nonil,
 	}
 	codeMap[addr] = copied
 	copied.mapKey = c.mapKey.copy(codeMap)
@@ -480,6 +495,7 @@ func newInterfaceCode(ctx *encodeCompileContext) *opcode {
 		indent:     ctx.indent,
 		root:       ctx.root,
 		next:       newEndOp(ctx),
+		fields:     ctx.fields,
 	}
 }
 
@@ -22,7 +22,7 @@ const (
 	codeStructEnd            codeType = 11
 )
 
-var opTypeStrings = [2770]string{
+var opTypeStrings = [3670]string{
 	"End",
 	"Interface",
 	"Ptr",
@@ -40,8 +40,12 @@ var opTypeStrings = [2770]string{
 	"MapKey",
 	"MapValue",
 	"MapEnd",
+	"SortedObject",
+	"SortedObjectEnd",
 	"StructFieldRecursiveEnd",
 	"StructAnonymousEnd",
+	"Custom",
+	"StructFieldUnknown",
 	"Int",
 	"Int8",
 	"Int16",
@@ -111,5467 +115,7263 @@ var opTypeStrings = [2770]string{
 	"StructFieldHeadOmitEmptyIntOnly",
 	"StructFieldHeadStringTagInt",
 	"StructFieldHeadStringTagIntOnly",
+	"StructFieldHeadOmitEmptyStringTagInt",
+	"StructFieldHeadOmitEmptyStringTagIntOnly",
 	"StructFieldAnonymousHeadInt",
 	"StructFieldAnonymousHeadIntOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt",
 	"StructFieldAnonymousHeadOmitEmptyIntOnly",
 	"StructFieldAnonymousHeadStringTagInt",
 	"StructFieldAnonymousHeadStringTagIntOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt",
+	"StructFieldAnonymousHeadOmitEmptyStringTagIntOnly",
 	"StructFieldPtrHeadInt",
 	"StructFieldPtrHeadIntOnly",
 	"StructFieldPtrHeadOmitEmptyInt",
 	"StructFieldPtrHeadOmitEmptyIntOnly",
 	"StructFieldPtrHeadStringTagInt",
 	"StructFieldPtrHeadStringTagIntOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt",
+	"StructFieldPtrHeadOmitEmptyStringTagIntOnly",
 	"StructFieldPtrAnonymousHeadInt",
 	"StructFieldPtrAnonymousHeadIntOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt",
 	"StructFieldPtrAnonymousHeadOmitEmptyIntOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt",
 	"StructFieldPtrAnonymousHeadStringTagIntOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagIntOnly",
 	"StructFieldNPtrHeadInt",
 	"StructFieldNPtrHeadIntOnly",
 	"StructFieldNPtrHeadOmitEmptyInt",
 	"StructFieldNPtrHeadOmitEmptyIntOnly",
 	"StructFieldNPtrHeadStringTagInt",
 	"StructFieldNPtrHeadStringTagIntOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt",
+	"StructFieldNPtrHeadOmitEmptyStringTagIntOnly",
 	"StructFieldNPtrAnonymousHeadInt",
 	"StructFieldNPtrAnonymousHeadIntOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt",
 	"StructFieldNPtrAnonymousHeadOmitEmptyIntOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt",
 	"StructFieldNPtrAnonymousHeadStringTagIntOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagIntOnly",
 	"StructFieldHeadInt8",
 	"StructFieldHeadInt8Only",
 	"StructFieldHeadOmitEmptyInt8",
 	"StructFieldHeadOmitEmptyInt8Only",
 	"StructFieldHeadStringTagInt8",
 	"StructFieldHeadStringTagInt8Only",
+	"StructFieldHeadOmitEmptyStringTagInt8",
+	"StructFieldHeadOmitEmptyStringTagInt8Only",
 	"StructFieldAnonymousHeadInt8",
 	"StructFieldAnonymousHeadInt8Only",
 	"StructFieldAnonymousHeadOmitEmptyInt8",
 	"StructFieldAnonymousHeadOmitEmptyInt8Only",
 	"StructFieldAnonymousHeadStringTagInt8",
 	"StructFieldAnonymousHeadStringTagInt8Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt8",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt8Only",
 	"StructFieldPtrHeadInt8",
 	"StructFieldPtrHeadInt8Only",
 	"StructFieldPtrHeadOmitEmptyInt8",
 	"StructFieldPtrHeadOmitEmptyInt8Only",
 	"StructFieldPtrHeadStringTagInt8",
 	"StructFieldPtrHeadStringTagInt8Only",
+	"StructFieldPtrHeadOmitEmptyStringTagInt8",
+	"StructFieldPtrHeadOmitEmptyStringTagInt8Only",
 	"StructFieldPtrAnonymousHeadInt8",
 	"StructFieldPtrAnonymousHeadInt8Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt8",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt8Only",
 	"StructFieldPtrAnonymousHeadStringTagInt8",
 	"StructFieldPtrAnonymousHeadStringTagInt8Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt8",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Only",
 	"StructFieldNPtrHeadInt8",
 	"StructFieldNPtrHeadInt8Only",
 	"StructFieldNPtrHeadOmitEmptyInt8",
 	"StructFieldNPtrHeadOmitEmptyInt8Only",
 	"StructFieldNPtrHeadStringTagInt8",
 	"StructFieldNPtrHeadStringTagInt8Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt8",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt8Only",
 	"StructFieldNPtrAnonymousHeadInt8",
 	"StructFieldNPtrAnonymousHeadInt8Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt8",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt8Only",
 	"StructFieldNPtrAnonymousHeadStringTagInt8",
 	"StructFieldNPtrAnonymousHeadStringTagInt8Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8Only",
 	"StructFieldHeadInt16",
 	"StructFieldHeadInt16Only",
 	"StructFieldHeadOmitEmptyInt16",
 	"StructFieldHeadOmitEmptyInt16Only",
 	"StructFieldHeadStringTagInt16",
 	"StructFieldHeadStringTagInt16Only",
+	"StructFieldHeadOmitEmptyStringTagInt16",
+	"StructFieldHeadOmitEmptyStringTagInt16Only",
 	"StructFieldAnonymousHeadInt16",
 	"StructFieldAnonymousHeadInt16Only",
 	"StructFieldAnonymousHeadOmitEmptyInt16",
 	"StructFieldAnonymousHeadOmitEmptyInt16Only",
 	"StructFieldAnonymousHeadStringTagInt16",
 	"StructFieldAnonymousHeadStringTagInt16Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt16",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt16Only",
 	"StructFieldPtrHeadInt16",
 	"StructFieldPtrHeadInt16Only",
 	"StructFieldPtrHeadOmitEmptyInt16",
 	"StructFieldPtrHeadOmitEmptyInt16Only",
 	"StructFieldPtrHeadStringTagInt16",
 	"StructFieldPtrHeadStringTagInt16Only",
+	"StructFieldPtrHeadOmitEmptyStringTagInt16",
+	"StructFieldPtrHeadOmitEmptyStringTagInt16Only",
 	"StructFieldPtrAnonymousHeadInt16",
 	"StructFieldPtrAnonymousHeadInt16Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt16",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt16Only",
 	"StructFieldPtrAnonymousHeadStringTagInt16",
 	"StructFieldPtrAnonymousHeadStringTagInt16Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt16",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Only",
 	"StructFieldNPtrHeadInt16",
 	"StructFieldNPtrHeadInt16Only",
 	"StructFieldNPtrHeadOmitEmptyInt16",
 	"StructFieldNPtrHeadOmitEmptyInt16Only",
 	"StructFieldNPtrHeadStringTagInt16",
 	"StructFieldNPtrHeadStringTagInt16Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt16",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt16Only",
 	"StructFieldNPtrAnonymousHeadInt16",
 	"StructFieldNPtrAnonymousHeadInt16Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt16",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt16Only",
 	"StructFieldNPtrAnonymousHeadStringTagInt16",
 	"StructFieldNPtrAnonymousHeadStringTagInt16Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16Only",
 	"StructFieldHeadInt32",
 	"StructFieldHeadInt32Only",
 	"StructFieldHeadOmitEmptyInt32",
 	"StructFieldHeadOmitEmptyInt32Only",
 	"StructFieldHeadStringTagInt32",
 	"StructFieldHeadStringTagInt32Only",
+	"StructFieldHeadOmitEmptyStringTagInt32",
+	"StructFieldHeadOmitEmptyStringTagInt32Only",
 	"StructFieldAnonymousHeadInt32",
 	"StructFieldAnonymousHeadInt32Only",
 	"StructFieldAnonymousHeadOmitEmptyInt32",
 	"StructFieldAnonymousHeadOmitEmptyInt32Only",
 	"StructFieldAnonymousHeadStringTagInt32",
 	"StructFieldAnonymousHeadStringTagInt32Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt32",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt32Only",
 	"StructFieldPtrHeadInt32",
 	"StructFieldPtrHeadInt32Only",
 	"StructFieldPtrHeadOmitEmptyInt32",
 	"StructFieldPtrHeadOmitEmptyInt32Only",
 	"StructFieldPtrHeadStringTagInt32",
 	"StructFieldPtrHeadStringTagInt32Only",
+	"StructFieldPtrHeadOmitEmptyStringTagInt32",
+	"StructFieldPtrHeadOmitEmptyStringTagInt32Only",
 	"StructFieldPtrAnonymousHeadInt32",
 	"StructFieldPtrAnonymousHeadInt32Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt32",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt32Only",
 	"StructFieldPtrAnonymousHeadStringTagInt32",
 	"StructFieldPtrAnonymousHeadStringTagInt32Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt32",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Only",
 	"StructFieldNPtrHeadInt32",
 	"StructFieldNPtrHeadInt32Only",
 	"StructFieldNPtrHeadOmitEmptyInt32",
 	"StructFieldNPtrHeadOmitEmptyInt32Only",
 	"StructFieldNPtrHeadStringTagInt32",
 	"StructFieldNPtrHeadStringTagInt32Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt32",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt32Only",
 	"StructFieldNPtrAnonymousHeadInt32",
 	"StructFieldNPtrAnonymousHeadInt32Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt32",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt32Only",
 	"StructFieldNPtrAnonymousHeadStringTagInt32",
 	"StructFieldNPtrAnonymousHeadStringTagInt32Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32Only",
 	"StructFieldHeadInt64",
 	"StructFieldHeadInt64Only",
 	"StructFieldHeadOmitEmptyInt64",
 	"StructFieldHeadOmitEmptyInt64Only",
 	"StructFieldHeadStringTagInt64",
 	"StructFieldHeadStringTagInt64Only",
+	"StructFieldHeadOmitEmptyStringTagInt64",
+	"StructFieldHeadOmitEmptyStringTagInt64Only",
 	"StructFieldAnonymousHeadInt64",
 	"StructFieldAnonymousHeadInt64Only",
 	"StructFieldAnonymousHeadOmitEmptyInt64",
 	"StructFieldAnonymousHeadOmitEmptyInt64Only",
 	"StructFieldAnonymousHeadStringTagInt64",
 	"StructFieldAnonymousHeadStringTagInt64Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt64",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt64Only",
 	"StructFieldPtrHeadInt64",
 	"StructFieldPtrHeadInt64Only",
 	"StructFieldPtrHeadOmitEmptyInt64",
 	"StructFieldPtrHeadOmitEmptyInt64Only",
 	"StructFieldPtrHeadStringTagInt64",
 	"StructFieldPtrHeadStringTagInt64Only",
+	"StructFieldPtrHeadOmitEmptyStringTagInt64",
+	"StructFieldPtrHeadOmitEmptyStringTagInt64Only",
 	"StructFieldPtrAnonymousHeadInt64",
 	"StructFieldPtrAnonymousHeadInt64Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt64",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt64Only",
 	"StructFieldPtrAnonymousHeadStringTagInt64",
 	"StructFieldPtrAnonymousHeadStringTagInt64Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt64",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Only",
 	"StructFieldNPtrHeadInt64",
 	"StructFieldNPtrHeadInt64Only",
 	"StructFieldNPtrHeadOmitEmptyInt64",
 	"StructFieldNPtrHeadOmitEmptyInt64Only",
 	"StructFieldNPtrHeadStringTagInt64",
 	"StructFieldNPtrHeadStringTagInt64Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt64",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt64Only",
 	"StructFieldNPtrAnonymousHeadInt64",
 	"StructFieldNPtrAnonymousHeadInt64Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt64",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt64Only",
 	"StructFieldNPtrAnonymousHeadStringTagInt64",
 	"StructFieldNPtrAnonymousHeadStringTagInt64Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64Only",
 	"StructFieldHeadUint",
 	"StructFieldHeadUintOnly",
 	"StructFieldHeadOmitEmptyUint",
 	"StructFieldHeadOmitEmptyUintOnly",
 	"StructFieldHeadStringTagUint",
 	"StructFieldHeadStringTagUintOnly",
+	"StructFieldHeadOmitEmptyStringTagUint",
+	"StructFieldHeadOmitEmptyStringTagUintOnly",
 	"StructFieldAnonymousHeadUint",
 	"StructFieldAnonymousHeadUintOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint",
 	"StructFieldAnonymousHeadOmitEmptyUintOnly",
 	"StructFieldAnonymousHeadStringTagUint",
 	"StructFieldAnonymousHeadStringTagUintOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUintOnly",
 	"StructFieldPtrHeadUint",
 	"StructFieldPtrHeadUintOnly",
 	"StructFieldPtrHeadOmitEmptyUint",
 	"StructFieldPtrHeadOmitEmptyUintOnly",
 	"StructFieldPtrHeadStringTagUint",
 	"StructFieldPtrHeadStringTagUintOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint",
+	"StructFieldPtrHeadOmitEmptyStringTagUintOnly",
 	"StructFieldPtrAnonymousHeadUint",
 	"StructFieldPtrAnonymousHeadUintOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint",
 	"StructFieldPtrAnonymousHeadOmitEmptyUintOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint",
 	"StructFieldPtrAnonymousHeadStringTagUintOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUintOnly",
 	"StructFieldNPtrHeadUint",
 	"StructFieldNPtrHeadUintOnly",
 	"StructFieldNPtrHeadOmitEmptyUint",
 	"StructFieldNPtrHeadOmitEmptyUintOnly",
 	"StructFieldNPtrHeadStringTagUint",
 	"StructFieldNPtrHeadStringTagUintOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint",
+	"StructFieldNPtrHeadOmitEmptyStringTagUintOnly",
 	"StructFieldNPtrAnonymousHeadUint",
 	"StructFieldNPtrAnonymousHeadUintOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUintOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint",
 	"StructFieldNPtrAnonymousHeadStringTagUintOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUintOnly",
 	"StructFieldHeadUint8",
 	"StructFieldHeadUint8Only",
 	"StructFieldHeadOmitEmptyUint8",
 	"StructFieldHeadOmitEmptyUint8Only",
 	"StructFieldHeadStringTagUint8",
 	"StructFieldHeadStringTagUint8Only",
+	"StructFieldHeadOmitEmptyStringTagUint8",
+	"StructFieldHeadOmitEmptyStringTagUint8Only",
 	"StructFieldAnonymousHeadUint8",
 	"StructFieldAnonymousHeadUint8Only",
 	"StructFieldAnonymousHeadOmitEmptyUint8",
 	"StructFieldAnonymousHeadOmitEmptyUint8Only",
 	"StructFieldAnonymousHeadStringTagUint8",
 	"StructFieldAnonymousHeadStringTagUint8Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint8",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint8Only",
 	"StructFieldPtrHeadUint8",
 	"StructFieldPtrHeadUint8Only",
 	"StructFieldPtrHeadOmitEmptyUint8",
 	"StructFieldPtrHeadOmitEmptyUint8Only",
 	"StructFieldPtrHeadStringTagUint8",
 	"StructFieldPtrHeadStringTagUint8Only",
+	"StructFieldPtrHeadOmitEmptyStringTagUint8",
+	"StructFieldPtrHeadOmitEmptyStringTagUint8Only",
 	"StructFieldPtrAnonymousHeadUint8",
 	"StructFieldPtrAnonymousHeadUint8Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint8",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint8Only",
 	"StructFieldPtrAnonymousHeadStringTagUint8",
 	"StructFieldPtrAnonymousHeadStringTagUint8Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint8",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Only",
 	"StructFieldNPtrHeadUint8",
 	"StructFieldNPtrHeadUint8Only",
 	"StructFieldNPtrHeadOmitEmptyUint8",
 	"StructFieldNPtrHeadOmitEmptyUint8Only",
 	"StructFieldNPtrHeadStringTagUint8",
 	"StructFieldNPtrHeadStringTagUint8Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint8",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint8Only",
 	"StructFieldNPtrAnonymousHeadUint8",
 	"StructFieldNPtrAnonymousHeadUint8Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint8",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint8Only",
 	"StructFieldNPtrAnonymousHeadStringTagUint8",
 	"StructFieldNPtrAnonymousHeadStringTagUint8Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8Only",
 	"StructFieldHeadUint16",
 	"StructFieldHeadUint16Only",
 	"StructFieldHeadOmitEmptyUint16",
 	"StructFieldHeadOmitEmptyUint16Only",
 	"StructFieldHeadStringTagUint16",
 	"StructFieldHeadStringTagUint16Only",
+	"StructFieldHeadOmitEmptyStringTagUint16",
+	"StructFieldHeadOmitEmptyStringTagUint16Only",
 	"StructFieldAnonymousHeadUint16",
 	"StructFieldAnonymousHeadUint16Only",
 	"StructFieldAnonymousHeadOmitEmptyUint16",
 	"StructFieldAnonymousHeadOmitEmptyUint16Only",
 	"StructFieldAnonymousHeadStringTagUint16",
 	"StructFieldAnonymousHeadStringTagUint16Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint16",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint16Only",
 	"StructFieldPtrHeadUint16",
 	"StructFieldPtrHeadUint16Only",
 	"StructFieldPtrHeadOmitEmptyUint16",
 	"StructFieldPtrHeadOmitEmptyUint16Only",
 	"StructFieldPtrHeadStringTagUint16",
 	"StructFieldPtrHeadStringTagUint16Only",
+	"StructFieldPtrHeadOmitEmptyStringTagUint16",
+	"StructFieldPtrHeadOmitEmptyStringTagUint16Only",
 	"StructFieldPtrAnonymousHeadUint16",
 	"StructFieldPtrAnonymousHeadUint16Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint16",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint16Only",
 	"StructFieldPtrAnonymousHeadStringTagUint16",
 	"StructFieldPtrAnonymousHeadStringTagUint16Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint16",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Only",
 	"StructFieldNPtrHeadUint16",
 	"StructFieldNPtrHeadUint16Only",
 	"StructFieldNPtrHeadOmitEmptyUint16",
 	"StructFieldNPtrHeadOmitEmptyUint16Only",
 	"StructFieldNPtrHeadStringTagUint16",
 	"StructFieldNPtrHeadStringTagUint16Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint16",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint16Only",
 	"StructFieldNPtrAnonymousHeadUint16",
 	"StructFieldNPtrAnonymousHeadUint16Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint16",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint16Only",
 	"StructFieldNPtrAnonymousHeadStringTagUint16",
 	"StructFieldNPtrAnonymousHeadStringTagUint16Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16Only",
 	"StructFieldHeadUint32",
 	"StructFieldHeadUint32Only",
 	"StructFieldHeadOmitEmptyUint32",
 	"StructFieldHeadOmitEmptyUint32Only",
 	"StructFieldHeadStringTagUint32",
 	"StructFieldHeadStringTagUint32Only",
+	"StructFieldHeadOmitEmptyStringTagUint32",
+	"StructFieldHeadOmitEmptyStringTagUint32Only",
 	"StructFieldAnonymousHeadUint32",
 	"StructFieldAnonymousHeadUint32Only",
 	"StructFieldAnonymousHeadOmitEmptyUint32",
 	"StructFieldAnonymousHeadOmitEmptyUint32Only",
 	"StructFieldAnonymousHeadStringTagUint32",
 	"StructFieldAnonymousHeadStringTagUint32Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint32",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint32Only",
 	"StructFieldPtrHeadUint32",
 	"StructFieldPtrHeadUint32Only",
 	"StructFieldPtrHeadOmitEmptyUint32",
 	"StructFieldPtrHeadOmitEmptyUint32Only",
 	"StructFieldPtrHeadStringTagUint32",
 	"StructFieldPtrHeadStringTagUint32Only",
+	"StructFieldPtrHeadOmitEmptyStringTagUint32",
+	"StructFieldPtrHeadOmitEmptyStringTagUint32Only",
 	"StructFieldPtrAnonymousHeadUint32",
 	"StructFieldPtrAnonymousHeadUint32Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint32",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint32Only",
 	"StructFieldPtrAnonymousHeadStringTagUint32",
 	"StructFieldPtrAnonymousHeadStringTagUint32Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint32",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Only",
 	"StructFieldNPtrHeadUint32",
 	"StructFieldNPtrHeadUint32Only",
 	"StructFieldNPtrHeadOmitEmptyUint32",
 	"StructFieldNPtrHeadOmitEmptyUint32Only",
 	"StructFieldNPtrHeadStringTagUint32",
 	"StructFieldNPtrHeadStringTagUint32Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint32",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint32Only",
 	"StructFieldNPtrAnonymousHeadUint32",
 	"StructFieldNPtrAnonymousHeadUint32Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint32",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint32Only",
 	"StructFieldNPtrAnonymousHeadStringTagUint32",
 	"StructFieldNPtrAnonymousHeadStringTagUint32Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32Only",
 	"StructFieldHeadUint64",
 	"StructFieldHeadUint64Only",
 	"StructFieldHeadOmitEmptyUint64",
 	"StructFieldHeadOmitEmptyUint64Only",
 	"StructFieldHeadStringTagUint64",
 	"StructFieldHeadStringTagUint64Only",
+	"StructFieldHeadOmitEmptyStringTagUint64",
+	"StructFieldHeadOmitEmptyStringTagUint64Only",
 	"StructFieldAnonymousHeadUint64",
 	"StructFieldAnonymousHeadUint64Only",
 	"StructFieldAnonymousHeadOmitEmptyUint64",
 	"StructFieldAnonymousHeadOmitEmptyUint64Only",
 	"StructFieldAnonymousHeadStringTagUint64",
 	"StructFieldAnonymousHeadStringTagUint64Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint64",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint64Only",
 	"StructFieldPtrHeadUint64",
 	"StructFieldPtrHeadUint64Only",
 	"StructFieldPtrHeadOmitEmptyUint64",
 	"StructFieldPtrHeadOmitEmptyUint64Only",
 	"StructFieldPtrHeadStringTagUint64",
 	"StructFieldPtrHeadStringTagUint64Only",
+	"StructFieldPtrHeadOmitEmptyStringTagUint64",
+	"StructFieldPtrHeadOmitEmptyStringTagUint64Only",
 	"StructFieldPtrAnonymousHeadUint64",
 	"StructFieldPtrAnonymousHeadUint64Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint64",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint64Only",
 	"StructFieldPtrAnonymousHeadStringTagUint64",
 	"StructFieldPtrAnonymousHeadStringTagUint64Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint64",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Only",
 	"StructFieldNPtrHeadUint64",
 	"StructFieldNPtrHeadUint64Only",
 	"StructFieldNPtrHeadOmitEmptyUint64",
 	"StructFieldNPtrHeadOmitEmptyUint64Only",
 	"StructFieldNPtrHeadStringTagUint64",
 	"StructFieldNPtrHeadStringTagUint64Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint64",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint64Only",
 	"StructFieldNPtrAnonymousHeadUint64",
 	"StructFieldNPtrAnonymousHeadUint64Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint64",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint64Only",
 	"StructFieldNPtrAnonymousHeadStringTagUint64",
 	"StructFieldNPtrAnonymousHeadStringTagUint64Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64Only",
 	"StructFieldHeadFloat32",
 	"StructFieldHeadFloat32Only",
 	"StructFieldHeadOmitEmptyFloat32",
 	"StructFieldHeadOmitEmptyFloat32Only",
 	"StructFieldHeadStringTagFloat32",
 	"StructFieldHeadStringTagFloat32Only",
+	"StructFieldHeadOmitEmptyStringTagFloat32",
+	"StructFieldHeadOmitEmptyStringTagFloat32Only",
 	"StructFieldAnonymousHeadFloat32",
 	"StructFieldAnonymousHeadFloat32Only",
 	"StructFieldAnonymousHeadOmitEmptyFloat32",
 	"StructFieldAnonymousHeadOmitEmptyFloat32Only",
 	"StructFieldAnonymousHeadStringTagFloat32",
 	"StructFieldAnonymousHeadStringTagFloat32Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat32",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat32Only",
 	"StructFieldPtrHeadFloat32",
 	"StructFieldPtrHeadFloat32Only",
 	"StructFieldPtrHeadOmitEmptyFloat32",
 	"StructFieldPtrHeadOmitEmptyFloat32Only",
 	"StructFieldPtrHeadStringTagFloat32",
 	"StructFieldPtrHeadStringTagFloat32Only",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat32",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat32Only",
 	"StructFieldPtrAnonymousHeadFloat32",
 	"StructFieldPtrAnonymousHeadFloat32Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat32",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat32Only",
 	"StructFieldPtrAnonymousHeadStringTagFloat32",
 	"StructFieldPtrAnonymousHeadStringTagFloat32Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Only",
 	"StructFieldNPtrHeadFloat32",
 	"StructFieldNPtrHeadFloat32Only",
 	"StructFieldNPtrHeadOmitEmptyFloat32",
 	"StructFieldNPtrHeadOmitEmptyFloat32Only",
 	"StructFieldNPtrHeadStringTagFloat32",
 	"StructFieldNPtrHeadStringTagFloat32Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat32",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat32Only",
 	"StructFieldNPtrAnonymousHeadFloat32",
 	"StructFieldNPtrAnonymousHeadFloat32Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat32",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat32Only",
 	"StructFieldNPtrAnonymousHeadStringTagFloat32",
 	"StructFieldNPtrAnonymousHeadStringTagFloat32Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32Only",
 	"StructFieldHeadFloat64",
 	"StructFieldHeadFloat64Only",
 	"StructFieldHeadOmitEmptyFloat64",
 	"StructFieldHeadOmitEmptyFloat64Only",
 	"StructFieldHeadStringTagFloat64",
 	"StructFieldHeadStringTagFloat64Only",
+	"StructFieldHeadOmitEmptyStringTagFloat64",
+	"StructFieldHeadOmitEmptyStringTagFloat64Only",
 	"StructFieldAnonymousHeadFloat64",
 	"StructFieldAnonymousHeadFloat64Only",
 	"StructFieldAnonymousHeadOmitEmptyFloat64",
 	"StructFieldAnonymousHeadOmitEmptyFloat64Only",
 	"StructFieldAnonymousHeadStringTagFloat64",
 	"StructFieldAnonymousHeadStringTagFloat64Only",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat64",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat64Only",
 	"StructFieldPtrHeadFloat64",
 	"StructFieldPtrHeadFloat64Only",
 	"StructFieldPtrHeadOmitEmptyFloat64",
 	"StructFieldPtrHeadOmitEmptyFloat64Only",
 	"StructFieldPtrHeadStringTagFloat64",
 	"StructFieldPtrHeadStringTagFloat64Only",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat64",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat64Only",
 	"StructFieldPtrAnonymousHeadFloat64",
 	"StructFieldPtrAnonymousHeadFloat64Only",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat64",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat64Only",
 	"StructFieldPtrAnonymousHeadStringTagFloat64",
 	"StructFieldPtrAnonymousHeadStringTagFloat64Only",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Only",
 	"StructFieldNPtrHeadFloat64",
 	"StructFieldNPtrHeadFloat64Only",
 	"StructFieldNPtrHeadOmitEmptyFloat64",
 	"StructFieldNPtrHeadOmitEmptyFloat64Only",
 	"StructFieldNPtrHeadStringTagFloat64",
 	"StructFieldNPtrHeadStringTagFloat64Only",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat64",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat64Only",
 	"StructFieldNPtrAnonymousHeadFloat64",
 	"StructFieldNPtrAnonymousHeadFloat64Only",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat64",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat64Only",
 	"StructFieldNPtrAnonymousHeadStringTagFloat64",
 	"StructFieldNPtrAnonymousHeadStringTagFloat64Only",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64Only",
 	"StructFieldHeadBool",
 	"StructFieldHeadBoolOnly",
 	"StructFieldHeadOmitEmptyBool",
 	"StructFieldHeadOmitEmptyBoolOnly",
 	"StructFieldHeadStringTagBool",
 	"StructFieldHeadStringTagBoolOnly",
+	"StructFieldHeadOmitEmptyStringTagBool",
+	"StructFieldHeadOmitEmptyStringTagBoolOnly",
 	"StructFieldAnonymousHeadBool",
 	"StructFieldAnonymousHeadBoolOnly",
 	"StructFieldAnonymousHeadOmitEmptyBool",
 	"StructFieldAnonymousHeadOmitEmptyBoolOnly",
 	"StructFieldAnonymousHeadStringTagBool",
 	"StructFieldAnonymousHeadStringTagBoolOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBool",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBoolOnly",
 	"StructFieldPtrHeadBool",
 	"StructFieldPtrHeadBoolOnly",
 	"StructFieldPtrHeadOmitEmptyBool",
 	"StructFieldPtrHeadOmitEmptyBoolOnly",
 	"StructFieldPtrHeadStringTagBool",
 	"StructFieldPtrHeadStringTagBoolOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagBool",
+	"StructFieldPtrHeadOmitEmptyStringTagBoolOnly",
 	"StructFieldPtrAnonymousHeadBool",
 	"StructFieldPtrAnonymousHeadBoolOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyBool",
 	"StructFieldPtrAnonymousHeadOmitEmptyBoolOnly",
 	"StructFieldPtrAnonymousHeadStringTagBool",
 	"StructFieldPtrAnonymousHeadStringTagBoolOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBool",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBoolOnly",
 	"StructFieldNPtrHeadBool",
 	"StructFieldNPtrHeadBoolOnly",
 	"StructFieldNPtrHeadOmitEmptyBool",
 	"StructFieldNPtrHeadOmitEmptyBoolOnly",
 	"StructFieldNPtrHeadStringTagBool",
 	"StructFieldNPtrHeadStringTagBoolOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagBool",
+	"StructFieldNPtrHeadOmitEmptyStringTagBoolOnly",
 	"StructFieldNPtrAnonymousHeadBool",
 	"StructFieldNPtrAnonymousHeadBoolOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBool",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBoolOnly",
 	"StructFieldNPtrAnonymousHeadStringTagBool",
 	"StructFieldNPtrAnonymousHeadStringTagBoolOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBool",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolOnly",
 	"StructFieldHeadString",
 	"StructFieldHeadStringOnly",
 	"StructFieldHeadOmitEmptyString",
 	"StructFieldHeadOmitEmptyStringOnly",
 	"StructFieldHeadStringTagString",
 	"StructFieldHeadStringTagStringOnly",
+	"StructFieldHeadOmitEmptyStringTagString",
+	"StructFieldHeadOmitEmptyStringTagStringOnly",
 	"StructFieldAnonymousHeadString",
 	"StructFieldAnonymousHeadStringOnly",
 	"StructFieldAnonymousHeadOmitEmptyString",
 	"StructFieldAnonymousHeadOmitEmptyStringOnly",
 	"StructFieldAnonymousHeadStringTagString",
 	"StructFieldAnonymousHeadStringTagStringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagString",
+	"StructFieldAnonymousHeadOmitEmptyStringTagStringOnly",
 	"StructFieldPtrHeadString",
 	"StructFieldPtrHeadStringOnly",
 	"StructFieldPtrHeadOmitEmptyString",
 	"StructFieldPtrHeadOmitEmptyStringOnly",
 	"StructFieldPtrHeadStringTagString",
 	"StructFieldPtrHeadStringTagStringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagString",
+	"StructFieldPtrHeadOmitEmptyStringTagStringOnly",
 	"StructFieldPtrAnonymousHeadString",
 	"StructFieldPtrAnonymousHeadStringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyString",
 	"StructFieldPtrAnonymousHeadOmitEmptyStringOnly",
 	"StructFieldPtrAnonymousHeadStringTagString",
 	"StructFieldPtrAnonymousHeadStringTagStringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagString",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagStringOnly",
 	"StructFieldNPtrHeadString",
 	"StructFieldNPtrHeadStringOnly",
 	"StructFieldNPtrHeadOmitEmptyString",
 	"StructFieldNPtrHeadOmitEmptyStringOnly",
 	"StructFieldNPtrHeadStringTagString",
 	"StructFieldNPtrHeadStringTagStringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagString",
+	"StructFieldNPtrHeadOmitEmptyStringTagStringOnly",
 	"StructFieldNPtrAnonymousHeadString",
 	"StructFieldNPtrAnonymousHeadStringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyString",
 	"StructFieldNPtrAnonymousHeadOmitEmptyStringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagString",
 	"StructFieldNPtrAnonymousHeadStringTagStringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagString",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagStringOnly",
 	"StructFieldHeadBytes",
 	"StructFieldHeadBytesOnly",
 	"StructFieldHeadOmitEmptyBytes",
 	"StructFieldHeadOmitEmptyBytesOnly",
 	"StructFieldHeadStringTagBytes",
 	"StructFieldHeadStringTagBytesOnly",
+	"StructFieldHeadOmitEmptyStringTagBytes",
+	"StructFieldHeadOmitEmptyStringTagBytesOnly",
 	"StructFieldAnonymousHeadBytes",
 	"StructFieldAnonymousHeadBytesOnly",
 	"StructFieldAnonymousHeadOmitEmptyBytes",
 	"StructFieldAnonymousHeadOmitEmptyBytesOnly",
 	"StructFieldAnonymousHeadStringTagBytes",
 	"StructFieldAnonymousHeadStringTagBytesOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBytes",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBytesOnly",
 	"StructFieldPtrHeadBytes",
 	"StructFieldPtrHeadBytesOnly",
 	"StructFieldPtrHeadOmitEmptyBytes",
 	"StructFieldPtrHeadOmitEmptyBytesOnly",
 	"StructFieldPtrHeadStringTagBytes",
 	"StructFieldPtrHeadStringTagBytesOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagBytes",
+	"StructFieldPtrHeadOmitEmptyStringTagBytesOnly",
 	"StructFieldPtrAnonymousHeadBytes",
 	"StructFieldPtrAnonymousHeadBytesOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyBytes",
 	"StructFieldPtrAnonymousHeadOmitEmptyBytesOnly",
 	"StructFieldPtrAnonymousHeadStringTagBytes",
 	"StructFieldPtrAnonymousHeadStringTagBytesOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBytes",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBytesOnly",
 	"StructFieldNPtrHeadBytes",
 	"StructFieldNPtrHeadBytesOnly",
 	"StructFieldNPtrHeadOmitEmptyBytes",
 	"StructFieldNPtrHeadOmitEmptyBytesOnly",
 	"StructFieldNPtrHeadStringTagBytes",
 	"StructFieldNPtrHeadStringTagBytesOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagBytes",
+	"StructFieldNPtrHeadOmitEmptyStringTagBytesOnly",
 	"StructFieldNPtrAnonymousHeadBytes",
 	"StructFieldNPtrAnonymousHeadBytesOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBytes",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBytesOnly",
 	"StructFieldNPtrAnonymousHeadStringTagBytes",
 	"StructFieldNPtrAnonymousHeadStringTagBytesOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBytes",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesOnly",
 	"StructFieldHeadArray",
 	"StructFieldHeadArrayOnly",
 	"StructFieldHeadOmitEmptyArray",
 	"StructFieldHeadOmitEmptyArrayOnly",
 	"StructFieldHeadStringTagArray",
 	"StructFieldHeadStringTagArrayOnly",
+	"StructFieldHeadOmitEmptyStringTagArray",
+	"StructFieldHeadOmitEmptyStringTagArrayOnly",
 	"StructFieldAnonymousHeadArray",
 	"StructFieldAnonymousHeadArrayOnly",
 	"StructFieldAnonymousHeadOmitEmptyArray",
 	"StructFieldAnonymousHeadOmitEmptyArrayOnly",
 	"StructFieldAnonymousHeadStringTagArray",
 	"StructFieldAnonymousHeadStringTagArrayOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagArray",
+	"StructFieldAnonymousHeadOmitEmptyStringTagArrayOnly",
 	"StructFieldPtrHeadArray",
 	"StructFieldPtrHeadArrayOnly",
 	"StructFieldPtrHeadOmitEmptyArray",
 	"StructFieldPtrHeadOmitEmptyArrayOnly",
 	"StructFieldPtrHeadStringTagArray",
 	"StructFieldPtrHeadStringTagArrayOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagArray",
+	"StructFieldPtrHeadOmitEmptyStringTagArrayOnly",
 	"StructFieldPtrAnonymousHeadArray",
 	"StructFieldPtrAnonymousHeadArrayOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyArray",
 	"StructFieldPtrAnonymousHeadOmitEmptyArrayOnly",
 	"StructFieldPtrAnonymousHeadStringTagArray",
 	"StructFieldPtrAnonymousHeadStringTagArrayOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagArray",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagArrayOnly",
 	"StructFieldNPtrHeadArray",
 	"StructFieldNPtrHeadArrayOnly",
 	"StructFieldNPtrHeadOmitEmptyArray",
 	"StructFieldNPtrHeadOmitEmptyArrayOnly",
 	"StructFieldNPtrHeadStringTagArray",
 	"StructFieldNPtrHeadStringTagArrayOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagArray",
+	"StructFieldNPtrHeadOmitEmptyStringTagArrayOnly",
 	"StructFieldNPtrAnonymousHeadArray",
 	"StructFieldNPtrAnonymousHeadArrayOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyArray",
 	"StructFieldNPtrAnonymousHeadOmitEmptyArrayOnly",
 	"StructFieldNPtrAnonymousHeadStringTagArray",
 	"StructFieldNPtrAnonymousHeadStringTagArrayOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagArray",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagArrayOnly",
 	"StructFieldHeadMap",
 	"StructFieldHeadMapOnly",
 	"StructFieldHeadOmitEmptyMap",
 	"StructFieldHeadOmitEmptyMapOnly",
 	"StructFieldHeadStringTagMap",
 	"StructFieldHeadStringTagMapOnly",
+	"StructFieldHeadOmitEmptyStringTagMap",
+	"StructFieldHeadOmitEmptyStringTagMapOnly",
 	"StructFieldAnonymousHeadMap",
 	"StructFieldAnonymousHeadMapOnly",
 	"StructFieldAnonymousHeadOmitEmptyMap",
 	"StructFieldAnonymousHeadOmitEmptyMapOnly",
 	"StructFieldAnonymousHeadStringTagMap",
 	"StructFieldAnonymousHeadStringTagMapOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagMap",
+	"StructFieldAnonymousHeadOmitEmptyStringTagMapOnly",
 	"StructFieldPtrHeadMap",
 	"StructFieldPtrHeadMapOnly",
 	"StructFieldPtrHeadOmitEmptyMap",
 	"StructFieldPtrHeadOmitEmptyMapOnly",
 	"StructFieldPtrHeadStringTagMap",
 	"StructFieldPtrHeadStringTagMapOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagMap",
+	"StructFieldPtrHeadOmitEmptyStringTagMapOnly",
 	"StructFieldPtrAnonymousHeadMap",
 	"StructFieldPtrAnonymousHeadMapOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyMap",
 	"StructFieldPtrAnonymousHeadOmitEmptyMapOnly",
 	"StructFieldPtrAnonymousHeadStringTagMap",
 	"StructFieldPtrAnonymousHeadStringTagMapOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagMap",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagMapOnly",
 	"StructFieldNPtrHeadMap",
 	"StructFieldNPtrHeadMapOnly",
 	"StructFieldNPtrHeadOmitEmptyMap",
 	"StructFieldNPtrHeadOmitEmptyMapOnly",
 	"StructFieldNPtrHeadStringTagMap",
 	"StructFieldNPtrHeadStringTagMapOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagMap",
+	"StructFieldNPtrHeadOmitEmptyStringTagMapOnly",
 	"StructFieldNPtrAnonymousHeadMap",
 	"StructFieldNPtrAnonymousHeadMapOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyMap",
 	"StructFieldNPtrAnonymousHeadOmitEmptyMapOnly",
 	"StructFieldNPtrAnonymousHeadStringTagMap",
 	"StructFieldNPtrAnonymousHeadStringTagMapOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagMap",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagMapOnly",
 	"StructFieldHeadMapLoad",
 	"StructFieldHeadMapLoadOnly",
 	"StructFieldHeadOmitEmptyMapLoad",
 	"StructFieldHeadOmitEmptyMapLoadOnly",
 	"StructFieldHeadStringTagMapLoad",
 	"StructFieldHeadStringTagMapLoadOnly",
+	"StructFieldHeadOmitEmptyStringTagMapLoad",
+	"StructFieldHeadOmitEmptyStringTagMapLoadOnly",
 	"StructFieldAnonymousHeadMapLoad",
 	"StructFieldAnonymousHeadMapLoadOnly",
 	"StructFieldAnonymousHeadOmitEmptyMapLoad",
 	"StructFieldAnonymousHeadOmitEmptyMapLoadOnly",
 	"StructFieldAnonymousHeadStringTagMapLoad",
 	"StructFieldAnonymousHeadStringTagMapLoadOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagMapLoad",
+	"StructFieldAnonymousHeadOmitEmptyStringTagMapLoadOnly",
 	"StructFieldPtrHeadMapLoad",
 	"StructFieldPtrHeadMapLoadOnly",
 	"StructFieldPtrHeadOmitEmptyMapLoad",
 	"StructFieldPtrHeadOmitEmptyMapLoadOnly",
 	"StructFieldPtrHeadStringTagMapLoad",
 	"StructFieldPtrHeadStringTagMapLoadOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagMapLoad",
+	"StructFieldPtrHeadOmitEmptyStringTagMapLoadOnly",
 	"StructFieldPtrAnonymousHeadMapLoad",
 	"StructFieldPtrAnonymousHeadMapLoadOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyMapLoad",
 	"StructFieldPtrAnonymousHeadOmitEmptyMapLoadOnly",
 	"StructFieldPtrAnonymousHeadStringTagMapLoad",
 	"StructFieldPtrAnonymousHeadStringTagMapLoadOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagMapLoad",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagMapLoadOnly",
 	"StructFieldNPtrHeadMapLoad",
 	"StructFieldNPtrHeadMapLoadOnly",
 	"StructFieldNPtrHeadOmitEmptyMapLoad",
 	"StructFieldNPtrHeadOmitEmptyMapLoadOnly",
 	"StructFieldNPtrHeadStringTagMapLoad",
 	"StructFieldNPtrHeadStringTagMapLoadOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagMapLoad",
+	"StructFieldNPtrHeadOmitEmptyStringTagMapLoadOnly",
 	"StructFieldNPtrAnonymousHeadMapLoad",
 	"StructFieldNPtrAnonymousHeadMapLoadOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyMapLoad",
 	"StructFieldNPtrAnonymousHeadOmitEmptyMapLoadOnly",
 	"StructFieldNPtrAnonymousHeadStringTagMapLoad",
 	"StructFieldNPtrAnonymousHeadStringTagMapLoadOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagMapLoad",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagMapLoadOnly",
 	"StructFieldHeadSlice",
 	"StructFieldHeadSliceOnly",
 	"StructFieldHeadOmitEmptySlice",
 	"StructFieldHeadOmitEmptySliceOnly",
 	"StructFieldHeadStringTagSlice",
 	"StructFieldHeadStringTagSliceOnly",
+	"StructFieldHeadOmitEmptyStringTagSlice",
+	"StructFieldHeadOmitEmptyStringTagSliceOnly",
 	"StructFieldAnonymousHeadSlice",
 	"StructFieldAnonymousHeadSliceOnly",
 	"StructFieldAnonymousHeadOmitEmptySlice",
 	"StructFieldAnonymousHeadOmitEmptySliceOnly",
 	"StructFieldAnonymousHeadStringTagSlice",
 	"StructFieldAnonymousHeadStringTagSliceOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagSlice",
+	"StructFieldAnonymousHeadOmitEmptyStringTagSliceOnly",
 	"StructFieldPtrHeadSlice",
 	"StructFieldPtrHeadSliceOnly",
 	"StructFieldPtrHeadOmitEmptySlice",
 	"StructFieldPtrHeadOmitEmptySliceOnly",
 	"StructFieldPtrHeadStringTagSlice",
 	"StructFieldPtrHeadStringTagSliceOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagSlice",
+	"StructFieldPtrHeadOmitEmptyStringTagSliceOnly",
 	"StructFieldPtrAnonymousHeadSlice",
 	"StructFieldPtrAnonymousHeadSliceOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptySlice",
 	"StructFieldPtrAnonymousHeadOmitEmptySliceOnly",
 	"StructFieldPtrAnonymousHeadStringTagSlice",
 	"StructFieldPtrAnonymousHeadStringTagSliceOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagSlice",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagSliceOnly",
 	"StructFieldNPtrHeadSlice",
 	"StructFieldNPtrHeadSliceOnly",
 	"StructFieldNPtrHeadOmitEmptySlice",
 	"StructFieldNPtrHeadOmitEmptySliceOnly",
 	"StructFieldNPtrHeadStringTagSlice",
 	"StructFieldNPtrHeadStringTagSliceOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagSlice",
+	"StructFieldNPtrHeadOmitEmptyStringTagSliceOnly",
 	"StructFieldNPtrAnonymousHeadSlice",
 	"StructFieldNPtrAnonymousHeadSliceOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptySlice",
 	"StructFieldNPtrAnonymousHeadOmitEmptySliceOnly",
 	"StructFieldNPtrAnonymousHeadStringTagSlice",
 	"StructFieldNPtrAnonymousHeadStringTagSliceOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagSlice",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagSliceOnly",
 	"StructFieldHeadStruct",
 	"StructFieldHeadStructOnly",
 	"StructFieldHeadOmitEmptyStruct",
 	"StructFieldHeadOmitEmptyStructOnly",
 	"StructFieldHeadStringTagStruct",
 	"StructFieldHeadStringTagStructOnly",
+	"StructFieldHeadOmitEmptyStringTagStruct",
+	"StructFieldHeadOmitEmptyStringTagStructOnly",
 	"StructFieldAnonymousHeadStruct",
 	"StructFieldAnonymousHeadStructOnly",
 	"StructFieldAnonymousHeadOmitEmptyStruct",
 	"StructFieldAnonymousHeadOmitEmptyStructOnly",
 	"StructFieldAnonymousHeadStringTagStruct",
 	"StructFieldAnonymousHeadStringTagStructOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagStruct",
+	"StructFieldAnonymousHeadOmitEmptyStringTagStructOnly",
 	"StructFieldPtrHeadStruct",
 	"StructFieldPtrHeadStructOnly",
 	"StructFieldPtrHeadOmitEmptyStruct",
 	"StructFieldPtrHeadOmitEmptyStructOnly",
 	"StructFieldPtrHeadStringTagStruct",
 	"StructFieldPtrHeadStringTagStructOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagStruct",
+	"StructFieldPtrHeadOmitEmptyStringTagStructOnly",
 	"StructFieldPtrAnonymousHeadStruct",
 	"StructFieldPtrAnonymousHeadStructOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyStruct",
 	"StructFieldPtrAnonymousHeadOmitEmptyStructOnly",
 	"StructFieldPtrAnonymousHeadStringTagStruct",
 	"StructFieldPtrAnonymousHeadStringTagStructOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagStruct",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagStructOnly",
 	"StructFieldNPtrHeadStruct",
 	"StructFieldNPtrHeadStructOnly",
 	"StructFieldNPtrHeadOmitEmptyStruct",
 	"StructFieldNPtrHeadOmitEmptyStructOnly",
 	"StructFieldNPtrHeadStringTagStruct",
 	"StructFieldNPtrHeadStringTagStructOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagStruct",
+	"StructFieldNPtrHeadOmitEmptyStringTagStructOnly",
 	"StructFieldNPtrAnonymousHeadStruct",
 	"StructFieldNPtrAnonymousHeadStructOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyStruct",
 	"StructFieldNPtrAnonymousHeadOmitEmptyStructOnly",
 	"StructFieldNPtrAnonymousHeadStringTagStruct",
 	"StructFieldNPtrAnonymousHeadStringTagStructOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagStruct",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagStructOnly",
 	"StructFieldHeadMarshalJSON",
 	"StructFieldHeadMarshalJSONOnly",
 	"StructFieldHeadOmitEmptyMarshalJSON",
 	"StructFieldHeadOmitEmptyMarshalJSONOnly",
 	"StructFieldHeadStringTagMarshalJSON",
 	"StructFieldHeadStringTagMarshalJSONOnly",
+	"StructFieldHeadOmitEmptyStringTagMarshalJSON",
+	"StructFieldHeadOmitEmptyStringTagMarshalJSONOnly",
 	"StructFieldAnonymousHeadMarshalJSON",
 	"StructFieldAnonymousHeadMarshalJSONOnly",
 	"StructFieldAnonymousHeadOmitEmptyMarshalJSON",
 	"StructFieldAnonymousHeadOmitEmptyMarshalJSONOnly",
 	"StructFieldAnonymousHeadStringTagMarshalJSON",
 	"StructFieldAnonymousHeadStringTagMarshalJSONOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagMarshalJSON",
+	"StructFieldAnonymousHeadOmitEmptyStringTagMarshalJSONOnly",
 	"StructFieldPtrHeadMarshalJSON",
 	"StructFieldPtrHeadMarshalJSONOnly",
 	"StructFieldPtrHeadOmitEmptyMarshalJSON",
 	"StructFieldPtrHeadOmitEmptyMarshalJSONOnly",
 	"StructFieldPtrHeadStringTagMarshalJSON",
 	"StructFieldPtrHeadStringTagMarshalJSONOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagMarshalJSON",
+	"StructFieldPtrHeadOmitEmptyStringTagMarshalJSONOnly",
 	"StructFieldPtrAnonymousHeadMarshalJSON",
 	"StructFieldPtrAnonymousHeadMarshalJSONOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyMarshalJSON",
 	"StructFieldPtrAnonymousHeadOmitEmptyMarshalJSONOnly",
 	"StructFieldPtrAnonymousHeadStringTagMarshalJSON",
 	"StructFieldPtrAnonymousHeadStringTagMarshalJSONOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalJSON",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalJSONOnly",
 	"StructFieldNPtrHeadMarshalJSON",
 	"StructFieldNPtrHeadMarshalJSONOnly",
 	"StructFieldNPtrHeadOmitEmptyMarshalJSON",
 	"StructFieldNPtrHeadOmitEmptyMarshalJSONOnly",
 	"StructFieldNPtrHeadStringTagMarshalJSON",
 	"StructFieldNPtrHeadStringTagMarshalJSONOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagMarshalJSON",
+	"StructFieldNPtrHeadOmitEmptyStringTagMarshalJSONOnly",
 	"StructFieldNPtrAnonymousHeadMarshalJSON",
 	"StructFieldNPtrAnonymousHeadMarshalJSONOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyMarshalJSON",
 	"StructFieldNPtrAnonymousHeadOmitEmptyMarshalJSONOnly",
 	"StructFieldNPtrAnonymousHeadStringTagMarshalJSON",
 	"StructFieldNPtrAnonymousHeadStringTagMarshalJSONOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagMarshalJSON",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagMarshalJSONOnly",
 	"StructFieldHeadMarshalText",
 	"StructFieldHeadMarshalTextOnly",
 	"StructFieldHeadOmitEmptyMarshalText",
 	"StructFieldHeadOmitEmptyMarshalTextOnly",
 	"StructFieldHeadStringTagMarshalText",
 	"StructFieldHeadStringTagMarshalTextOnly",
+	"StructFieldHeadOmitEmptyStringTagMarshalText",
+	"StructFieldHeadOmitEmptyStringTagMarshalTextOnly",
 	"StructFieldAnonymousHeadMarshalText",
 	"StructFieldAnonymousHeadMarshalTextOnly",
 	"StructFieldAnonymousHeadOmitEmptyMarshalText",
 	"StructFieldAnonymousHeadOmitEmptyMarshalTextOnly",
 	"StructFieldAnonymousHeadStringTagMarshalText",
 	"StructFieldAnonymousHeadStringTagMarshalTextOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagMarshalText",
+	"StructFieldAnonymousHeadOmitEmptyStringTagMarshalTextOnly",
 	"StructFieldPtrHeadMarshalText",
 	"StructFieldPtrHeadMarshalTextOnly",
 	"StructFieldPtrHeadOmitEmptyMarshalText",
 	"StructFieldPtrHeadOmitEmptyMarshalTextOnly",
 	"StructFieldPtrHeadStringTagMarshalText",
 	"StructFieldPtrHeadStringTagMarshalTextOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagMarshalText",
+	"StructFieldPtrHeadOmitEmptyStringTagMarshalTextOnly",
 	"StructFieldPtrAnonymousHeadMarshalText",
 	"StructFieldPtrAnonymousHeadMarshalTextOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyMarshalText",
 	"StructFieldPtrAnonymousHeadOmitEmptyMarshalTextOnly",
 	"StructFieldPtrAnonymousHeadStringTagMarshalText",
 	"StructFieldPtrAnonymousHeadStringTagMarshalTextOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalText",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalTextOnly",
 	"StructFieldNPtrHeadMarshalText",
 	"StructFieldNPtrHeadMarshalTextOnly",
 	"StructFieldNPtrHeadOmitEmptyMarshalText",
 	"StructFieldNPtrHeadOmitEmptyMarshalTextOnly",
 	"StructFieldNPtrHeadStringTagMarshalText",
 	"StructFieldNPtrHeadStringTagMarshalTextOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagMarshalText",
+	"StructFieldNPtrHeadOmitEmptyStringTagMarshalTextOnly",
 	"StructFieldNPtrAnonymousHeadMarshalText",
 	"StructFieldNPtrAnonymousHeadMarshalTextOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyMarshalText",
 	"StructFieldNPtrAnonymousHeadOmitEmptyMarshalTextOnly",
 	"StructFieldNPtrAnonymousHeadStringTagMarshalText",
 	"StructFieldNPtrAnonymousHeadStringTagMarshalTextOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagMarshalText",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagMarshalTextOnly",
 	"StructFieldHeadRecursive",
 	"StructFieldHeadRecursiveOnly",
 	"StructFieldHeadOmitEmptyRecursive",
 	"StructFieldHeadOmitEmptyRecursiveOnly",
 	"StructFieldHeadStringTagRecursive",
 	"StructFieldHeadStringTagRecursiveOnly",
+	"StructFieldHeadOmitEmptyStringTagRecursive",
+	"StructFieldHeadOmitEmptyStringTagRecursiveOnly",
 	"StructFieldAnonymousHeadRecursive",
 	"StructFieldAnonymousHeadRecursiveOnly",
 	"StructFieldAnonymousHeadOmitEmptyRecursive",
 	"StructFieldAnonymousHeadOmitEmptyRecursiveOnly",
 	"StructFieldAnonymousHeadStringTagRecursive",
 	"StructFieldAnonymousHeadStringTagRecursiveOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagRecursive",
+	"StructFieldAnonymousHeadOmitEmptyStringTagRecursiveOnly",
 	"StructFieldPtrHeadRecursive",
 	"StructFieldPtrHeadRecursiveOnly",
 	"StructFieldPtrHeadOmitEmptyRecursive",
 	"StructFieldPtrHeadOmitEmptyRecursiveOnly",
 	"StructFieldPtrHeadStringTagRecursive",
 	"StructFieldPtrHeadStringTagRecursiveOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagRecursive",
+	"StructFieldPtrHeadOmitEmptyStringTagRecursiveOnly",
 	"StructFieldPtrAnonymousHeadRecursive",
 	"StructFieldPtrAnonymousHeadRecursiveOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyRecursive",
 	"StructFieldPtrAnonymousHeadOmitEmptyRecursiveOnly",
 	"StructFieldPtrAnonymousHeadStringTagRecursive",
 	"StructFieldPtrAnonymousHeadStringTagRecursiveOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagRecursive",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagRecursiveOnly",
 	"StructFieldNPtrHeadRecursive",
 	"StructFieldNPtrHeadRecursiveOnly",
 	"StructFieldNPtrHeadOmitEmptyRecursive",
 	"StructFieldNPtrHeadOmitEmptyRecursiveOnly",
 	"StructFieldNPtrHeadStringTagRecursive",
 	"StructFieldNPtrHeadStringTagRecursiveOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagRecursive",
+	"StructFieldNPtrHeadOmitEmptyStringTagRecursiveOnly",
 	"StructFieldNPtrAnonymousHeadRecursive",
 	"StructFieldNPtrAnonymousHeadRecursiveOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyRecursive",
 	"StructFieldNPtrAnonymousHeadOmitEmptyRecursiveOnly",
 	"StructFieldNPtrAnonymousHeadStringTagRecursive",
 	"StructFieldNPtrAnonymousHeadStringTagRecursiveOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagRecursive",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagRecursiveOnly",
 	"StructFieldHeadIntString",
 	"StructFieldHeadIntStringOnly",
 	"StructFieldHeadOmitEmptyIntString",
 	"StructFieldHeadOmitEmptyIntStringOnly",
 	"StructFieldHeadStringTagIntString",
 	"StructFieldHeadStringTagIntStringOnly",
+	"StructFieldHeadOmitEmptyStringTagIntString",
+	"StructFieldHeadOmitEmptyStringTagIntStringOnly",
 	"StructFieldAnonymousHeadIntString",
 	"StructFieldAnonymousHeadIntStringOnly",
 	"StructFieldAnonymousHeadOmitEmptyIntString",
 	"StructFieldAnonymousHeadOmitEmptyIntStringOnly",
 	"StructFieldAnonymousHeadStringTagIntString",
 	"StructFieldAnonymousHeadStringTagIntStringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagIntString",
+	"StructFieldAnonymousHeadOmitEmptyStringTagIntStringOnly",
 	"StructFieldPtrHeadIntString",
 	"StructFieldPtrHeadIntStringOnly",
 	"StructFieldPtrHeadOmitEmptyIntString",
 	"StructFieldPtrHeadOmitEmptyIntStringOnly",
 	"StructFieldPtrHeadStringTagIntString",
 	"StructFieldPtrHeadStringTagIntStringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagIntString",
+	"StructFieldPtrHeadOmitEmptyStringTagIntStringOnly",
 	"StructFieldPtrAnonymousHeadIntString",
 	"StructFieldPtrAnonymousHeadIntStringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyIntString",
 	"StructFieldPtrAnonymousHeadOmitEmptyIntStringOnly",
 	"StructFieldPtrAnonymousHeadStringTagIntString",
 	"StructFieldPtrAnonymousHeadStringTagIntStringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagIntString",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagIntStringOnly",
 	"StructFieldNPtrHeadIntString",
 	"StructFieldNPtrHeadIntStringOnly",
 	"StructFieldNPtrHeadOmitEmptyIntString",
 	"StructFieldNPtrHeadOmitEmptyIntStringOnly",
 	"StructFieldNPtrHeadStringTagIntString",
 	"StructFieldNPtrHeadStringTagIntStringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagIntString",
+	"StructFieldNPtrHeadOmitEmptyStringTagIntStringOnly",
 	"StructFieldNPtrAnonymousHeadIntString",
 	"StructFieldNPtrAnonymousHeadIntStringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyIntString",
 	"StructFieldNPtrAnonymousHeadOmitEmptyIntStringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagIntString",
 	"StructFieldNPtrAnonymousHeadStringTagIntStringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagIntString",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagIntStringOnly",
 	"StructFieldHeadInt8String",
 	"StructFieldHeadInt8StringOnly",
 	"StructFieldHeadOmitEmptyInt8String",
 	"StructFieldHeadOmitEmptyInt8StringOnly",
 	"StructFieldHeadStringTagInt8String",
 	"StructFieldHeadStringTagInt8StringOnly",
+	"StructFieldHeadOmitEmptyStringTagInt8String",
+	"StructFieldHeadOmitEmptyStringTagInt8StringOnly",
 	"StructFieldAnonymousHeadInt8String",
 	"StructFieldAnonymousHeadInt8StringOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt8String",
 	"StructFieldAnonymousHeadOmitEmptyInt8StringOnly",
 	"StructFieldAnonymousHeadStringTagInt8String",
 	"StructFieldAnonymousHeadStringTagInt8StringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt8String",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt8StringOnly",
 	"StructFieldPtrHeadInt8String",
 	"StructFieldPtrHeadInt8StringOnly",
 	"StructFieldPtrHeadOmitEmptyInt8String",
 	"StructFieldPtrHeadOmitEmptyInt8StringOnly",
 	"StructFieldPtrHeadStringTagInt8String",
 	"StructFieldPtrHeadStringTagInt8StringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt8String",
+	"StructFieldPtrHeadOmitEmptyStringTagInt8StringOnly",
 	"StructFieldPtrAnonymousHeadInt8String",
 	"StructFieldPtrAnonymousHeadInt8StringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt8String",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt8StringOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt8String",
 	"StructFieldPtrAnonymousHeadStringTagInt8StringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt8String",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt8StringOnly",
 	"StructFieldNPtrHeadInt8String",
 	"StructFieldNPtrHeadInt8StringOnly",
 	"StructFieldNPtrHeadOmitEmptyInt8String",
 	"StructFieldNPtrHeadOmitEmptyInt8StringOnly",
 	"StructFieldNPtrHeadStringTagInt8String",
 	"StructFieldNPtrHeadStringTagInt8StringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt8String",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt8StringOnly",
 	"StructFieldNPtrAnonymousHeadInt8String",
 	"StructFieldNPtrAnonymousHeadInt8StringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt8String",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt8StringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt8String",
 	"StructFieldNPtrAnonymousHeadStringTagInt8StringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8String",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8StringOnly",
 	"StructFieldHeadInt16String",
 	"StructFieldHeadInt16StringOnly",
 	"StructFieldHeadOmitEmptyInt16String",
 	"StructFieldHeadOmitEmptyInt16StringOnly",
 	"StructFieldHeadStringTagInt16String",
 	"StructFieldHeadStringTagInt16StringOnly",
+	"StructFieldHeadOmitEmptyStringTagInt16String",
+	"StructFieldHeadOmitEmptyStringTagInt16StringOnly",
 	"StructFieldAnonymousHeadInt16String",
 	"StructFieldAnonymousHeadInt16StringOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt16String",
 	"StructFieldAnonymousHeadOmitEmptyInt16StringOnly",
 	"StructFieldAnonymousHeadStringTagInt16String",
 	"StructFieldAnonymousHeadStringTagInt16StringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt16String",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt16StringOnly",
 	"StructFieldPtrHeadInt16String",
 	"StructFieldPtrHeadInt16StringOnly",
 	"StructFieldPtrHeadOmitEmptyInt16String",
 	"StructFieldPtrHeadOmitEmptyInt16StringOnly",
 	"StructFieldPtrHeadStringTagInt16String",
 	"StructFieldPtrHeadStringTagInt16StringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt16String",
+	"StructFieldPtrHeadOmitEmptyStringTagInt16StringOnly",
 	"StructFieldPtrAnonymousHeadInt16String",
 	"StructFieldPtrAnonymousHeadInt16StringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt16String",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt16StringOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt16String",
 	"StructFieldPtrAnonymousHeadStringTagInt16StringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt16String",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt16StringOnly",
 	"StructFieldNPtrHeadInt16String",
 	"StructFieldNPtrHeadInt16StringOnly",
 	"StructFieldNPtrHeadOmitEmptyInt16String",
 	"StructFieldNPtrHeadOmitEmptyInt16StringOnly",
 	"StructFieldNPtrHeadStringTagInt16String",
 	"StructFieldNPtrHeadStringTagInt16StringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt16String",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt16StringOnly",
 	"StructFieldNPtrAnonymousHeadInt16String",
 	"StructFieldNPtrAnonymousHeadInt16StringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt16String",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt16StringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt16String",
 	"StructFieldNPtrAnonymousHeadStringTagInt16StringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16String",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16StringOnly",
 	"StructFieldHeadInt32String",
 	"StructFieldHeadInt32StringOnly",
 	"StructFieldHeadOmitEmptyInt32String",
 	"StructFieldHeadOmitEmptyInt32StringOnly",
 	"StructFieldHeadStringTagInt32String",
 	"StructFieldHeadStringTagInt32StringOnly",
+	"StructFieldHeadOmitEmptyStringTagInt32String",
+	"StructFieldHeadOmitEmptyStringTagInt32StringOnly",
 	"StructFieldAnonymousHeadInt32String",
 	"StructFieldAnonymousHeadInt32StringOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt32String",
 	"StructFieldAnonymousHeadOmitEmptyInt32StringOnly",
 	"StructFieldAnonymousHeadStringTagInt32String",
 	"StructFieldAnonymousHeadStringTagInt32StringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt32String",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt32StringOnly",
 	"StructFieldPtrHeadInt32String",
 	"StructFieldPtrHeadInt32StringOnly",
 	"StructFieldPtrHeadOmitEmptyInt32String",
 	"StructFieldPtrHeadOmitEmptyInt32StringOnly",
 	"StructFieldPtrHeadStringTagInt32String",
 	"StructFieldPtrHeadStringTagInt32StringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt32String",
+	"StructFieldPtrHeadOmitEmptyStringTagInt32StringOnly",
 	"StructFieldPtrAnonymousHeadInt32String",
 	"StructFieldPtrAnonymousHeadInt32StringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt32String",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt32StringOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt32String",
 	"StructFieldPtrAnonymousHeadStringTagInt32StringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt32String",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt32StringOnly",
 	"StructFieldNPtrHeadInt32String",
 	"StructFieldNPtrHeadInt32StringOnly",
 	"StructFieldNPtrHeadOmitEmptyInt32String",
 	"StructFieldNPtrHeadOmitEmptyInt32StringOnly",
 	"StructFieldNPtrHeadStringTagInt32String",
 	"StructFieldNPtrHeadStringTagInt32StringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt32String",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt32StringOnly",
 	"StructFieldNPtrAnonymousHeadInt32String",
 	"StructFieldNPtrAnonymousHeadInt32StringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt32String",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt32StringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt32String",
 	"StructFieldNPtrAnonymousHeadStringTagInt32StringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32String",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32StringOnly",
 	"StructFieldHeadInt64String",
 	"StructFieldHeadInt64StringOnly",
 	"StructFieldHeadOmitEmptyInt64String",
 	"StructFieldHeadOmitEmptyInt64StringOnly",
 	"StructFieldHeadStringTagInt64String",
 	"StructFieldHeadStringTagInt64StringOnly",
+	"StructFieldHeadOmitEmptyStringTagInt64String",
+	"StructFieldHeadOmitEmptyStringTagInt64StringOnly",
 	"StructFieldAnonymousHeadInt64String",
 	"StructFieldAnonymousHeadInt64StringOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt64String",
 	"StructFieldAnonymousHeadOmitEmptyInt64StringOnly",
 	"StructFieldAnonymousHeadStringTagInt64String",
 	"StructFieldAnonymousHeadStringTagInt64StringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt64String",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt64StringOnly",
 	"StructFieldPtrHeadInt64String",
 	"StructFieldPtrHeadInt64StringOnly",
 	"StructFieldPtrHeadOmitEmptyInt64String",
 	"StructFieldPtrHeadOmitEmptyInt64StringOnly",
 	"StructFieldPtrHeadStringTagInt64String",
 	"StructFieldPtrHeadStringTagInt64StringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt64String",
+	"StructFieldPtrHeadOmitEmptyStringTagInt64StringOnly",
 	"StructFieldPtrAnonymousHeadInt64String",
 	"StructFieldPtrAnonymousHeadInt64StringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt64String",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt64StringOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt64String",
 	"StructFieldPtrAnonymousHeadStringTagInt64StringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt64String",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt64StringOnly",
 	"StructFieldNPtrHeadInt64String",
 	"StructFieldNPtrHeadInt64StringOnly",
 	"StructFieldNPtrHeadOmitEmptyInt64String",
 	"StructFieldNPtrHeadOmitEmptyInt64StringOnly",
 	"StructFieldNPtrHeadStringTagInt64String",
 	"StructFieldNPtrHeadStringTagInt64StringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt64String",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt64StringOnly",
 	"StructFieldNPtrAnonymousHeadInt64String",
 	"StructFieldNPtrAnonymousHeadInt64StringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt64String",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt64StringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt64String",
 	"StructFieldNPtrAnonymousHeadStringTagInt64StringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64String",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64StringOnly",
 	"StructFieldHeadUintString",
 	"StructFieldHeadUintStringOnly",
 	"StructFieldHeadOmitEmptyUintString",
 	"StructFieldHeadOmitEmptyUintStringOnly",
 	"StructFieldHeadStringTagUintString",
 	"StructFieldHeadStringTagUintStringOnly",
+	"StructFieldHeadOmitEmptyStringTagUintString",
+	"StructFieldHeadOmitEmptyStringTagUintStringOnly",
 	"StructFieldAnonymousHeadUintString",
 	"StructFieldAnonymousHeadUintStringOnly",
 	"StructFieldAnonymousHeadOmitEmptyUintString",
 	"StructFieldAnonymousHeadOmitEmptyUintStringOnly",
 	"StructFieldAnonymousHeadStringTagUintString",
 	"StructFieldAnonymousHeadStringTagUintStringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUintString",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUintStringOnly",
 	"StructFieldPtrHeadUintString",
 	"StructFieldPtrHeadUintStringOnly",
 	"StructFieldPtrHeadOmitEmptyUintString",
 	"StructFieldPtrHeadOmitEmptyUintStringOnly",
 	"StructFieldPtrHeadStringTagUintString",
 	"StructFieldPtrHeadStringTagUintStringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUintString",
+	"StructFieldPtrHeadOmitEmptyStringTagUintStringOnly",
 	"StructFieldPtrAnonymousHeadUintString",
 	"StructFieldPtrAnonymousHeadUintStringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUintString",
 	"StructFieldPtrAnonymousHeadOmitEmptyUintStringOnly",
 	"StructFieldPtrAnonymousHeadStringTagUintString",
 	"StructFieldPtrAnonymousHeadStringTagUintStringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUintString",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUintStringOnly",
 	"StructFieldNPtrHeadUintString",
 	"StructFieldNPtrHeadUintStringOnly",
 	"StructFieldNPtrHeadOmitEmptyUintString",
 	"StructFieldNPtrHeadOmitEmptyUintStringOnly",
 	"StructFieldNPtrHeadStringTagUintString",
 	"StructFieldNPtrHeadStringTagUintStringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUintString",
+	"StructFieldNPtrHeadOmitEmptyStringTagUintStringOnly",
 	"StructFieldNPtrAnonymousHeadUintString",
 	"StructFieldNPtrAnonymousHeadUintStringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUintString",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUintStringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUintString",
 	"StructFieldNPtrAnonymousHeadStringTagUintStringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUintString",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUintStringOnly",
 	"StructFieldHeadUint8String",
 	"StructFieldHeadUint8StringOnly",
 	"StructFieldHeadOmitEmptyUint8String",
 	"StructFieldHeadOmitEmptyUint8StringOnly",
 	"StructFieldHeadStringTagUint8String",
 	"StructFieldHeadStringTagUint8StringOnly",
+	"StructFieldHeadOmitEmptyStringTagUint8String",
+	"StructFieldHeadOmitEmptyStringTagUint8StringOnly",
 	"StructFieldAnonymousHeadUint8String",
 	"StructFieldAnonymousHeadUint8StringOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint8String",
 	"StructFieldAnonymousHeadOmitEmptyUint8StringOnly",
 	"StructFieldAnonymousHeadStringTagUint8String",
 	"StructFieldAnonymousHeadStringTagUint8StringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint8String",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint8StringOnly",
 	"StructFieldPtrHeadUint8String",
 	"StructFieldPtrHeadUint8StringOnly",
 	"StructFieldPtrHeadOmitEmptyUint8String",
 	"StructFieldPtrHeadOmitEmptyUint8StringOnly",
 	"StructFieldPtrHeadStringTagUint8String",
 	"StructFieldPtrHeadStringTagUint8StringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint8String",
+	"StructFieldPtrHeadOmitEmptyStringTagUint8StringOnly",
 	"StructFieldPtrAnonymousHeadUint8String",
 	"StructFieldPtrAnonymousHeadUint8StringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint8String",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint8StringOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint8String",
 	"StructFieldPtrAnonymousHeadStringTagUint8StringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint8String",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint8StringOnly",
 	"StructFieldNPtrHeadUint8String",
 	"StructFieldNPtrHeadUint8StringOnly",
 	"StructFieldNPtrHeadOmitEmptyUint8String",
 	"StructFieldNPtrHeadOmitEmptyUint8StringOnly",
 	"StructFieldNPtrHeadStringTagUint8String",
 	"StructFieldNPtrHeadStringTagUint8StringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint8String",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint8StringOnly",
 	"StructFieldNPtrAnonymousHeadUint8String",
 	"StructFieldNPtrAnonymousHeadUint8StringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint8String",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint8StringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint8String",
 	"StructFieldNPtrAnonymousHeadStringTagUint8StringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8String",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8StringOnly",
 	"StructFieldHeadUint16String",
 	"StructFieldHeadUint16StringOnly",
 	"StructFieldHeadOmitEmptyUint16String",
 	"StructFieldHeadOmitEmptyUint16StringOnly",
 	"StructFieldHeadStringTagUint16String",
 	"StructFieldHeadStringTagUint16StringOnly",
+	"StructFieldHeadOmitEmptyStringTagUint16String",
+	"StructFieldHeadOmitEmptyStringTagUint16StringOnly",
 	"StructFieldAnonymousHeadUint16String",
 	"StructFieldAnonymousHeadUint16StringOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint16String",
 	"StructFieldAnonymousHeadOmitEmptyUint16StringOnly",
 	"StructFieldAnonymousHeadStringTagUint16String",
 	"StructFieldAnonymousHeadStringTagUint16StringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint16String",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint16StringOnly",
 	"StructFieldPtrHeadUint16String",
 	"StructFieldPtrHeadUint16StringOnly",
 	"StructFieldPtrHeadOmitEmptyUint16String",
 	"StructFieldPtrHeadOmitEmptyUint16StringOnly",
 	"StructFieldPtrHeadStringTagUint16String",
 	"StructFieldPtrHeadStringTagUint16StringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint16String",
+	"StructFieldPtrHeadOmitEmptyStringTagUint16StringOnly",
 	"StructFieldPtrAnonymousHeadUint16String",
 	"StructFieldPtrAnonymousHeadUint16StringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint16String",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint16StringOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint16String",
 	"StructFieldPtrAnonymousHeadStringTagUint16StringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint16String",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint16StringOnly",
 	"StructFieldNPtrHeadUint16String",
 	"StructFieldNPtrHeadUint16StringOnly",
 	"StructFieldNPtrHeadOmitEmptyUint16String",
 	"StructFieldNPtrHeadOmitEmptyUint16StringOnly",
 	"StructFieldNPtrHeadStringTagUint16String",
 	"StructFieldNPtrHeadStringTagUint16StringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint16String",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint16StringOnly",
 	"StructFieldNPtrAnonymousHeadUint16String",
 	"StructFieldNPtrAnonymousHeadUint16StringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint16String",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint16StringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint16String",
 	"StructFieldNPtrAnonymousHeadStringTagUint16StringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16String",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16StringOnly",
 	"StructFieldHeadUint32String",
 	"StructFieldHeadUint32StringOnly",
 	"StructFieldHeadOmitEmptyUint32String",
 	"StructFieldHeadOmitEmptyUint32StringOnly",
 	"StructFieldHeadStringTagUint32String",
 	"StructFieldHeadStringTagUint32StringOnly",
+	"StructFieldHeadOmitEmptyStringTagUint32String",
+	"StructFieldHeadOmitEmptyStringTagUint32StringOnly",
 	"StructFieldAnonymousHeadUint32String",
 	"StructFieldAnonymousHeadUint32StringOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint32String",
 	"StructFieldAnonymousHeadOmitEmptyUint32StringOnly",
 	"StructFieldAnonymousHeadStringTagUint32String",
 	"StructFieldAnonymousHeadStringTagUint32StringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint32String",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint32StringOnly",
 	"StructFieldPtrHeadUint32String",
 	"StructFieldPtrHeadUint32StringOnly",
 	"StructFieldPtrHeadOmitEmptyUint32String",
 	"StructFieldPtrHeadOmitEmptyUint32StringOnly",
 	"StructFieldPtrHeadStringTagUint32String",
 	"StructFieldPtrHeadStringTagUint32StringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint32String",
+	"StructFieldPtrHeadOmitEmptyStringTagUint32StringOnly",
 	"StructFieldPtrAnonymousHeadUint32String",
 	"StructFieldPtrAnonymousHeadUint32StringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint32String",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint32StringOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint32String",
 	"StructFieldPtrAnonymousHeadStringTagUint32StringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint32String",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint32StringOnly",
 	"StructFieldNPtrHeadUint32String",
 	"StructFieldNPtrHeadUint32StringOnly",
 	"StructFieldNPtrHeadOmitEmptyUint32String",
 	"StructFieldNPtrHeadOmitEmptyUint32StringOnly",
 	"StructFieldNPtrHeadStringTagUint32String",
 	"StructFieldNPtrHeadStringTagUint32StringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint32String",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint32StringOnly",
 	"StructFieldNPtrAnonymousHeadUint32String",
 	"StructFieldNPtrAnonymousHeadUint32StringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint32String",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint32StringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint32String",
 	"StructFieldNPtrAnonymousHeadStringTagUint32StringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32String",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32StringOnly",
 	"StructFieldHeadUint64String",
 	"StructFieldHeadUint64StringOnly",
 	"StructFieldHeadOmitEmptyUint64String",
 	"StructFieldHeadOmitEmptyUint64StringOnly",
 	"StructFieldHeadStringTagUint64String",
 	"StructFieldHeadStringTagUint64StringOnly",
+	"StructFieldHeadOmitEmptyStringTagUint64String",
+	"StructFieldHeadOmitEmptyStringTagUint64StringOnly",
 	"StructFieldAnonymousHeadUint64String",
 	"StructFieldAnonymousHeadUint64StringOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint64String",
 	"StructFieldAnonymousHeadOmitEmptyUint64StringOnly",
 	"StructFieldAnonymousHeadStringTagUint64String",
 	"StructFieldAnonymousHeadStringTagUint64StringOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint64String",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint64StringOnly",
 	"StructFieldPtrHeadUint64String",
 	"StructFieldPtrHeadUint64StringOnly",
 	"StructFieldPtrHeadOmitEmptyUint64String",
 	"StructFieldPtrHeadOmitEmptyUint64StringOnly",
 	"StructFieldPtrHeadStringTagUint64String",
 	"StructFieldPtrHeadStringTagUint64StringOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint64String",
+	"StructFieldPtrHeadOmitEmptyStringTagUint64StringOnly",
 	"StructFieldPtrAnonymousHeadUint64String",
 	"StructFieldPtrAnonymousHeadUint64StringOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint64String",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint64StringOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint64String",
 	"StructFieldPtrAnonymousHeadStringTagUint64StringOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint64String",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint64StringOnly",
 	"StructFieldNPtrHeadUint64String",
 	"StructFieldNPtrHeadUint64StringOnly",
 	"StructFieldNPtrHeadOmitEmptyUint64String",
 	"StructFieldNPtrHeadOmitEmptyUint64StringOnly",
 	"StructFieldNPtrHeadStringTagUint64String",
 	"StructFieldNPtrHeadStringTagUint64StringOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint64String",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint64StringOnly",
 	"StructFieldNPtrAnonymousHeadUint64String",
 	"StructFieldNPtrAnonymousHeadUint64StringOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint64String",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint64StringOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint64String",
 	"StructFieldNPtrAnonymousHeadStringTagUint64StringOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64String",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64StringOnly",
 	"StructFieldHeadIntPtr",
 	"StructFieldHeadIntPtrOnly",
 	"StructFieldHeadOmitEmptyIntPtr",
 	"StructFieldHeadOmitEmptyIntPtrOnly",
 	"StructFieldHeadStringTagIntPtr",
 	"StructFieldHeadStringTagIntPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagIntPtr",
+	"StructFieldHeadOmitEmptyStringTagIntPtrOnly",
 	"StructFieldAnonymousHeadIntPtr",
 	"StructFieldAnonymousHeadIntPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyIntPtr",
 	"StructFieldAnonymousHeadOmitEmptyIntPtrOnly",
 	"StructFieldAnonymousHeadStringTagIntPtr",
 	"StructFieldAnonymousHeadStringTagIntPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagIntPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagIntPtrOnly",
 	"StructFieldPtrHeadIntPtr",
 	"StructFieldPtrHeadIntPtrOnly",
 	"StructFieldPtrHeadOmitEmptyIntPtr",
 	"StructFieldPtrHeadOmitEmptyIntPtrOnly",
 	"StructFieldPtrHeadStringTagIntPtr",
 	"StructFieldPtrHeadStringTagIntPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagIntPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagIntPtrOnly",
 	"StructFieldPtrAnonymousHeadIntPtr",
 	"StructFieldPtrAnonymousHeadIntPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyIntPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyIntPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagIntPtr",
 	"StructFieldPtrAnonymousHeadStringTagIntPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtrOnly",
 	"StructFieldNPtrHeadIntPtr",
 	"StructFieldNPtrHeadIntPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyIntPtr",
 	"StructFieldNPtrHeadOmitEmptyIntPtrOnly",
 	"StructFieldNPtrHeadStringTagIntPtr",
 	"StructFieldNPtrHeadStringTagIntPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagIntPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagIntPtrOnly",
 	"StructFieldNPtrAnonymousHeadIntPtr",
 	"StructFieldNPtrAnonymousHeadIntPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyIntPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyIntPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagIntPtr",
 	"StructFieldNPtrAnonymousHeadStringTagIntPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagIntPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagIntPtrOnly",
 	"StructFieldHeadInt8Ptr",
 	"StructFieldHeadInt8PtrOnly",
 	"StructFieldHeadOmitEmptyInt8Ptr",
 	"StructFieldHeadOmitEmptyInt8PtrOnly",
 	"StructFieldHeadStringTagInt8Ptr",
 	"StructFieldHeadStringTagInt8PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagInt8Ptr",
+	"StructFieldHeadOmitEmptyStringTagInt8PtrOnly",
 	"StructFieldAnonymousHeadInt8Ptr",
 	"StructFieldAnonymousHeadInt8PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt8Ptr",
 	"StructFieldAnonymousHeadOmitEmptyInt8PtrOnly",
 	"StructFieldAnonymousHeadStringTagInt8Ptr",
 	"StructFieldAnonymousHeadStringTagInt8PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt8Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt8PtrOnly",
 	"StructFieldPtrHeadInt8Ptr",
 	"StructFieldPtrHeadInt8PtrOnly",
 	"StructFieldPtrHeadOmitEmptyInt8Ptr",
 	"StructFieldPtrHeadOmitEmptyInt8PtrOnly",
 	"StructFieldPtrHeadStringTagInt8Ptr",
 	"StructFieldPtrHeadStringTagInt8PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt8Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagInt8PtrOnly",
 	"StructFieldPtrAnonymousHeadInt8Ptr",
 	"StructFieldPtrAnonymousHeadInt8PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt8Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt8PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt8Ptr",
 	"StructFieldPtrAnonymousHeadStringTagInt8PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt8PtrOnly",
 	"StructFieldNPtrHeadInt8Ptr",
 	"StructFieldNPtrHeadInt8PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyInt8Ptr",
 	"StructFieldNPtrHeadOmitEmptyInt8PtrOnly",
 	"StructFieldNPtrHeadStringTagInt8Ptr",
 	"StructFieldNPtrHeadStringTagInt8PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt8Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt8PtrOnly",
 	"StructFieldNPtrAnonymousHeadInt8Ptr",
 	"StructFieldNPtrAnonymousHeadInt8PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt8Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt8PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt8Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagInt8PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8PtrOnly",
 	"StructFieldHeadInt16Ptr",
 	"StructFieldHeadInt16PtrOnly",
 	"StructFieldHeadOmitEmptyInt16Ptr",
 	"StructFieldHeadOmitEmptyInt16PtrOnly",
 	"StructFieldHeadStringTagInt16Ptr",
 	"StructFieldHeadStringTagInt16PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagInt16Ptr",
+	"StructFieldHeadOmitEmptyStringTagInt16PtrOnly",
 	"StructFieldAnonymousHeadInt16Ptr",
 	"StructFieldAnonymousHeadInt16PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt16Ptr",
 	"StructFieldAnonymousHeadOmitEmptyInt16PtrOnly",
 	"StructFieldAnonymousHeadStringTagInt16Ptr",
 	"StructFieldAnonymousHeadStringTagInt16PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt16Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt16PtrOnly",
 	"StructFieldPtrHeadInt16Ptr",
 	"StructFieldPtrHeadInt16PtrOnly",
 	"StructFieldPtrHeadOmitEmptyInt16Ptr",
 	"StructFieldPtrHeadOmitEmptyInt16PtrOnly",
 	"StructFieldPtrHeadStringTagInt16Ptr",
 	"StructFieldPtrHeadStringTagInt16PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt16Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagInt16PtrOnly",
 	"StructFieldPtrAnonymousHeadInt16Ptr",
 	"StructFieldPtrAnonymousHeadInt16PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt16Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt16PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt16Ptr",
 	"StructFieldPtrAnonymousHeadStringTagInt16PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt16PtrOnly",
 	"StructFieldNPtrHeadInt16Ptr",
 	"StructFieldNPtrHeadInt16PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyInt16Ptr",
 	"StructFieldNPtrHeadOmitEmptyInt16PtrOnly",
 	"StructFieldNPtrHeadStringTagInt16Ptr",
 	"StructFieldNPtrHeadStringTagInt16PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt16Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt16PtrOnly",
 	"StructFieldNPtrAnonymousHeadInt16Ptr",
 	"StructFieldNPtrAnonymousHeadInt16PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt16Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt16PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt16Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagInt16PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16PtrOnly",
 	"StructFieldHeadInt32Ptr",
 	"StructFieldHeadInt32PtrOnly",
 	"StructFieldHeadOmitEmptyInt32Ptr",
 	"StructFieldHeadOmitEmptyInt32PtrOnly",
 	"StructFieldHeadStringTagInt32Ptr",
 	"StructFieldHeadStringTagInt32PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagInt32Ptr",
+	"StructFieldHeadOmitEmptyStringTagInt32PtrOnly",
 	"StructFieldAnonymousHeadInt32Ptr",
 	"StructFieldAnonymousHeadInt32PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt32Ptr",
 	"StructFieldAnonymousHeadOmitEmptyInt32PtrOnly",
 	"StructFieldAnonymousHeadStringTagInt32Ptr",
 	"StructFieldAnonymousHeadStringTagInt32PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt32Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt32PtrOnly",
 	"StructFieldPtrHeadInt32Ptr",
 	"StructFieldPtrHeadInt32PtrOnly",
 	"StructFieldPtrHeadOmitEmptyInt32Ptr",
 	"StructFieldPtrHeadOmitEmptyInt32PtrOnly",
 	"StructFieldPtrHeadStringTagInt32Ptr",
 	"StructFieldPtrHeadStringTagInt32PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt32Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagInt32PtrOnly",
 	"StructFieldPtrAnonymousHeadInt32Ptr",
 	"StructFieldPtrAnonymousHeadInt32PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt32Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt32PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt32Ptr",
 	"StructFieldPtrAnonymousHeadStringTagInt32PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt32PtrOnly",
 	"StructFieldNPtrHeadInt32Ptr",
 	"StructFieldNPtrHeadInt32PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyInt32Ptr",
 	"StructFieldNPtrHeadOmitEmptyInt32PtrOnly",
 	"StructFieldNPtrHeadStringTagInt32Ptr",
 	"StructFieldNPtrHeadStringTagInt32PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt32Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt32PtrOnly",
 	"StructFieldNPtrAnonymousHeadInt32Ptr",
 	"StructFieldNPtrAnonymousHeadInt32PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt32Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt32PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt32Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagInt32PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32PtrOnly",
 	"StructFieldHeadInt64Ptr",
 	"StructFieldHeadInt64PtrOnly",
 	"StructFieldHeadOmitEmptyInt64Ptr",
 	"StructFieldHeadOmitEmptyInt64PtrOnly",
 	"StructFieldHeadStringTagInt64Ptr",
 	"StructFieldHeadStringTagInt64PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagInt64Ptr",
+	"StructFieldHeadOmitEmptyStringTagInt64PtrOnly",
 	"StructFieldAnonymousHeadInt64Ptr",
 	"StructFieldAnonymousHeadInt64PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt64Ptr",
 	"StructFieldAnonymousHeadOmitEmptyInt64PtrOnly",
 	"StructFieldAnonymousHeadStringTagInt64Ptr",
 	"StructFieldAnonymousHeadStringTagInt64PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt64Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt64PtrOnly",
 	"StructFieldPtrHeadInt64Ptr",
 	"StructFieldPtrHeadInt64PtrOnly",
 	"StructFieldPtrHeadOmitEmptyInt64Ptr",
 	"StructFieldPtrHeadOmitEmptyInt64PtrOnly",
 	"StructFieldPtrHeadStringTagInt64Ptr",
 	"StructFieldPtrHeadStringTagInt64PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt64Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagInt64PtrOnly",
 	"StructFieldPtrAnonymousHeadInt64Ptr",
 	"StructFieldPtrAnonymousHeadInt64PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt64Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt64PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt64Ptr",
 	"StructFieldPtrAnonymousHeadStringTagInt64PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt64PtrOnly",
 	"StructFieldNPtrHeadInt64Ptr",
 	"StructFieldNPtrHeadInt64PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyInt64Ptr",
 	"StructFieldNPtrHeadOmitEmptyInt64PtrOnly",
 	"StructFieldNPtrHeadStringTagInt64Ptr",
 	"StructFieldNPtrHeadStringTagInt64PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt64Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt64PtrOnly",
 	"StructFieldNPtrAnonymousHeadInt64Ptr",
 	"StructFieldNPtrAnonymousHeadInt64PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt64Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt64PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt64Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagInt64PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64PtrOnly",
 	"StructFieldHeadUintPtr",
 	"StructFieldHeadUintPtrOnly",
 	"StructFieldHeadOmitEmptyUintPtr",
 	"StructFieldHeadOmitEmptyUintPtrOnly",
 	"StructFieldHeadStringTagUintPtr",
 	"StructFieldHeadStringTagUintPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUintPtr",
+	"StructFieldHeadOmitEmptyStringTagUintPtrOnly",
 	"StructFieldAnonymousHeadUintPtr",
 	"StructFieldAnonymousHeadUintPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUintPtr",
 	"StructFieldAnonymousHeadOmitEmptyUintPtrOnly",
 	"StructFieldAnonymousHeadStringTagUintPtr",
 	"StructFieldAnonymousHeadStringTagUintPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUintPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUintPtrOnly",
 	"StructFieldPtrHeadUintPtr",
 	"StructFieldPtrHeadUintPtrOnly",
 	"StructFieldPtrHeadOmitEmptyUintPtr",
 	"StructFieldPtrHeadOmitEmptyUintPtrOnly",
 	"StructFieldPtrHeadStringTagUintPtr",
 	"StructFieldPtrHeadStringTagUintPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUintPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagUintPtrOnly",
 	"StructFieldPtrAnonymousHeadUintPtr",
 	"StructFieldPtrAnonymousHeadUintPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUintPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUintPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUintPtr",
 	"StructFieldPtrAnonymousHeadStringTagUintPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtrOnly",
 	"StructFieldNPtrHeadUintPtr",
 	"StructFieldNPtrHeadUintPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUintPtr",
 	"StructFieldNPtrHeadOmitEmptyUintPtrOnly",
 	"StructFieldNPtrHeadStringTagUintPtr",
 	"StructFieldNPtrHeadStringTagUintPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUintPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUintPtrOnly",
 	"StructFieldNPtrAnonymousHeadUintPtr",
 	"StructFieldNPtrAnonymousHeadUintPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUintPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUintPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUintPtr",
 	"StructFieldNPtrAnonymousHeadStringTagUintPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUintPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUintPtrOnly",
 	"StructFieldHeadUint8Ptr",
 	"StructFieldHeadUint8PtrOnly",
 	"StructFieldHeadOmitEmptyUint8Ptr",
 	"StructFieldHeadOmitEmptyUint8PtrOnly",
 	"StructFieldHeadStringTagUint8Ptr",
 	"StructFieldHeadStringTagUint8PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUint8Ptr",
+	"StructFieldHeadOmitEmptyStringTagUint8PtrOnly",
 	"StructFieldAnonymousHeadUint8Ptr",
 	"StructFieldAnonymousHeadUint8PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint8Ptr",
 	"StructFieldAnonymousHeadOmitEmptyUint8PtrOnly",
 	"StructFieldAnonymousHeadStringTagUint8Ptr",
 	"StructFieldAnonymousHeadStringTagUint8PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint8Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint8PtrOnly",
 	"StructFieldPtrHeadUint8Ptr",
 	"StructFieldPtrHeadUint8PtrOnly",
 	"StructFieldPtrHeadOmitEmptyUint8Ptr",
 	"StructFieldPtrHeadOmitEmptyUint8PtrOnly",
 	"StructFieldPtrHeadStringTagUint8Ptr",
 	"StructFieldPtrHeadStringTagUint8PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint8Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagUint8PtrOnly",
 	"StructFieldPtrAnonymousHeadUint8Ptr",
 	"StructFieldPtrAnonymousHeadUint8PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint8Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint8PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint8Ptr",
 	"StructFieldPtrAnonymousHeadStringTagUint8PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint8PtrOnly",
 	"StructFieldNPtrHeadUint8Ptr",
 	"StructFieldNPtrHeadUint8PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUint8Ptr",
 	"StructFieldNPtrHeadOmitEmptyUint8PtrOnly",
 	"StructFieldNPtrHeadStringTagUint8Ptr",
 	"StructFieldNPtrHeadStringTagUint8PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint8Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint8PtrOnly",
 	"StructFieldNPtrAnonymousHeadUint8Ptr",
 	"StructFieldNPtrAnonymousHeadUint8PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint8Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint8PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint8Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagUint8PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8PtrOnly",
 	"StructFieldHeadUint16Ptr",
 	"StructFieldHeadUint16PtrOnly",
 	"StructFieldHeadOmitEmptyUint16Ptr",
 	"StructFieldHeadOmitEmptyUint16PtrOnly",
 	"StructFieldHeadStringTagUint16Ptr",
 	"StructFieldHeadStringTagUint16PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUint16Ptr",
+	"StructFieldHeadOmitEmptyStringTagUint16PtrOnly",
 	"StructFieldAnonymousHeadUint16Ptr",
 	"StructFieldAnonymousHeadUint16PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint16Ptr",
 	"StructFieldAnonymousHeadOmitEmptyUint16PtrOnly",
 	"StructFieldAnonymousHeadStringTagUint16Ptr",
 	"StructFieldAnonymousHeadStringTagUint16PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint16Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint16PtrOnly",
 	"StructFieldPtrHeadUint16Ptr",
 	"StructFieldPtrHeadUint16PtrOnly",
 	"StructFieldPtrHeadOmitEmptyUint16Ptr",
 	"StructFieldPtrHeadOmitEmptyUint16PtrOnly",
 	"StructFieldPtrHeadStringTagUint16Ptr",
 	"StructFieldPtrHeadStringTagUint16PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint16Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagUint16PtrOnly",
 	"StructFieldPtrAnonymousHeadUint16Ptr",
 	"StructFieldPtrAnonymousHeadUint16PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint16Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint16PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint16Ptr",
 	"StructFieldPtrAnonymousHeadStringTagUint16PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint16PtrOnly",
 	"StructFieldNPtrHeadUint16Ptr",
 	"StructFieldNPtrHeadUint16PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUint16Ptr",
 	"StructFieldNPtrHeadOmitEmptyUint16PtrOnly",
 	"StructFieldNPtrHeadStringTagUint16Ptr",
 	"StructFieldNPtrHeadStringTagUint16PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint16Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint16PtrOnly",
 	"StructFieldNPtrAnonymousHeadUint16Ptr",
 	"StructFieldNPtrAnonymousHeadUint16PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint16Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint16PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint16Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagUint16PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16PtrOnly",
 	"StructFieldHeadUint32Ptr",
 	"StructFieldHeadUint32PtrOnly",
 	"StructFieldHeadOmitEmptyUint32Ptr",
 	"StructFieldHeadOmitEmptyUint32PtrOnly",
 	"StructFieldHeadStringTagUint32Ptr",
 	"StructFieldHeadStringTagUint32PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUint32Ptr",
+	"StructFieldHeadOmitEmptyStringTagUint32PtrOnly",
 	"StructFieldAnonymousHeadUint32Ptr",
 	"StructFieldAnonymousHeadUint32PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint32Ptr",
 	"StructFieldAnonymousHeadOmitEmptyUint32PtrOnly",
 	"StructFieldAnonymousHeadStringTagUint32Ptr",
 	"StructFieldAnonymousHeadStringTagUint32PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint32Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint32PtrOnly",
 	"StructFieldPtrHeadUint32Ptr",
 	"StructFieldPtrHeadUint32PtrOnly",
 	"StructFieldPtrHeadOmitEmptyUint32Ptr",
 	"StructFieldPtrHeadOmitEmptyUint32PtrOnly",
 	"StructFieldPtrHeadStringTagUint32Ptr",
 	"StructFieldPtrHeadStringTagUint32PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint32Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagUint32PtrOnly",
 	"StructFieldPtrAnonymousHeadUint32Ptr",
 	"StructFieldPtrAnonymousHeadUint32PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint32Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint32PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint32Ptr",
 	"StructFieldPtrAnonymousHeadStringTagUint32PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint32PtrOnly",
 	"StructFieldNPtrHeadUint32Ptr",
 	"StructFieldNPtrHeadUint32PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUint32Ptr",
 	"StructFieldNPtrHeadOmitEmptyUint32PtrOnly",
 	"StructFieldNPtrHeadStringTagUint32Ptr",
 	"StructFieldNPtrHeadStringTagUint32PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint32Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint32PtrOnly",
 	"StructFieldNPtrAnonymousHeadUint32Ptr",
 	"StructFieldNPtrAnonymousHeadUint32PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint32Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint32PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint32Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagUint32PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32PtrOnly",
 	"StructFieldHeadUint64Ptr",
 	"StructFieldHeadUint64PtrOnly",
 	"StructFieldHeadOmitEmptyUint64Ptr",
 	"StructFieldHeadOmitEmptyUint64PtrOnly",
 	"StructFieldHeadStringTagUint64Ptr",
 	"StructFieldHeadStringTagUint64PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUint64Ptr",
+	"StructFieldHeadOmitEmptyStringTagUint64PtrOnly",
 	"StructFieldAnonymousHeadUint64Ptr",
 	"StructFieldAnonymousHeadUint64PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint64Ptr",
 	"StructFieldAnonymousHeadOmitEmptyUint64PtrOnly",
 	"StructFieldAnonymousHeadStringTagUint64Ptr",
 	"StructFieldAnonymousHeadStringTagUint64PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint64Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint64PtrOnly",
 	"StructFieldPtrHeadUint64Ptr",
 	"StructFieldPtrHeadUint64PtrOnly",
 	"StructFieldPtrHeadOmitEmptyUint64Ptr",
 	"StructFieldPtrHeadOmitEmptyUint64PtrOnly",
 	"StructFieldPtrHeadStringTagUint64Ptr",
 	"StructFieldPtrHeadStringTagUint64PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint64Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagUint64PtrOnly",
 	"StructFieldPtrAnonymousHeadUint64Ptr",
 	"StructFieldPtrAnonymousHeadUint64PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint64Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint64PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint64Ptr",
 	"StructFieldPtrAnonymousHeadStringTagUint64PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint64PtrOnly",
 	"StructFieldNPtrHeadUint64Ptr",
 	"StructFieldNPtrHeadUint64PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUint64Ptr",
 	"StructFieldNPtrHeadOmitEmptyUint64PtrOnly",
 	"StructFieldNPtrHeadStringTagUint64Ptr",
 	"StructFieldNPtrHeadStringTagUint64PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint64Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint64PtrOnly",
 	"StructFieldNPtrAnonymousHeadUint64Ptr",
 	"StructFieldNPtrAnonymousHeadUint64PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint64Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint64PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint64Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagUint64PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64PtrOnly",
 	"StructFieldHeadFloat32Ptr",
 	"StructFieldHeadFloat32PtrOnly",
 	"StructFieldHeadOmitEmptyFloat32Ptr",
 	"StructFieldHeadOmitEmptyFloat32PtrOnly",
 	"StructFieldHeadStringTagFloat32Ptr",
 	"StructFieldHeadStringTagFloat32PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagFloat32Ptr",
+	"StructFieldHeadOmitEmptyStringTagFloat32PtrOnly",
 	"StructFieldAnonymousHeadFloat32Ptr",
 	"StructFieldAnonymousHeadFloat32PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyFloat32Ptr",
 	"StructFieldAnonymousHeadOmitEmptyFloat32PtrOnly",
 	"StructFieldAnonymousHeadStringTagFloat32Ptr",
 	"StructFieldAnonymousHeadStringTagFloat32PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat32Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat32PtrOnly",
 	"StructFieldPtrHeadFloat32Ptr",
 	"StructFieldPtrHeadFloat32PtrOnly",
 	"StructFieldPtrHeadOmitEmptyFloat32Ptr",
 	"StructFieldPtrHeadOmitEmptyFloat32PtrOnly",
 	"StructFieldPtrHeadStringTagFloat32Ptr",
 	"StructFieldPtrHeadStringTagFloat32PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat32Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat32PtrOnly",
 	"StructFieldPtrAnonymousHeadFloat32Ptr",
 	"StructFieldPtrAnonymousHeadFloat32PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat32Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat32PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagFloat32Ptr",
 	"StructFieldPtrAnonymousHeadStringTagFloat32PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32PtrOnly",
 	"StructFieldNPtrHeadFloat32Ptr",
 	"StructFieldNPtrHeadFloat32PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyFloat32Ptr",
 	"StructFieldNPtrHeadOmitEmptyFloat32PtrOnly",
 	"StructFieldNPtrHeadStringTagFloat32Ptr",
 	"StructFieldNPtrHeadStringTagFloat32PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat32Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat32PtrOnly",
 	"StructFieldNPtrAnonymousHeadFloat32Ptr",
 	"StructFieldNPtrAnonymousHeadFloat32PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat32Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat32PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagFloat32Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagFloat32PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32PtrOnly",
 	"StructFieldHeadFloat64Ptr",
 	"StructFieldHeadFloat64PtrOnly",
 	"StructFieldHeadOmitEmptyFloat64Ptr",
 	"StructFieldHeadOmitEmptyFloat64PtrOnly",
 	"StructFieldHeadStringTagFloat64Ptr",
 	"StructFieldHeadStringTagFloat64PtrOnly",
+	"StructFieldHeadOmitEmptyStringTagFloat64Ptr",
+	"StructFieldHeadOmitEmptyStringTagFloat64PtrOnly",
 	"StructFieldAnonymousHeadFloat64Ptr",
 	"StructFieldAnonymousHeadFloat64PtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyFloat64Ptr",
 	"StructFieldAnonymousHeadOmitEmptyFloat64PtrOnly",
 	"StructFieldAnonymousHeadStringTagFloat64Ptr",
 	"StructFieldAnonymousHeadStringTagFloat64PtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat64Ptr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat64PtrOnly",
 	"StructFieldPtrHeadFloat64Ptr",
 	"StructFieldPtrHeadFloat64PtrOnly",
 	"StructFieldPtrHeadOmitEmptyFloat64Ptr",
 	"StructFieldPtrHeadOmitEmptyFloat64PtrOnly",
 	"StructFieldPtrHeadStringTagFloat64Ptr",
 	"StructFieldPtrHeadStringTagFloat64PtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat64Ptr",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat64PtrOnly",
 	"StructFieldPtrAnonymousHeadFloat64Ptr",
 	"StructFieldPtrAnonymousHeadFloat64PtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat64Ptr",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat64PtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagFloat64Ptr",
 	"StructFieldPtrAnonymousHeadStringTagFloat64PtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Ptr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64PtrOnly",
 	"StructFieldNPtrHeadFloat64Ptr",
 	"StructFieldNPtrHeadFloat64PtrOnly",
 	"StructFieldNPtrHeadOmitEmptyFloat64Ptr",
 	"StructFieldNPtrHeadOmitEmptyFloat64PtrOnly",
 	"StructFieldNPtrHeadStringTagFloat64Ptr",
 	"StructFieldNPtrHeadStringTagFloat64PtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat64Ptr",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat64PtrOnly",
 	"StructFieldNPtrAnonymousHeadFloat64Ptr",
 	"StructFieldNPtrAnonymousHeadFloat64PtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat64Ptr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat64PtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagFloat64Ptr",
 	"StructFieldNPtrAnonymousHeadStringTagFloat64PtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64Ptr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64PtrOnly",
 	"StructFieldHeadBoolPtr",
 	"StructFieldHeadBoolPtrOnly",
 	"StructFieldHeadOmitEmptyBoolPtr",
 	"StructFieldHeadOmitEmptyBoolPtrOnly",
 	"StructFieldHeadStringTagBoolPtr",
 	"StructFieldHeadStringTagBoolPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagBoolPtr",
+	"StructFieldHeadOmitEmptyStringTagBoolPtrOnly",
 	"StructFieldAnonymousHeadBoolPtr",
 	"StructFieldAnonymousHeadBoolPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyBoolPtr",
 	"StructFieldAnonymousHeadOmitEmptyBoolPtrOnly",
 	"StructFieldAnonymousHeadStringTagBoolPtr",
 	"StructFieldAnonymousHeadStringTagBoolPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBoolPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBoolPtrOnly",
 	"StructFieldPtrHeadBoolPtr",
 	"StructFieldPtrHeadBoolPtrOnly",
 	"StructFieldPtrHeadOmitEmptyBoolPtr",
 	"StructFieldPtrHeadOmitEmptyBoolPtrOnly",
 	"StructFieldPtrHeadStringTagBoolPtr",
 	"StructFieldPtrHeadStringTagBoolPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagBoolPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagBoolPtrOnly",
 	"StructFieldPtrAnonymousHeadBoolPtr",
 	"StructFieldPtrAnonymousHeadBoolPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyBoolPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyBoolPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagBoolPtr",
 	"StructFieldPtrAnonymousHeadStringTagBoolPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBoolPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBoolPtrOnly",
 	"StructFieldNPtrHeadBoolPtr",
 	"StructFieldNPtrHeadBoolPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyBoolPtr",
 	"StructFieldNPtrHeadOmitEmptyBoolPtrOnly",
 	"StructFieldNPtrHeadStringTagBoolPtr",
 	"StructFieldNPtrHeadStringTagBoolPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagBoolPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagBoolPtrOnly",
 	"StructFieldNPtrAnonymousHeadBoolPtr",
 	"StructFieldNPtrAnonymousHeadBoolPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBoolPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBoolPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagBoolPtr",
 	"StructFieldNPtrAnonymousHeadStringTagBoolPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolPtrOnly",
 	"StructFieldHeadStringPtr",
 	"StructFieldHeadStringPtrOnly",
 	"StructFieldHeadOmitEmptyStringPtr",
 	"StructFieldHeadOmitEmptyStringPtrOnly",
 	"StructFieldHeadStringTagStringPtr",
 	"StructFieldHeadStringTagStringPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagStringPtr",
+	"StructFieldHeadOmitEmptyStringTagStringPtrOnly",
 	"StructFieldAnonymousHeadStringPtr",
 	"StructFieldAnonymousHeadStringPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyStringPtr",
 	"StructFieldAnonymousHeadOmitEmptyStringPtrOnly",
 	"StructFieldAnonymousHeadStringTagStringPtr",
 	"StructFieldAnonymousHeadStringTagStringPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagStringPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagStringPtrOnly",
 	"StructFieldPtrHeadStringPtr",
 	"StructFieldPtrHeadStringPtrOnly",
 	"StructFieldPtrHeadOmitEmptyStringPtr",
 	"StructFieldPtrHeadOmitEmptyStringPtrOnly",
 	"StructFieldPtrHeadStringTagStringPtr",
 	"StructFieldPtrHeadStringTagStringPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagStringPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagStringPtrOnly",
 	"StructFieldPtrAnonymousHeadStringPtr",
 	"StructFieldPtrAnonymousHeadStringPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyStringPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyStringPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagStringPtr",
 	"StructFieldPtrAnonymousHeadStringTagStringPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtrOnly",
 	"StructFieldNPtrHeadStringPtr",
 	"StructFieldNPtrHeadStringPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyStringPtr",
 	"StructFieldNPtrHeadOmitEmptyStringPtrOnly",
 	"StructFieldNPtrHeadStringTagStringPtr",
 	"StructFieldNPtrHeadStringTagStringPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagStringPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagStringPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringPtr",
 	"StructFieldNPtrAnonymousHeadStringPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyStringPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyStringPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagStringPtr",
 	"StructFieldNPtrAnonymousHeadStringTagStringPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagStringPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagStringPtrOnly",
 	"StructFieldHeadBytesPtr",
 	"StructFieldHeadBytesPtrOnly",
 	"StructFieldHeadOmitEmptyBytesPtr",
 	"StructFieldHeadOmitEmptyBytesPtrOnly",
 	"StructFieldHeadStringTagBytesPtr",
 	"StructFieldHeadStringTagBytesPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagBytesPtr",
+	"StructFieldHeadOmitEmptyStringTagBytesPtrOnly",
 	"StructFieldAnonymousHeadBytesPtr",
 	"StructFieldAnonymousHeadBytesPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyBytesPtr",
 	"StructFieldAnonymousHeadOmitEmptyBytesPtrOnly",
 	"StructFieldAnonymousHeadStringTagBytesPtr",
 	"StructFieldAnonymousHeadStringTagBytesPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBytesPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBytesPtrOnly",
 	"StructFieldPtrHeadBytesPtr",
 	"StructFieldPtrHeadBytesPtrOnly",
 	"StructFieldPtrHeadOmitEmptyBytesPtr",
 	"StructFieldPtrHeadOmitEmptyBytesPtrOnly",
 	"StructFieldPtrHeadStringTagBytesPtr",
 	"StructFieldPtrHeadStringTagBytesPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagBytesPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagBytesPtrOnly",
 	"StructFieldPtrAnonymousHeadBytesPtr",
 	"StructFieldPtrAnonymousHeadBytesPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyBytesPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyBytesPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagBytesPtr",
 	"StructFieldPtrAnonymousHeadStringTagBytesPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBytesPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBytesPtrOnly",
 	"StructFieldNPtrHeadBytesPtr",
 	"StructFieldNPtrHeadBytesPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyBytesPtr",
 	"StructFieldNPtrHeadOmitEmptyBytesPtrOnly",
 	"StructFieldNPtrHeadStringTagBytesPtr",
 	"StructFieldNPtrHeadStringTagBytesPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagBytesPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagBytesPtrOnly",
 	"StructFieldNPtrAnonymousHeadBytesPtr",
 	"StructFieldNPtrAnonymousHeadBytesPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBytesPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBytesPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagBytesPtr",
 	"StructFieldNPtrAnonymousHeadStringTagBytesPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesPtrOnly",
 	"StructFieldHeadIntNPtr",
 	"StructFieldHeadIntNPtrOnly",
 	"StructFieldHeadOmitEmptyIntNPtr",
 	"StructFieldHeadOmitEmptyIntNPtrOnly",
 	"StructFieldHeadStringTagIntNPtr",
 	"StructFieldHeadStringTagIntNPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagIntNPtr",
+	"StructFieldHeadOmitEmptyStringTagIntNPtrOnly",
 	"StructFieldAnonymousHeadIntNPtr",
 	"StructFieldAnonymousHeadIntNPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyIntNPtr",
 	"StructFieldAnonymousHeadOmitEmptyIntNPtrOnly",
 	"StructFieldAnonymousHeadStringTagIntNPtr",
 	"StructFieldAnonymousHeadStringTagIntNPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagIntNPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagIntNPtrOnly",
 	"StructFieldPtrHeadIntNPtr",
 	"StructFieldPtrHeadIntNPtrOnly",
 	"StructFieldPtrHeadOmitEmptyIntNPtr",
 	"StructFieldPtrHeadOmitEmptyIntNPtrOnly",
 	"StructFieldPtrHeadStringTagIntNPtr",
 	"StructFieldPtrHeadStringTagIntNPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagIntNPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagIntNPtrOnly",
 	"StructFieldPtrAnonymousHeadIntNPtr",
 	"StructFieldPtrAnonymousHeadIntNPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyIntNPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyIntNPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagIntNPtr",
 	"StructFieldPtrAnonymousHeadStringTagIntNPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagIntNPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagIntNPtrOnly",
 	"StructFieldNPtrHeadIntNPtr",
 	"StructFieldNPtrHeadIntNPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyIntNPtr",
 	"StructFieldNPtrHeadOmitEmptyIntNPtrOnly",
 	"StructFieldNPtrHeadStringTagIntNPtr",
 	"StructFieldNPtrHeadStringTagIntNPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagIntNPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagIntNPtrOnly",
 	"StructFieldNPtrAnonymousHeadIntNPtr",
 	"StructFieldNPtrAnonymousHeadIntNPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyIntNPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyIntNPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagIntNPtr",
 	"StructFieldNPtrAnonymousHeadStringTagIntNPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagIntNPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagIntNPtrOnly",
 	"StructFieldHeadInt8NPtr",
 	"StructFieldHeadInt8NPtrOnly",
 	"StructFieldHeadOmitEmptyInt8NPtr",
 	"StructFieldHeadOmitEmptyInt8NPtrOnly",
 	"StructFieldHeadStringTagInt8NPtr",
 	"StructFieldHeadStringTagInt8NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagInt8NPtr",
+	"StructFieldHeadOmitEmptyStringTagInt8NPtrOnly",
 	"StructFieldAnonymousHeadInt8NPtr",
 	"StructFieldAnonymousHeadInt8NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt8NPtr",
 	"StructFieldAnonymousHeadOmitEmptyInt8NPtrOnly",
 	"StructFieldAnonymousHeadStringTagInt8NPtr",
 	"StructFieldAnonymousHeadStringTagInt8NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt8NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt8NPtrOnly",
 	"StructFieldPtrHeadInt8NPtr",
 	"StructFieldPtrHeadInt8NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyInt8NPtr",
 	"StructFieldPtrHeadOmitEmptyInt8NPtrOnly",
 	"StructFieldPtrHeadStringTagInt8NPtr",
 	"StructFieldPtrHeadStringTagInt8NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt8NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagInt8NPtrOnly",
 	"StructFieldPtrAnonymousHeadInt8NPtr",
 	"StructFieldPtrAnonymousHeadInt8NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt8NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt8NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt8NPtr",
 	"StructFieldPtrAnonymousHeadStringTagInt8NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt8NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt8NPtrOnly",
 	"StructFieldNPtrHeadInt8NPtr",
 	"StructFieldNPtrHeadInt8NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyInt8NPtr",
 	"StructFieldNPtrHeadOmitEmptyInt8NPtrOnly",
 	"StructFieldNPtrHeadStringTagInt8NPtr",
 	"StructFieldNPtrHeadStringTagInt8NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt8NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt8NPtrOnly",
 	"StructFieldNPtrAnonymousHeadInt8NPtr",
 	"StructFieldNPtrAnonymousHeadInt8NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt8NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt8NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt8NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagInt8NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8NPtrOnly",
 	"StructFieldHeadInt16NPtr",
 	"StructFieldHeadInt16NPtrOnly",
 	"StructFieldHeadOmitEmptyInt16NPtr",
 	"StructFieldHeadOmitEmptyInt16NPtrOnly",
 	"StructFieldHeadStringTagInt16NPtr",
 	"StructFieldHeadStringTagInt16NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagInt16NPtr",
+	"StructFieldHeadOmitEmptyStringTagInt16NPtrOnly",
 	"StructFieldAnonymousHeadInt16NPtr",
 	"StructFieldAnonymousHeadInt16NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt16NPtr",
 	"StructFieldAnonymousHeadOmitEmptyInt16NPtrOnly",
 	"StructFieldAnonymousHeadStringTagInt16NPtr",
 	"StructFieldAnonymousHeadStringTagInt16NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt16NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt16NPtrOnly",
 	"StructFieldPtrHeadInt16NPtr",
 	"StructFieldPtrHeadInt16NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyInt16NPtr",
 	"StructFieldPtrHeadOmitEmptyInt16NPtrOnly",
 	"StructFieldPtrHeadStringTagInt16NPtr",
 	"StructFieldPtrHeadStringTagInt16NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt16NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagInt16NPtrOnly",
 	"StructFieldPtrAnonymousHeadInt16NPtr",
 	"StructFieldPtrAnonymousHeadInt16NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt16NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt16NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt16NPtr",
 	"StructFieldPtrAnonymousHeadStringTagInt16NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt16NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt16NPtrOnly",
 	"StructFieldNPtrHeadInt16NPtr",
 	"StructFieldNPtrHeadInt16NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyInt16NPtr",
 	"StructFieldNPtrHeadOmitEmptyInt16NPtrOnly",
 	"StructFieldNPtrHeadStringTagInt16NPtr",
 	"StructFieldNPtrHeadStringTagInt16NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt16NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt16NPtrOnly",
 	"StructFieldNPtrAnonymousHeadInt16NPtr",
 	"StructFieldNPtrAnonymousHeadInt16NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt16NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt16NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt16NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagInt16NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16NPtrOnly",
 	"StructFieldHeadInt32NPtr",
 	"StructFieldHeadInt32NPtrOnly",
 	"StructFieldHeadOmitEmptyInt32NPtr",
 	"StructFieldHeadOmitEmptyInt32NPtrOnly",
 	"StructFieldHeadStringTagInt32NPtr",
 	"StructFieldHeadStringTagInt32NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagInt32NPtr",
+	"StructFieldHeadOmitEmptyStringTagInt32NPtrOnly",
 	"StructFieldAnonymousHeadInt32NPtr",
 	"StructFieldAnonymousHeadInt32NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt32NPtr",
 	"StructFieldAnonymousHeadOmitEmptyInt32NPtrOnly",
 	"StructFieldAnonymousHeadStringTagInt32NPtr",
 	"StructFieldAnonymousHeadStringTagInt32NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt32NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt32NPtrOnly",
 	"StructFieldPtrHeadInt32NPtr",
 	"StructFieldPtrHeadInt32NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyInt32NPtr",
 	"StructFieldPtrHeadOmitEmptyInt32NPtrOnly",
 	"StructFieldPtrHeadStringTagInt32NPtr",
 	"StructFieldPtrHeadStringTagInt32NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt32NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagInt32NPtrOnly",
 	"StructFieldPtrAnonymousHeadInt32NPtr",
 	"StructFieldPtrAnonymousHeadInt32NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt32NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt32NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt32NPtr",
 	"StructFieldPtrAnonymousHeadStringTagInt32NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt32NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt32NPtrOnly",
 	"StructFieldNPtrHeadInt32NPtr",
 	"StructFieldNPtrHeadInt32NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyInt32NPtr",
 	"StructFieldNPtrHeadOmitEmptyInt32NPtrOnly",
 	"StructFieldNPtrHeadStringTagInt32NPtr",
 	"StructFieldNPtrHeadStringTagInt32NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt32NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt32NPtrOnly",
 	"StructFieldNPtrAnonymousHeadInt32NPtr",
 	"StructFieldNPtrAnonymousHeadInt32NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt32NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt32NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt32NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagInt32NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32NPtrOnly",
 	"StructFieldHeadInt64NPtr",
 	"StructFieldHeadInt64NPtrOnly",
 	"StructFieldHeadOmitEmptyInt64NPtr",
 	"StructFieldHeadOmitEmptyInt64NPtrOnly",
 	"StructFieldHeadStringTagInt64NPtr",
 	"StructFieldHeadStringTagInt64NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagInt64NPtr",
+	"StructFieldHeadOmitEmptyStringTagInt64NPtrOnly",
 	"StructFieldAnonymousHeadInt64NPtr",
 	"StructFieldAnonymousHeadInt64NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyInt64NPtr",
 	"StructFieldAnonymousHeadOmitEmptyInt64NPtrOnly",
 	"StructFieldAnonymousHeadStringTagInt64NPtr",
 	"StructFieldAnonymousHeadStringTagInt64NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt64NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagInt64NPtrOnly",
 	"StructFieldPtrHeadInt64NPtr",
 	"StructFieldPtrHeadInt64NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyInt64NPtr",
 	"StructFieldPtrHeadOmitEmptyInt64NPtrOnly",
 	"StructFieldPtrHeadStringTagInt64NPtr",
 	"StructFieldPtrHeadStringTagInt64NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagInt64NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagInt64NPtrOnly",
 	"StructFieldPtrAnonymousHeadInt64NPtr",
 	"StructFieldPtrAnonymousHeadInt64NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt64NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyInt64NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagInt64NPtr",
 	"StructFieldPtrAnonymousHeadStringTagInt64NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt64NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagInt64NPtrOnly",
 	"StructFieldNPtrHeadInt64NPtr",
 	"StructFieldNPtrHeadInt64NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyInt64NPtr",
 	"StructFieldNPtrHeadOmitEmptyInt64NPtrOnly",
 	"StructFieldNPtrHeadStringTagInt64NPtr",
 	"StructFieldNPtrHeadStringTagInt64NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt64NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagInt64NPtrOnly",
 	"StructFieldNPtrAnonymousHeadInt64NPtr",
 	"StructFieldNPtrAnonymousHeadInt64NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt64NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyInt64NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagInt64NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagInt64NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64NPtrOnly",
 	"StructFieldHeadUintNPtr",
 	"StructFieldHeadUintNPtrOnly",
 	"StructFieldHeadOmitEmptyUintNPtr",
 	"StructFieldHeadOmitEmptyUintNPtrOnly",
 	"StructFieldHeadStringTagUintNPtr",
 	"StructFieldHeadStringTagUintNPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUintNPtr",
+	"StructFieldHeadOmitEmptyStringTagUintNPtrOnly",
 	"StructFieldAnonymousHeadUintNPtr",
 	"StructFieldAnonymousHeadUintNPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUintNPtr",
 	"StructFieldAnonymousHeadOmitEmptyUintNPtrOnly",
 	"StructFieldAnonymousHeadStringTagUintNPtr",
 	"StructFieldAnonymousHeadStringTagUintNPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUintNPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUintNPtrOnly",
 	"StructFieldPtrHeadUintNPtr",
 	"StructFieldPtrHeadUintNPtrOnly",
 	"StructFieldPtrHeadOmitEmptyUintNPtr",
 	"StructFieldPtrHeadOmitEmptyUintNPtrOnly",
 	"StructFieldPtrHeadStringTagUintNPtr",
 	"StructFieldPtrHeadStringTagUintNPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUintNPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagUintNPtrOnly",
 	"StructFieldPtrAnonymousHeadUintNPtr",
 	"StructFieldPtrAnonymousHeadUintNPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUintNPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUintNPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUintNPtr",
 	"StructFieldPtrAnonymousHeadStringTagUintNPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUintNPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUintNPtrOnly",
 	"StructFieldNPtrHeadUintNPtr",
 	"StructFieldNPtrHeadUintNPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUintNPtr",
 	"StructFieldNPtrHeadOmitEmptyUintNPtrOnly",
 	"StructFieldNPtrHeadStringTagUintNPtr",
 	"StructFieldNPtrHeadStringTagUintNPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUintNPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUintNPtrOnly",
 	"StructFieldNPtrAnonymousHeadUintNPtr",
 	"StructFieldNPtrAnonymousHeadUintNPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUintNPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUintNPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUintNPtr",
 	"StructFieldNPtrAnonymousHeadStringTagUintNPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUintNPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUintNPtrOnly",
 	"StructFieldHeadUint8NPtr",
 	"StructFieldHeadUint8NPtrOnly",
 	"StructFieldHeadOmitEmptyUint8NPtr",
 	"StructFieldHeadOmitEmptyUint8NPtrOnly",
 	"StructFieldHeadStringTagUint8NPtr",
 	"StructFieldHeadStringTagUint8NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUint8NPtr",
+	"StructFieldHeadOmitEmptyStringTagUint8NPtrOnly",
 	"StructFieldAnonymousHeadUint8NPtr",
 	"StructFieldAnonymousHeadUint8NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint8NPtr",
 	"StructFieldAnonymousHeadOmitEmptyUint8NPtrOnly",
 	"StructFieldAnonymousHeadStringTagUint8NPtr",
 	"StructFieldAnonymousHeadStringTagUint8NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint8NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint8NPtrOnly",
 	"StructFieldPtrHeadUint8NPtr",
 	"StructFieldPtrHeadUint8NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyUint8NPtr",
 	"StructFieldPtrHeadOmitEmptyUint8NPtrOnly",
 	"StructFieldPtrHeadStringTagUint8NPtr",
 	"StructFieldPtrHeadStringTagUint8NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint8NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagUint8NPtrOnly",
 	"StructFieldPtrAnonymousHeadUint8NPtr",
 	"StructFieldPtrAnonymousHeadUint8NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint8NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint8NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint8NPtr",
 	"StructFieldPtrAnonymousHeadStringTagUint8NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint8NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint8NPtrOnly",
 	"StructFieldNPtrHeadUint8NPtr",
 	"StructFieldNPtrHeadUint8NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUint8NPtr",
 	"StructFieldNPtrHeadOmitEmptyUint8NPtrOnly",
 	"StructFieldNPtrHeadStringTagUint8NPtr",
 	"StructFieldNPtrHeadStringTagUint8NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint8NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint8NPtrOnly",
 	"StructFieldNPtrAnonymousHeadUint8NPtr",
 	"StructFieldNPtrAnonymousHeadUint8NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint8NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint8NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint8NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagUint8NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8NPtrOnly",
 	"StructFieldHeadUint16NPtr",
 	"StructFieldHeadUint16NPtrOnly",
 	"StructFieldHeadOmitEmptyUint16NPtr",
 	"StructFieldHeadOmitEmptyUint16NPtrOnly",
 	"StructFieldHeadStringTagUint16NPtr",
 	"StructFieldHeadStringTagUint16NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUint16NPtr",
+	"StructFieldHeadOmitEmptyStringTagUint16NPtrOnly",
 	"StructFieldAnonymousHeadUint16NPtr",
 	"StructFieldAnonymousHeadUint16NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint16NPtr",
 	"StructFieldAnonymousHeadOmitEmptyUint16NPtrOnly",
 	"StructFieldAnonymousHeadStringTagUint16NPtr",
 	"StructFieldAnonymousHeadStringTagUint16NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint16NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint16NPtrOnly",
 	"StructFieldPtrHeadUint16NPtr",
 	"StructFieldPtrHeadUint16NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyUint16NPtr",
 	"StructFieldPtrHeadOmitEmptyUint16NPtrOnly",
 	"StructFieldPtrHeadStringTagUint16NPtr",
 	"StructFieldPtrHeadStringTagUint16NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint16NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagUint16NPtrOnly",
 	"StructFieldPtrAnonymousHeadUint16NPtr",
 	"StructFieldPtrAnonymousHeadUint16NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint16NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint16NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint16NPtr",
 	"StructFieldPtrAnonymousHeadStringTagUint16NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint16NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint16NPtrOnly",
 	"StructFieldNPtrHeadUint16NPtr",
 	"StructFieldNPtrHeadUint16NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUint16NPtr",
 	"StructFieldNPtrHeadOmitEmptyUint16NPtrOnly",
 	"StructFieldNPtrHeadStringTagUint16NPtr",
 	"StructFieldNPtrHeadStringTagUint16NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint16NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint16NPtrOnly",
 	"StructFieldNPtrAnonymousHeadUint16NPtr",
 	"StructFieldNPtrAnonymousHeadUint16NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint16NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint16NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint16NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagUint16NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16NPtrOnly",
 	"StructFieldHeadUint32NPtr",
 	"StructFieldHeadUint32NPtrOnly",
 	"StructFieldHeadOmitEmptyUint32NPtr",
 	"StructFieldHeadOmitEmptyUint32NPtrOnly",
 	"StructFieldHeadStringTagUint32NPtr",
 	"StructFieldHeadStringTagUint32NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUint32NPtr",
+	"StructFieldHeadOmitEmptyStringTagUint32NPtrOnly",
 	"StructFieldAnonymousHeadUint32NPtr",
 	"StructFieldAnonymousHeadUint32NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint32NPtr",
 	"StructFieldAnonymousHeadOmitEmptyUint32NPtrOnly",
 	"StructFieldAnonymousHeadStringTagUint32NPtr",
 	"StructFieldAnonymousHeadStringTagUint32NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint32NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint32NPtrOnly",
 	"StructFieldPtrHeadUint32NPtr",
 	"StructFieldPtrHeadUint32NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyUint32NPtr",
 	"StructFieldPtrHeadOmitEmptyUint32NPtrOnly",
 	"StructFieldPtrHeadStringTagUint32NPtr",
 	"StructFieldPtrHeadStringTagUint32NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint32NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagUint32NPtrOnly",
 	"StructFieldPtrAnonymousHeadUint32NPtr",
 	"StructFieldPtrAnonymousHeadUint32NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint32NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint32NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint32NPtr",
 	"StructFieldPtrAnonymousHeadStringTagUint32NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint32NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint32NPtrOnly",
 	"StructFieldNPtrHeadUint32NPtr",
 	"StructFieldNPtrHeadUint32NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUint32NPtr",
 	"StructFieldNPtrHeadOmitEmptyUint32NPtrOnly",
 	"StructFieldNPtrHeadStringTagUint32NPtr",
 	"StructFieldNPtrHeadStringTagUint32NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint32NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint32NPtrOnly",
 	"StructFieldNPtrAnonymousHeadUint32NPtr",
 	"StructFieldNPtrAnonymousHeadUint32NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint32NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint32NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint32NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagUint32NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32NPtrOnly",
 	"StructFieldHeadUint64NPtr",
 	"StructFieldHeadUint64NPtrOnly",
 	"StructFieldHeadOmitEmptyUint64NPtr",
 	"StructFieldHeadOmitEmptyUint64NPtrOnly",
 	"StructFieldHeadStringTagUint64NPtr",
 	"StructFieldHeadStringTagUint64NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagUint64NPtr",
+	"StructFieldHeadOmitEmptyStringTagUint64NPtrOnly",
 	"StructFieldAnonymousHeadUint64NPtr",
 	"StructFieldAnonymousHeadUint64NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyUint64NPtr",
 	"StructFieldAnonymousHeadOmitEmptyUint64NPtrOnly",
 	"StructFieldAnonymousHeadStringTagUint64NPtr",
 	"StructFieldAnonymousHeadStringTagUint64NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint64NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagUint64NPtrOnly",
 	"StructFieldPtrHeadUint64NPtr",
 	"StructFieldPtrHeadUint64NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyUint64NPtr",
 	"StructFieldPtrHeadOmitEmptyUint64NPtrOnly",
 	"StructFieldPtrHeadStringTagUint64NPtr",
 	"StructFieldPtrHeadStringTagUint64NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagUint64NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagUint64NPtrOnly",
 	"StructFieldPtrAnonymousHeadUint64NPtr",
 	"StructFieldPtrAnonymousHeadUint64NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint64NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyUint64NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagUint64NPtr",
 	"StructFieldPtrAnonymousHeadStringTagUint64NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint64NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagUint64NPtrOnly",
 	"StructFieldNPtrHeadUint64NPtr",
 	"StructFieldNPtrHeadUint64NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyUint64NPtr",
 	"StructFieldNPtrHeadOmitEmptyUint64NPtrOnly",
 	"StructFieldNPtrHeadStringTagUint64NPtr",
 	"StructFieldNPtrHeadStringTagUint64NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint64NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagUint64NPtrOnly",
 	"StructFieldNPtrAnonymousHeadUint64NPtr",
 	"StructFieldNPtrAnonymousHeadUint64NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint64NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyUint64NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagUint64NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagUint64NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64NPtrOnly",
 	"StructFieldHeadFloat32NPtr",
 	"StructFieldHeadFloat32NPtrOnly",
 	"StructFieldHeadOmitEmptyFloat32NPtr",
 	"StructFieldHeadOmitEmptyFloat32NPtrOnly",
 	"StructFieldHeadStringTagFloat32NPtr",
 	"StructFieldHeadStringTagFloat32NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagFloat32NPtr",
+	"StructFieldHeadOmitEmptyStringTagFloat32NPtrOnly",
 	"StructFieldAnonymousHeadFloat32NPtr",
 	"StructFieldAnonymousHeadFloat32NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyFloat32NPtr",
 	"StructFieldAnonymousHeadOmitEmptyFloat32NPtrOnly",
 	"StructFieldAnonymousHeadStringTagFloat32NPtr",
 	"StructFieldAnonymousHeadStringTagFloat32NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat32NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat32NPtrOnly",
 	"StructFieldPtrHeadFloat32NPtr",
 	"StructFieldPtrHeadFloat32NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyFloat32NPtr",
 	"StructFieldPtrHeadOmitEmptyFloat32NPtrOnly",
 	"StructFieldPtrHeadStringTagFloat32NPtr",
 	"StructFieldPtrHeadStringTagFloat32NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat32NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat32NPtrOnly",
 	"StructFieldPtrAnonymousHeadFloat32NPtr",
 	"StructFieldPtrAnonymousHeadFloat32NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat32NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat32NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagFloat32NPtr",
 	"StructFieldPtrAnonymousHeadStringTagFloat32NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32NPtrOnly",
 	"StructFieldNPtrHeadFloat32NPtr",
 	"StructFieldNPtrHeadFloat32NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyFloat32NPtr",
 	"StructFieldNPtrHeadOmitEmptyFloat32NPtrOnly",
 	"StructFieldNPtrHeadStringTagFloat32NPtr",
 	"StructFieldNPtrHeadStringTagFloat32NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat32NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat32NPtrOnly",
 	"StructFieldNPtrAnonymousHeadFloat32NPtr",
 	"StructFieldNPtrAnonymousHeadFloat32NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat32NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat32NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagFloat32NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagFloat32NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32NPtrOnly",
 	"StructFieldHeadFloat64NPtr",
 	"StructFieldHeadFloat64NPtrOnly",
 	"StructFieldHeadOmitEmptyFloat64NPtr",
 	"StructFieldHeadOmitEmptyFloat64NPtrOnly",
 	"StructFieldHeadStringTagFloat64NPtr",
 	"StructFieldHeadStringTagFloat64NPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagFloat64NPtr",
+	"StructFieldHeadOmitEmptyStringTagFloat64NPtrOnly",
 	"StructFieldAnonymousHeadFloat64NPtr",
 	"StructFieldAnonymousHeadFloat64NPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyFloat64NPtr",
 	"StructFieldAnonymousHeadOmitEmptyFloat64NPtrOnly",
 	"StructFieldAnonymousHeadStringTagFloat64NPtr",
 	"StructFieldAnonymousHeadStringTagFloat64NPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat64NPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagFloat64NPtrOnly",
 	"StructFieldPtrHeadFloat64NPtr",
 	"StructFieldPtrHeadFloat64NPtrOnly",
 	"StructFieldPtrHeadOmitEmptyFloat64NPtr",
 	"StructFieldPtrHeadOmitEmptyFloat64NPtrOnly",
 	"StructFieldPtrHeadStringTagFloat64NPtr",
 	"StructFieldPtrHeadStringTagFloat64NPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat64NPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagFloat64NPtrOnly",
 	"StructFieldPtrAnonymousHeadFloat64NPtr",
 	"StructFieldPtrAnonymousHeadFloat64NPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat64NPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyFloat64NPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagFloat64NPtr",
 	"StructFieldPtrAnonymousHeadStringTagFloat64NPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64NPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64NPtrOnly",
 	"StructFieldNPtrHeadFloat64NPtr",
 	"StructFieldNPtrHeadFloat64NPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyFloat64NPtr",
 	"StructFieldNPtrHeadOmitEmptyFloat64NPtrOnly",
 	"StructFieldNPtrHeadStringTagFloat64NPtr",
 	"StructFieldNPtrHeadStringTagFloat64NPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat64NPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagFloat64NPtrOnly",
 	"StructFieldNPtrAnonymousHeadFloat64NPtr",
 	"StructFieldNPtrAnonymousHeadFloat64NPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat64NPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyFloat64NPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagFloat64NPtr",
 	"StructFieldNPtrAnonymousHeadStringTagFloat64NPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64NPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64NPtrOnly",
 	"StructFieldHeadBoolNPtr",
 	"StructFieldHeadBoolNPtrOnly",
 	"StructFieldHeadOmitEmptyBoolNPtr",
 	"StructFieldHeadOmitEmptyBoolNPtrOnly",
 	"StructFieldHeadStringTagBoolNPtr",
 	"StructFieldHeadStringTagBoolNPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagBoolNPtr",
+	"StructFieldHeadOmitEmptyStringTagBoolNPtrOnly",
 	"StructFieldAnonymousHeadBoolNPtr",
 	"StructFieldAnonymousHeadBoolNPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyBoolNPtr",
 	"StructFieldAnonymousHeadOmitEmptyBoolNPtrOnly",
 	"StructFieldAnonymousHeadStringTagBoolNPtr",
 	"StructFieldAnonymousHeadStringTagBoolNPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBoolNPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBoolNPtrOnly",
 	"StructFieldPtrHeadBoolNPtr",
 	"StructFieldPtrHeadBoolNPtrOnly",
 	"StructFieldPtrHeadOmitEmptyBoolNPtr",
 	"StructFieldPtrHeadOmitEmptyBoolNPtrOnly",
 	"StructFieldPtrHeadStringTagBoolNPtr",
 	"StructFieldPtrHeadStringTagBoolNPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagBoolNPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagBoolNPtrOnly",
 	"StructFieldPtrAnonymousHeadBoolNPtr",
 	"StructFieldPtrAnonymousHeadBoolNPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyBoolNPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyBoolNPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagBoolNPtr",
 	"StructFieldPtrAnonymousHeadStringTagBoolNPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBoolNPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBoolNPtrOnly",
 	"StructFieldNPtrHeadBoolNPtr",
 	"StructFieldNPtrHeadBoolNPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyBoolNPtr",
 	"StructFieldNPtrHeadOmitEmptyBoolNPtrOnly",
 	"StructFieldNPtrHeadStringTagBoolNPtr",
 	"StructFieldNPtrHeadStringTagBoolNPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagBoolNPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagBoolNPtrOnly",
 	"StructFieldNPtrAnonymousHeadBoolNPtr",
 	"StructFieldNPtrAnonymousHeadBoolNPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBoolNPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBoolNPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagBoolNPtr",
 	"StructFieldNPtrAnonymousHeadStringTagBoolNPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolNPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolNPtrOnly",
 	"StructFieldHeadStringNPtr",
 	"StructFieldHeadStringNPtrOnly",
 	"StructFieldHeadOmitEmptyStringNPtr",
 	"StructFieldHeadOmitEmptyStringNPtrOnly",
 	"StructFieldHeadStringTagStringNPtr",
 	"StructFieldHeadStringTagStringNPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagStringNPtr",
+	"StructFieldHeadOmitEmptyStringTagStringNPtrOnly",
 	"StructFieldAnonymousHeadStringNPtr",
 	"StructFieldAnonymousHeadStringNPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyStringNPtr",
 	"StructFieldAnonymousHeadOmitEmptyStringNPtrOnly",
 	"StructFieldAnonymousHeadStringTagStringNPtr",
 	"StructFieldAnonymousHeadStringTagStringNPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagStringNPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagStringNPtrOnly",
 	"StructFieldPtrHeadStringNPtr",
 	"StructFieldPtrHeadStringNPtrOnly",
 	"StructFieldPtrHeadOmitEmptyStringNPtr",
 	"StructFieldPtrHeadOmitEmptyStringNPtrOnly",
 	"StructFieldPtrHeadStringTagStringNPtr",
 	"StructFieldPtrHeadStringTagStringNPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagStringNPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagStringNPtrOnly",
 	"StructFieldPtrAnonymousHeadStringNPtr",
 	"StructFieldPtrAnonymousHeadStringNPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyStringNPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyStringNPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagStringNPtr",
 	"StructFieldPtrAnonymousHeadStringTagStringNPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagStringNPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagStringNPtrOnly",
 	"StructFieldNPtrHeadStringNPtr",
 	"StructFieldNPtrHeadStringNPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyStringNPtr",
 	"StructFieldNPtrHeadOmitEmptyStringNPtrOnly",
 	"StructFieldNPtrHeadStringTagStringNPtr",
 	"StructFieldNPtrHeadStringTagStringNPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagStringNPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagStringNPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringNPtr",
 	"StructFieldNPtrAnonymousHeadStringNPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyStringNPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyStringNPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagStringNPtr",
 	"StructFieldNPtrAnonymousHeadStringTagStringNPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagStringNPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagStringNPtrOnly",
 	"StructFieldHeadBytesNPtr",
 	"StructFieldHeadBytesNPtrOnly",
 	"StructFieldHeadOmitEmptyBytesNPtr",
 	"StructFieldHeadOmitEmptyBytesNPtrOnly",
 	"StructFieldHeadStringTagBytesNPtr",
 	"StructFieldHeadStringTagBytesNPtrOnly",
+	"StructFieldHeadOmitEmptyStringTagBytesNPtr",
+	"StructFieldHeadOmitEmptyStringTagBytesNPtrOnly",
 	"StructFieldAnonymousHeadBytesNPtr",
 	"StructFieldAnonymousHeadBytesNPtrOnly",
 	"StructFieldAnonymousHeadOmitEmptyBytesNPtr",
 	"StructFieldAnonymousHeadOmitEmptyBytesNPtrOnly",
 	"StructFieldAnonymousHeadStringTagBytesNPtr",
 	"StructFieldAnonymousHeadStringTagBytesNPtrOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBytesNPtr",
+	"StructFieldAnonymousHeadOmitEmptyStringTagBytesNPtrOnly",
 	"StructFieldPtrHeadBytesNPtr",
 	"StructFieldPtrHeadBytesNPtrOnly",
 	"StructFieldPtrHeadOmitEmptyBytesNPtr",
 	"StructFieldPtrHeadOmitEmptyBytesNPtrOnly",
 	"StructFieldPtrHeadStringTagBytesNPtr",
 	"StructFieldPtrHeadStringTagBytesNPtrOnly",
+	"StructFieldPtrHeadOmitEmptyStringTagBytesNPtr",
+	"StructFieldPtrHeadOmitEmptyStringTagBytesNPtrOnly",
 	"StructFieldPtrAnonymousHeadBytesNPtr",
 	"StructFieldPtrAnonymousHeadBytesNPtrOnly",
 	"StructFieldPtrAnonymousHeadOmitEmptyBytesNPtr",
 	"StructFieldPtrAnonymousHeadOmitEmptyBytesNPtrOnly",
 	"StructFieldPtrAnonymousHeadStringTagBytesNPtr",
 	"StructFieldPtrAnonymousHeadStringTagBytesNPtrOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBytesNPtr",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagBytesNPtrOnly",
 	"StructFieldNPtrHeadBytesNPtr",
 	"StructFieldNPtrHeadBytesNPtrOnly",
 	"StructFieldNPtrHeadOmitEmptyBytesNPtr",
 	"StructFieldNPtrHeadOmitEmptyBytesNPtrOnly",
 	"StructFieldNPtrHeadStringTagBytesNPtr",
 	"StructFieldNPtrHeadStringTagBytesNPtrOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTagBytesNPtr",
+	"StructFieldNPtrHeadOmitEmptyStringTagBytesNPtrOnly",
 	"StructFieldNPtrAnonymousHeadBytesNPtr",
 	"StructFieldNPtrAnonymousHeadBytesNPtrOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBytesNPtr",
 	"StructFieldNPtrAnonymousHeadOmitEmptyBytesNPtrOnly",
 	"StructFieldNPtrAnonymousHeadStringTagBytesNPtr",
 	"StructFieldNPtrAnonymousHeadStringTagBytesNPtrOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesNPtr",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesNPtrOnly",
 	"StructFieldHead",
 	"StructFieldHeadOnly",
 	"StructFieldHeadOmitEmpty",
 	"StructFieldHeadOmitEmptyOnly",
 	"StructFieldHeadStringTag",
 	"StructFieldHeadStringTagOnly",
+	"StructFieldHeadOmitEmptyStringTag",
+	"StructFieldHeadOmitEmptyStringTagOnly",
 	"StructFieldAnonymousHead",
 	"StructFieldAnonymousHeadOnly",
 	"StructFieldAnonymousHeadOmitEmpty",
 	"StructFieldAnonymousHeadOmitEmptyOnly",
 	"StructFieldAnonymousHeadStringTag",
 	"StructFieldAnonymousHeadStringTagOnly",
+	"StructFieldAnonymousHeadOmitEmptyStringTag",
+	"StructFieldAnonymousHeadOmitEmptyStringTagOnly",
 	"StructFieldPtrHead",
 	"StructFieldPtrHeadOnly",
 	"StructFieldPtrHeadOmitEmpty",
 	"StructFieldPtrHeadOmitEmptyOnly",
 	"StructFieldPtrHeadStringTag",
 	"StructFieldPtrHeadStringTagOnly",
+	"StructFieldPtrHeadOmitEmptyStringTag",
+	"StructFieldPtrHeadOmitEmptyStringTagOnly",
 	"StructFieldPtrAnonymousHead",
 	"StructFieldPtrAnonymousHeadOnly",
 	"StructFieldPtrAnonymousHeadOmitEmpty",
 	"StructFieldPtrAnonymousHeadOmitEmptyOnly",
 	"StructFieldPtrAnonymousHeadStringTag",
 	"StructFieldPtrAnonymousHeadStringTagOnly",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTag",
+	"StructFieldPtrAnonymousHeadOmitEmptyStringTagOnly",
 	"StructFieldNPtrHead",
 	"StructFieldNPtrHeadOnly",
 	"StructFieldNPtrHeadOmitEmpty",
 	"StructFieldNPtrHeadOmitEmptyOnly",
 	"StructFieldNPtrHeadStringTag",
 	"StructFieldNPtrHeadStringTagOnly",
+	"StructFieldNPtrHeadOmitEmptyStringTag",
+	"StructFieldNPtrHeadOmitEmptyStringTagOnly",
 	"StructFieldNPtrAnonymousHead",
 	"StructFieldNPtrAnonymousHeadOnly",
 	"StructFieldNPtrAnonymousHeadOmitEmpty",
 	"StructFieldNPtrAnonymousHeadOmitEmptyOnly",
 	"StructFieldNPtrAnonymousHeadStringTag",
 	"StructFieldNPtrAnonymousHeadStringTagOnly",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTag",
+	"StructFieldNPtrAnonymousHeadOmitEmptyStringTagOnly",
 	"StructFieldInt",
 	"StructFieldOmitEmptyInt",
 	"StructFieldStringTagInt",
+	"StructFieldOmitEmptyStringTagInt",
 	"StructFieldInt8",
 	"StructFieldOmitEmptyInt8",
 	"StructFieldStringTagInt8",
+	"StructFieldOmitEmptyStringTagInt8",
 	"StructFieldInt16",
 	"StructFieldOmitEmptyInt16",
 	"StructFieldStringTagInt16",
+	"StructFieldOmitEmptyStringTagInt16",
 	"StructFieldInt32",
 	"StructFieldOmitEmptyInt32",
 	"StructFieldStringTagInt32",
+	"StructFieldOmitEmptyStringTagInt32",
 	"StructFieldInt64",
 	"StructFieldOmitEmptyInt64",
 	"StructFieldStringTagInt64",
+	"StructFieldOmitEmptyStringTagInt64",
 	"StructFieldUint",
 	"StructFieldOmitEmptyUint",
 	"StructFieldStringTagUint",
+	"StructFieldOmitEmptyStringTagUint",
 	"StructFieldUint8",
 	"StructFieldOmitEmptyUint8",
 	"StructFieldStringTagUint8",
+	"StructFieldOmitEmptyStringTagUint8",
 	"StructFieldUint16",
 	"StructFieldOmitEmptyUint16",
 	"StructFieldStringTagUint16",
+	"StructFieldOmitEmptyStringTagUint16",
 	"StructFieldUint32",
 	"StructFieldOmitEmptyUint32",
 	"StructFieldStringTagUint32",
+	"StructFieldOmitEmptyStringTagUint32",
 	"StructFieldUint64",
 	"StructFieldOmitEmptyUint64",
 	"StructFieldStringTagUint64",
+	"StructFieldOmitEmptyStringTagUint64",
 	"StructFieldFloat32",
 	"StructFieldOmitEmptyFloat32",
 	"StructFieldStringTagFloat32",
+	"StructFieldOmitEmptyStringTagFloat32",
 	"StructFieldFloat64",
 	"StructFieldOmitEmptyFloat64",
 	"StructFieldStringTagFloat64",
+	"StructFieldOmitEmptyStringTagFloat64",
 	"StructFieldBool",
 	"StructFieldOmitEmptyBool",
 	"StructFieldStringTagBool",
+	"StructFieldOmitEmptyStringTagBool",
 	"StructFieldString",
 	"StructFieldOmitEmptyString",
 	"StructFieldStringTagString",
+	"StructFieldOmitEmptyStringTagString",
 	"StructFieldBytes",
 	"StructFieldOmitEmptyBytes",
 	"StructFieldStringTagBytes",
+	"StructFieldOmitEmptyStringTagBytes",
 	"StructFieldArray",
 	"StructFieldOmitEmptyArray",
 	"StructFieldStringTagArray",
+	"StructFieldOmitEmptyStringTagArray",
 	"StructFieldMap",
 	"StructFieldOmitEmptyMap",
 	"StructFieldStringTagMap",
+	"StructFieldOmitEmptyStringTagMap",
 	"StructFieldMapLoad",
 	"StructFieldOmitEmptyMapLoad",
 	"StructFieldStringTagMapLoad",
+	"StructFieldOmitEmptyStringTagMapLoad",
 	"StructFieldSlice",
 	"StructFieldOmitEmptySlice",
 	"StructFieldStringTagSlice",
+	"StructFieldOmitEmptyStringTagSlice",
 	"StructFieldStruct",
 	"StructFieldOmitEmptyStruct",
 	"StructFieldStringTagStruct",
+	"StructFieldOmitEmptyStringTagStruct",
 	"StructFieldMarshalJSON",
 	"StructFieldOmitEmptyMarshalJSON",
 	"StructFieldStringTagMarshalJSON",
+	"StructFieldOmitEmptyStringTagMarshalJSON",
 	"StructFieldMarshalText",
 	"StructFieldOmitEmptyMarshalText",
 	"StructFieldStringTagMarshalText",
+	"StructFieldOmitEmptyStringTagMarshalText",
 	"StructFieldRecursive",
 	"StructFieldOmitEmptyRecursive",
 	"StructFieldStringTagRecursive",
+	"StructFieldOmitEmptyStringTagRecursive",
 	"StructFieldIntString",
 	"StructFieldOmitEmptyIntString",
 	"StructFieldStringTagIntString",
+	"StructFieldOmitEmptyStringTagIntString",
 	"StructFieldInt8String",
 	"StructFieldOmitEmptyInt8String",
 	"StructFieldStringTagInt8String",
+	"StructFieldOmitEmptyStringTagInt8String",
 	"StructFieldInt16String",
 	"StructFieldOmitEmptyInt16String",
 	"StructFieldStringTagInt16String",
+	"StructFieldOmitEmptyStringTagInt16String",
 	"StructFieldInt32String",
 	"StructFieldOmitEmptyInt32String",
 	"StructFieldStringTagInt32String",
+	"StructFieldOmitEmptyStringTagInt32String",
 	"StructFieldInt64String",
 	"StructFieldOmitEmptyInt64String",
 	"StructFieldStringTagInt64String",
+	"StructFieldOmitEmptyStringTagInt64String",
 	"StructFieldUintString",
 	"StructFieldOmitEmptyUintString",
 	"StructFieldStringTagUintString",
+	"StructFieldOmitEmptyStringTagUintString",
 	"StructFieldUint8String",
 	"StructFieldOmitEmptyUint8String",
 	"StructFieldStringTagUint8String",
+	"StructFieldOmitEmptyStringTagUint8String",
 	"StructFieldUint16String",
 	"StructFieldOmitEmptyUint16String",
 	"StructFieldStringTagUint16String",
+	"StructFieldOmitEmptyStringTagUint16String",
 	"StructFieldUint32String",
 	"StructFieldOmitEmptyUint32String",
 	"StructFieldStringTagUint32String",
+	"StructFieldOmitEmptyStringTagUint32String",
 	"StructFieldUint64String",
 	"StructFieldOmitEmptyUint64String",
 	"StructFieldStringTagUint64String",
+	"StructFieldOmitEmptyStringTagUint64String",
 	"StructFieldIntPtr",
 	"StructFieldOmitEmptyIntPtr",
 	"StructFieldStringTagIntPtr",
+	"StructFieldOmitEmptyStringTagIntPtr",
 	"StructFieldInt8Ptr",
 	"StructFieldOmitEmptyInt8Ptr",
 	"StructFieldStringTagInt8Ptr",
+	"StructFieldOmitEmptyStringTagInt8Ptr",
 	"StructFieldInt16Ptr",
 	"StructFieldOmitEmptyInt16Ptr",
 	"StructFieldStringTagInt16Ptr",
+	"StructFieldOmitEmptyStringTagInt16Ptr",
 	"StructFieldInt32Ptr",
 	"StructFieldOmitEmptyInt32Ptr",
 	"StructFieldStringTagInt32Ptr",
+	"StructFieldOmitEmptyStringTagInt32Ptr",
 	"StructFieldInt64Ptr",
 	"StructFieldOmitEmptyInt64Ptr",
 	"StructFieldStringTagInt64Ptr",
+	"StructFieldOmitEmptyStringTagInt64Ptr",
 	"StructFieldUintPtr",
 	"StructFieldOmitEmptyUintPtr",
 	"StructFieldStringTagUintPtr",
+	"StructFieldOmitEmptyStringTagUintPtr",
 	"StructFieldUint8Ptr",
 	"StructFieldOmitEmptyUint8Ptr",
 	"StructFieldStringTagUint8Ptr",
+	"StructFieldOmitEmptyStringTagUint8Ptr",
 	"StructFieldUint16Ptr",
 	"StructFieldOmitEmptyUint16Ptr",
 	"StructFieldStringTagUint16Ptr",
+	"StructFieldOmitEmptyStringTagUint16Ptr",
 	"StructFieldUint32Ptr",
 	"StructFieldOmitEmptyUint32Ptr",
 	"StructFieldStringTagUint32Ptr",
+	"StructFieldOmitEmptyStringTagUint32Ptr",
 	"StructFieldUint64Ptr",
 	"StructFieldOmitEmptyUint64Ptr",
 	"StructFieldStringTagUint64Ptr",
+	"StructFieldOmitEmptyStringTagUint64Ptr",
 	"StructFieldFloat32Ptr",
 	"StructFieldOmitEmptyFloat32Ptr",
 	"StructFieldStringTagFloat32Ptr",
+	"StructFieldOmitEmptyStringTagFloat32Ptr",
 	"StructFieldFloat64Ptr",
 	"StructFieldOmitEmptyFloat64Ptr",
 	"StructFieldStringTagFloat64Ptr",
+	"StructFieldOmitEmptyStringTagFloat64Ptr",
 	"StructFieldBoolPtr",
 	"StructFieldOmitEmptyBoolPtr",
 	"StructFieldStringTagBoolPtr",
+	"StructFieldOmitEmptyStringTagBoolPtr",
 	"StructFieldStringPtr",
 	"StructFieldOmitEmptyStringPtr",
 	"StructFieldStringTagStringPtr",
+	"StructFieldOmitEmptyStringTagStringPtr",
 	"StructFieldBytesPtr",
 	"StructFieldOmitEmptyBytesPtr",
 	"StructFieldStringTagBytesPtr",
+	"StructFieldOmitEmptyStringTagBytesPtr",
 	"StructFieldIntNPtr",
 	"StructFieldOmitEmptyIntNPtr",
 	"StructFieldStringTagIntNPtr",
+	"StructFieldOmitEmptyStringTagIntNPtr",
 	"StructFieldInt8NPtr",
 	"StructFieldOmitEmptyInt8NPtr",
 	"StructFieldStringTagInt8NPtr",
+	"StructFieldOmitEmptyStringTagInt8NPtr",
 	"StructFieldInt16NPtr",
 	"StructFieldOmitEmptyInt16NPtr",
 	"StructFieldStringTagInt16NPtr",
+	"StructFieldOmitEmptyStringTagInt16NPtr",
 	"StructFieldInt32NPtr",
 	"StructFieldOmitEmptyInt32NPtr",
 	"StructFieldStringTagInt32NPtr",
+	"StructFieldOmitEmptyStringTagInt32NPtr",
 	"StructFieldInt64NPtr",
 	"StructFieldOmitEmptyInt64NPtr",
 	"StructFieldStringTagInt64NPtr",
+	"StructFieldOmitEmptyStringTagInt64NPtr",
 	"StructFieldUintNPtr",
 	"StructFieldOmitEmptyUintNPtr",
 	"StructFieldStringTagUintNPtr",
+	"StructFieldOmitEmptyStringTagUintNPtr",
 	"StructFieldUint8NPtr",
 	"StructFieldOmitEmptyUint8NPtr",
 	"StructFieldStringTagUint8NPtr",
+	"StructFieldOmitEmptyStringTagUint8NPtr",
 	"StructFieldUint16NPtr",
 	"StructFieldOmitEmptyUint16NPtr",
 	"StructFieldStringTagUint16NPtr",
+	"StructFieldOmitEmptyStringTagUint16NPtr",
 	"StructFieldUint32NPtr",
 	"StructFieldOmitEmptyUint32NPtr",
 	"StructFieldStringTagUint32NPtr",
+	"StructFieldOmitEmptyStringTagUint32NPtr",
 	"StructFieldUint64NPtr",
 	"StructFieldOmitEmptyUint64NPtr",
 	"StructFieldStringTagUint64NPtr",
+	"StructFieldOmitEmptyStringTagUint64NPtr",
 	"StructFieldFloat32NPtr",
 	"StructFieldOmitEmptyFloat32NPtr",
 	"StructFieldStringTagFloat32NPtr",
+	"StructFieldOmitEmptyStringTagFloat32NPtr",
 	"StructFieldFloat64NPtr",
 	"StructFieldOmitEmptyFloat64NPtr",
 	"StructFieldStringTagFloat64NPtr",
+	"StructFieldOmitEmptyStringTagFloat64NPtr",
 	"StructFieldBoolNPtr",
 	"StructFieldOmitEmptyBoolNPtr",
 	"StructFieldStringTagBoolNPtr",
+	"StructFieldOmitEmptyStringTagBoolNPtr",
 	"StructFieldStringNPtr",
 	"StructFieldOmitEmptyStringNPtr",
 	"StructFieldStringTagStringNPtr",
+	"StructFieldOmitEmptyStringTagStringNPtr",
 	"StructFieldBytesNPtr",
 	"StructFieldOmitEmptyBytesNPtr",
 	"StructFieldStringTagBytesNPtr",
+	"StructFieldOmitEmptyStringTagBytesNPtr",
 	"StructField",
 	"StructFieldOmitEmpty",
 	"StructFieldStringTag",
+	"StructFieldOmitEmptyStringTag",
 	"StructEndInt",
 	"StructEndOmitEmptyInt",
 	"StructEndStringTagInt",
+	"StructEndOmitEmptyStringTagInt",
 	"StructEndInt8",
 	"StructEndOmitEmptyInt8",
 	"StructEndStringTagInt8",
+	"StructEndOmitEmptyStringTagInt8",
 	"StructEndInt16",
 	"StructEndOmitEmptyInt16",
 	"StructEndStringTagInt16",
+	"StructEndOmitEmptyStringTagInt16",
 	"StructEndInt32",
 	"StructEndOmitEmptyInt32",
 	"StructEndStringTagInt32",
+	"StructEndOmitEmptyStringTagInt32",
 	"StructEndInt64",
 	"StructEndOmitEmptyInt64",
 	"StructEndStringTagInt64",
+	"StructEndOmitEmptyStringTagInt64",
 	"StructEndUint",
 	"StructEndOmitEmptyUint",
 	"StructEndStringTagUint",
+	"StructEndOmitEmptyStringTagUint",
 	"StructEndUint8",
 	"StructEndOmitEmptyUint8",
 	"StructEndStringTagUint8",
+	"StructEndOmitEmptyStringTagUint8",
 	"StructEndUint16",
 	"StructEndOmitEmptyUint16",
 	"StructEndStringTagUint16",
+	"StructEndOmitEmptyStringTagUint16",
 	"StructEndUint32",
 	"StructEndOmitEmptyUint32",
 	"StructEndStringTagUint32",
+	"StructEndOmitEmptyStringTagUint32",
 	"StructEndUint64",
 	"StructEndOmitEmptyUint64",
 	"StructEndStringTagUint64",
+	"StructEndOmitEmptyStringTagUint64",
 	"StructEndFloat32",
 	"StructEndOmitEmptyFloat32",
 	"StructEndStringTagFloat32",
+	"StructEndOmitEmptyStringTagFloat32",
 	"StructEndFloat64",
 	"StructEndOmitEmptyFloat64",
 	"StructEndStringTagFloat64",
+	"StructEndOmitEmptyStringTagFloat64",
 	"StructEndBool",
 	"StructEndOmitEmptyBool",
 	"StructEndStringTagBool",
+	"StructEndOmitEmptyStringTagBool",
 	"StructEndString",
 	"StructEndOmitEmptyString",
 	"StructEndStringTagString",
+	"StructEndOmitEmptyStringTagString",
 	"StructEndBytes",
 	"StructEndOmitEmptyBytes",
 	"StructEndStringTagBytes",
+	"StructEndOmitEmptyStringTagBytes",
 	"StructEndArray",
 	"StructEndOmitEmptyArray",
 	"StructEndStringTagArray",
+	"StructEndOmitEmptyStringTagArray",
 	"StructEndMap",
 	"StructEndOmitEmptyMap",
 	"StructEndStringTagMap",
+	"StructEndOmitEmptyStringTagMap",
 	"StructEndMapLoad",
 	"StructEndOmitEmptyMapLoad",
 	"StructEndStringTagMapLoad",
+	"StructEndOmitEmptyStringTagMapLoad",
 	"StructEndSlice",
 	"StructEndOmitEmptySlice",
 	"StructEndStringTagSlice",
+	"StructEndOmitEmptyStringTagSlice",
 	"StructEndStruct",
 	"StructEndOmitEmptyStruct",
 	"StructEndStringTagStruct",
+	"StructEndOmitEmptyStringTagStruct",
 	"StructEndMarshalJSON",
 	"StructEndOmitEmptyMarshalJSON",
 	"StructEndStringTagMarshalJSON",
+	"StructEndOmitEmptyStringTagMarshalJSON",
 	"StructEndMarshalText",
 	"StructEndOmitEmptyMarshalText",
 	"StructEndStringTagMarshalText",
+	"StructEndOmitEmptyStringTagMarshalText",
 	"StructEndRecursive",
 	"StructEndOmitEmptyRecursive",
 	"StructEndStringTagRecursive",
+	"StructEndOmitEmptyStringTagRecursive",
 	"StructEndIntString",
 	"StructEndOmitEmptyIntString",
 	"StructEndStringTagIntString",
+	"StructEndOmitEmptyStringTagIntString",
 	"StructEndInt8String",
 	"StructEndOmitEmptyInt8String",
 	"StructEndStringTagInt8String",
+	"StructEndOmitEmptyStringTagInt8String",
 	"StructEndInt16String",
 	"StructEndOmitEmptyInt16String",
 	"StructEndStringTagInt16String",
+	"StructEndOmitEmptyStringTagInt16String",
 	"StructEndInt32String",
 	"StructEndOmitEmptyInt32String",
 	"StructEndStringTagInt32String",
+	"StructEndOmitEmptyStringTagInt32String",
 	"StructEndInt64String",
 	"StructEndOmitEmptyInt64String",
 	"StructEndStringTagInt64String",
+	"StructEndOmitEmptyStringTagInt64String",
 	"StructEndUintString",
 	"StructEndOmitEmptyUintString",
 	"StructEndStringTagUintString",
+	"StructEndOmitEmptyStringTagUintString",
 	"StructEndUint8String",
 	"StructEndOmitEmptyUint8String",
 	"StructEndStringTagUint8String",
+	"StructEndOmitEmptyStringTagUint8String",
 	"StructEndUint16String",
 	"StructEndOmitEmptyUint16String",
 	"StructEndStringTagUint16String",
+	"StructEndOmitEmptyStringTagUint16String",
 	"StructEndUint32String",
 	"StructEndOmitEmptyUint32String",
 	"StructEndStringTagUint32String",
+	"StructEndOmitEmptyStringTagUint32String",
 	"StructEndUint64String",
 	"StructEndOmitEmptyUint64String",
 	"StructEndStringTagUint64String",
+	"StructEndOmitEmptyStringTagUint64String",
 	"StructEndIntPtr",
 	"StructEndOmitEmptyIntPtr",
 	"StructEndStringTagIntPtr",
+	"StructEndOmitEmptyStringTagIntPtr",
 	"StructEndInt8Ptr",
 	"StructEndOmitEmptyInt8Ptr",
 	"StructEndStringTagInt8Ptr",
+	"StructEndOmitEmptyStringTagInt8Ptr",
 	"StructEndInt16Ptr",
 	"StructEndOmitEmptyInt16Ptr",
 	"StructEndStringTagInt16Ptr",
+	"StructEndOmitEmptyStringTagInt16Ptr",
 	"StructEndInt32Ptr",
 	"StructEndOmitEmptyInt32Ptr",
 	"StructEndStringTagInt32Ptr",
+	"StructEndOmitEmptyStringTagInt32Ptr",
 	"StructEndInt64Ptr",
 	"StructEndOmitEmptyInt64Ptr",
 	"StructEndStringTagInt64Ptr",
+	"StructEndOmitEmptyStringTagInt64Ptr",
 	"StructEndUintPtr",
 	"StructEndOmitEmptyUintPtr",
 	"StructEndStringTagUintPtr",
+	"StructEndOmitEmptyStringTagUintPtr",
 	"StructEndUint8Ptr",
 	"StructEndOmitEmptyUint8Ptr",
 	"StructEndStringTagUint8Ptr",
+	"StructEndOmitEmptyStringTagUint8Ptr",
 	"StructEndUint16Ptr",
 	"StructEndOmitEmptyUint16Ptr",
 	"StructEndStringTagUint16Ptr",
+	"StructEndOmitEmptyStringTagUint16Ptr",
 	"StructEndUint32Ptr",
 	"StructEndOmitEmptyUint32Ptr",
 	"StructEndStringTagUint32Ptr",
+	"StructEndOmitEmptyStringTagUint32Ptr",
 	"StructEndUint64Ptr",
 	"StructEndOmitEmptyUint64Ptr",
 	"StructEndStringTagUint64Ptr",
+	"StructEndOmitEmptyStringTagUint64Ptr",
 	"StructEndFloat32Ptr",
 	"StructEndOmitEmptyFloat32Ptr",
 	"StructEndStringTagFloat32Ptr",
+	"StructEndOmitEmptyStringTagFloat32Ptr",
 	"StructEndFloat64Ptr",
 	"StructEndOmitEmptyFloat64Ptr",
 	"StructEndStringTagFloat64Ptr",
+	"StructEndOmitEmptyStringTagFloat64Ptr",
 	"StructEndBoolPtr",
 	"StructEndOmitEmptyBoolPtr",
 	"StructEndStringTagBoolPtr",
+	"StructEndOmitEmptyStringTagBoolPtr",
 	"StructEndStringPtr",
 	"StructEndOmitEmptyStringPtr",
 	"StructEndStringTagStringPtr",
+	"StructEndOmitEmptyStringTagStringPtr",
 	"StructEndBytesPtr",
 	"StructEndOmitEmptyBytesPtr",
 	"StructEndStringTagBytesPtr",
+	"StructEndOmitEmptyStringTagBytesPtr",
 	"StructEndIntNPtr",
 	"StructEndOmitEmptyIntNPtr",
 	"StructEndStringTagIntNPtr",
+	"StructEndOmitEmptyStringTagIntNPtr",
 	"StructEndInt8NPtr",
 	"StructEndOmitEmptyInt8NPtr",
 	"StructEndStringTagInt8NPtr",
+	"StructEndOmitEmptyStringTagInt8NPtr",
 	"StructEndInt16NPtr",
 	"StructEndOmitEmptyInt16NPtr",
 	"StructEndStringTagInt16NPtr",
+	"StructEndOmitEmptyStringTagInt16NPtr",
 	"StructEndInt32NPtr",
 	"StructEndOmitEmptyInt32NPtr",
 	"StructEndStringTagInt32NPtr",
+	"StructEndOmitEmptyStringTagInt32NPtr",
 	"StructEndInt64NPtr",
 	"StructEndOmitEmptyInt64NPtr",
 	"StructEndStringTagInt64NPtr",
+	"StructEndOmitEmptyStringTagInt64NPtr",
 	"StructEndUintNPtr",
 	"StructEndOmitEmptyUintNPtr",
 	"StructEndStringTagUintNPtr",
+	"StructEndOmitEmptyStringTagUintNPtr",
 	"StructEndUint8NPtr",
 	"StructEndOmitEmptyUint8NPtr",
 	"StructEndStringTagUint8NPtr",
+	"StructEndOmitEmptyStringTagUint8NPtr",
 	"StructEndUint16NPtr",
 	"StructEndOmitEmptyUint16NPtr",
 	"StructEndStringTagUint16NPtr",
+	"StructEndOmitEmptyStringTagUint16NPtr",
 	"StructEndUint32NPtr",
 	"StructEndOmitEmptyUint32NPtr",
 	"StructEndStringTagUint32NPtr",
+	"StructEndOmitEmptyStringTagUint32NPtr",
 	"StructEndUint64NPtr",
 	"StructEndOmitEmptyUint64NPtr",
 	"StructEndStringTagUint64NPtr",
+	"StructEndOmitEmptyStringTagUint64NPtr",
 	"StructEndFloat32NPtr",
 	"StructEndOmitEmptyFloat32NPtr",
 	"StructEndStringTagFloat32NPtr",
+	"StructEndOmitEmptyStringTagFloat32NPtr",
 	"StructEndFloat64NPtr",
 	"StructEndOmitEmptyFloat64NPtr",
 	"StructEndStringTagFloat64NPtr",
+	"StructEndOmitEmptyStringTagFloat64NPtr",
 	"StructEndBoolNPtr",
 	"StructEndOmitEmptyBoolNPtr",
 	"StructEndStringTagBoolNPtr",
+	"StructEndOmitEmptyStringTagBoolNPtr",
 	"StructEndStringNPtr",
 	"StructEndOmitEmptyStringNPtr",
 	"StructEndStringTagStringNPtr",
+	"StructEndOmitEmptyStringTagStringNPtr",
 	"StructEndBytesNPtr",
 	"StructEndOmitEmptyBytesNPtr",
 	"StructEndStringTagBytesNPtr",
+	"StructEndOmitEmptyStringTagBytesNPtr",
 	"StructEnd",
 	"StructEndOmitEmpty",
 	"StructEndStringTag",
+	"StructEndOmitEmptyStringTag",
 }
 
 type opType int
 
 const (
-	opEnd                                                   opType = 0
-	opInterface                                             opType = 1
-	opPtr                                                   opType = 2
-	opNPtr                                                  opType = 3
-	opSliceHead                                             opType = 4
-	opRootSliceHead                                         opType = 5
-	opSliceElem                                             opType = 6
-	opRootSliceElem                                         opType = 7
-	opSliceEnd                                              opType = 8
-	opArrayHead                                             opType = 9
-	opArrayElem                                             opType = 10
-	opArrayEnd                                              opType = 11
-	opMapHead                                               opType = 12
-	opMapHeadLoad                                           opType = 13
-	opMapKey                                                opType = 14
-	opMapValue                                              opType = 15
-	opMapEnd                                                opType = 16
-	opStructFieldRecursiveEnd                               opType = 17
-	opStructAnonymousEnd                                    opType = 18
-	opInt                                                   opType = 19
-	opInt8                                                  opType = 20
-	opInt16                                                 opType = 21
-	opInt32                                                 opType = 22
-	opInt64                                                 opType = 23
-	opUint                                                  opType = 24
-	opUint8                                                 opType = 25
-	opUint16                                                opType = 26
-	opUint32                                                opType = 27
-	opUint64                                                opType = 28
-	opFloat32                                               opType = 29
-	opFloat64                                               opType = 30
-	opBool                                                  opType = 31
-	opString                                                opType = 32
-	opBytes                                                 opType = 33
-	opArray                                                 opType = 34
-	opMap                                                   opType = 35
-	opMapLoad                                               opType = 36
-	opSlice                                                 opType = 37
-	opStruct                                                opType = 38
-	opMarshalJSON                                           opType = 39
-	opMarshalText                                           opType = 40
-	opRecursive                                             opType = 41
-	opIntString                                             opType = 42
-	opInt8String                                            opType = 43
-	opInt16String                                           opType = 44
-	opInt32String                                           opType = 45
-	opInt64String                                           opType = 46
-	opUintString                                            opType = 47
-	opUint8String                                           opType = 48
-	opUint16String                                          opType = 49
-	opUint32String                                          opType = 50
-	opUint64String                                          opType = 51
-	opIntPtr                                                opType = 52
-	opInt8Ptr                                               opType = 53
-	opInt16Ptr                                              opType = 54
-	opInt32Ptr                                              opType = 55
-	opInt64Ptr                                              opType = 56
-	opUintPtr                                               opType = 57
-	opUint8Ptr                                              opType = 58
-	opUint16Ptr                                             opType = 59
-	opUint32Ptr                                             opType = 60
-	opUint64Ptr                                             opType = 61
-	opFloat32Ptr                                            opType = 62
-	opFloat64Ptr                                            opType = 63
-	opBoolPtr                                               opType = 64
-	opStringPtr                                             opType = 65
-	opBytesPtr                                              opType = 66
-	opIntNPtr                                               opType = 67
-	opInt8NPtr                                              opType = 68
-	opInt16NPtr                                             opType = 69
-	opInt32NPtr                                             opType = 70
-	opInt64NPtr                                             opType = 71
-	opUintNPtr                                              opType = 72
-	opUint8NPtr                                             opType = 73
-	opUint16NPtr                                            opType = 74
-	opUint32NPtr                                            opType = 75
-	opUint64NPtr                                            opType = 76
-	opFloat32NPtr                                           opType = 77
-	opFloat64NPtr                                           opType = 78
-	opBoolNPtr                                              opType = 79
-	opStringNPtr                                            opType = 80
-	opBytesNPtr                                             opType = 81
-	opStructFieldHeadInt                                    opType = 82
-	opStructFieldHeadIntOnly                                opType = 83
-	opStructFieldHeadOmitEmptyInt                           opType = 84
-	opStructFieldHeadOmitEmptyIntOnly                       opType = 85
-	opStructFieldHeadStringTagInt                           opType = 86
-	opStructFieldHeadStringTagIntOnly                       opType = 87
-	opStructFieldAnonymousHeadInt                           opType = 88
-	opStructFieldAnonymousHeadIntOnly                       opType = 89
-	opStructFieldAnonymousHeadOmitEmptyInt                  opType = 90
-	opStructFieldAnonymousHeadOmitEmptyIntOnly              opType = 91
-	opStructFieldAnonymousHeadStringTagInt                  opType = 92
-	opStructFieldAnonymousHeadStringTagIntOnly              opType = 93
-	opStructFieldPtrHeadInt                                 opType = 94
-	opStructFieldPtrHeadIntOnly                             opType = 95
-	opStructFieldPtrHeadOmitEmptyInt                        opType = 96
-	opStructFieldPtrHeadOmitEmptyIntOnly                    opType = 97
-	opStructFieldPtrHeadStringTagInt                        opType = 98
-	opStructFieldPtrHeadStringTagIntOnly                    opType = 99
-	opStructFieldPtrAnonymousHeadInt                        opType = 100
-	opStructFieldPtrAnonymousHeadIntOnly                    opType = 101
-	opStructFieldPtrAnonymousHeadOmitEmptyInt               opType = 102
-	opStructFieldPtrAnonymousHeadOmitEmptyIntOnly           opType = 103
-	opStructFieldPtrAnonymousHeadStringTagInt               opType = 104
-	opStructFieldPtrAnonymousHeadStringTagIntOnly           opType = 105
-	opStructFieldNPtrHeadInt                                opType = 106
-	opStructFieldNPtrHeadIntOnly                            opType = 107
-	opStructFieldNPtrHeadOmitEmptyInt                       opType = 108
-	opStructFieldNPtrHeadOmitEmptyIntOnly                   opType = 109
-	opStructFieldNPtrHeadStringTagInt                       opType = 110
-	opStructFieldNPtrHeadStringTagIntOnly                   opType = 111
-	opStructFieldNPtrAnonymousHeadInt                       opType = 112
-	opStructFieldNPtrAnonymousHeadIntOnly                   opType = 113
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt              opType = 114
-	opStructFieldNPtrAnonymousHeadOmitEmptyIntOnly          opType = 115
-	opStructFieldNPtrAnonymousHeadStringTagInt              opType = 116
-	opStructFieldNPtrAnonymousHeadStringTagIntOnly          opType = 117
-	opStructFieldHeadInt8                                   opType = 118
-	opStructFieldHeadInt8Only                               opType = 119
-	opStructFieldHeadOmitEmptyInt8                          opType = 120
-	opStructFieldHeadOmitEmptyInt8Only                      opType = 121
-	opStructFieldHeadStringTagInt8                          opType = 122
-	opStructFieldHeadStringTagInt8Only                      opType = 123
-	opStructFieldAnonymousHeadInt8                          opType = 124
-	opStructFieldAnonymousHeadInt8Only                      opType = 125
-	opStructFieldAnonymousHeadOmitEmptyInt8                 opType = 126
-	opStructFieldAnonymousHeadOmitEmptyInt8Only             opType = 127
-	opStructFieldAnonymousHeadStringTagInt8                 opType = 128
-	opStructFieldAnonymousHeadStringTagInt8Only             opType = 129
-	opStructFieldPtrHeadInt8                                opType = 130
-	opStructFieldPtrHeadInt8Only                            opType = 131
-	opStructFieldPtrHeadOmitEmptyInt8                       opType = 132
-	opStructFieldPtrHeadOmitEmptyInt8Only                   opType = 133
-	opStructFieldPtrHeadStringTagInt8                       opType = 134
-	opStructFieldPtrHeadStringTagInt8Only                   opType = 135
-	opStructFieldPtrAnonymousHeadInt8                       opType = 136
-	opStructFieldPtrAnonymousHeadInt8Only                   opType = 137
-	opStructFieldPtrAnonymousHeadOmitEmptyInt8              opType = 138
-	opStructFieldPtrAnonymousHeadOmitEmptyInt8Only          opType = 139
-	opStructFieldPtrAnonymousHeadStringTagInt8              opType = 140
-	opStructFieldPtrAnonymousHeadStringTagInt8Only          opType = 141
-	opStructFieldNPtrHeadInt8                               opType = 142
-	opStructFieldNPtrHeadInt8Only                           opType = 143
-	opStructFieldNPtrHeadOmitEmptyInt8                      opType = 144
-	opStructFieldNPtrHeadOmitEmptyInt8Only                  opType = 145
-	opStructFieldNPtrHeadStringTagInt8                      opType = 146
-	opStructFieldNPtrHeadStringTagInt8Only                  opType = 147
-	opStructFieldNPtrAnonymousHeadInt8                      opType = 148
-	opStructFieldNPtrAnonymousHeadInt8Only                  opType = 149
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt8             opType = 150
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt8Only         opType = 151
-	opStructFieldNPtrAnonymousHeadStringTagInt8             opType = 152
-	opStructFieldNPtrAnonymousHeadStringTagInt8Only         opType = 153
-	opStructFieldHeadInt16                                  opType = 154
-	opStructFieldHeadInt16Only                              opType = 155
-	opStructFieldHeadOmitEmptyInt16                         opType = 156
-	opStructFieldHeadOmitEmptyInt16Only                     opType = 157
-	opStructFieldHeadStringTagInt16                         opType = 158
-	opStructFieldHeadStringTagInt16Only                     opType = 159
-	opStructFieldAnonymousHeadInt16                         opType = 160
-	opStructFieldAnonymousHeadInt16Only                     opType = 161
-	opStructFieldAnonymousHeadOmitEmptyInt16                opType = 162
-	opStructFieldAnonymousHeadOmitEmptyInt16Only            opType = 163
-	opStructFieldAnonymousHeadStringTagInt16                opType = 164
-	opStructFieldAnonymousHeadStringTagInt16Only            opType = 165
-	opStructFieldPtrHeadInt16                               opType = 166
-	opStructFieldPtrHeadInt16Only                           opType = 167
-	opStructFieldPtrHeadOmitEmptyInt16                      opType = 168
-	opStructFieldPtrHeadOmitEmptyInt16Only                  opType = 169
-	opStructFieldPtrHeadStringTagInt16                      opType = 170
-	opStructFieldPtrHeadStringTagInt16Only                  opType = 171
-	opStructFieldPtrAnonymousHeadInt16                      opType = 172
-	opStructFieldPtrAnonymousHeadInt16Only                  opType = 173
-	opStructFieldPtrAnonymousHeadOmitEmptyInt16             opType = 174
-	opStructFieldPtrAnonymousHeadOmitEmptyInt16Only         opType = 175
-	opStructFieldPtrAnonymousHeadStringTagInt16             opType = 176
-	opStructFieldPtrAnonymousHeadStringTagInt16Only         opType = 177
-	opStructFieldNPtrHeadInt16                              opType = 178
-	opStructFieldNPtrHeadInt16Only                          opType = 179
-	opStructFieldNPtrHeadOmitEmptyInt16                     opType = 180
-	opStructFieldNPtrHeadOmitEmptyInt16Only                 opType = 181
-	opStructFieldNPtrHeadStringTagInt16                     opType = 182
-	opStructFieldNPtrHeadStringTagInt16Only                 opType = 183
-	opStructFieldNPtrAnonymousHeadInt16                     opType = 184
-	opStructFieldNPtrAnonymousHeadInt16Only                 opType = 185
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt16            opType = 186
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt16Only        opType = 187
-	opStructFieldNPtrAnonymousHeadStringTagInt16            opType = 188
-	opStructFieldNPtrAnonymousHeadStringTagInt16Only        opType = 189
-	opStructFieldHeadInt32                                  opType = 190
-	opStructFieldHeadInt32Only                              opType = 191
-	opStructFieldHeadOmitEmptyInt32                         opType = 192
-	opStructFieldHeadOmitEmptyInt32Only                     opType = 193
-	opStructFieldHeadStringTagInt32                         opType = 194
-	opStructFieldHeadStringTagInt32Only                     opType = 195
-	opStructFieldAnonymousHeadInt32                         opType = 196
-	opStructFieldAnonymousHeadInt32Only                     opType = 197
-	opStructFieldAnonymousHeadOmitEmptyInt32                opType = 198
-	opStructFieldAnonymousHeadOmitEmptyInt32Only            opType = 199
-	opStructFieldAnonymousHeadStringTagInt32                opType = 200
-	opStructFieldAnonymousHeadStringTagInt32Only            opType = 201
-	opStructFieldPtrHeadInt32                               opType = 202
-	opStructFieldPtrHeadInt32Only                           opType = 203
-	opStructFieldPtrHeadOmitEmptyInt32                      opType = 204
-	opStructFieldPtrHeadOmitEmptyInt32Only                  opType = 205
-	opStructFieldPtrHeadStringTagInt32                      opType = 206
-	opStructFieldPtrHeadStringTagInt32Only                  opType = 207
-	opStructFieldPtrAnonymousHeadInt32                      opType = 208
-	opStructFieldPtrAnonymousHeadInt32Only                  opType = 209
-	opStructFieldPtrAnonymousHeadOmitEmptyInt32             opType = 210
-	opStructFieldPtrAnonymousHeadOmitEmptyInt32Only         opType = 211
-	opStructFieldPtrAnonymousHeadStringTagInt32             opType = 212
-	opStructFieldPtrAnonymousHeadStringTagInt32Only         opType = 213
-	opStructFieldNPtrHeadInt32                              opType = 214
-	opStructFieldNPtrHeadInt32Only                          opType = 215
-	opStructFieldNPtrHeadOmitEmptyInt32                     opType = 216
-	opStructFieldNPtrHeadOmitEmptyInt32Only                 opType = 217
-	opStructFieldNPtrHeadStringTagInt32                     opType = 218
-	opStructFieldNPtrHeadStringTagInt32Only                 opType = 219
-	opStructFieldNPtrAnonymousHeadInt32                     opType = 220
-	opStructFieldNPtrAnonymousHeadInt32Only                 opType = 221
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt32            opType = 222
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt32Only        opType = 223
-	opStructFieldNPtrAnonymousHeadStringTagInt32            opType = 224
-	opStructFieldNPtrAnonymousHeadStringTagInt32Only        opType = 225
-	opStructFieldHeadInt64                                  opType = 226
-	opStructFieldHeadInt64Only                              opType = 227
-	opStructFieldHeadOmitEmptyInt64                         opType = 228
-	opStructFieldHeadOmitEmptyInt64Only                     opType = 229
-	opStructFieldHeadStringTagInt64                         opType = 230
-	opStructFieldHeadStringTagInt64Only                     opType = 231
-	opStructFieldAnonymousHeadInt64                         opType = 232
-	opStructFieldAnonymousHeadInt64Only                     opType = 233
-	opStructFieldAnonymousHeadOmitEmptyInt64                opType = 234
-	opStructFieldAnonymousHeadOmitEmptyInt64Only            opType = 235
-	opStructFieldAnonymousHeadStringTagInt64                opType = 236
-	opStructFieldAnonymousHeadStringTagInt64Only            opType = 237
-	opStructFieldPtrHeadInt64                               opType = 238
-	opStructFieldPtrHeadInt64Only                           opType = 239
-	opStructFieldPtrHeadOmitEmptyInt64                      opType = 240
-	opStructFieldPtrHeadOmitEmptyInt64Only                  opType = 241
-	opStructFieldPtrHeadStringTagInt64                      opType = 242
-	opStructFieldPtrHeadStringTagInt64Only                  opType = 243
-	opStructFieldPtrAnonymousHeadInt64                      opType = 244
-	opStructFieldPtrAnonymousHeadInt64Only                  opType = 245
-	opStructFieldPtrAnonymousHeadOmitEmptyInt64             opType = 246
-	opStructFieldPtrAnonymousHeadOmitEmptyInt64Only         opType = 247
-	opStructFieldPtrAnonymousHeadStringTagInt64             opType = 248
-	opStructFieldPtrAnonymousHeadStringTagInt64Only         opType = 249
-	opStructFieldNPtrHeadInt64                              opType = 250
-	opStructFieldNPtrHeadInt64Only                          opType = 251
-	opStructFieldNPtrHeadOmitEmptyInt64                     opType = 252
-	opStructFieldNPtrHeadOmitEmptyInt64Only                 opType = 253
-	opStructFieldNPtrHeadStringTagInt64                     opType = 254
-	opStructFieldNPtrHeadStringTagInt64Only                 opType = 255
-	opStructFieldNPtrAnonymousHeadInt64                     opType = 256
-	opStructFieldNPtrAnonymousHeadInt64Only                 opType = 257
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt64            opType = 258
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt64Only        opType = 259
-	opStructFieldNPtrAnonymousHeadStringTagInt64            opType = 260
-	opStructFieldNPtrAnonymousHeadStringTagInt64Only        opType = 261
-	opStructFieldHeadUint                                   opType = 262
-	opStructFieldHeadUintOnly                               opType = 263
-	opStructFieldHeadOmitEmptyUint                          opType = 264
-	opStructFieldHeadOmitEmptyUintOnly                      opType = 265
-	opStructFieldHeadStringTagUint                          opType = 266
-	opStructFieldHeadStringTagUintOnly                      opType = 267
-	opStructFieldAnonymousHeadUint                          opType = 268
-	opStructFieldAnonymousHeadUintOnly                      opType = 269
-	opStructFieldAnonymousHeadOmitEmptyUint                 opType = 270
-	opStructFieldAnonymousHeadOmitEmptyUintOnly             opType = 271
-	opStructFieldAnonymousHeadStringTagUint                 opType = 272
-	opStructFieldAnonymousHeadStringTagUintOnly             opType = 273
-	opStructFieldPtrHeadUint                                opType = 274
-	opStructFieldPtrHeadUintOnly                            opType = 275
-	opStructFieldPtrHeadOmitEmptyUint                       opType = 276
-	opStructFieldPtrHeadOmitEmptyUintOnly                   opType = 277
-	opStructFieldPtrHeadStringTagUint                       opType = 278
-	opStructFieldPtrHeadStringTagUintOnly                   opType = 279
-	opStructFieldPtrAnonymousHeadUint                       opType = 280
-	opStructFieldPtrAnonymousHeadUintOnly                   opType = 281
-	opStructFieldPtrAnonymousHeadOmitEmptyUint              opType = 282
-	opStructFieldPtrAnonymousHeadOmitEmptyUintOnly          opType = 283
-	opStructFieldPtrAnonymousHeadStringTagUint              opType = 284
-	opStructFieldPtrAnonymousHeadStringTagUintOnly          opType = 285
-	opStructFieldNPtrHeadUint                               opType = 286
-	opStructFieldNPtrHeadUintOnly                           opType = 287
-	opStructFieldNPtrHeadOmitEmptyUint                      opType = 288
-	opStructFieldNPtrHeadOmitEmptyUintOnly                  opType = 289
-	opStructFieldNPtrHeadStringTagUint                      opType = 290
-	opStructFieldNPtrHeadStringTagUintOnly                  opType = 291
-	opStructFieldNPtrAnonymousHeadUint                      opType = 292
-	opStructFieldNPtrAnonymousHeadUintOnly                  opType = 293
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint             opType = 294
-	opStructFieldNPtrAnonymousHeadOmitEmptyUintOnly         opType = 295
-	opStructFieldNPtrAnonymousHeadStringTagUint             opType = 296
-	opStructFieldNPtrAnonymousHeadStringTagUintOnly         opType = 297
-	opStructFieldHeadUint8                                  opType = 298
-	opStructFieldHeadUint8Only                              opType = 299
-	opStructFieldHeadOmitEmptyUint8                         opType = 300
-	opStructFieldHeadOmitEmptyUint8Only                     opType = 301
-	opStructFieldHeadStringTagUint8                         opType = 302
-	opStructFieldHeadStringTagUint8Only                     opType = 303
-	opStructFieldAnonymousHeadUint8                         opType = 304
-	opStructFieldAnonymousHeadUint8Only                     opType = 305
-	opStructFieldAnonymousHeadOmitEmptyUint8                opType = 306
-	opStructFieldAnonymousHeadOmitEmptyUint8Only            opType = 307
-	opStructFieldAnonymousHeadStringTagUint8                opType = 308
-	opStructFieldAnonymousHeadStringTagUint8Only            opType = 309
-	opStructFieldPtrHeadUint8                               opType = 310
-	opStructFieldPtrHeadUint8Only                           opType = 311
-	opStructFieldPtrHeadOmitEmptyUint8                      opType = 312
-	opStructFieldPtrHeadOmitEmptyUint8Only                  opType = 313
-	opStructFieldPtrHeadStringTagUint8                      opType = 314
-	opStructFieldPtrHeadStringTagUint8Only                  opType = 315
-	opStructFieldPtrAnonymousHeadUint8                      opType = 316
-	opStructFieldPtrAnonymousHeadUint8Only                  opType = 317
-	opStructFieldPtrAnonymousHeadOmitEmptyUint8             opType = 318
-	opStructFieldPtrAnonymousHeadOmitEmptyUint8Only         opType = 319
-	opStructFieldPtrAnonymousHeadStringTagUint8             opType = 320
-	opStructFieldPtrAnonymousHeadStringTagUint8Only         opType = 321
-	opStructFieldNPtrHeadUint8                              opType = 322
-	opStructFieldNPtrHeadUint8Only                          opType = 323
-	opStructFieldNPtrHeadOmitEmptyUint8                     opType = 324
-	opStructFieldNPtrHeadOmitEmptyUint8Only                 opType = 325
-	opStructFieldNPtrHeadStringTagUint8                     opType = 326
-	opStructFieldNPtrHeadStringTagUint8Only                 opType = 327
-	opStructFieldNPtrAnonymousHeadUint8                     opType = 328
-	opStructFieldNPtrAnonymousHeadUint8Only                 opType = 329
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint8            opType = 330
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint8Only        opType = 331
-	opStructFieldNPtrAnonymousHeadStringTagUint8            opType = 332
-	opStructFieldNPtrAnonymousHeadStringTagUint8Only        opType = 333
-	opStructFieldHeadUint16                                 opType = 334
-	opStructFieldHeadUint16Only                             opType = 335
-	opStructFieldHeadOmitEmptyUint16                        opType = 336
-	opStructFieldHeadOmitEmptyUint16Only                    opType = 337
-	opStructFieldHeadStringTagUint16                        opType = 338
-	opStructFieldHeadStringTagUint16Only                    opType = 339
-	opStructFieldAnonymousHeadUint16                        opType = 340
-	opStructFieldAnonymousHeadUint16Only                    opType = 341
-	opStructFieldAnonymousHeadOmitEmptyUint16               opType = 342
-	opStructFieldAnonymousHeadOmitEmptyUint16Only           opType = 343
-	opStructFieldAnonymousHeadStringTagUint16               opType = 344
-	opStructFieldAnonymousHeadStringTagUint16Only           opType = 345
-	opStructFieldPtrHeadUint16                              opType = 346
-	opStructFieldPtrHeadUint16Only                          opType = 347
-	opStructFieldPtrHeadOmitEmptyUint16                     opType = 348
-	opStructFieldPtrHeadOmitEmptyUint16Only                 opType = 349
-	opStructFieldPtrHeadStringTagUint16                     opType = 350
-	opStructFieldPtrHeadStringTagUint16Only                 opType = 351
-	opStructFieldPtrAnonymousHeadUint16                     opType = 352
-	opStructFieldPtrAnonymousHeadUint16Only                 opType = 353
-	opStructFieldPtrAnonymousHeadOmitEmptyUint16            opType = 354
-	opStructFieldPtrAnonymousHeadOmitEmptyUint16Only        opType = 355
-	opStructFieldPtrAnonymousHeadStringTagUint16            opType = 356
-	opStructFieldPtrAnonymousHeadStringTagUint16Only        opType = 357
-	opStructFieldNPtrHeadUint16                             opType = 358
-	opStructFieldNPtrHeadUint16Only                         opType = 359
-	opStructFieldNPtrHeadOmitEmptyUint16                    opType = 360
-	opStructFieldNPtrHeadOmitEmptyUint16Only                opType = 361
-	opStructFieldNPtrHeadStringTagUint16                    opType = 362
-	opStructFieldNPtrHeadStringTagUint16Only                opType = 363
-	opStructFieldNPtrAnonymousHeadUint16                    opType = 364
-	opStructFieldNPtrAnonymousHeadUint16Only                opType = 365
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint16           opType = 366
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint16Only       opType = 367
-	opStructFieldNPtrAnonymousHeadStringTagUint16           opType = 368
-	opStructFieldNPtrAnonymousHeadStringTagUint16Only       opType = 369
-	opStructFieldHeadUint32                                 opType = 370
-	opStructFieldHeadUint32Only                             opType = 371
-	opStructFieldHeadOmitEmptyUint32                        opType = 372
-	opStructFieldHeadOmitEmptyUint32Only                    opType = 373
-	opStructFieldHeadStringTagUint32                        opType = 374
-	opStructFieldHeadStringTagUint32Only                    opType = 375
-	opStructFieldAnonymousHeadUint32                        opType = 376
-	opStructFieldAnonymousHeadUint32Only                    opType = 377
-	opStructFieldAnonymousHeadOmitEmptyUint32               opType = 378
-	opStructFieldAnonymousHeadOmitEmptyUint32Only           opType = 379
-	opStructFieldAnonymousHeadStringTagUint32               opType = 380
-	opStructFieldAnonymousHeadStringTagUint32Only           opType = 381
-	opStructFieldPtrHeadUint32                              opType = 382
-	opStructFieldPtrHeadUint32Only                          opType = 383
-	opStructFieldPtrHeadOmitEmptyUint32                     opType = 384
-	opStructFieldPtrHeadOmitEmptyUint32Only                 opType = 385
-	opStructFieldPtrHeadStringTagUint32                     opType = 386
-	opStructFieldPtrHeadStringTagUint32Only                 opType = 387
-	opStructFieldPtrAnonymousHeadUint32                     opType = 388
-	opStructFieldPtrAnonymousHeadUint32Only                 opType = 389
-	opStructFieldPtrAnonymousHeadOmitEmptyUint32            opType = 390
-	opStructFieldPtrAnonymousHeadOmitEmptyUint32Only        opType = 391
-	opStructFieldPtrAnonymousHeadStringTagUint32            opType = 392
-	opStructFieldPtrAnonymousHeadStringTagUint32Only        opType = 393
-	opStructFieldNPtrHeadUint32                             opType = 394
-	opStructFieldNPtrHeadUint32Only                         opType = 395
-	opStructFieldNPtrHeadOmitEmptyUint32                    opType = 396
-	opStructFieldNPtrHeadOmitEmptyUint32Only                opType = 397
-	opStructFieldNPtrHeadStringTagUint32                    opType = 398
-	opStructFieldNPtrHeadStringTagUint32Only                opType = 399
-	opStructFieldNPtrAnonymousHeadUint32                    opType = 400
-	opStructFieldNPtrAnonymousHeadUint32Only                opType = 401
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint32           opType = 402
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint32Only       opType = 403
-	opStructFieldNPtrAnonymousHeadStringTagUint32           opType = 404
-	opStructFieldNPtrAnonymousHeadStringTagUint32Only       opType = 405
-	opStructFieldHeadUint64                                 opType = 406
-	opStructFieldHeadUint64Only                             opType = 407
-	opStructFieldHeadOmitEmptyUint64                        opType = 408
-	opStructFieldHeadOmitEmptyUint64Only                    opType = 409
-	opStructFieldHeadStringTagUint64                        opType = 410
-	opStructFieldHeadStringTagUint64Only                    opType = 411
-	opStructFieldAnonymousHeadUint64                        opType = 412
-	opStructFieldAnonymousHeadUint64Only                    opType = 413
-	opStructFieldAnonymousHeadOmitEmptyUint64               opType = 414
-	opStructFieldAnonymousHeadOmitEmptyUint64Only           opType = 415
-	opStructFieldAnonymousHeadStringTagUint64               opType = 416
-	opStructFieldAnonymousHeadStringTagUint64Only           opType = 417
-	opStructFieldPtrHeadUint64                              opType = 418
-	opStructFieldPtrHeadUint64Only                          opType = 419
-	opStructFieldPtrHeadOmitEmptyUint64                     opType = 420
-	opStructFieldPtrHeadOmitEmptyUint64Only                 opType = 421
-	opStructFieldPtrHeadStringTagUint64                     opType = 422
-	opStructFieldPtrHeadStringTagUint64Only                 opType = 423
-	opStructFieldPtrAnonymousHeadUint64                     opType = 424
-	opStructFieldPtrAnonymousHeadUint64Only                 opType = 425
-	opStructFieldPtrAnonymousHeadOmitEmptyUint64            opType = 426
-	opStructFieldPtrAnonymousHeadOmitEmptyUint64Only        opType = 427
-	opStructFieldPtrAnonymousHeadStringTagUint64            opType = 428
-	opStructFieldPtrAnonymousHeadStringTagUint64Only        opType = 429
-	opStructFieldNPtrHeadUint64                             opType = 430
-	opStructFieldNPtrHeadUint64Only                         opType = 431
-	opStructFieldNPtrHeadOmitEmptyUint64                    opType = 432
-	opStructFieldNPtrHeadOmitEmptyUint64Only                opType = 433
-	opStructFieldNPtrHeadStringTagUint64                    opType = 434
-	opStructFieldNPtrHeadStringTagUint64Only                opType = 435
-	opStructFieldNPtrAnonymousHeadUint64                    opType = 436
-	opStructFieldNPtrAnonymousHeadUint64Only                opType = 437
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint64           opType = 438
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint64Only       opType = 439
-	opStructFieldNPtrAnonymousHeadStringTagUint64           opType = 440
-	opStructFieldNPtrAnonymousHeadStringTagUint64Only       opType = 441
-	opStructFieldHeadFloat32                                opType = 442
-	opStructFieldHeadFloat32Only                            opType = 443
-	opStructFieldHeadOmitEmptyFloat32                       opType = 444
-	opStructFieldHeadOmitEmptyFloat32Only                   opType = 445
-	opStructFieldHeadStringTagFloat32                       opType = 446
-	opStructFieldHeadStringTagFloat32Only                   opType = 447
-	opStructFieldAnonymousHeadFloat32                       opType = 448
-	opStructFieldAnonymousHeadFloat32Only                   opType = 449
-	opStructFieldAnonymousHeadOmitEmptyFloat32              opType = 450
-	opStructFieldAnonymousHeadOmitEmptyFloat32Only          opType = 451
-	opStructFieldAnonymousHeadStringTagFloat32              opType = 452
-	opStructFieldAnonymousHeadStringTagFloat32Only          opType = 453
-	opStructFieldPtrHeadFloat32                             opType = 454
-	opStructFieldPtrHeadFloat32Only                         opType = 455
-	opStructFieldPtrHeadOmitEmptyFloat32                    opType = 456
-	opStructFieldPtrHeadOmitEmptyFloat32Only                opType = 457
-	opStructFieldPtrHeadStringTagFloat32                    opType = 458
-	opStructFieldPtrHeadStringTagFloat32Only                opType = 459
-	opStructFieldPtrAnonymousHeadFloat32                    opType = 460
-	opStructFieldPtrAnonymousHeadFloat32Only                opType = 461
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat32           opType = 462
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat32Only       opType = 463
-	opStructFieldPtrAnonymousHeadStringTagFloat32           opType = 464
-	opStructFieldPtrAnonymousHeadStringTagFloat32Only       opType = 465
-	opStructFieldNPtrHeadFloat32                            opType = 466
-	opStructFieldNPtrHeadFloat32Only                        opType = 467
-	opStructFieldNPtrHeadOmitEmptyFloat32                   opType = 468
-	opStructFieldNPtrHeadOmitEmptyFloat32Only               opType = 469
-	opStructFieldNPtrHeadStringTagFloat32                   opType = 470
-	opStructFieldNPtrHeadStringTagFloat32Only               opType = 471
-	opStructFieldNPtrAnonymousHeadFloat32                   opType = 472
-	opStructFieldNPtrAnonymousHeadFloat32Only               opType = 473
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32          opType = 474
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32Only      opType = 475
-	opStructFieldNPtrAnonymousHeadStringTagFloat32          opType = 476
-	opStructFieldNPtrAnonymousHeadStringTagFloat32Only      opType = 477
-	opStructFieldHeadFloat64                                opType = 478
-	opStructFieldHeadFloat64Only                            opType = 479
-	opStructFieldHeadOmitEmptyFloat64                       opType = 480
-	opStructFieldHeadOmitEmptyFloat64Only                   opType = 481
-	opStructFieldHeadStringTagFloat64                       opType = 482
-	opStructFieldHeadStringTagFloat64Only                   opType = 483
-	opStructFieldAnonymousHeadFloat64                       opType = 484
-	opStructFieldAnonymousHeadFloat64Only                   opType = 485
-	opStructFieldAnonymousHeadOmitEmptyFloat64              opType = 486
-	opStructFieldAnonymousHeadOmitEmptyFloat64Only          opType = 487
-	opStructFieldAnonymousHeadStringTagFloat64              opType = 488
-	opStructFieldAnonymousHeadStringTagFloat64Only          opType = 489
-	opStructFieldPtrHeadFloat64                             opType = 490
-	opStructFieldPtrHeadFloat64Only                         opType = 491
-	opStructFieldPtrHeadOmitEmptyFloat64                    opType = 492
-	opStructFieldPtrHeadOmitEmptyFloat64Only                opType = 493
-	opStructFieldPtrHeadStringTagFloat64                    opType = 494
-	opStructFieldPtrHeadStringTagFloat64Only                opType = 495
-	opStructFieldPtrAnonymousHeadFloat64                    opType = 496
-	opStructFieldPtrAnonymousHeadFloat64Only                opType = 497
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat64           opType = 498
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat64Only       opType = 499
-	opStructFieldPtrAnonymousHeadStringTagFloat64           opType = 500
-	opStructFieldPtrAnonymousHeadStringTagFloat64Only       opType = 501
-	opStructFieldNPtrHeadFloat64                            opType = 502
-	opStructFieldNPtrHeadFloat64Only                        opType = 503
-	opStructFieldNPtrHeadOmitEmptyFloat64                   opType = 504
-	opStructFieldNPtrHeadOmitEmptyFloat64Only               opType = 505
-	opStructFieldNPtrHeadStringTagFloat64                   opType = 506
-	opStructFieldNPtrHeadStringTagFloat64Only               opType = 507
-	opStructFieldNPtrAnonymousHeadFloat64                   opType = 508
-	opStructFieldNPtrAnonymousHeadFloat64Only               opType = 509
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64          opType = 510
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64Only      opType = 511
-	opStructFieldNPtrAnonymousHeadStringTagFloat64          opType = 512
-	opStructFieldNPtrAnonymousHeadStringTagFloat64Only      opType = 513
-	opStructFieldHeadBool                                   opType = 514
-	opStructFieldHeadBoolOnly                               opType = 515
-	opStructFieldHeadOmitEmptyBool                          opType = 516
-	opStructFieldHeadOmitEmptyBoolOnly                      opType = 517
-	opStructFieldHeadStringTagBool                          opType = 518
-	opStructFieldHeadStringTagBoolOnly                      opType = 519
-	opStructFieldAnonymousHeadBool                          opType = 520
-	opStructFieldAnonymousHeadBoolOnly                      opType = 521
-	opStructFieldAnonymousHeadOmitEmptyBool                 opType = 522
-	opStructFieldAnonymousHeadOmitEmptyBoolOnly             opType = 523
-	opStructFieldAnonymousHeadStringTagBool                 opType = 524
-	opStructFieldAnonymousHeadStringTagBoolOnly             opType = 525
-	opStructFieldPtrHeadBool                                opType = 526
-	opStructFieldPtrHeadBoolOnly                            opType = 527
-	opStructFieldPtrHeadOmitEmptyBool                       opType = 528
-	opStructFieldPtrHeadOmitEmptyBoolOnly                   opType = 529
-	opStructFieldPtrHeadStringTagBool                       opType = 530
-	opStructFieldPtrHeadStringTagBoolOnly                   opType = 531
-	opStructFieldPtrAnonymousHeadBool                       opType = 532
-	opStructFieldPtrAnonymousHeadBoolOnly                   opType = 533
-	opStructFieldPtrAnonymousHeadOmitEmptyBool              opType = 534
-	opStructFieldPtrAnonymousHeadOmitEmptyBoolOnly          opType = 535
-	opStructFieldPtrAnonymousHeadStringTagBool              opType = 536
-	opStructFieldPtrAnonymousHeadStringTagBoolOnly          opType = 537
-	opStructFieldNPtrHeadBool                               opType = 538
-	opStructFieldNPtrHeadBoolOnly                           opType = 539
-	opStructFieldNPtrHeadOmitEmptyBool                      opType = 540
-	opStructFieldNPtrHeadOmitEmptyBoolOnly                  opType = 541
-	opStructFieldNPtrHeadStringTagBool                      opType = 542
-	opStructFieldNPtrHeadStringTagBoolOnly                  opType = 543
-	opStructFieldNPtrAnonymousHeadBool                      opType = 544
-	opStructFieldNPtrAnonymousHeadBoolOnly                  opType = 545
-	opStructFieldNPtrAnonymousHeadOmitEmptyBool             opType = 546
-	opStructFieldNPtrAnonymousHeadOmitEmptyBoolOnly         opType = 547
-	opStructFieldNPtrAnonymousHeadStringTagBool             opType = 548
-	opStructFieldNPtrAnonymousHeadStringTagBoolOnly         opType = 549
-	opStructFieldHeadString                                 opType = 550
-	opStructFieldHeadStringOnly                             opType = 551
-	opStructFieldHeadOmitEmptyString                        opType = 552
-	opStructFieldHeadOmitEmptyStringOnly                    opType = 553
-	opStructFieldHeadStringTagString                        opType = 554
-	opStructFieldHeadStringTagStringOnly                    opType = 555
-	opStructFieldAnonymousHeadString                        opType = 556
-	opStructFieldAnonymousHeadStringOnly                    opType = 557
-	opStructFieldAnonymousHeadOmitEmptyString               opType = 558
-	opStructFieldAnonymousHeadOmitEmptyStringOnly           opType = 559
-	opStructFieldAnonymousHeadStringTagString               opType = 560
-	opStructFieldAnonymousHeadStringTagStringOnly           opType = 561
-	opStructFieldPtrHeadString                              opType = 562
-	opStructFieldPtrHeadStringOnly                          opType = 563
-	opStructFieldPtrHeadOmitEmptyString                     opType = 564
-	opStructFieldPtrHeadOmitEmptyStringOnly                 opType = 565
-	opStructFieldPtrHeadStringTagString                     opType = 566
-	opStructFieldPtrHeadStringTagStringOnly                 opType = 567
-	opStructFieldPtrAnonymousHeadString                     opType = 568
-	opStructFieldPtrAnonymousHeadStringOnly                 opType = 569
-	opStructFieldPtrAnonymousHeadOmitEmptyString            opType = 570
-	opStructFieldPtrAnonymousHeadOmitEmptyStringOnly        opType = 571
-	opStructFieldPtrAnonymousHeadStringTagString            opType = 572
-	opStructFieldPtrAnonymousHeadStringTagStringOnly        opType = 573
-	opStructFieldNPtrHeadString                             opType = 574
-	opStructFieldNPtrHeadStringOnly                         opType = 575
-	opStructFieldNPtrHeadOmitEmptyString                    opType = 576
-	opStructFieldNPtrHeadOmitEmptyStringOnly                opType = 577
-	opStructFieldNPtrHeadStringTagString                    opType = 578
-	opStructFieldNPtrHeadStringTagStringOnly                opType = 579
-	opStructFieldNPtrAnonymousHeadString                    opType = 580
-	opStructFieldNPtrAnonymousHeadStringOnly                opType = 581
-	opStructFieldNPtrAnonymousHeadOmitEmptyString           opType = 582
-	opStructFieldNPtrAnonymousHeadOmitEmptyStringOnly       opType = 583
-	opStructFieldNPtrAnonymousHeadStringTagString           opType = 584
-	opStructFieldNPtrAnonymousHeadStringTagStringOnly       opType = 585
-	opStructFieldHeadBytes                                  opType = 586
-	opStructFieldHeadBytesOnly                              opType = 587
-	opStructFieldHeadOmitEmptyBytes                         opType = 588
-	opStructFieldHeadOmitEmptyBytesOnly                     opType = 589
-	opStructFieldHeadStringTagBytes                         opType = 590
-	opStructFieldHeadStringTagBytesOnly                     opType = 591
-	opStructFieldAnonymousHeadBytes                         opType = 592
-	opStructFieldAnonymousHeadBytesOnly                     opType = 593
-	opStructFieldAnonymousHeadOmitEmptyBytes                opType = 594
-	opStructFieldAnonymousHeadOmitEmptyBytesOnly            opType = 595
-	opStructFieldAnonymousHeadStringTagBytes                opType = 596
-	opStructFieldAnonymousHeadStringTagBytesOnly            opType = 597
-	opStructFieldPtrHeadBytes                               opType = 598
-	opStructFieldPtrHeadBytesOnly                           opType = 599
-	opStructFieldPtrHeadOmitEmptyBytes                      opType = 600
-	opStructFieldPtrHeadOmitEmptyBytesOnly                  opType = 601
-	opStructFieldPtrHeadStringTagBytes                      opType = 602
-	opStructFieldPtrHeadStringTagBytesOnly                  opType = 603
-	opStructFieldPtrAnonymousHeadBytes                      opType = 604
-	opStructFieldPtrAnonymousHeadBytesOnly                  opType = 605
-	opStructFieldPtrAnonymousHeadOmitEmptyBytes             opType = 606
-	opStructFieldPtrAnonymousHeadOmitEmptyBytesOnly         opType = 607
-	opStructFieldPtrAnonymousHeadStringTagBytes             opType = 608
-	opStructFieldPtrAnonymousHeadStringTagBytesOnly         opType = 609
-	opStructFieldNPtrHeadBytes                              opType = 610
-	opStructFieldNPtrHeadBytesOnly                          opType = 611
-	opStructFieldNPtrHeadOmitEmptyBytes                     opType = 612
-	opStructFieldNPtrHeadOmitEmptyBytesOnly                 opType = 613
-	opStructFieldNPtrHeadStringTagBytes                     opType = 614
-	opStructFieldNPtrHeadStringTagBytesOnly                 opType = 615
-	opStructFieldNPtrAnonymousHeadBytes                     opType = 616
-	opStructFieldNPtrAnonymousHeadBytesOnly                 opType = 617
-	opStructFieldNPtrAnonymousHeadOmitEmptyBytes            opType = 618
-	opStructFieldNPtrAnonymousHeadOmitEmptyBytesOnly        opType = 619
-	opStructFieldNPtrAnonymousHeadStringTagBytes            opType = 620
-	opStructFieldNPtrAnonymousHeadStringTagBytesOnly        opType = 621
-	opStructFieldHeadArray                                  opType = 622
-	opStructFieldHeadArrayOnly                              opType = 623
-	opStructFieldHeadOmitEmptyArray                         opType = 624
-	opStructFieldHeadOmitEmptyArrayOnly                     opType = 625
-	opStructFieldHeadStringTagArray                         opType = 626
-	opStructFieldHeadStringTagArrayOnly                     opType = 627
-	opStructFieldAnonymousHeadArray                         opType = 628
-	opStructFieldAnonymousHeadArrayOnly                     opType = 629
-	opStructFieldAnonymousHeadOmitEmptyArray                opType = 630
-	opStructFieldAnonymousHeadOmitEmptyArrayOnly            opType = 631
-	opStructFieldAnonymousHeadStringTagArray                opType = 632
-	opStructFieldAnonymousHeadStringTagArrayOnly            opType = 633
-	opStructFieldPtrHeadArray                               opType = 634
-	opStructFieldPtrHeadArrayOnly                           opType = 635
-	opStructFieldPtrHeadOmitEmptyArray                      opType = 636
-	opStructFieldPtrHeadOmitEmptyArrayOnly                  opType = 637
-	opStructFieldPtrHeadStringTagArray                      opType = 638
-	opStructFieldPtrHeadStringTagArrayOnly                  opType = 639
-	opStructFieldPtrAnonymousHeadArray                      opType = 640
-	opStructFieldPtrAnonymousHeadArrayOnly                  opType = 641
-	opStructFieldPtrAnonymousHeadOmitEmptyArray             opType = 642
-	opStructFieldPtrAnonymousHeadOmitEmptyArrayOnly         opType = 643
-	opStructFieldPtrAnonymousHeadStringTagArray             opType = 644
-	opStructFieldPtrAnonymousHeadStringTagArrayOnly         opType = 645
-	opStructFieldNPtrHeadArray                              opType = 646
-	opStructFieldNPtrHeadArrayOnly                          opType = 647
-	opStructFieldNPtrHeadOmitEmptyArray                     opType = 648
-	opStructFieldNPtrHeadOmitEmptyArrayOnly                 opType = 649
-	opStructFieldNPtrHeadStringTagArray                     opType = 650
-	opStructFieldNPtrHeadStringTagArrayOnly                 opType = 651
-	opStructFieldNPtrAnonymousHeadArray                     opType = 652
-	opStructFieldNPtrAnonymousHeadArrayOnly                 opType = 653
-	opStructFieldNPtrAnonymousHeadOmitEmptyArray            opType = 654
-	opStructFieldNPtrAnonymousHeadOmitEmptyArrayOnly        opType = 655
-	opStructFieldNPtrAnonymousHeadStringTagArray            opType = 656
-	opStructFieldNPtrAnonymousHeadStringTagArrayOnly        opType = 657
-	opStructFieldHeadMap                                    opType = 658
-	opStructFieldHeadMapOnly                                opType = 659
-	opStructFieldHeadOmitEmptyMap                           opType = 660
-	opStructFieldHeadOmitEmptyMapOnly                       opType = 661
-	opStructFieldHeadStringTagMap                           opType = 662
-	opStructFieldHeadStringTagMapOnly                       opType = 663
-	opStructFieldAnonymousHeadMap                           opType = 664
-	opStructFieldAnonymousHeadMapOnly                       opType = 665
-	opStructFieldAnonymousHeadOmitEmptyMap                  opType = 666
-	opStructFieldAnonymousHeadOmitEmptyMapOnly              opType = 667
-	opStructFieldAnonymousHeadStringTagMap                  opType = 668
-	opStructFieldAnonymousHeadStringTagMapOnly              opType = 669
-	opStructFieldPtrHeadMap                                 opType = 670
-	opStructFieldPtrHeadMapOnly                             opType = 671
-	opStructFieldPtrHeadOmitEmptyMap                        opType = 672
-	opStructFieldPtrHeadOmitEmptyMapOnly                    opType = 673
-	opStructFieldPtrHeadStringTagMap                        opType = 674
-	opStructFieldPtrHeadStringTagMapOnly                    opType = 675
-	opStructFieldPtrAnonymousHeadMap                        opType = 676
-	opStructFieldPtrAnonymousHeadMapOnly                    opType = 677
-	opStructFieldPtrAnonymousHeadOmitEmptyMap               opType = 678
-	opStructFieldPtrAnonymousHeadOmitEmptyMapOnly           opType = 679
-	opStructFieldPtrAnonymousHeadStringTagMap               opType = 680
-	opStructFieldPtrAnonymousHeadStringTagMapOnly           opType = 681
-	opStructFieldNPtrHeadMap                                opType = 682
-	opStructFieldNPtrHeadMapOnly                            opType = 683
-	opStructFieldNPtrHeadOmitEmptyMap                       opType = 684
-	opStructFieldNPtrHeadOmitEmptyMapOnly                   opType = 685
-	opStructFieldNPtrHeadStringTagMap                       opType = 686
-	opStructFieldNPtrHeadStringTagMapOnly                   opType = 687
-	opStructFieldNPtrAnonymousHeadMap                       opType = 688
-	opStructFieldNPtrAnonymousHeadMapOnly                   opType = 689
-	opStructFieldNPtrAnonymousHeadOmitEmptyMap              opType = 690
-	opStructFieldNPtrAnonymousHeadOmitEmptyMapOnly          opType = 691
-	opStructFieldNPtrAnonymousHeadStringTagMap              opType = 692
-	opStructFieldNPtrAnonymousHeadStringTagMapOnly          opType = 693
-	opStructFieldHeadMapLoad                                opType = 694
-	opStructFieldHeadMapLoadOnly                            opType = 695
-	opStructFieldHeadOmitEmptyMapLoad                       opType = 696
-	opStructFieldHeadOmitEmptyMapLoadOnly                   opType = 697
-	opStructFieldHeadStringTagMapLoad                       opType = 698
-	opStructFieldHeadStringTagMapLoadOnly                   opType = 699
-	opStructFieldAnonymousHeadMapLoad                       opType = 700
-	opStructFieldAnonymousHeadMapLoadOnly                   opType = 701
-	opStructFieldAnonymousHeadOmitEmptyMapLoad              opType = 702
-	opStructFieldAnonymousHeadOmitEmptyMapLoadOnly          opType = 703
-	opStructFieldAnonymousHeadStringTagMapLoad              opType = 704
-	opStructFieldAnonymousHeadStringTagMapLoadOnly          opType = 705
-	opStructFieldPtrHeadMapLoad                             opType = 706
-	opStructFieldPtrHeadMapLoadOnly                         opType = 707
-	opStructFieldPtrHeadOmitEmptyMapLoad                    opType = 708
-	opStructFieldPtrHeadOmitEmptyMapLoadOnly                opType = 709
-	opStructFieldPtrHeadStringTagMapLoad                    opType = 710
-	opStructFieldPtrHeadStringTagMapLoadOnly                opType = 711
-	opStructFieldPtrAnonymousHeadMapLoad                    opType = 712
-	opStructFieldPtrAnonymousHeadMapLoadOnly                opType = 713
-	opStructFieldPtrAnonymousHeadOmitEmptyMapLoad           opType = 714
-	opStructFieldPtrAnonymousHeadOmitEmptyMapLoadOnly       opType = 715
-	opStructFieldPtrAnonymousHeadStringTagMapLoad           opType = 716
-	opStructFieldPtrAnonymousHeadStringTagMapLoadOnly       opType = 717
-	opStructFieldNPtrHeadMapLoad                            opType = 718
-	opStructFieldNPtrHeadMapLoadOnly                        opType = 719
-	opStructFieldNPtrHeadOmitEmptyMapLoad                   opType = 720
-	opStructFieldNPtrHeadOmitEmptyMapLoadOnly               opType = 721
-	opStructFieldNPtrHeadStringTagMapLoad                   opType = 722
-	opStructFieldNPtrHeadStringTagMapLoadOnly               opType = 723
-	opStructFieldNPtrAnonymousHeadMapLoad                   opType = 724
-	opStructFieldNPtrAnonymousHeadMapLoadOnly               opType = 725
-	opStructFieldNPtrAnonymousHeadOmitEmptyMapLoad          opType = 726
-	opStructFieldNPtrAnonymousHeadOmitEmptyMapLoadOnly      opType = 727
-	opStructFieldNPtrAnonymousHeadStringTagMapLoad          opType = 728
-	opStructFieldNPtrAnonymousHeadStringTagMapLoadOnly      opType = 729
-	opStructFieldHeadSlice                                  opType = 730
-	opStructFieldHeadSliceOnly                              opType = 731
-	opStructFieldHeadOmitEmptySlice                         opType = 732
-	opStructFieldHeadOmitEmptySliceOnly                     opType = 733
-	opStructFieldHeadStringTagSlice                         opType = 734
-	opStructFieldHeadStringTagSliceOnly                     opType = 735
-	opStructFieldAnonymousHeadSlice                         opType = 736
-	opStructFieldAnonymousHeadSliceOnly                     opType = 737
-	opStructFieldAnonymousHeadOmitEmptySlice                opType = 738
-	opStructFieldAnonymousHeadOmitEmptySliceOnly            opType = 739
-	opStructFieldAnonymousHeadStringTagSlice                opType = 740
-	opStructFieldAnonymousHeadStringTagSliceOnly            opType = 741
-	opStructFieldPtrHeadSlice                               opType = 742
-	opStructFieldPtrHeadSliceOnly                           opType = 743
-	opStructFieldPtrHeadOmitEmptySlice                      opType = 744
-	opStructFieldPtrHeadOmitEmptySliceOnly                  opType = 745
-	opStructFieldPtrHeadStringTagSlice                      opType = 746
-	opStructFieldPtrHeadStringTagSliceOnly                  opType = 747
-	opStructFieldPtrAnonymousHeadSlice                      opType = 748
-	opStructFieldPtrAnonymousHeadSliceOnly                  opType = 749
-	opStructFieldPtrAnonymousHeadOmitEmptySlice             opType = 750
-	opStructFieldPtrAnonymousHeadOmitEmptySliceOnly         opType = 751
-	opStructFieldPtrAnonymousHeadStringTagSlice             opType = 752
-	opStructFieldPtrAnonymousHeadStringTagSliceOnly         opType = 753
-	opStructFieldNPtrHeadSlice                              opType = 754
-	opStructFieldNPtrHeadSliceOnly                          opType = 755
-	opStructFieldNPtrHeadOmitEmptySlice                     opType = 756
-	opStructFieldNPtrHeadOmitEmptySliceOnly                 opType = 757
-	opStructFieldNPtrHeadStringTagSlice                     opType = 758
-	opStructFieldNPtrHeadStringTagSliceOnly                 opType = 759
-	opStructFieldNPtrAnonymousHeadSlice                     opType = 760
-	opStructFieldNPtrAnonymousHeadSliceOnly                 opType = 761
-	opStructFieldNPtrAnonymousHeadOmitEmptySlice            opType = 762
-	opStructFieldNPtrAnonymousHeadOmitEmptySliceOnly        opType = 763
-	opStructFieldNPtrAnonymousHeadStringTagSlice            opType = 764
-	opStructFieldNPtrAnonymousHeadStringTagSliceOnly        opType = 765
-	opStructFieldHeadStruct                                 opType = 766
-	opStructFieldHeadStructOnly                             opType = 767
-	opStructFieldHeadOmitEmptyStruct                        opType = 768
-	opStructFieldHeadOmitEmptyStructOnly                    opType = 769
-	opStructFieldHeadStringTagStruct                        opType = 770
-	opStructFieldHeadStringTagStructOnly                    opType = 771
-	opStructFieldAnonymousHeadStruct                        opType = 772
-	opStructFieldAnonymousHeadStructOnly                    opType = 773
-	opStructFieldAnonymousHeadOmitEmptyStruct               opType = 774
-	opStructFieldAnonymousHeadOmitEmptyStructOnly           opType = 775
-	opStructFieldAnonymousHeadStringTagStruct               opType = 776
-	opStructFieldAnonymousHeadStringTagStructOnly           opType = 777
-	opStructFieldPtrHeadStruct                              opType = 778
-	opStructFieldPtrHeadStructOnly                          opType = 779
-	opStructFieldPtrHeadOmitEmptyStruct                     opType = 780
-	opStructFieldPtrHeadOmitEmptyStructOnly                 opType = 781
-	opStructFieldPtrHeadStringTagStruct                     opType = 782
-	opStructFieldPtrHeadStringTagStructOnly                 opType = 783
-	opStructFieldPtrAnonymousHeadStruct                     opType = 784
-	opStructFieldPtrAnonymousHeadStructOnly                 opType = 785
-	opStructFieldPtrAnonymousHeadOmitEmptyStruct            opType = 786
-	opStructFieldPtrAnonymousHeadOmitEmptyStructOnly        opType = 787
-	opStructFieldPtrAnonymousHeadStringTagStruct            opType = 788
-	opStructFieldPtrAnonymousHeadStringTagStructOnly        opType = 789
-	opStructFieldNPtrHeadStruct                             opType = 790
-	opStructFieldNPtrHeadStructOnly                         opType = 791
-	opStructFieldNPtrHeadOmitEmptyStruct                    opType = 792
-	opStructFieldNPtrHeadOmitEmptyStructOnly                opType = 793
-	opStructFieldNPtrHeadStringTagStruct                    opType = 794
-	opStructFieldNPtrHeadStringTagStructOnly                opType = 795
-	opStructFieldNPtrAnonymousHeadStruct                    opType = 796
-	opStructFieldNPtrAnonymousHeadStructOnly                opType = 797
-	opStructFieldNPtrAnonymousHeadOmitEmptyStruct           opType = 798
-	opStructFieldNPtrAnonymousHeadOmitEmptyStructOnly       opType = 799
-	opStructFieldNPtrAnonymousHeadStringTagStruct           opType = 800
-	opStructFieldNPtrAnonymousHeadStringTagStructOnly       opType = 801
-	opStructFieldHeadMarshalJSON                            opType = 802
-	opStructFieldHeadMarshalJSONOnly                        opType = 803
-	opStructFieldHeadOmitEmptyMarshalJSON                   opType = 804
-	opStructFieldHeadOmitEmptyMarshalJSONOnly               opType = 805
-	opStructFieldHeadStringTagMarshalJSON                   opType = 806
-	opStructFieldHeadStringTagMarshalJSONOnly               opType = 807
-	opStructFieldAnonymousHeadMarshalJSON                   opType = 808
-	opStructFieldAnonymousHeadMarshalJSONOnly               opType = 809
-	opStructFieldAnonymousHeadOmitEmptyMarshalJSON          opType = 810
-	opStructFieldAnonymousHeadOmitEmptyMarshalJSONOnly      opType = 811
-	opStructFieldAnonymousHeadStringTagMarshalJSON          opType = 812
-	opStructFieldAnonymousHeadStringTagMarshalJSONOnly      opType = 813
-	opStructFieldPtrHeadMarshalJSON                         opType = 814
-	opStructFieldPtrHeadMarshalJSONOnly                     opType = 815
-	opStructFieldPtrHeadOmitEmptyMarshalJSON                opType = 816
-	opStructFieldPtrHeadOmitEmptyMarshalJSONOnly            opType = 817
-	opStructFieldPtrHeadStringTagMarshalJSON                opType = 818
-	opStructFieldPtrHeadStringTagMarshalJSONOnly            opType = 819
-	opStructFieldPtrAnonymousHeadMarshalJSON                opType = 820
-	opStructFieldPtrAnonymousHeadMarshalJSONOnly            opType = 821
-	opStructFieldPtrAnonymousHeadOmitEmptyMarshalJSON       opType = 822
-	opStructFieldPtrAnonymousHeadOmitEmptyMarshalJSONOnly   opType = 823
-	opStructFieldPtrAnonymousHeadStringTagMarshalJSON       opType = 824
-	opStructFieldPtrAnonymousHeadStringTagMarshalJSONOnly   opType = 825
-	opStructFieldNPtrHeadMarshalJSON                        opType = 826
-	opStructFieldNPtrHeadMarshalJSONOnly                    opType = 827
-	opStructFieldNPtrHeadOmitEmptyMarshalJSON               opType = 828
-	opStructFieldNPtrHeadOmitEmptyMarshalJSONOnly           opType = 829
-	opStructFieldNPtrHeadStringTagMarshalJSON               opType = 830
-	opStructFieldNPtrHeadStringTagMarshalJSONOnly           opType = 831
-	opStructFieldNPtrAnonymousHeadMarshalJSON               opType = 832
-	opStructFieldNPtrAnonymousHeadMarshalJSONOnly           opType = 833
-	opStructFieldNPtrAnonymousHeadOmitEmptyMarshalJSON      opType = 834
-	opStructFieldNPtrAnonymousHeadOmitEmptyMarshalJSONOnly  opType = 835
-	opStructFieldNPtrAnonymousHeadStringTagMarshalJSON      opType = 836
-	opStructFieldNPtrAnonymousHeadStringTagMarshalJSONOnly  opType = 837
-	opStructFieldHeadMarshalText                            opType = 838
-	opStructFieldHeadMarshalTextOnly                        opType = 839
-	opStructFieldHeadOmitEmptyMarshalText                   opType = 840
-	opStructFieldHeadOmitEmptyMarshalTextOnly               opType = 841
-	opStructFieldHeadStringTagMarshalText                   opType = 842
-	opStructFieldHeadStringTagMarshalTextOnly               opType = 843
-	opStructFieldAnonymousHeadMarshalText                   opType = 844
-	opStructFieldAnonymousHeadMarshalTextOnly               opType = 845
-	opStructFieldAnonymousHeadOmitEmptyMarshalText          opType = 846
-	opStructFieldAnonymousHeadOmitEmptyMarshalTextOnly      opType = 847
-	opStructFieldAnonymousHeadStringTagMarshalText          opType = 848
-	opStructFieldAnonymousHeadStringTagMarshalTextOnly      opType = 849
-	opStructFieldPtrHeadMarshalText                         opType = 850
-	opStructFieldPtrHeadMarshalTextOnly                     opType = 851
-	opStructFieldPtrHeadOmitEmptyMarshalText                opType = 852
-	opStructFieldPtrHeadOmitEmptyMarshalTextOnly            opType = 853
-	opStructFieldPtrHeadStringTagMarshalText                opType = 854
-	opStructFieldPtrHeadStringTagMarshalTextOnly            opType = 855
-	opStructFieldPtrAnonymousHeadMarshalText                opType = 856
-	opStructFieldPtrAnonymousHeadMarshalTextOnly            opType = 857
-	opStructFieldPtrAnonymousHeadOmitEmptyMarshalText       opType = 858
-	opStructFieldPtrAnonymousHeadOmitEmptyMarshalTextOnly   opType = 859
-	opStructFieldPtrAnonymousHeadStringTagMarshalText       opType = 860
-	opStructFieldPtrAnonymousHeadStringTagMarshalTextOnly   opType = 861
-	opStructFieldNPtrHeadMarshalText                        opType = 862
-	opStructFieldNPtrHeadMarshalTextOnly                    opType = 863
-	opStructFieldNPtrHeadOmitEmptyMarshalText               opType = 864
-	opStructFieldNPtrHeadOmitEmptyMarshalTextOnly           opType = 865
-	opStructFieldNPtrHeadStringTagMarshalText               opType = 866
-	opStructFieldNPtrHeadStringTagMarshalTextOnly           opType = 867
-	opStructFieldNPtrAnonymousHeadMarshalText               opType = 868
-	opStructFieldNPtrAnonymousHeadMarshalTextOnly           opType = 869
-	opStructFieldNPtrAnonymousHeadOmitEmptyMarshalText      opType = 870
-	opStructFieldNPtrAnonymousHeadOmitEmptyMarshalTextOnly  opType = 871
-	opStructFieldNPtrAnonymousHeadStringTagMarshalText      opType = 872
-	opStructFieldNPtrAnonymousHeadStringTagMarshalTextOnly  opType = 873
-	opStructFieldHeadRecursive                              opType = 874
-	opStructFieldHeadRecursiveOnly                          opType = 875
-	opStructFieldHeadOmitEmptyRecursive                     opType = 876
-	opStructFieldHeadOmitEmptyRecursiveOnly                 opType = 877
-	opStructFieldHeadStringTagRecursive                     opType = 878
-	opStructFieldHeadStringTagRecursiveOnly                 opType = 879
-	opStructFieldAnonymousHeadRecursive                     opType = 880
-	opStructFieldAnonymousHeadRecursiveOnly                 opType = 881
-	opStructFieldAnonymousHeadOmitEmptyRecursive            opType = 882
-	opStructFieldAnonymousHeadOmitEmptyRecursiveOnly        opType = 883
-	opStructFieldAnonymousHeadStringTagRecursive            opType = 884
-	opStructFieldAnonymousHeadStringTagRecursiveOnly        opType = 885
-	opStructFieldPtrHeadRecursive                           opType = 886
-	opStructFieldPtrHeadRecursiveOnly                       opType = 887
-	opStructFieldPtrHeadOmitEmptyRecursive                  opType = 888
-	opStructFieldPtrHeadOmitEmptyRecursiveOnly              opType = 889
-	opStructFieldPtrHeadStringTagRecursive                  opType = 890
-	opStructFieldPtrHeadStringTagRecursiveOnly              opType = 891
-	opStructFieldPtrAnonymousHeadRecursive                  opType = 892
-	opStructFieldPtrAnonymousHeadRecursiveOnly              opType = 893
-	opStructFieldPtrAnonymousHeadOmitEmptyRecursive         opType = 894
-	opStructFieldPtrAnonymousHeadOmitEmptyRecursiveOnly     opType = 895
-	opStructFieldPtrAnonymousHeadStringTagRecursive         opType = 896
-	opStructFieldPtrAnonymousHeadStringTagRecursiveOnly     opType = 897
-	opStructFieldNPtrHeadRecursive                          opType = 898
-	opStructFieldNPtrHeadRecursiveOnly                      opType = 899
-	opStructFieldNPtrHeadOmitEmptyRecursive                 opType = 900
-	opStructFieldNPtrHeadOmitEmptyRecursiveOnly             opType = 901
-	opStructFieldNPtrHeadStringTagRecursive                 opType = 902
-	opStructFieldNPtrHeadStringTagRecursiveOnly             opType = 903
-	opStructFieldNPtrAnonymousHeadRecursive                 opType = 904
-	opStructFieldNPtrAnonymousHeadRecursiveOnly             opType = 905
-	opStructFieldNPtrAnonymousHeadOmitEmptyRecursive        opType = 906
-	opStructFieldNPtrAnonymousHeadOmitEmptyRecursiveOnly    opType = 907
-	opStructFieldNPtrAnonymousHeadStringTagRecursive        opType = 908
-	opStructFieldNPtrAnonymousHeadStringTagRecursiveOnly    opType = 909
-	opStructFieldHeadIntString                              opType = 910
-	opStructFieldHeadIntStringOnly                          opType = 911
-	opStructFieldHeadOmitEmptyIntString                     opType = 912
-	opStructFieldHeadOmitEmptyIntStringOnly                 opType = 913
-	opStructFieldHeadStringTagIntString                     opType = 914
-	opStructFieldHeadStringTagIntStringOnly                 opType = 915
-	opStructFieldAnonymousHeadIntString                     opType = 916
-	opStructFieldAnonymousHeadIntStringOnly                 opType = 917
-	opStructFieldAnonymousHeadOmitEmptyIntString            opType = 918
-	opStructFieldAnonymousHeadOmitEmptyIntStringOnly        opType = 919
-	opStructFieldAnonymousHeadStringTagIntString            opType = 920
-	opStructFieldAnonymousHeadStringTagIntStringOnly        opType = 921
-	opStructFieldPtrHeadIntString                           opType = 922
-	opStructFieldPtrHeadIntStringOnly                       opType = 923
-	opStructFieldPtrHeadOmitEmptyIntString                  opType = 924
-	opStructFieldPtrHeadOmitEmptyIntStringOnly              opType = 925
-	opStructFieldPtrHeadStringTagIntString                  opType = 926
-	opStructFieldPtrHeadStringTagIntStringOnly              opType = 927
-	opStructFieldPtrAnonymousHeadIntString                  opType = 928
-	opStructFieldPtrAnonymousHeadIntStringOnly              opType = 929
-	opStructFieldPtrAnonymousHeadOmitEmptyIntString         opType = 930
-	opStructFieldPtrAnonymousHeadOmitEmptyIntStringOnly     opType = 931
-	opStructFieldPtrAnonymousHeadStringTagIntString         opType = 932
-	opStructFieldPtrAnonymousHeadStringTagIntStringOnly     opType = 933
-	opStructFieldNPtrHeadIntString                          opType = 934
-	opStructFieldNPtrHeadIntStringOnly                      opType = 935
-	opStructFieldNPtrHeadOmitEmptyIntString                 opType = 936
-	opStructFieldNPtrHeadOmitEmptyIntStringOnly             opType = 937
-	opStructFieldNPtrHeadStringTagIntString                 opType = 938
-	opStructFieldNPtrHeadStringTagIntStringOnly             opType = 939
-	opStructFieldNPtrAnonymousHeadIntString                 opType = 940
-	opStructFieldNPtrAnonymousHeadIntStringOnly             opType = 941
-	opStructFieldNPtrAnonymousHeadOmitEmptyIntString        opType = 942
-	opStructFieldNPtrAnonymousHeadOmitEmptyIntStringOnly    opType = 943
-	opStructFieldNPtrAnonymousHeadStringTagIntString        opType = 944
-	opStructFieldNPtrAnonymousHeadStringTagIntStringOnly    opType = 945
-	opStructFieldHeadInt8String                             opType = 946
-	opStructFieldHeadInt8StringOnly                         opType = 947
-	opStructFieldHeadOmitEmptyInt8String                    opType = 948
-	opStructFieldHeadOmitEmptyInt8StringOnly                opType = 949
-	opStructFieldHeadStringTagInt8String                    opType = 950
-	opStructFieldHeadStringTagInt8StringOnly                opType = 951
-	opStructFieldAnonymousHeadInt8String                    opType = 952
-	opStructFieldAnonymousHeadInt8StringOnly                opType = 953
-	opStructFieldAnonymousHeadOmitEmptyInt8String           opType = 954
-	opStructFieldAnonymousHeadOmitEmptyInt8StringOnly       opType = 955
-	opStructFieldAnonymousHeadStringTagInt8String           opType = 956
-	opStructFieldAnonymousHeadStringTagInt8StringOnly       opType = 957
-	opStructFieldPtrHeadInt8String                          opType = 958
-	opStructFieldPtrHeadInt8StringOnly                      opType = 959
-	opStructFieldPtrHeadOmitEmptyInt8String                 opType = 960
-	opStructFieldPtrHeadOmitEmptyInt8StringOnly             opType = 961
-	opStructFieldPtrHeadStringTagInt8String                 opType = 962
-	opStructFieldPtrHeadStringTagInt8StringOnly             opType = 963
-	opStructFieldPtrAnonymousHeadInt8String                 opType = 964
-	opStructFieldPtrAnonymousHeadInt8StringOnly             opType = 965
-	opStructFieldPtrAnonymousHeadOmitEmptyInt8String        opType = 966
-	opStructFieldPtrAnonymousHeadOmitEmptyInt8StringOnly    opType = 967
-	opStructFieldPtrAnonymousHeadStringTagInt8String        opType = 968
-	opStructFieldPtrAnonymousHeadStringTagInt8StringOnly    opType = 969
-	opStructFieldNPtrHeadInt8String                         opType = 970
-	opStructFieldNPtrHeadInt8StringOnly                     opType = 971
-	opStructFieldNPtrHeadOmitEmptyInt8String                opType = 972
-	opStructFieldNPtrHeadOmitEmptyInt8StringOnly            opType = 973
-	opStructFieldNPtrHeadStringTagInt8String                opType = 974
-	opStructFieldNPtrHeadStringTagInt8StringOnly            opType = 975
-	opStructFieldNPtrAnonymousHeadInt8String                opType = 976
-	opStructFieldNPtrAnonymousHeadInt8StringOnly            opType = 977
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt8String       opType = 978
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt8StringOnly   opType = 979
-	opStructFieldNPtrAnonymousHeadStringTagInt8String       opType = 980
-	opStructFieldNPtrAnonymousHeadStringTagInt8StringOnly   opType = 981
-	opStructFieldHeadInt16String                            opType = 982
-	opStructFieldHeadInt16StringOnly                        opType = 983
-	opStructFieldHeadOmitEmptyInt16String                   opType = 984
-	opStructFieldHeadOmitEmptyInt16StringOnly               opType = 985
-	opStructFieldHeadStringTagInt16String                   opType = 986
-	opStructFieldHeadStringTagInt16StringOnly               opType = 987
-	opStructFieldAnonymousHeadInt16String                   opType = 988
-	opStructFieldAnonymousHeadInt16StringOnly               opType = 989
-	opStructFieldAnonymousHeadOmitEmptyInt16String          opType = 990
-	opStructFieldAnonymousHeadOmitEmptyInt16StringOnly      opType = 991
-	opStructFieldAnonymousHeadStringTagInt16String          opType = 992
-	opStructFieldAnonymousHeadStringTagInt16StringOnly      opType = 993
-	opStructFieldPtrHeadInt16String                         opType = 994
-	opStructFieldPtrHeadInt16StringOnly                     opType = 995
-	opStructFieldPtrHeadOmitEmptyInt16String                opType = 996
-	opStructFieldPtrHeadOmitEmptyInt16StringOnly            opType = 997
-	opStructFieldPtrHeadStringTagInt16String                opType = 998
-	opStructFieldPtrHeadStringTagInt16StringOnly            opType = 999
-	opStructFieldPtrAnonymousHeadInt16String                opType = 1000
-	opStructFieldPtrAnonymousHeadInt16StringOnly            opType = 1001
-	opStructFieldPtrAnonymousHeadOmitEmptyInt16String       opType = 1002
-	opStructFieldPtrAnonymousHeadOmitEmptyInt16StringOnly   opType = 1003
-	opStructFieldPtrAnonymousHeadStringTagInt16String       opType = 1004
-	opStructFieldPtrAnonymousHeadStringTagInt16StringOnly   opType = 1005
-	opStructFieldNPtrHeadInt16String                        opType = 1006
-	opStructFieldNPtrHeadInt16StringOnly                    opType = 1007
-	opStructFieldNPtrHeadOmitEmptyInt16String               opType = 1008
-	opStructFieldNPtrHeadOmitEmptyInt16StringOnly           opType = 1009
-	opStructFieldNPtrHeadStringTagInt16String               opType = 1010
-	opStructFieldNPtrHeadStringTagInt16StringOnly           opType = 1011
-	opStructFieldNPtrAnonymousHeadInt16String               opType = 1012
-	opStructFieldNPtrAnonymousHeadInt16StringOnly           opType = 1013
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt16String      opType = 1014
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt16StringOnly  opType = 1015
-	opStructFieldNPtrAnonymousHeadStringTagInt16String      opType = 1016
-	opStructFieldNPtrAnonymousHeadStringTagInt16StringOnly  opType = 1017
-	opStructFieldHeadInt32String                            opType = 1018
-	opStructFieldHeadInt32StringOnly                        opType = 1019
-	opStructFieldHeadOmitEmptyInt32String                   opType = 1020
-	opStructFieldHeadOmitEmptyInt32StringOnly               opType = 1021
-	opStructFieldHeadStringTagInt32String                   opType = 1022
-	opStructFieldHeadStringTagInt32StringOnly               opType = 1023
-	opStructFieldAnonymousHeadInt32String                   opType = 1024
-	opStructFieldAnonymousHeadInt32StringOnly               opType = 1025
-	opStructFieldAnonymousHeadOmitEmptyInt32String          opType = 1026
-	opStructFieldAnonymousHeadOmitEmptyInt32StringOnly      opType = 1027
-	opStructFieldAnonymousHeadStringTagInt32String          opType = 1028
-	opStructFieldAnonymousHeadStringTagInt32StringOnly      opType = 1029
-	opStructFieldPtrHeadInt32String                         opType = 1030
-	opStructFieldPtrHeadInt32StringOnly                     opType = 1031
-	opStructFieldPtrHeadOmitEmptyInt32String                opType = 1032
-	opStructFieldPtrHeadOmitEmptyInt32StringOnly            opType = 1033
-	opStructFieldPtrHeadStringTagInt32String                opType = 1034
-	opStructFieldPtrHeadStringTagInt32StringOnly            opType = 1035
-	opStructFieldPtrAnonymousHeadInt32String                opType = 1036
-	opStructFieldPtrAnonymousHeadInt32StringOnly            opType = 1037
-	opStructFieldPtrAnonymousHeadOmitEmptyInt32String       opType = 1038
-	opStructFieldPtrAnonymousHeadOmitEmptyInt32StringOnly   opType = 1039
-	opStructFieldPtrAnonymousHeadStringTagInt32String       opType = 1040
-	opStructFieldPtrAnonymousHeadStringTagInt32StringOnly   opType = 1041
-	opStructFieldNPtrHeadInt32String                        opType = 1042
-	opStructFieldNPtrHeadInt32StringOnly                    opType = 1043
-	opStructFieldNPtrHeadOmitEmptyInt32String               opType = 1044
-	opStructFieldNPtrHeadOmitEmptyInt32StringOnly           opType = 1045
-	opStructFieldNPtrHeadStringTagInt32String               opType = 1046
-	opStructFieldNPtrHeadStringTagInt32StringOnly           opType = 1047
-	opStructFieldNPtrAnonymousHeadInt32String               opType = 1048
-	opStructFieldNPtrAnonymousHeadInt32StringOnly           opType = 1049
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt32String      opType = 1050
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt32StringOnly  opType = 1051
-	opStructFieldNPtrAnonymousHeadStringTagInt32String      opType = 1052
-	opStructFieldNPtrAnonymousHeadStringTagInt32StringOnly  opType = 1053
-	opStructFieldHeadInt64String                            opType = 1054
-	opStructFieldHeadInt64StringOnly                        opType = 1055
-	opStructFieldHeadOmitEmptyInt64String                   opType = 1056
-	opStructFieldHeadOmitEmptyInt64StringOnly               opType = 1057
-	opStructFieldHeadStringTagInt64String                   opType = 1058
-	opStructFieldHeadStringTagInt64StringOnly               opType = 1059
-	opStructFieldAnonymousHeadInt64String                   opType = 1060
-	opStructFieldAnonymousHeadInt64StringOnly               opType = 1061
-	opStructFieldAnonymousHeadOmitEmptyInt64String          opType = 1062
-	opStructFieldAnonymousHeadOmitEmptyInt64StringOnly      opType = 1063
-	opStructFieldAnonymousHeadStringTagInt64String          opType = 1064
-	opStructFieldAnonymousHeadStringTagInt64StringOnly      opType = 1065
-	opStructFieldPtrHeadInt64String                         opType = 1066
-	opStructFieldPtrHeadInt64StringOnly                     opType = 1067
-	opStructFieldPtrHeadOmitEmptyInt64String                opType = 1068
-	opStructFieldPtrHeadOmitEmptyInt64StringOnly            opType = 1069
-	opStructFieldPtrHeadStringTagInt64String                opType = 1070
-	opStructFieldPtrHeadStringTagInt64StringOnly            opType = 1071
-	opStructFieldPtrAnonymousHeadInt64String                opType = 1072
-	opStructFieldPtrAnonymousHeadInt64StringOnly            opType = 1073
-	opStructFieldPtrAnonymousHeadOmitEmptyInt64String       opType = 1074
-	opStructFieldPtrAnonymousHeadOmitEmptyInt64StringOnly   opType = 1075
-	opStructFieldPtrAnonymousHeadStringTagInt64String       opType = 1076
-	opStructFieldPtrAnonymousHeadStringTagInt64StringOnly   opType = 1077
-	opStructFieldNPtrHeadInt64String                        opType = 1078
-	opStructFieldNPtrHeadInt64StringOnly                    opType = 1079
-	opStructFieldNPtrHeadOmitEmptyInt64String               opType = 1080
-	opStructFieldNPtrHeadOmitEmptyInt64StringOnly           opType = 1081
-	opStructFieldNPtrHeadStringTagInt64String               opType = 1082
-	opStructFieldNPtrHeadStringTagInt64StringOnly           opType = 1083
-	opStructFieldNPtrAnonymousHeadInt64String               opType = 1084
-	opStructFieldNPtrAnonymousHeadInt64StringOnly           opType = 1085
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt64String      opType = 1086
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt64StringOnly  opType = 1087
-	opStructFieldNPtrAnonymousHeadStringTagInt64String      opType = 1088
-	opStructFieldNPtrAnonymousHeadStringTagInt64StringOnly  opType = 1089
-	opStructFieldHeadUintString                             opType = 1090
-	opStructFieldHeadUintStringOnly                         opType = 1091
-	opStructFieldHeadOmitEmptyUintString                    opType = 1092
-	opStructFieldHeadOmitEmptyUintStringOnly                opType = 1093
-	opStructFieldHeadStringTagUintString                    opType = 1094
-	opStructFieldHeadStringTagUintStringOnly                opType = 1095
-	opStructFieldAnonymousHeadUintString                    opType = 1096
-	opStructFieldAnonymousHeadUintStringOnly                opType = 1097
-	opStructFieldAnonymousHeadOmitEmptyUintString           opType = 1098
-	opStructFieldAnonymousHeadOmitEmptyUintStringOnly       opType = 1099
-	opStructFieldAnonymousHeadStringTagUintString           opType = 1100
-	opStructFieldAnonymousHeadStringTagUintStringOnly       opType = 1101
-	opStructFieldPtrHeadUintString                          opType = 1102
-	opStructFieldPtrHeadUintStringOnly                      opType = 1103
-	opStructFieldPtrHeadOmitEmptyUintString                 opType = 1104
-	opStructFieldPtrHeadOmitEmptyUintStringOnly             opType = 1105
-	opStructFieldPtrHeadStringTagUintString                 opType = 1106
-	opStructFieldPtrHeadStringTagUintStringOnly             opType = 1107
-	opStructFieldPtrAnonymousHeadUintString                 opType = 1108
-	opStructFieldPtrAnonymousHeadUintStringOnly             opType = 1109
-	opStructFieldPtrAnonymousHeadOmitEmptyUintString        opType = 1110
-	opStructFieldPtrAnonymousHeadOmitEmptyUintStringOnly    opType = 1111
-	opStructFieldPtrAnonymousHeadStringTagUintString        opType = 1112
-	opStructFieldPtrAnonymousHeadStringTagUintStringOnly    opType = 1113
-	opStructFieldNPtrHeadUintString                         opType = 1114
-	opStructFieldNPtrHeadUintStringOnly                     opType = 1115
-	opStructFieldNPtrHeadOmitEmptyUintString                opType = 1116
-	opStructFieldNPtrHeadOmitEmptyUintStringOnly            opType = 1117
-	opStructFieldNPtrHeadStringTagUintString                opType = 1118
-	opStructFieldNPtrHeadStringTagUintStringOnly            opType = 1119
-	opStructFieldNPtrAnonymousHeadUintString                opType = 1120
-	opStructFieldNPtrAnonymousHeadUintStringOnly            opType = 1121
-	opStructFieldNPtrAnonymousHeadOmitEmptyUintString       opType = 1122
-	opStructFieldNPtrAnonymousHeadOmitEmptyUintStringOnly   opType = 1123
-	opStructFieldNPtrAnonymousHeadStringTagUintString       opType = 1124
-	opStructFieldNPtrAnonymousHeadStringTagUintStringOnly   opType = 1125
-	opStructFieldHeadUint8String                            opType = 1126
-	opStructFieldHeadUint8StringOnly                        opType = 1127
-	opStructFieldHeadOmitEmptyUint8String                   opType = 1128
-	opStructFieldHeadOmitEmptyUint8StringOnly               opType = 1129
-	opStructFieldHeadStringTagUint8String                   opType = 1130
-	opStructFieldHeadStringTagUint8StringOnly               opType = 1131
-	opStructFieldAnonymousHeadUint8String                   opType = 1132
-	opStructFieldAnonymousHeadUint8StringOnly               opType = 1133
-	opStructFieldAnonymousHeadOmitEmptyUint8String          opType = 1134
-	opStructFieldAnonymousHeadOmitEmptyUint8StringOnly      opType = 1135
-	opStructFieldAnonymousHeadStringTagUint8String          opType = 1136
-	opStructFieldAnonymousHeadStringTagUint8StringOnly      opType = 1137
-	opStructFieldPtrHeadUint8String                         opType = 1138
-	opStructFieldPtrHeadUint8StringOnly                     opType = 1139
-	opStructFieldPtrHeadOmitEmptyUint8String                opType = 1140
-	opStructFieldPtrHeadOmitEmptyUint8StringOnly            opType = 1141
-	opStructFieldPtrHeadStringTagUint8String                opType = 1142
-	opStructFieldPtrHeadStringTagUint8StringOnly            opType = 1143
-	opStructFieldPtrAnonymousHeadUint8String                opType = 1144
-	opStructFieldPtrAnonymousHeadUint8StringOnly            opType = 1145
-	opStructFieldPtrAnonymousHeadOmitEmptyUint8String       opType = 1146
-	opStructFieldPtrAnonymousHeadOmitEmptyUint8StringOnly   opType = 1147
-	opStructFieldPtrAnonymousHeadStringTagUint8String       opType = 1148
-	opStructFieldPtrAnonymousHeadStringTagUint8StringOnly   opType = 1149
-	opStructFieldNPtrHeadUint8String                        opType = 1150
-	opStructFieldNPtrHeadUint8StringOnly                    opType = 1151
-	opStructFieldNPtrHeadOmitEmptyUint8String               opType = 1152
-	opStructFieldNPtrHeadOmitEmptyUint8StringOnly           opType = 1153
-	opStructFieldNPtrHeadStringTagUint8String               opType = 1154
-	opStructFieldNPtrHeadStringTagUint8StringOnly           opType = 1155
-	opStructFieldNPtrAnonymousHeadUint8String               opType = 1156
-	opStructFieldNPtrAnonymousHeadUint8StringOnly           opType = 1157
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint8String      opType = 1158
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint8StringOnly  opType = 1159
-	opStructFieldNPtrAnonymousHeadStringTagUint8String      opType = 1160
-	opStructFieldNPtrAnonymousHeadStringTagUint8StringOnly  opType = 1161
-	opStructFieldHeadUint16String                           opType = 1162
-	opStructFieldHeadUint16StringOnly                       opType = 1163
-	opStructFieldHeadOmitEmptyUint16String                  opType = 1164
-	opStructFieldHeadOmitEmptyUint16StringOnly              opType = 1165
-	opStructFieldHeadStringTagUint16String                  opType = 1166
-	opStructFieldHeadStringTagUint16StringOnly              opType = 1167
-	opStructFieldAnonymousHeadUint16String                  opType = 1168
-	opStructFieldAnonymousHeadUint16StringOnly              opType = 1169
-	opStructFieldAnonymousHeadOmitEmptyUint16String         opType = 1170
-	opStructFieldAnonymousHeadOmitEmptyUint16StringOnly     opType = 1171
-	opStructFieldAnonymousHeadStringTagUint16String         opType = 1172
-	opStructFieldAnonymousHeadStringTagUint16StringOnly     opType = 1173
-	opStructFieldPtrHeadUint16String                        opType = 1174
-	opStructFieldPtrHeadUint16StringOnly                    opType = 1175
-	opStructFieldPtrHeadOmitEmptyUint16String               opType = 1176
-	opStructFieldPtrHeadOmitEmptyUint16StringOnly           opType = 1177
-	opStructFieldPtrHeadStringTagUint16String               opType = 1178
-	opStructFieldPtrHeadStringTagUint16StringOnly           opType = 1179
-	opStructFieldPtrAnonymousHeadUint16String               opType = 1180
-	opStructFieldPtrAnonymousHeadUint16StringOnly           opType = 1181
-	opStructFieldPtrAnonymousHeadOmitEmptyUint16String      opType = 1182
-	opStructFieldPtrAnonymousHeadOmitEmptyUint16StringOnly  opType = 1183
-	opStructFieldPtrAnonymousHeadStringTagUint16String      opType = 1184
-	opStructFieldPtrAnonymousHeadStringTagUint16StringOnly  opType = 1185
-	opStructFieldNPtrHeadUint16String                       opType = 1186
-	opStructFieldNPtrHeadUint16StringOnly                   opType = 1187
-	opStructFieldNPtrHeadOmitEmptyUint16String              opType = 1188
-	opStructFieldNPtrHeadOmitEmptyUint16StringOnly          opType = 1189
-	opStructFieldNPtrHeadStringTagUint16String              opType = 1190
-	opStructFieldNPtrHeadStringTagUint16StringOnly          opType = 1191
-	opStructFieldNPtrAnonymousHeadUint16String              opType = 1192
-	opStructFieldNPtrAnonymousHeadUint16StringOnly          opType = 1193
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint16String     opType = 1194
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint16StringOnly opType = 1195
-	opStructFieldNPtrAnonymousHeadStringTagUint16String     opType = 1196
-	opStructFieldNPtrAnonymousHeadStringTagUint16StringOnly opType = 1197
-	opStructFieldHeadUint32String                           opType = 1198
-	opStructFieldHeadUint32StringOnly                       opType = 1199
-	opStructFieldHeadOmitEmptyUint32String                  opType = 1200
-	opStructFieldHeadOmitEmptyUint32StringOnly              opType = 1201
-	opStructFieldHeadStringTagUint32String                  opType = 1202
-	opStructFieldHeadStringTagUint32StringOnly              opType = 1203
-	opStructFieldAnonymousHeadUint32String                  opType = 1204
-	opStructFieldAnonymousHeadUint32StringOnly              opType = 1205
-	opStructFieldAnonymousHeadOmitEmptyUint32String         opType = 1206
-	opStructFieldAnonymousHeadOmitEmptyUint32StringOnly     opType = 1207
-	opStructFieldAnonymousHeadStringTagUint32String         opType = 1208
-	opStructFieldAnonymousHeadStringTagUint32StringOnly     opType = 1209
-	opStructFieldPtrHeadUint32String                        opType = 1210
-	opStructFieldPtrHeadUint32StringOnly                    opType = 1211
-	opStructFieldPtrHeadOmitEmptyUint32String               opType = 1212
-	opStructFieldPtrHeadOmitEmptyUint32StringOnly           opType = 1213
-	opStructFieldPtrHeadStringTagUint32String               opType = 1214
-	opStructFieldPtrHeadStringTagUint32StringOnly           opType = 1215
-	opStructFieldPtrAnonymousHeadUint32String               opType = 1216
-	opStructFieldPtrAnonymousHeadUint32StringOnly           opType = 1217
-	opStructFieldPtrAnonymousHeadOmitEmptyUint32String      opType = 1218
-	opStructFieldPtrAnonymousHeadOmitEmptyUint32StringOnly  opType = 1219
-	opStructFieldPtrAnonymousHeadStringTagUint32String      opType = 1220
-	opStructFieldPtrAnonymousHeadStringTagUint32StringOnly  opType = 1221
-	opStructFieldNPtrHeadUint32String                       opType = 1222
-	opStructFieldNPtrHeadUint32StringOnly                   opType = 1223
-	opStructFieldNPtrHeadOmitEmptyUint32String              opType = 1224
-	opStructFieldNPtrHeadOmitEmptyUint32StringOnly          opType = 1225
-	opStructFieldNPtrHeadStringTagUint32String              opType = 1226
-	opStructFieldNPtrHeadStringTagUint32StringOnly          opType = 1227
-	opStructFieldNPtrAnonymousHeadUint32String              opType = 1228
-	opStructFieldNPtrAnonymousHeadUint32StringOnly          opType = 1229
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint32String     opType = 1230
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint32StringOnly opType = 1231
-	opStructFieldNPtrAnonymousHeadStringTagUint32String     opType = 1232
-	opStructFieldNPtrAnonymousHeadStringTagUint32StringOnly opType = 1233
-	opStructFieldHeadUint64String                           opType = 1234
-	opStructFieldHeadUint64StringOnly                       opType = 1235
-	opStructFieldHeadOmitEmptyUint64String                  opType = 1236
-	opStructFieldHeadOmitEmptyUint64StringOnly              opType = 1237
-	opStructFieldHeadStringTagUint64String                  opType = 1238
-	opStructFieldHeadStringTagUint64StringOnly              opType = 1239
-	opStructFieldAnonymousHeadUint64String                  opType = 1240
-	opStructFieldAnonymousHeadUint64StringOnly              opType = 1241
-	opStructFieldAnonymousHeadOmitEmptyUint64String         opType = 1242
-	opStructFieldAnonymousHeadOmitEmptyUint64StringOnly     opType = 1243
-	opStructFieldAnonymousHeadStringTagUint64String         opType = 1244
-	opStructFieldAnonymousHeadStringTagUint64StringOnly     opType = 1245
-	opStructFieldPtrHeadUint64String                        opType = 1246
-	opStructFieldPtrHeadUint64StringOnly                    opType = 1247
-	opStructFieldPtrHeadOmitEmptyUint64String               opType = 1248
-	opStructFieldPtrHeadOmitEmptyUint64StringOnly           opType = 1249
-	opStructFieldPtrHeadStringTagUint64String               opType = 1250
-	opStructFieldPtrHeadStringTagUint64StringOnly           opType = 1251
-	opStructFieldPtrAnonymousHeadUint64String               opType = 1252
-	opStructFieldPtrAnonymousHeadUint64StringOnly           opType = 1253
-	opStructFieldPtrAnonymousHeadOmitEmptyUint64String      opType = 1254
-	opStructFieldPtrAnonymousHeadOmitEmptyUint64StringOnly  opType = 1255
-	opStructFieldPtrAnonymousHeadStringTagUint64String      opType = 1256
-	opStructFieldPtrAnonymousHeadStringTagUint64StringOnly  opType = 1257
-	opStructFieldNPtrHeadUint64String                       opType = 1258
-	opStructFieldNPtrHeadUint64StringOnly                   opType = 1259
-	opStructFieldNPtrHeadOmitEmptyUint64String              opType = 1260
-	opStructFieldNPtrHeadOmitEmptyUint64StringOnly          opType = 1261
-	opStructFieldNPtrHeadStringTagUint64String              opType = 1262
-	opStructFieldNPtrHeadStringTagUint64StringOnly          opType = 1263
-	opStructFieldNPtrAnonymousHeadUint64String              opType = 1264
-	opStructFieldNPtrAnonymousHeadUint64StringOnly          opType = 1265
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint64String     opType = 1266
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint64StringOnly opType = 1267
-	opStructFieldNPtrAnonymousHeadStringTagUint64String     opType = 1268
-	opStructFieldNPtrAnonymousHeadStringTagUint64StringOnly opType = 1269
-	opStructFieldHeadIntPtr                                 opType = 1270
-	opStructFieldHeadIntPtrOnly                             opType = 1271
-	opStructFieldHeadOmitEmptyIntPtr                        opType = 1272
-	opStructFieldHeadOmitEmptyIntPtrOnly                    opType = 1273
-	opStructFieldHeadStringTagIntPtr                        opType = 1274
-	opStructFieldHeadStringTagIntPtrOnly                    opType = 1275
-	opStructFieldAnonymousHeadIntPtr                        opType = 1276
-	opStructFieldAnonymousHeadIntPtrOnly                    opType = 1277
-	opStructFieldAnonymousHeadOmitEmptyIntPtr               opType = 1278
-	opStructFieldAnonymousHeadOmitEmptyIntPtrOnly           opType = 1279
-	opStructFieldAnonymousHeadStringTagIntPtr               opType = 1280
-	opStructFieldAnonymousHeadStringTagIntPtrOnly           opType = 1281
-	opStructFieldPtrHeadIntPtr                              opType = 1282
-	opStructFieldPtrHeadIntPtrOnly                          opType = 1283
-	opStructFieldPtrHeadOmitEmptyIntPtr                     opType = 1284
-	opStructFieldPtrHeadOmitEmptyIntPtrOnly                 opType = 1285
-	opStructFieldPtrHeadStringTagIntPtr                     opType = 1286
-	opStructFieldPtrHeadStringTagIntPtrOnly                 opType = 1287
-	opStructFieldPtrAnonymousHeadIntPtr                     opType = 1288
-	opStructFieldPtrAnonymousHeadIntPtrOnly                 opType = 1289
-	opStructFieldPtrAnonymousHeadOmitEmptyIntPtr            opType = 1290
-	opStructFieldPtrAnonymousHeadOmitEmptyIntPtrOnly        opType = 1291
-	opStructFieldPtrAnonymousHeadStringTagIntPtr            opType = 1292
-	opStructFieldPtrAnonymousHeadStringTagIntPtrOnly        opType = 1293
-	opStructFieldNPtrHeadIntPtr                             opType = 1294
-	opStructFieldNPtrHeadIntPtrOnly                         opType = 1295
-	opStructFieldNPtrHeadOmitEmptyIntPtr                    opType = 1296
-	opStructFieldNPtrHeadOmitEmptyIntPtrOnly                opType = 1297
-	opStructFieldNPtrHeadStringTagIntPtr                    opType = 1298
-	opStructFieldNPtrHeadStringTagIntPtrOnly                opType = 1299
-	opStructFieldNPtrAnonymousHeadIntPtr                    opType = 1300
-	opStructFieldNPtrAnonymousHeadIntPtrOnly                opType = 1301
-	opStructFieldNPtrAnonymousHeadOmitEmptyIntPtr           opType = 1302
-	opStructFieldNPtrAnonymousHeadOmitEmptyIntPtrOnly       opType = 1303
-	opStructFieldNPtrAnonymousHeadStringTagIntPtr           opType = 1304
-	opStructFieldNPtrAnonymousHeadStringTagIntPtrOnly       opType = 1305
-	opStructFieldHeadInt8Ptr                                opType = 1306
-	opStructFieldHeadInt8PtrOnly                            opType = 1307
-	opStructFieldHeadOmitEmptyInt8Ptr                       opType = 1308
-	opStructFieldHeadOmitEmptyInt8PtrOnly                   opType = 1309
-	opStructFieldHeadStringTagInt8Ptr                       opType = 1310
-	opStructFieldHeadStringTagInt8PtrOnly                   opType = 1311
-	opStructFieldAnonymousHeadInt8Ptr                       opType = 1312
-	opStructFieldAnonymousHeadInt8PtrOnly                   opType = 1313
-	opStructFieldAnonymousHeadOmitEmptyInt8Ptr              opType = 1314
-	opStructFieldAnonymousHeadOmitEmptyInt8PtrOnly          opType = 1315
-	opStructFieldAnonymousHeadStringTagInt8Ptr              opType = 1316
-	opStructFieldAnonymousHeadStringTagInt8PtrOnly          opType = 1317
-	opStructFieldPtrHeadInt8Ptr                             opType = 1318
-	opStructFieldPtrHeadInt8PtrOnly                         opType = 1319
-	opStructFieldPtrHeadOmitEmptyInt8Ptr                    opType = 1320
-	opStructFieldPtrHeadOmitEmptyInt8PtrOnly                opType = 1321
-	opStructFieldPtrHeadStringTagInt8Ptr                    opType = 1322
-	opStructFieldPtrHeadStringTagInt8PtrOnly                opType = 1323
-	opStructFieldPtrAnonymousHeadInt8Ptr                    opType = 1324
-	opStructFieldPtrAnonymousHeadInt8PtrOnly                opType = 1325
-	opStructFieldPtrAnonymousHeadOmitEmptyInt8Ptr           opType = 1326
-	opStructFieldPtrAnonymousHeadOmitEmptyInt8PtrOnly       opType = 1327
-	opStructFieldPtrAnonymousHeadStringTagInt8Ptr           opType = 1328
-	opStructFieldPtrAnonymousHeadStringTagInt8PtrOnly       opType = 1329
-	opStructFieldNPtrHeadInt8Ptr                            opType = 1330
-	opStructFieldNPtrHeadInt8PtrOnly                        opType = 1331
-	opStructFieldNPtrHeadOmitEmptyInt8Ptr                   opType = 1332
-	opStructFieldNPtrHeadOmitEmptyInt8PtrOnly               opType = 1333
-	opStructFieldNPtrHeadStringTagInt8Ptr                   opType = 1334
-	opStructFieldNPtrHeadStringTagInt8PtrOnly               opType = 1335
-	opStructFieldNPtrAnonymousHeadInt8Ptr                   opType = 1336
-	opStructFieldNPtrAnonymousHeadInt8PtrOnly               opType = 1337
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt8Ptr          opType = 1338
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt8PtrOnly      opType = 1339
-	opStructFieldNPtrAnonymousHeadStringTagInt8Ptr          opType = 1340
-	opStructFieldNPtrAnonymousHeadStringTagInt8PtrOnly      opType = 1341
-	opStructFieldHeadInt16Ptr                               opType = 1342
-	opStructFieldHeadInt16PtrOnly                           opType = 1343
-	opStructFieldHeadOmitEmptyInt16Ptr                      opType = 1344
-	opStructFieldHeadOmitEmptyInt16PtrOnly                  opType = 1345
-	opStructFieldHeadStringTagInt16Ptr                      opType = 1346
-	opStructFieldHeadStringTagInt16PtrOnly                  opType = 1347
-	opStructFieldAnonymousHeadInt16Ptr                      opType = 1348
-	opStructFieldAnonymousHeadInt16PtrOnly                  opType = 1349
-	opStructFieldAnonymousHeadOmitEmptyInt16Ptr             opType = 1350
-	opStructFieldAnonymousHeadOmitEmptyInt16PtrOnly         opType = 1351
-	opStructFieldAnonymousHeadStringTagInt16Ptr             opType = 1352
-	opStructFieldAnonymousHeadStringTagInt16PtrOnly         opType = 1353
-	opStructFieldPtrHeadInt16Ptr                            opType = 1354
-	opStructFieldPtrHeadInt16PtrOnly                        opType = 1355
-	opStructFieldPtrHeadOmitEmptyInt16Ptr                   opType = 1356
-	opStructFieldPtrHeadOmitEmptyInt16PtrOnly               opType = 1357
-	opStructFieldPtrHeadStringTagInt16Ptr                   opType = 1358
-	opStructFieldPtrHeadStringTagInt16PtrOnly               opType = 1359
-	opStructFieldPtrAnonymousHeadInt16Ptr                   opType = 1360
-	opStructFieldPtrAnonymousHeadInt16PtrOnly               opType = 1361
-	opStructFieldPtrAnonymousHeadOmitEmptyInt16Ptr          opType = 1362
-	opStructFieldPtrAnonymousHeadOmitEmptyInt16PtrOnly      opType = 1363
-	opStructFieldPtrAnonymousHeadStringTagInt16Ptr          opType = 1364
-	opStructFieldPtrAnonymousHeadStringTagInt16PtrOnly      opType = 1365
-	opStructFieldNPtrHeadInt16Ptr                           opType = 1366
-	opStructFieldNPtrHeadInt16PtrOnly                       opType = 1367
-	opStructFieldNPtrHeadOmitEmptyInt16Ptr                  opType = 1368
-	opStructFieldNPtrHeadOmitEmptyInt16PtrOnly              opType = 1369
-	opStructFieldNPtrHeadStringTagInt16Ptr                  opType = 1370
-	opStructFieldNPtrHeadStringTagInt16PtrOnly              opType = 1371
-	opStructFieldNPtrAnonymousHeadInt16Ptr                  opType = 1372
-	opStructFieldNPtrAnonymousHeadInt16PtrOnly              opType = 1373
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt16Ptr         opType = 1374
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt16PtrOnly     opType = 1375
-	opStructFieldNPtrAnonymousHeadStringTagInt16Ptr         opType = 1376
-	opStructFieldNPtrAnonymousHeadStringTagInt16PtrOnly     opType = 1377
-	opStructFieldHeadInt32Ptr                               opType = 1378
-	opStructFieldHeadInt32PtrOnly                           opType = 1379
-	opStructFieldHeadOmitEmptyInt32Ptr                      opType = 1380
-	opStructFieldHeadOmitEmptyInt32PtrOnly                  opType = 1381
-	opStructFieldHeadStringTagInt32Ptr                      opType = 1382
-	opStructFieldHeadStringTagInt32PtrOnly                  opType = 1383
-	opStructFieldAnonymousHeadInt32Ptr                      opType = 1384
-	opStructFieldAnonymousHeadInt32PtrOnly                  opType = 1385
-	opStructFieldAnonymousHeadOmitEmptyInt32Ptr             opType = 1386
-	opStructFieldAnonymousHeadOmitEmptyInt32PtrOnly         opType = 1387
-	opStructFieldAnonymousHeadStringTagInt32Ptr             opType = 1388
-	opStructFieldAnonymousHeadStringTagInt32PtrOnly         opType = 1389
-	opStructFieldPtrHeadInt32Ptr                            opType = 1390
-	opStructFieldPtrHeadInt32PtrOnly                        opType = 1391
-	opStructFieldPtrHeadOmitEmptyInt32Ptr                   opType = 1392
-	opStructFieldPtrHeadOmitEmptyInt32PtrOnly               opType = 1393
-	opStructFieldPtrHeadStringTagInt32Ptr                   opType = 1394
-	opStructFieldPtrHeadStringTagInt32PtrOnly               opType = 1395
-	opStructFieldPtrAnonymousHeadInt32Ptr                   opType = 1396
-	opStructFieldPtrAnonymousHeadInt32PtrOnly               opType = 1397
-	opStructFieldPtrAnonymousHeadOmitEmptyInt32Ptr          opType = 1398
-	opStructFieldPtrAnonymousHeadOmitEmptyInt32PtrOnly      opType = 1399
-	opStructFieldPtrAnonymousHeadStringTagInt32Ptr          opType = 1400
-	opStructFieldPtrAnonymousHeadStringTagInt32PtrOnly      opType = 1401
-	opStructFieldNPtrHeadInt32Ptr                           opType = 1402
-	opStructFieldNPtrHeadInt32PtrOnly                       opType = 1403
-	opStructFieldNPtrHeadOmitEmptyInt32Ptr                  opType = 1404
-	opStructFieldNPtrHeadOmitEmptyInt32PtrOnly              opType = 1405
-	opStructFieldNPtrHeadStringTagInt32Ptr                  opType = 1406
-	opStructFieldNPtrHeadStringTagInt32PtrOnly              opType = 1407
-	opStructFieldNPtrAnonymousHeadInt32Ptr                  opType = 1408
-	opStructFieldNPtrAnonymousHeadInt32PtrOnly              opType = 1409
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt32Ptr         opType = 1410
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt32PtrOnly     opType = 1411
-	opStructFieldNPtrAnonymousHeadStringTagInt32Ptr         opType = 1412
-	opStructFieldNPtrAnonymousHeadStringTagInt32PtrOnly     opType = 1413
-	opStructFieldHeadInt64Ptr                               opType = 1414
-	opStructFieldHeadInt64PtrOnly                           opType = 1415
-	opStructFieldHeadOmitEmptyInt64Ptr                      opType = 1416
-	opStructFieldHeadOmitEmptyInt64PtrOnly                  opType = 1417
-	opStructFieldHeadStringTagInt64Ptr                      opType = 1418
-	opStructFieldHeadStringTagInt64PtrOnly                  opType = 1419
-	opStructFieldAnonymousHeadInt64Ptr                      opType = 1420
-	opStructFieldAnonymousHeadInt64PtrOnly                  opType = 1421
-	opStructFieldAnonymousHeadOmitEmptyInt64Ptr             opType = 1422
-	opStructFieldAnonymousHeadOmitEmptyInt64PtrOnly         opType = 1423
-	opStructFieldAnonymousHeadStringTagInt64Ptr             opType = 1424
-	opStructFieldAnonymousHeadStringTagInt64PtrOnly         opType = 1425
-	opStructFieldPtrHeadInt64Ptr                            opType = 1426
-	opStructFieldPtrHeadInt64PtrOnly                        opType = 1427
-	opStructFieldPtrHeadOmitEmptyInt64Ptr                   opType = 1428
-	opStructFieldPtrHeadOmitEmptyInt64PtrOnly               opType = 1429
-	opStructFieldPtrHeadStringTagInt64Ptr                   opType = 1430
-	opStructFieldPtrHeadStringTagInt64PtrOnly               opType = 1431
-	opStructFieldPtrAnonymousHeadInt64Ptr                   opType = 1432
-	opStructFieldPtrAnonymousHeadInt64PtrOnly               opType = 1433
-	opStructFieldPtrAnonymousHeadOmitEmptyInt64Ptr          opType = 1434
-	opStructFieldPtrAnonymousHeadOmitEmptyInt64PtrOnly      opType = 1435
-	opStructFieldPtrAnonymousHeadStringTagInt64Ptr          opType = 1436
-	opStructFieldPtrAnonymousHeadStringTagInt64PtrOnly      opType = 1437
-	opStructFieldNPtrHeadInt64Ptr                           opType = 1438
-	opStructFieldNPtrHeadInt64PtrOnly                       opType = 1439
-	opStructFieldNPtrHeadOmitEmptyInt64Ptr                  opType = 1440
-	opStructFieldNPtrHeadOmitEmptyInt64PtrOnly              opType = 1441
-	opStructFieldNPtrHeadStringTagInt64Ptr                  opType = 1442
-	opStructFieldNPtrHeadStringTagInt64PtrOnly              opType = 1443
-	opStructFieldNPtrAnonymousHeadInt64Ptr                  opType = 1444
-	opStructFieldNPtrAnonymousHeadInt64PtrOnly              opType = 1445
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt64Ptr         opType = 1446
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt64PtrOnly     opType = 1447
-	opStructFieldNPtrAnonymousHeadStringTagInt64Ptr         opType = 1448
-	opStructFieldNPtrAnonymousHeadStringTagInt64PtrOnly     opType = 1449
-	opStructFieldHeadUintPtr                                opType = 1450
-	opStructFieldHeadUintPtrOnly                            opType = 1451
-	opStructFieldHeadOmitEmptyUintPtr                       opType = 1452
-	opStructFieldHeadOmitEmptyUintPtrOnly                   opType = 1453
-	opStructFieldHeadStringTagUintPtr                       opType = 1454
-	opStructFieldHeadStringTagUintPtrOnly                   opType = 1455
-	opStructFieldAnonymousHeadUintPtr                       opType = 1456
-	opStructFieldAnonymousHeadUintPtrOnly                   opType = 1457
-	opStructFieldAnonymousHeadOmitEmptyUintPtr              opType = 1458
-	opStructFieldAnonymousHeadOmitEmptyUintPtrOnly          opType = 1459
-	opStructFieldAnonymousHeadStringTagUintPtr              opType = 1460
-	opStructFieldAnonymousHeadStringTagUintPtrOnly          opType = 1461
-	opStructFieldPtrHeadUintPtr                             opType = 1462
-	opStructFieldPtrHeadUintPtrOnly                         opType = 1463
-	opStructFieldPtrHeadOmitEmptyUintPtr                    opType = 1464
-	opStructFieldPtrHeadOmitEmptyUintPtrOnly                opType = 1465
-	opStructFieldPtrHeadStringTagUintPtr                    opType = 1466
-	opStructFieldPtrHeadStringTagUintPtrOnly                opType = 1467
-	opStructFieldPtrAnonymousHeadUintPtr                    opType = 1468
-	opStructFieldPtrAnonymousHeadUintPtrOnly                opType = 1469
-	opStructFieldPtrAnonymousHeadOmitEmptyUintPtr           opType = 1470
-	opStructFieldPtrAnonymousHeadOmitEmptyUintPtrOnly       opType = 1471
-	opStructFieldPtrAnonymousHeadStringTagUintPtr           opType = 1472
-	opStructFieldPtrAnonymousHeadStringTagUintPtrOnly       opType = 1473
-	opStructFieldNPtrHeadUintPtr                            opType = 1474
-	opStructFieldNPtrHeadUintPtrOnly                        opType = 1475
-	opStructFieldNPtrHeadOmitEmptyUintPtr                   opType = 1476
-	opStructFieldNPtrHeadOmitEmptyUintPtrOnly               opType = 1477
-	opStructFieldNPtrHeadStringTagUintPtr                   opType = 1478
-	opStructFieldNPtrHeadStringTagUintPtrOnly               opType = 1479
-	opStructFieldNPtrAnonymousHeadUintPtr                   opType = 1480
-	opStructFieldNPtrAnonymousHeadUintPtrOnly               opType = 1481
-	opStructFieldNPtrAnonymousHeadOmitEmptyUintPtr          opType = 1482
-	opStructFieldNPtrAnonymousHeadOmitEmptyUintPtrOnly      opType = 1483
-	opStructFieldNPtrAnonymousHeadStringTagUintPtr          opType = 1484
-	opStructFieldNPtrAnonymousHeadStringTagUintPtrOnly      opType = 1485
-	opStructFieldHeadUint8Ptr                               opType = 1486
-	opStructFieldHeadUint8PtrOnly                           opType = 1487
-	opStructFieldHeadOmitEmptyUint8Ptr                      opType = 1488
-	opStructFieldHeadOmitEmptyUint8PtrOnly                  opType = 1489
-	opStructFieldHeadStringTagUint8Ptr                      opType = 1490
-	opStructFieldHeadStringTagUint8PtrOnly                  opType = 1491
-	opStructFieldAnonymousHeadUint8Ptr                      opType = 1492
-	opStructFieldAnonymousHeadUint8PtrOnly                  opType = 1493
-	opStructFieldAnonymousHeadOmitEmptyUint8Ptr             opType = 1494
-	opStructFieldAnonymousHeadOmitEmptyUint8PtrOnly         opType = 1495
-	opStructFieldAnonymousHeadStringTagUint8Ptr             opType = 1496
-	opStructFieldAnonymousHeadStringTagUint8PtrOnly         opType = 1497
-	opStructFieldPtrHeadUint8Ptr                            opType = 1498
-	opStructFieldPtrHeadUint8PtrOnly                        opType = 1499
-	opStructFieldPtrHeadOmitEmptyUint8Ptr                   opType = 1500
-	opStructFieldPtrHeadOmitEmptyUint8PtrOnly               opType = 1501
-	opStructFieldPtrHeadStringTagUint8Ptr                   opType = 1502
-	opStructFieldPtrHeadStringTagUint8PtrOnly               opType = 1503
-	opStructFieldPtrAnonymousHeadUint8Ptr                   opType = 1504
-	opStructFieldPtrAnonymousHeadUint8PtrOnly               opType = 1505
-	opStructFieldPtrAnonymousHeadOmitEmptyUint8Ptr          opType = 1506
-	opStructFieldPtrAnonymousHeadOmitEmptyUint8PtrOnly      opType = 1507
-	opStructFieldPtrAnonymousHeadStringTagUint8Ptr          opType = 1508
-	opStructFieldPtrAnonymousHeadStringTagUint8PtrOnly      opType = 1509
-	opStructFieldNPtrHeadUint8Ptr                           opType = 1510
-	opStructFieldNPtrHeadUint8PtrOnly                       opType = 1511
-	opStructFieldNPtrHeadOmitEmptyUint8Ptr                  opType = 1512
-	opStructFieldNPtrHeadOmitEmptyUint8PtrOnly              opType = 1513
-	opStructFieldNPtrHeadStringTagUint8Ptr                  opType = 1514
-	opStructFieldNPtrHeadStringTagUint8PtrOnly              opType = 1515
-	opStructFieldNPtrAnonymousHeadUint8Ptr                  opType = 1516
-	opStructFieldNPtrAnonymousHeadUint8PtrOnly              opType = 1517
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint8Ptr         opType = 1518
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint8PtrOnly     opType = 1519
-	opStructFieldNPtrAnonymousHeadStringTagUint8Ptr         opType = 1520
-	opStructFieldNPtrAnonymousHeadStringTagUint8PtrOnly     opType = 1521
-	opStructFieldHeadUint16Ptr                              opType = 1522
-	opStructFieldHeadUint16PtrOnly                          opType = 1523
-	opStructFieldHeadOmitEmptyUint16Ptr                     opType = 1524
-	opStructFieldHeadOmitEmptyUint16PtrOnly                 opType = 1525
-	opStructFieldHeadStringTagUint16Ptr                     opType = 1526
-	opStructFieldHeadStringTagUint16PtrOnly                 opType = 1527
-	opStructFieldAnonymousHeadUint16Ptr                     opType = 1528
-	opStructFieldAnonymousHeadUint16PtrOnly                 opType = 1529
-	opStructFieldAnonymousHeadOmitEmptyUint16Ptr            opType = 1530
-	opStructFieldAnonymousHeadOmitEmptyUint16PtrOnly        opType = 1531
-	opStructFieldAnonymousHeadStringTagUint16Ptr            opType = 1532
-	opStructFieldAnonymousHeadStringTagUint16PtrOnly        opType = 1533
-	opStructFieldPtrHeadUint16Ptr                           opType = 1534
-	opStructFieldPtrHeadUint16PtrOnly                       opType = 1535
-	opStructFieldPtrHeadOmitEmptyUint16Ptr                  opType = 1536
-	opStructFieldPtrHeadOmitEmptyUint16PtrOnly              opType = 1537
-	opStructFieldPtrHeadStringTagUint16Ptr                  opType = 1538
-	opStructFieldPtrHeadStringTagUint16PtrOnly              opType = 1539
-	opStructFieldPtrAnonymousHeadUint16Ptr                  opType = 1540
-	opStructFieldPtrAnonymousHeadUint16PtrOnly              opType = 1541
-	opStructFieldPtrAnonymousHeadOmitEmptyUint16Ptr         opType = 1542
-	opStructFieldPtrAnonymousHeadOmitEmptyUint16PtrOnly     opType = 1543
-	opStructFieldPtrAnonymousHeadStringTagUint16Ptr         opType = 1544
-	opStructFieldPtrAnonymousHeadStringTagUint16PtrOnly     opType = 1545
-	opStructFieldNPtrHeadUint16Ptr                          opType = 1546
-	opStructFieldNPtrHeadUint16PtrOnly                      opType = 1547
-	opStructFieldNPtrHeadOmitEmptyUint16Ptr                 opType = 1548
-	opStructFieldNPtrHeadOmitEmptyUint16PtrOnly             opType = 1549
-	opStructFieldNPtrHeadStringTagUint16Ptr                 opType = 1550
-	opStructFieldNPtrHeadStringTagUint16PtrOnly             opType = 1551
-	opStructFieldNPtrAnonymousHeadUint16Ptr                 opType = 1552
-	opStructFieldNPtrAnonymousHeadUint16PtrOnly             opType = 1553
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint16Ptr        opType = 1554
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint16PtrOnly    opType = 1555
-	opStructFieldNPtrAnonymousHeadStringTagUint16Ptr        opType = 1556
-	opStructFieldNPtrAnonymousHeadStringTagUint16PtrOnly    opType = 1557
-	opStructFieldHeadUint32Ptr                              opType = 1558
-	opStructFieldHeadUint32PtrOnly                          opType = 1559
-	opStructFieldHeadOmitEmptyUint32Ptr                     opType = 1560
-	opStructFieldHeadOmitEmptyUint32PtrOnly                 opType = 1561
-	opStructFieldHeadStringTagUint32Ptr                     opType = 1562
-	opStructFieldHeadStringTagUint32PtrOnly                 opType = 1563
-	opStructFieldAnonymousHeadUint32Ptr                     opType = 1564
-	opStructFieldAnonymousHeadUint32PtrOnly                 opType = 1565
-	opStructFieldAnonymousHeadOmitEmptyUint32Ptr            opType = 1566
-	opStructFieldAnonymousHeadOmitEmptyUint32PtrOnly        opType = 1567
-	opStructFieldAnonymousHeadStringTagUint32Ptr            opType = 1568
-	opStructFieldAnonymousHeadStringTagUint32PtrOnly        opType = 1569
-	opStructFieldPtrHeadUint32Ptr                           opType = 1570
-	opStructFieldPtrHeadUint32PtrOnly                       opType = 1571
-	opStructFieldPtrHeadOmitEmptyUint32Ptr                  opType = 1572
-	opStructFieldPtrHeadOmitEmptyUint32PtrOnly              opType = 1573
-	opStructFieldPtrHeadStringTagUint32Ptr                  opType = 1574
-	opStructFieldPtrHeadStringTagUint32PtrOnly              opType = 1575
-	opStructFieldPtrAnonymousHeadUint32Ptr                  opType = 1576
-	opStructFieldPtrAnonymousHeadUint32PtrOnly              opType = 1577
-	opStructFieldPtrAnonymousHeadOmitEmptyUint32Ptr         opType = 1578
-	opStructFieldPtrAnonymousHeadOmitEmptyUint32PtrOnly     opType = 1579
-	opStructFieldPtrAnonymousHeadStringTagUint32Ptr         opType = 1580
-	opStructFieldPtrAnonymousHeadStringTagUint32PtrOnly     opType = 1581
-	opStructFieldNPtrHeadUint32Ptr                          opType = 1582
-	opStructFieldNPtrHeadUint32PtrOnly                      opType = 1583
-	opStructFieldNPtrHeadOmitEmptyUint32Ptr                 opType = 1584
-	opStructFieldNPtrHeadOmitEmptyUint32PtrOnly             opType = 1585
-	opStructFieldNPtrHeadStringTagUint32Ptr                 opType = 1586
-	opStructFieldNPtrHeadStringTagUint32PtrOnly             opType = 1587
-	opStructFieldNPtrAnonymousHeadUint32Ptr                 opType = 1588
-	opStructFieldNPtrAnonymousHeadUint32PtrOnly             opType = 1589
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint32Ptr        opType = 1590
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint32PtrOnly    opType = 1591
-	opStructFieldNPtrAnonymousHeadStringTagUint32Ptr        opType = 1592
-	opStructFieldNPtrAnonymousHeadStringTagUint32PtrOnly    opType = 1593
-	opStructFieldHeadUint64Ptr                              opType = 1594
-	opStructFieldHeadUint64PtrOnly                          opType = 1595
-	opStructFieldHeadOmitEmptyUint64Ptr                     opType = 1596
-	opStructFieldHeadOmitEmptyUint64PtrOnly                 opType = 1597
-	opStructFieldHeadStringTagUint64Ptr                     opType = 1598
-	opStructFieldHeadStringTagUint64PtrOnly                 opType = 1599
-	opStructFieldAnonymousHeadUint64Ptr                     opType = 1600
-	opStructFieldAnonymousHeadUint64PtrOnly                 opType = 1601
-	opStructFieldAnonymousHeadOmitEmptyUint64Ptr            opType = 1602
-	opStructFieldAnonymousHeadOmitEmptyUint64PtrOnly        opType = 1603
-	opStructFieldAnonymousHeadStringTagUint64Ptr            opType = 1604
-	opStructFieldAnonymousHeadStringTagUint64PtrOnly        opType = 1605
-	opStructFieldPtrHeadUint64Ptr                           opType = 1606
-	opStructFieldPtrHeadUint64PtrOnly                       opType = 1607
-	opStructFieldPtrHeadOmitEmptyUint64Ptr                  opType = 1608
-	opStructFieldPtrHeadOmitEmptyUint64PtrOnly              opType = 1609
-	opStructFieldPtrHeadStringTagUint64Ptr                  opType = 1610
-	opStructFieldPtrHeadStringTagUint64PtrOnly              opType = 1611
-	opStructFieldPtrAnonymousHeadUint64Ptr                  opType = 1612
-	opStructFieldPtrAnonymousHeadUint64PtrOnly              opType = 1613
-	opStructFieldPtrAnonymousHeadOmitEmptyUint64Ptr         opType = 1614
-	opStructFieldPtrAnonymousHeadOmitEmptyUint64PtrOnly     opType = 1615
-	opStructFieldPtrAnonymousHeadStringTagUint64Ptr         opType = 1616
-	opStructFieldPtrAnonymousHeadStringTagUint64PtrOnly     opType = 1617
-	opStructFieldNPtrHeadUint64Ptr                          opType = 1618
-	opStructFieldNPtrHeadUint64PtrOnly                      opType = 1619
-	opStructFieldNPtrHeadOmitEmptyUint64Ptr                 opType = 1620
-	opStructFieldNPtrHeadOmitEmptyUint64PtrOnly             opType = 1621
-	opStructFieldNPtrHeadStringTagUint64Ptr                 opType = 1622
-	opStructFieldNPtrHeadStringTagUint64PtrOnly             opType = 1623
-	opStructFieldNPtrAnonymousHeadUint64Ptr                 opType = 1624
-	opStructFieldNPtrAnonymousHeadUint64PtrOnly             opType = 1625
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint64Ptr        opType = 1626
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint64PtrOnly    opType = 1627
-	opStructFieldNPtrAnonymousHeadStringTagUint64Ptr        opType = 1628
-	opStructFieldNPtrAnonymousHeadStringTagUint64PtrOnly    opType = 1629
-	opStructFieldHeadFloat32Ptr                             opType = 1630
-	opStructFieldHeadFloat32PtrOnly                         opType = 1631
-	opStructFieldHeadOmitEmptyFloat32Ptr                    opType = 1632
-	opStructFieldHeadOmitEmptyFloat32PtrOnly                opType = 1633
-	opStructFieldHeadStringTagFloat32Ptr                    opType = 1634
-	opStructFieldHeadStringTagFloat32PtrOnly                opType = 1635
-	opStructFieldAnonymousHeadFloat32Ptr                    opType = 1636
-	opStructFieldAnonymousHeadFloat32PtrOnly                opType = 1637
-	opStructFieldAnonymousHeadOmitEmptyFloat32Ptr           opType = 1638
-	opStructFieldAnonymousHeadOmitEmptyFloat32PtrOnly       opType = 1639
-	opStructFieldAnonymousHeadStringTagFloat32Ptr           opType = 1640
-	opStructFieldAnonymousHeadStringTagFloat32PtrOnly       opType = 1641
-	opStructFieldPtrHeadFloat32Ptr                          opType = 1642
-	opStructFieldPtrHeadFloat32PtrOnly                      opType = 1643
-	opStructFieldPtrHeadOmitEmptyFloat32Ptr                 opType = 1644
-	opStructFieldPtrHeadOmitEmptyFloat32PtrOnly             opType = 1645
-	opStructFieldPtrHeadStringTagFloat32Ptr                 opType = 1646
-	opStructFieldPtrHeadStringTagFloat32PtrOnly             opType = 1647
-	opStructFieldPtrAnonymousHeadFloat32Ptr                 opType = 1648
-	opStructFieldPtrAnonymousHeadFloat32PtrOnly             opType = 1649
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat32Ptr        opType = 1650
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat32PtrOnly    opType = 1651
-	opStructFieldPtrAnonymousHeadStringTagFloat32Ptr        opType = 1652
-	opStructFieldPtrAnonymousHeadStringTagFloat32PtrOnly    opType = 1653
-	opStructFieldNPtrHeadFloat32Ptr                         opType = 1654
-	opStructFieldNPtrHeadFloat32PtrOnly                     opType = 1655
-	opStructFieldNPtrHeadOmitEmptyFloat32Ptr                opType = 1656
-	opStructFieldNPtrHeadOmitEmptyFloat32PtrOnly            opType = 1657
-	opStructFieldNPtrHeadStringTagFloat32Ptr                opType = 1658
-	opStructFieldNPtrHeadStringTagFloat32PtrOnly            opType = 1659
-	opStructFieldNPtrAnonymousHeadFloat32Ptr                opType = 1660
-	opStructFieldNPtrAnonymousHeadFloat32PtrOnly            opType = 1661
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32Ptr       opType = 1662
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32PtrOnly   opType = 1663
-	opStructFieldNPtrAnonymousHeadStringTagFloat32Ptr       opType = 1664
-	opStructFieldNPtrAnonymousHeadStringTagFloat32PtrOnly   opType = 1665
-	opStructFieldHeadFloat64Ptr                             opType = 1666
-	opStructFieldHeadFloat64PtrOnly                         opType = 1667
-	opStructFieldHeadOmitEmptyFloat64Ptr                    opType = 1668
-	opStructFieldHeadOmitEmptyFloat64PtrOnly                opType = 1669
-	opStructFieldHeadStringTagFloat64Ptr                    opType = 1670
-	opStructFieldHeadStringTagFloat64PtrOnly                opType = 1671
-	opStructFieldAnonymousHeadFloat64Ptr                    opType = 1672
-	opStructFieldAnonymousHeadFloat64PtrOnly                opType = 1673
-	opStructFieldAnonymousHeadOmitEmptyFloat64Ptr           opType = 1674
-	opStructFieldAnonymousHeadOmitEmptyFloat64PtrOnly       opType = 1675
-	opStructFieldAnonymousHeadStringTagFloat64Ptr           opType = 1676
-	opStructFieldAnonymousHeadStringTagFloat64PtrOnly       opType = 1677
-	opStructFieldPtrHeadFloat64Ptr                          opType = 1678
-	opStructFieldPtrHeadFloat64PtrOnly                      opType = 1679
-	opStructFieldPtrHeadOmitEmptyFloat64Ptr                 opType = 1680
-	opStructFieldPtrHeadOmitEmptyFloat64PtrOnly             opType = 1681
-	opStructFieldPtrHeadStringTagFloat64Ptr                 opType = 1682
-	opStructFieldPtrHeadStringTagFloat64PtrOnly             opType = 1683
-	opStructFieldPtrAnonymousHeadFloat64Ptr                 opType = 1684
-	opStructFieldPtrAnonymousHeadFloat64PtrOnly             opType = 1685
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat64Ptr        opType = 1686
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat64PtrOnly    opType = 1687
-	opStructFieldPtrAnonymousHeadStringTagFloat64Ptr        opType = 1688
-	opStructFieldPtrAnonymousHeadStringTagFloat64PtrOnly    opType = 1689
-	opStructFieldNPtrHeadFloat64Ptr                         opType = 1690
-	opStructFieldNPtrHeadFloat64PtrOnly                     opType = 1691
-	opStructFieldNPtrHeadOmitEmptyFloat64Ptr                opType = 1692
-	opStructFieldNPtrHeadOmitEmptyFloat64PtrOnly            opType = 1693
-	opStructFieldNPtrHeadStringTagFloat64Ptr                opType = 1694
-	opStructFieldNPtrHeadStringTagFloat64PtrOnly            opType = 1695
-	opStructFieldNPtrAnonymousHeadFloat64Ptr                opType = 1696
-	opStructFieldNPtrAnonymousHeadFloat64PtrOnly            opType = 1697
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64Ptr       opType = 1698
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64PtrOnly   opType = 1699
-	opStructFieldNPtrAnonymousHeadStringTagFloat64Ptr       opType = 1700
-	opStructFieldNPtrAnonymousHeadStringTagFloat64PtrOnly   opType = 1701
-	opStructFieldHeadBoolPtr                                opType = 1702
-	opStructFieldHeadBoolPtrOnly                            opType = 1703
-	opStructFieldHeadOmitEmptyBoolPtr                       opType = 1704
-	opStructFieldHeadOmitEmptyBoolPtrOnly                   opType = 1705
-	opStructFieldHeadStringTagBoolPtr                       opType = 1706
-	opStructFieldHeadStringTagBoolPtrOnly                   opType = 1707
-	opStructFieldAnonymousHeadBoolPtr                       opType = 1708
-	opStructFieldAnonymousHeadBoolPtrOnly                   opType = 1709
-	opStructFieldAnonymousHeadOmitEmptyBoolPtr              opType = 1710
-	opStructFieldAnonymousHeadOmitEmptyBoolPtrOnly          opType = 1711
-	opStructFieldAnonymousHeadStringTagBoolPtr              opType = 1712
-	opStructFieldAnonymousHeadStringTagBoolPtrOnly          opType = 1713
-	opStructFieldPtrHeadBoolPtr                             opType = 1714
-	opStructFieldPtrHeadBoolPtrOnly                         opType = 1715
-	opStructFieldPtrHeadOmitEmptyBoolPtr                    opType = 1716
-	opStructFieldPtrHeadOmitEmptyBoolPtrOnly                opType = 1717
-	opStructFieldPtrHeadStringTagBoolPtr                    opType = 1718
-	opStructFieldPtrHeadStringTagBoolPtrOnly                opType = 1719
-	opStructFieldPtrAnonymousHeadBoolPtr                    opType = 1720
-	opStructFieldPtrAnonymousHeadBoolPtrOnly                opType = 1721
-	opStructFieldPtrAnonymousHeadOmitEmptyBoolPtr           opType = 1722
-	opStructFieldPtrAnonymousHeadOmitEmptyBoolPtrOnly       opType = 1723
-	opStructFieldPtrAnonymousHeadStringTagBoolPtr           opType = 1724
-	opStructFieldPtrAnonymousHeadStringTagBoolPtrOnly       opType = 1725
-	opStructFieldNPtrHeadBoolPtr                            opType = 1726
-	opStructFieldNPtrHeadBoolPtrOnly                        opType = 1727
-	opStructFieldNPtrHeadOmitEmptyBoolPtr                   opType = 1728
-	opStructFieldNPtrHeadOmitEmptyBoolPtrOnly               opType = 1729
-	opStructFieldNPtrHeadStringTagBoolPtr                   opType = 1730
-	opStructFieldNPtrHeadStringTagBoolPtrOnly               opType = 1731
-	opStructFieldNPtrAnonymousHeadBoolPtr                   opType = 1732
-	opStructFieldNPtrAnonymousHeadBoolPtrOnly               opType = 1733
-	opStructFieldNPtrAnonymousHeadOmitEmptyBoolPtr          opType = 1734
-	opStructFieldNPtrAnonymousHeadOmitEmptyBoolPtrOnly      opType = 1735
-	opStructFieldNPtrAnonymousHeadStringTagBoolPtr          opType = 1736
-	opStructFieldNPtrAnonymousHeadStringTagBoolPtrOnly      opType = 1737
-	opStructFieldHeadStringPtr                              opType = 1738
-	opStructFieldHeadStringPtrOnly                          opType = 1739
-	opStructFieldHeadOmitEmptyStringPtr                     opType = 1740
-	opStructFieldHeadOmitEmptyStringPtrOnly                 opType = 1741
-	opStructFieldHeadStringTagStringPtr                     opType = 1742
-	opStructFieldHeadStringTagStringPtrOnly                 opType = 1743
-	opStructFieldAnonymousHeadStringPtr                     opType = 1744
-	opStructFieldAnonymousHeadStringPtrOnly                 opType = 1745
-	opStructFieldAnonymousHeadOmitEmptyStringPtr            opType = 1746
-	opStructFieldAnonymousHeadOmitEmptyStringPtrOnly        opType = 1747
-	opStructFieldAnonymousHeadStringTagStringPtr            opType = 1748
-	opStructFieldAnonymousHeadStringTagStringPtrOnly        opType = 1749
-	opStructFieldPtrHeadStringPtr                           opType = 1750
-	opStructFieldPtrHeadStringPtrOnly                       opType = 1751
-	opStructFieldPtrHeadOmitEmptyStringPtr                  opType = 1752
-	opStructFieldPtrHeadOmitEmptyStringPtrOnly              opType = 1753
-	opStructFieldPtrHeadStringTagStringPtr                  opType = 1754
-	opStructFieldPtrHeadStringTagStringPtrOnly              opType = 1755
-	opStructFieldPtrAnonymousHeadStringPtr                  opType = 1756
-	opStructFieldPtrAnonymousHeadStringPtrOnly              opType = 1757
-	opStructFieldPtrAnonymousHeadOmitEmptyStringPtr         opType = 1758
-	opStructFieldPtrAnonymousHeadOmitEmptyStringPtrOnly     opType = 1759
-	opStructFieldPtrAnonymousHeadStringTagStringPtr         opType = 1760
-	opStructFieldPtrAnonymousHeadStringTagStringPtrOnly     opType = 1761
-	opStructFieldNPtrHeadStringPtr                          opType = 1762
-	opStructFieldNPtrHeadStringPtrOnly                      opType = 1763
-	opStructFieldNPtrHeadOmitEmptyStringPtr                 opType = 1764
-	opStructFieldNPtrHeadOmitEmptyStringPtrOnly             opType = 1765
-	opStructFieldNPtrHeadStringTagStringPtr                 opType = 1766
-	opStructFieldNPtrHeadStringTagStringPtrOnly             opType = 1767
-	opStructFieldNPtrAnonymousHeadStringPtr                 opType = 1768
-	opStructFieldNPtrAnonymousHeadStringPtrOnly             opType = 1769
-	opStructFieldNPtrAnonymousHeadOmitEmptyStringPtr        opType = 1770
-	opStructFieldNPtrAnonymousHeadOmitEmptyStringPtrOnly    opType = 1771
-	opStructFieldNPtrAnonymousHeadStringTagStringPtr        opType = 1772
-	opStructFieldNPtrAnonymousHeadStringTagStringPtrOnly    opType = 1773
-	opStructFieldHeadBytesPtr                               opType = 1774
-	opStructFieldHeadBytesPtrOnly                           opType = 1775
-	opStructFieldHeadOmitEmptyBytesPtr                      opType = 1776
-	opStructFieldHeadOmitEmptyBytesPtrOnly                  opType = 1777
-	opStructFieldHeadStringTagBytesPtr                      opType = 1778
-	opStructFieldHeadStringTagBytesPtrOnly                  opType = 1779
-	opStructFieldAnonymousHeadBytesPtr                      opType = 1780
-	opStructFieldAnonymousHeadBytesPtrOnly                  opType = 1781
-	opStructFieldAnonymousHeadOmitEmptyBytesPtr             opType = 1782
-	opStructFieldAnonymousHeadOmitEmptyBytesPtrOnly         opType = 1783
-	opStructFieldAnonymousHeadStringTagBytesPtr             opType = 1784
-	opStructFieldAnonymousHeadStringTagBytesPtrOnly         opType = 1785
-	opStructFieldPtrHeadBytesPtr                            opType = 1786
-	opStructFieldPtrHeadBytesPtrOnly                        opType = 1787
-	opStructFieldPtrHeadOmitEmptyBytesPtr                   opType = 1788
-	opStructFieldPtrHeadOmitEmptyBytesPtrOnly               opType = 1789
-	opStructFieldPtrHeadStringTagBytesPtr                   opType = 1790
-	opStructFieldPtrHeadStringTagBytesPtrOnly               opType = 1791
-	opStructFieldPtrAnonymousHeadBytesPtr                   opType = 1792
-	opStructFieldPtrAnonymousHeadBytesPtrOnly               opType = 1793
-	opStructFieldPtrAnonymousHeadOmitEmptyBytesPtr          opType = 1794
-	opStructFieldPtrAnonymousHeadOmitEmptyBytesPtrOnly      opType = 1795
-	opStructFieldPtrAnonymousHeadStringTagBytesPtr          opType = 1796
-	opStructFieldPtrAnonymousHeadStringTagBytesPtrOnly      opType = 1797
-	opStructFieldNPtrHeadBytesPtr                           opType = 1798
-	opStructFieldNPtrHeadBytesPtrOnly                       opType = 1799
-	opStructFieldNPtrHeadOmitEmptyBytesPtr                  opType = 1800
-	opStructFieldNPtrHeadOmitEmptyBytesPtrOnly              opType = 1801
-	opStructFieldNPtrHeadStringTagBytesPtr                  opType = 1802
-	opStructFieldNPtrHeadStringTagBytesPtrOnly              opType = 1803
-	opStructFieldNPtrAnonymousHeadBytesPtr                  opType = 1804
-	opStructFieldNPtrAnonymousHeadBytesPtrOnly              opType = 1805
-	opStructFieldNPtrAnonymousHeadOmitEmptyBytesPtr         opType = 1806
-	opStructFieldNPtrAnonymousHeadOmitEmptyBytesPtrOnly     opType = 1807
-	opStructFieldNPtrAnonymousHeadStringTagBytesPtr         opType = 1808
-	opStructFieldNPtrAnonymousHeadStringTagBytesPtrOnly     opType = 1809
-	opStructFieldHeadIntNPtr                                opType = 1810
-	opStructFieldHeadIntNPtrOnly                            opType = 1811
-	opStructFieldHeadOmitEmptyIntNPtr                       opType = 1812
-	opStructFieldHeadOmitEmptyIntNPtrOnly                   opType = 1813
-	opStructFieldHeadStringTagIntNPtr                       opType = 1814
-	opStructFieldHeadStringTagIntNPtrOnly                   opType = 1815
-	opStructFieldAnonymousHeadIntNPtr                       opType = 1816
-	opStructFieldAnonymousHeadIntNPtrOnly                   opType = 1817
-	opStructFieldAnonymousHeadOmitEmptyIntNPtr              opType = 1818
-	opStructFieldAnonymousHeadOmitEmptyIntNPtrOnly          opType = 1819
-	opStructFieldAnonymousHeadStringTagIntNPtr              opType = 1820
-	opStructFieldAnonymousHeadStringTagIntNPtrOnly          opType = 1821
-	opStructFieldPtrHeadIntNPtr                             opType = 1822
-	opStructFieldPtrHeadIntNPtrOnly                         opType = 1823
-	opStructFieldPtrHeadOmitEmptyIntNPtr                    opType = 1824
-	opStructFieldPtrHeadOmitEmptyIntNPtrOnly                opType = 1825
-	opStructFieldPtrHeadStringTagIntNPtr                    opType = 1826
-	opStructFieldPtrHeadStringTagIntNPtrOnly                opType = 1827
-	opStructFieldPtrAnonymousHeadIntNPtr                    opType = 1828
-	opStructFieldPtrAnonymousHeadIntNPtrOnly                opType = 1829
-	opStructFieldPtrAnonymousHeadOmitEmptyIntNPtr           opType = 1830
-	opStructFieldPtrAnonymousHeadOmitEmptyIntNPtrOnly       opType = 1831
-	opStructFieldPtrAnonymousHeadStringTagIntNPtr           opType = 1832
-	opStructFieldPtrAnonymousHeadStringTagIntNPtrOnly       opType = 1833
-	opStructFieldNPtrHeadIntNPtr                            opType = 1834
-	opStructFieldNPtrHeadIntNPtrOnly                        opType = 1835
-	opStructFieldNPtrHeadOmitEmptyIntNPtr                   opType = 1836
-	opStructFieldNPtrHeadOmitEmptyIntNPtrOnly               opType = 1837
-	opStructFieldNPtrHeadStringTagIntNPtr                   opType = 1838
-	opStructFieldNPtrHeadStringTagIntNPtrOnly               opType = 1839
-	opStructFieldNPtrAnonymousHeadIntNPtr                   opType = 1840
-	opStructFieldNPtrAnonymousHeadIntNPtrOnly               opType = 1841
-	opStructFieldNPtrAnonymousHeadOmitEmptyIntNPtr          opType = 1842
-	opStructFieldNPtrAnonymousHeadOmitEmptyIntNPtrOnly      opType = 1843
-	opStructFieldNPtrAnonymousHeadStringTagIntNPtr          opType = 1844
-	opStructFieldNPtrAnonymousHeadStringTagIntNPtrOnly      opType = 1845
-	opStructFieldHeadInt8NPtr                               opType = 1846
-	opStructFieldHeadInt8NPtrOnly                           opType = 1847
-	opStructFieldHeadOmitEmptyInt8NPtr                      opType = 1848
-	opStructFieldHeadOmitEmptyInt8NPtrOnly                  opType = 1849
-	opStructFieldHeadStringTagInt8NPtr                      opType = 1850
-	opStructFieldHeadStringTagInt8NPtrOnly                  opType = 1851
-	opStructFieldAnonymousHeadInt8NPtr                      opType = 1852
-	opStructFieldAnonymousHeadInt8NPtrOnly                  opType = 1853
-	opStructFieldAnonymousHeadOmitEmptyInt8NPtr             opType = 1854
-	opStructFieldAnonymousHeadOmitEmptyInt8NPtrOnly         opType = 1855
-	opStructFieldAnonymousHeadStringTagInt8NPtr             opType = 1856
-	opStructFieldAnonymousHeadStringTagInt8NPtrOnly         opType = 1857
-	opStructFieldPtrHeadInt8NPtr                            opType = 1858
-	opStructFieldPtrHeadInt8NPtrOnly                        opType = 1859
-	opStructFieldPtrHeadOmitEmptyInt8NPtr                   opType = 1860
-	opStructFieldPtrHeadOmitEmptyInt8NPtrOnly               opType = 1861
-	opStructFieldPtrHeadStringTagInt8NPtr                   opType = 1862
-	opStructFieldPtrHeadStringTagInt8NPtrOnly               opType = 1863
-	opStructFieldPtrAnonymousHeadInt8NPtr                   opType = 1864
-	opStructFieldPtrAnonymousHeadInt8NPtrOnly               opType = 1865
-	opStructFieldPtrAnonymousHeadOmitEmptyInt8NPtr          opType = 1866
-	opStructFieldPtrAnonymousHeadOmitEmptyInt8NPtrOnly      opType = 1867
-	opStructFieldPtrAnonymousHeadStringTagInt8NPtr          opType = 1868
-	opStructFieldPtrAnonymousHeadStringTagInt8NPtrOnly      opType = 1869
-	opStructFieldNPtrHeadInt8NPtr                           opType = 1870
-	opStructFieldNPtrHeadInt8NPtrOnly                       opType = 1871
-	opStructFieldNPtrHeadOmitEmptyInt8NPtr                  opType = 1872
-	opStructFieldNPtrHeadOmitEmptyInt8NPtrOnly              opType = 1873
-	opStructFieldNPtrHeadStringTagInt8NPtr                  opType = 1874
-	opStructFieldNPtrHeadStringTagInt8NPtrOnly              opType = 1875
-	opStructFieldNPtrAnonymousHeadInt8NPtr                  opType = 1876
-	opStructFieldNPtrAnonymousHeadInt8NPtrOnly              opType = 1877
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt8NPtr         opType = 1878
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt8NPtrOnly     opType = 1879
-	opStructFieldNPtrAnonymousHeadStringTagInt8NPtr         opType = 1880
-	opStructFieldNPtrAnonymousHeadStringTagInt8NPtrOnly     opType = 1881
-	opStructFieldHeadInt16NPtr                              opType = 1882
-	opStructFieldHeadInt16NPtrOnly                          opType = 1883
-	opStructFieldHeadOmitEmptyInt16NPtr                     opType = 1884
-	opStructFieldHeadOmitEmptyInt16NPtrOnly                 opType = 1885
-	opStructFieldHeadStringTagInt16NPtr                     opType = 1886
-	opStructFieldHeadStringTagInt16NPtrOnly                 opType = 1887
-	opStructFieldAnonymousHeadInt16NPtr                     opType = 1888
-	opStructFieldAnonymousHeadInt16NPtrOnly                 opType = 1889
-	opStructFieldAnonymousHeadOmitEmptyInt16NPtr            opType = 1890
-	opStructFieldAnonymousHeadOmitEmptyInt16NPtrOnly        opType = 1891
-	opStructFieldAnonymousHeadStringTagInt16NPtr            opType = 1892
-	opStructFieldAnonymousHeadStringTagInt16NPtrOnly        opType = 1893
-	opStructFieldPtrHeadInt16NPtr                           opType = 1894
-	opStructFieldPtrHeadInt16NPtrOnly                       opType = 1895
-	opStructFieldPtrHeadOmitEmptyInt16NPtr                  opType = 1896
-	opStructFieldPtrHeadOmitEmptyInt16NPtrOnly              opType = 1897
-	opStructFieldPtrHeadStringTagInt16NPtr                  opType = 1898
-	opStructFieldPtrHeadStringTagInt16NPtrOnly              opType = 1899
-	opStructFieldPtrAnonymousHeadInt16NPtr                  opType = 1900
-	opStructFieldPtrAnonymousHeadInt16NPtrOnly              opType = 1901
-	opStructFieldPtrAnonymousHeadOmitEmptyInt16NPtr         opType = 1902
-	opStructFieldPtrAnonymousHeadOmitEmptyInt16NPtrOnly     opType = 1903
-	opStructFieldPtrAnonymousHeadStringTagInt16NPtr         opType = 1904
-	opStructFieldPtrAnonymousHeadStringTagInt16NPtrOnly     opType = 1905
-	opStructFieldNPtrHeadInt16NPtr                          opType = 1906
-	opStructFieldNPtrHeadInt16NPtrOnly                      opType = 1907
-	opStructFieldNPtrHeadOmitEmptyInt16NPtr                 opType = 1908
-	opStructFieldNPtrHeadOmitEmptyInt16NPtrOnly             opType = 1909
-	opStructFieldNPtrHeadStringTagInt16NPtr                 opType = 1910
-	opStructFieldNPtrHeadStringTagInt16NPtrOnly             opType = 1911
-	opStructFieldNPtrAnonymousHeadInt16NPtr                 opType = 1912
-	opStructFieldNPtrAnonymousHeadInt16NPtrOnly             opType = 1913
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt16NPtr        opType = 1914
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt16NPtrOnly    opType = 1915
-	opStructFieldNPtrAnonymousHeadStringTagInt16NPtr        opType = 1916
-	opStructFieldNPtrAnonymousHeadStringTagInt16NPtrOnly    opType = 1917
-	opStructFieldHeadInt32NPtr                              opType = 1918
-	opStructFieldHeadInt32NPtrOnly                          opType = 1919
-	opStructFieldHeadOmitEmptyInt32NPtr                     opType = 1920
-	opStructFieldHeadOmitEmptyInt32NPtrOnly                 opType = 1921
-	opStructFieldHeadStringTagInt32NPtr                     opType = 1922
-	opStructFieldHeadStringTagInt32NPtrOnly                 opType = 1923
-	opStructFieldAnonymousHeadInt32NPtr                     opType = 1924
-	opStructFieldAnonymousHeadInt32NPtrOnly                 opType = 1925
-	opStructFieldAnonymousHeadOmitEmptyInt32NPtr            opType = 1926
-	opStructFieldAnonymousHeadOmitEmptyInt32NPtrOnly        opType = 1927
-	opStructFieldAnonymousHeadStringTagInt32NPtr            opType = 1928
-	opStructFieldAnonymousHeadStringTagInt32NPtrOnly        opType = 1929
-	opStructFieldPtrHeadInt32NPtr                           opType = 1930
-	opStructFieldPtrHeadInt32NPtrOnly                       opType = 1931
-	opStructFieldPtrHeadOmitEmptyInt32NPtr                  opType = 1932
-	opStructFieldPtrHeadOmitEmptyInt32NPtrOnly              opType = 1933
-	opStructFieldPtrHeadStringTagInt32NPtr                  opType = 1934
-	opStructFieldPtrHeadStringTagInt32NPtrOnly              opType = 1935
-	opStructFieldPtrAnonymousHeadInt32NPtr                  opType = 1936
-	opStructFieldPtrAnonymousHeadInt32NPtrOnly              opType = 1937
-	opStructFieldPtrAnonymousHeadOmitEmptyInt32NPtr         opType = 1938
-	opStructFieldPtrAnonymousHeadOmitEmptyInt32NPtrOnly     opType = 1939
-	opStructFieldPtrAnonymousHeadStringTagInt32NPtr         opType = 1940
-	opStructFieldPtrAnonymousHeadStringTagInt32NPtrOnly     opType = 1941
-	opStructFieldNPtrHeadInt32NPtr                          opType = 1942
-	opStructFieldNPtrHeadInt32NPtrOnly                      opType = 1943
-	opStructFieldNPtrHeadOmitEmptyInt32NPtr                 opType = 1944
-	opStructFieldNPtrHeadOmitEmptyInt32NPtrOnly             opType = 1945
-	opStructFieldNPtrHeadStringTagInt32NPtr                 opType = 1946
-	opStructFieldNPtrHeadStringTagInt32NPtrOnly             opType = 1947
-	opStructFieldNPtrAnonymousHeadInt32NPtr                 opType = 1948
-	opStructFieldNPtrAnonymousHeadInt32NPtrOnly             opType = 1949
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt32NPtr        opType = 1950
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt32NPtrOnly    opType = 1951
-	opStructFieldNPtrAnonymousHeadStringTagInt32NPtr        opType = 1952
-	opStructFieldNPtrAnonymousHeadStringTagInt32NPtrOnly    opType = 1953
-	opStructFieldHeadInt64NPtr                              opType = 1954
-	opStructFieldHeadInt64NPtrOnly                          opType = 1955
-	opStructFieldHeadOmitEmptyInt64NPtr                     opType = 1956
-	opStructFieldHeadOmitEmptyInt64NPtrOnly                 opType = 1957
-	opStructFieldHeadStringTagInt64NPtr                     opType = 1958
-	opStructFieldHeadStringTagInt64NPtrOnly                 opType = 1959
-	opStructFieldAnonymousHeadInt64NPtr                     opType = 1960
-	opStructFieldAnonymousHeadInt64NPtrOnly                 opType = 1961
-	opStructFieldAnonymousHeadOmitEmptyInt64NPtr            opType = 1962
-	opStructFieldAnonymousHeadOmitEmptyInt64NPtrOnly        opType = 1963
-	opStructFieldAnonymousHeadStringTagInt64NPtr            opType = 1964
-	opStructFieldAnonymousHeadStringTagInt64NPtrOnly        opType = 1965
-	opStructFieldPtrHeadInt64NPtr                           opType = 1966
-	opStructFieldPtrHeadInt64NPtrOnly                       opType = 1967
-	opStructFieldPtrHeadOmitEmptyInt64NPtr                  opType = 1968
-	opStructFieldPtrHeadOmitEmptyInt64NPtrOnly              opType = 1969
-	opStructFieldPtrHeadStringTagInt64NPtr                  opType = 1970
-	opStructFieldPtrHeadStringTagInt64NPtrOnly              opType = 1971
-	opStructFieldPtrAnonymousHeadInt64NPtr                  opType = 1972
-	opStructFieldPtrAnonymousHeadInt64NPtrOnly              opType = 1973
-	opStructFieldPtrAnonymousHeadOmitEmptyInt64NPtr         opType = 1974
-	opStructFieldPtrAnonymousHeadOmitEmptyInt64NPtrOnly     opType = 1975
-	opStructFieldPtrAnonymousHeadStringTagInt64NPtr         opType = 1976
-	opStructFieldPtrAnonymousHeadStringTagInt64NPtrOnly     opType = 1977
-	opStructFieldNPtrHeadInt64NPtr                          opType = 1978
-	opStructFieldNPtrHeadInt64NPtrOnly                      opType = 1979
-	opStructFieldNPtrHeadOmitEmptyInt64NPtr                 opType = 1980
-	opStructFieldNPtrHeadOmitEmptyInt64NPtrOnly             opType = 1981
-	opStructFieldNPtrHeadStringTagInt64NPtr                 opType = 1982
-	opStructFieldNPtrHeadStringTagInt64NPtrOnly             opType = 1983
-	opStructFieldNPtrAnonymousHeadInt64NPtr                 opType = 1984
-	opStructFieldNPtrAnonymousHeadInt64NPtrOnly             opType = 1985
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt64NPtr        opType = 1986
-	opStructFieldNPtrAnonymousHeadOmitEmptyInt64NPtrOnly    opType = 1987
-	opStructFieldNPtrAnonymousHeadStringTagInt64NPtr        opType = 1988
-	opStructFieldNPtrAnonymousHeadStringTagInt64NPtrOnly    opType = 1989
-	opStructFieldHeadUintNPtr                               opType = 1990
-	opStructFieldHeadUintNPtrOnly                           opType = 1991
-	opStructFieldHeadOmitEmptyUintNPtr                      opType = 1992
-	opStructFieldHeadOmitEmptyUintNPtrOnly                  opType = 1993
-	opStructFieldHeadStringTagUintNPtr                      opType = 1994
-	opStructFieldHeadStringTagUintNPtrOnly                  opType = 1995
-	opStructFieldAnonymousHeadUintNPtr                      opType = 1996
-	opStructFieldAnonymousHeadUintNPtrOnly                  opType = 1997
-	opStructFieldAnonymousHeadOmitEmptyUintNPtr             opType = 1998
-	opStructFieldAnonymousHeadOmitEmptyUintNPtrOnly         opType = 1999
-	opStructFieldAnonymousHeadStringTagUintNPtr             opType = 2000
-	opStructFieldAnonymousHeadStringTagUintNPtrOnly         opType = 2001
-	opStructFieldPtrHeadUintNPtr                            opType = 2002
-	opStructFieldPtrHeadUintNPtrOnly                        opType = 2003
-	opStructFieldPtrHeadOmitEmptyUintNPtr                   opType = 2004
-	opStructFieldPtrHeadOmitEmptyUintNPtrOnly               opType = 2005
-	opStructFieldPtrHeadStringTagUintNPtr                   opType = 2006
-	opStructFieldPtrHeadStringTagUintNPtrOnly               opType = 2007
-	opStructFieldPtrAnonymousHeadUintNPtr                   opType = 2008
-	opStructFieldPtrAnonymousHeadUintNPtrOnly               opType = 2009
-	opStructFieldPtrAnonymousHeadOmitEmptyUintNPtr          opType = 2010
-	opStructFieldPtrAnonymousHeadOmitEmptyUintNPtrOnly      opType = 2011
-	opStructFieldPtrAnonymousHeadStringTagUintNPtr          opType = 2012
-	opStructFieldPtrAnonymousHeadStringTagUintNPtrOnly      opType = 2013
-	opStructFieldNPtrHeadUintNPtr                           opType = 2014
-	opStructFieldNPtrHeadUintNPtrOnly                       opType = 2015
-	opStructFieldNPtrHeadOmitEmptyUintNPtr                  opType = 2016
-	opStructFieldNPtrHeadOmitEmptyUintNPtrOnly              opType = 2017
-	opStructFieldNPtrHeadStringTagUintNPtr                  opType = 2018
-	opStructFieldNPtrHeadStringTagUintNPtrOnly              opType = 2019
-	opStructFieldNPtrAnonymousHeadUintNPtr                  opType = 2020
-	opStructFieldNPtrAnonymousHeadUintNPtrOnly              opType = 2021
-	opStructFieldNPtrAnonymousHeadOmitEmptyUintNPtr         opType = 2022
-	opStructFieldNPtrAnonymousHeadOmitEmptyUintNPtrOnly     opType = 2023
-	opStructFieldNPtrAnonymousHeadStringTagUintNPtr         opType = 2024
-	opStructFieldNPtrAnonymousHeadStringTagUintNPtrOnly     opType = 2025
-	opStructFieldHeadUint8NPtr                              opType = 2026
-	opStructFieldHeadUint8NPtrOnly                          opType = 2027
-	opStructFieldHeadOmitEmptyUint8NPtr                     opType = 2028
-	opStructFieldHeadOmitEmptyUint8NPtrOnly                 opType = 2029
-	opStructFieldHeadStringTagUint8NPtr                     opType = 2030
-	opStructFieldHeadStringTagUint8NPtrOnly                 opType = 2031
-	opStructFieldAnonymousHeadUint8NPtr                     opType = 2032
-	opStructFieldAnonymousHeadUint8NPtrOnly                 opType = 2033
-	opStructFieldAnonymousHeadOmitEmptyUint8NPtr            opType = 2034
-	opStructFieldAnonymousHeadOmitEmptyUint8NPtrOnly        opType = 2035
-	opStructFieldAnonymousHeadStringTagUint8NPtr            opType = 2036
-	opStructFieldAnonymousHeadStringTagUint8NPtrOnly        opType = 2037
-	opStructFieldPtrHeadUint8NPtr                           opType = 2038
-	opStructFieldPtrHeadUint8NPtrOnly                       opType = 2039
-	opStructFieldPtrHeadOmitEmptyUint8NPtr                  opType = 2040
-	opStructFieldPtrHeadOmitEmptyUint8NPtrOnly              opType = 2041
-	opStructFieldPtrHeadStringTagUint8NPtr                  opType = 2042
-	opStructFieldPtrHeadStringTagUint8NPtrOnly              opType = 2043
-	opStructFieldPtrAnonymousHeadUint8NPtr                  opType = 2044
-	opStructFieldPtrAnonymousHeadUint8NPtrOnly              opType = 2045
-	opStructFieldPtrAnonymousHeadOmitEmptyUint8NPtr         opType = 2046
-	opStructFieldPtrAnonymousHeadOmitEmptyUint8NPtrOnly     opType = 2047
-	opStructFieldPtrAnonymousHeadStringTagUint8NPtr         opType = 2048
-	opStructFieldPtrAnonymousHeadStringTagUint8NPtrOnly     opType = 2049
-	opStructFieldNPtrHeadUint8NPtr                          opType = 2050
-	opStructFieldNPtrHeadUint8NPtrOnly                      opType = 2051
-	opStructFieldNPtrHeadOmitEmptyUint8NPtr                 opType = 2052
-	opStructFieldNPtrHeadOmitEmptyUint8NPtrOnly             opType = 2053
-	opStructFieldNPtrHeadStringTagUint8NPtr                 opType = 2054
-	opStructFieldNPtrHeadStringTagUint8NPtrOnly             opType = 2055
-	opStructFieldNPtrAnonymousHeadUint8NPtr                 opType = 2056
-	opStructFieldNPtrAnonymousHeadUint8NPtrOnly             opType = 2057
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint8NPtr        opType = 2058
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint8NPtrOnly    opType = 2059
-	opStructFieldNPtrAnonymousHeadStringTagUint8NPtr        opType = 2060
-	opStructFieldNPtrAnonymousHeadStringTagUint8NPtrOnly    opType = 2061
-	opStructFieldHeadUint16NPtr                             opType = 2062
-	opStructFieldHeadUint16NPtrOnly                         opType = 2063
-	opStructFieldHeadOmitEmptyUint16NPtr                    opType = 2064
-	opStructFieldHeadOmitEmptyUint16NPtrOnly                opType = 2065
-	opStructFieldHeadStringTagUint16NPtr                    opType = 2066
-	opStructFieldHeadStringTagUint16NPtrOnly                opType = 2067
-	opStructFieldAnonymousHeadUint16NPtr                    opType = 2068
-	opStructFieldAnonymousHeadUint16NPtrOnly                opType = 2069
-	opStructFieldAnonymousHeadOmitEmptyUint16NPtr           opType = 2070
-	opStructFieldAnonymousHeadOmitEmptyUint16NPtrOnly       opType = 2071
-	opStructFieldAnonymousHeadStringTagUint16NPtr           opType = 2072
-	opStructFieldAnonymousHeadStringTagUint16NPtrOnly       opType = 2073
-	opStructFieldPtrHeadUint16NPtr                          opType = 2074
-	opStructFieldPtrHeadUint16NPtrOnly                      opType = 2075
-	opStructFieldPtrHeadOmitEmptyUint16NPtr                 opType = 2076
-	opStructFieldPtrHeadOmitEmptyUint16NPtrOnly             opType = 2077
-	opStructFieldPtrHeadStringTagUint16NPtr                 opType = 2078
-	opStructFieldPtrHeadStringTagUint16NPtrOnly             opType = 2079
-	opStructFieldPtrAnonymousHeadUint16NPtr                 opType = 2080
-	opStructFieldPtrAnonymousHeadUint16NPtrOnly             opType = 2081
-	opStructFieldPtrAnonymousHeadOmitEmptyUint16NPtr        opType = 2082
-	opStructFieldPtrAnonymousHeadOmitEmptyUint16NPtrOnly    opType = 2083
-	opStructFieldPtrAnonymousHeadStringTagUint16NPtr        opType = 2084
-	opStructFieldPtrAnonymousHeadStringTagUint16NPtrOnly    opType = 2085
-	opStructFieldNPtrHeadUint16NPtr                         opType = 2086
-	opStructFieldNPtrHeadUint16NPtrOnly                     opType = 2087
-	opStructFieldNPtrHeadOmitEmptyUint16NPtr                opType = 2088
-	opStructFieldNPtrHeadOmitEmptyUint16NPtrOnly            opType = 2089
-	opStructFieldNPtrHeadStringTagUint16NPtr                opType = 2090
-	opStructFieldNPtrHeadStringTagUint16NPtrOnly            opType = 2091
-	opStructFieldNPtrAnonymousHeadUint16NPtr                opType = 2092
-	opStructFieldNPtrAnonymousHeadUint16NPtrOnly            opType = 2093
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint16NPtr       opType = 2094
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint16NPtrOnly   opType = 2095
-	opStructFieldNPtrAnonymousHeadStringTagUint16NPtr       opType = 2096
-	opStructFieldNPtrAnonymousHeadStringTagUint16NPtrOnly   opType = 2097
-	opStructFieldHeadUint32NPtr                             opType = 2098
-	opStructFieldHeadUint32NPtrOnly                         opType = 2099
-	opStructFieldHeadOmitEmptyUint32NPtr                    opType = 2100
-	opStructFieldHeadOmitEmptyUint32NPtrOnly                opType = 2101
-	opStructFieldHeadStringTagUint32NPtr                    opType = 2102
-	opStructFieldHeadStringTagUint32NPtrOnly                opType = 2103
-	opStructFieldAnonymousHeadUint32NPtr                    opType = 2104
-	opStructFieldAnonymousHeadUint32NPtrOnly                opType = 2105
-	opStructFieldAnonymousHeadOmitEmptyUint32NPtr           opType = 2106
-	opStructFieldAnonymousHeadOmitEmptyUint32NPtrOnly       opType = 2107
-	opStructFieldAnonymousHeadStringTagUint32NPtr           opType = 2108
-	opStructFieldAnonymousHeadStringTagUint32NPtrOnly       opType = 2109
-	opStructFieldPtrHeadUint32NPtr                          opType = 2110
-	opStructFieldPtrHeadUint32NPtrOnly                      opType = 2111
-	opStructFieldPtrHeadOmitEmptyUint32NPtr                 opType = 2112
-	opStructFieldPtrHeadOmitEmptyUint32NPtrOnly             opType = 2113
-	opStructFieldPtrHeadStringTagUint32NPtr                 opType = 2114
-	opStructFieldPtrHeadStringTagUint32NPtrOnly             opType = 2115
-	opStructFieldPtrAnonymousHeadUint32NPtr                 opType = 2116
-	opStructFieldPtrAnonymousHeadUint32NPtrOnly             opType = 2117
-	opStructFieldPtrAnonymousHeadOmitEmptyUint32NPtr        opType = 2118
-	opStructFieldPtrAnonymousHeadOmitEmptyUint32NPtrOnly    opType = 2119
-	opStructFieldPtrAnonymousHeadStringTagUint32NPtr        opType = 2120
-	opStructFieldPtrAnonymousHeadStringTagUint32NPtrOnly    opType = 2121
-	opStructFieldNPtrHeadUint32NPtr                         opType = 2122
-	opStructFieldNPtrHeadUint32NPtrOnly                     opType = 2123
-	opStructFieldNPtrHeadOmitEmptyUint32NPtr                opType = 2124
-	opStructFieldNPtrHeadOmitEmptyUint32NPtrOnly            opType = 2125
-	opStructFieldNPtrHeadStringTagUint32NPtr                opType = 2126
-	opStructFieldNPtrHeadStringTagUint32NPtrOnly            opType = 2127
-	opStructFieldNPtrAnonymousHeadUint32NPtr                opType = 2128
-	opStructFieldNPtrAnonymousHeadUint32NPtrOnly            opType = 2129
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint32NPtr       opType = 2130
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint32NPtrOnly   opType = 2131
-	opStructFieldNPtrAnonymousHeadStringTagUint32NPtr       opType = 2132
-	opStructFieldNPtrAnonymousHeadStringTagUint32NPtrOnly   opType = 2133
-	opStructFieldHeadUint64NPtr                             opType = 2134
-	opStructFieldHeadUint64NPtrOnly                         opType = 2135
-	opStructFieldHeadOmitEmptyUint64NPtr                    opType = 2136
-	opStructFieldHeadOmitEmptyUint64NPtrOnly                opType = 2137
-	opStructFieldHeadStringTagUint64NPtr                    opType = 2138
-	opStructFieldHeadStringTagUint64NPtrOnly                opType = 2139
-	opStructFieldAnonymousHeadUint64NPtr                    opType = 2140
-	opStructFieldAnonymousHeadUint64NPtrOnly                opType = 2141
-	opStructFieldAnonymousHeadOmitEmptyUint64NPtr           opType = 2142
-	opStructFieldAnonymousHeadOmitEmptyUint64NPtrOnly       opType = 2143
-	opStructFieldAnonymousHeadStringTagUint64NPtr           opType = 2144
-	opStructFieldAnonymousHeadStringTagUint64NPtrOnly       opType = 2145
-	opStructFieldPtrHeadUint64NPtr                          opType = 2146
-	opStructFieldPtrHeadUint64NPtrOnly                      opType = 2147
-	opStructFieldPtrHeadOmitEmptyUint64NPtr                 opType = 2148
-	opStructFieldPtrHeadOmitEmptyUint64NPtrOnly             opType = 2149
-	opStructFieldPtrHeadStringTagUint64NPtr                 opType = 2150
-	opStructFieldPtrHeadStringTagUint64NPtrOnly             opType = 2151
-	opStructFieldPtrAnonymousHeadUint64NPtr                 opType = 2152
-	opStructFieldPtrAnonymousHeadUint64NPtrOnly             opType = 2153
-	opStructFieldPtrAnonymousHeadOmitEmptyUint64NPtr        opType = 2154
-	opStructFieldPtrAnonymousHeadOmitEmptyUint64NPtrOnly    opType = 2155
-	opStructFieldPtrAnonymousHeadStringTagUint64NPtr        opType = 2156
-	opStructFieldPtrAnonymousHeadStringTagUint64NPtrOnly    opType = 2157
-	opStructFieldNPtrHeadUint64NPtr                         opType = 2158
-	opStructFieldNPtrHeadUint64NPtrOnly                     opType = 2159
-	opStructFieldNPtrHeadOmitEmptyUint64NPtr                opType = 2160
-	opStructFieldNPtrHeadOmitEmptyUint64NPtrOnly            opType = 2161
-	opStructFieldNPtrHeadStringTagUint64NPtr                opType = 2162
-	opStructFieldNPtrHeadStringTagUint64NPtrOnly            opType = 2163
-	opStructFieldNPtrAnonymousHeadUint64NPtr                opType = 2164
-	opStructFieldNPtrAnonymousHeadUint64NPtrOnly            opType = 2165
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint64NPtr       opType = 2166
-	opStructFieldNPtrAnonymousHeadOmitEmptyUint64NPtrOnly   opType = 2167
-	opStructFieldNPtrAnonymousHeadStringTagUint64NPtr       opType = 2168
-	opStructFieldNPtrAnonymousHeadStringTagUint64NPtrOnly   opType = 2169
-	opStructFieldHeadFloat32NPtr                            opType = 2170
-	opStructFieldHeadFloat32NPtrOnly                        opType = 2171
-	opStructFieldHeadOmitEmptyFloat32NPtr                   opType = 2172
-	opStructFieldHeadOmitEmptyFloat32NPtrOnly               opType = 2173
-	opStructFieldHeadStringTagFloat32NPtr                   opType = 2174
-	opStructFieldHeadStringTagFloat32NPtrOnly               opType = 2175
-	opStructFieldAnonymousHeadFloat32NPtr                   opType = 2176
-	opStructFieldAnonymousHeadFloat32NPtrOnly               opType = 2177
-	opStructFieldAnonymousHeadOmitEmptyFloat32NPtr          opType = 2178
-	opStructFieldAnonymousHeadOmitEmptyFloat32NPtrOnly      opType = 2179
-	opStructFieldAnonymousHeadStringTagFloat32NPtr          opType = 2180
-	opStructFieldAnonymousHeadStringTagFloat32NPtrOnly      opType = 2181
-	opStructFieldPtrHeadFloat32NPtr                         opType = 2182
-	opStructFieldPtrHeadFloat32NPtrOnly                     opType = 2183
-	opStructFieldPtrHeadOmitEmptyFloat32NPtr                opType = 2184
-	opStructFieldPtrHeadOmitEmptyFloat32NPtrOnly            opType = 2185
-	opStructFieldPtrHeadStringTagFloat32NPtr                opType = 2186
-	opStructFieldPtrHeadStringTagFloat32NPtrOnly            opType = 2187
-	opStructFieldPtrAnonymousHeadFloat32NPtr                opType = 2188
-	opStructFieldPtrAnonymousHeadFloat32NPtrOnly            opType = 2189
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat32NPtr       opType = 2190
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat32NPtrOnly   opType = 2191
-	opStructFieldPtrAnonymousHeadStringTagFloat32NPtr       opType = 2192
-	opStructFieldPtrAnonymousHeadStringTagFloat32NPtrOnly   opType = 2193
-	opStructFieldNPtrHeadFloat32NPtr                        opType = 2194
-	opStructFieldNPtrHeadFloat32NPtrOnly                    opType = 2195
-	opStructFieldNPtrHeadOmitEmptyFloat32NPtr               opType = 2196
-	opStructFieldNPtrHeadOmitEmptyFloat32NPtrOnly           opType = 2197
-	opStructFieldNPtrHeadStringTagFloat32NPtr               opType = 2198
-	opStructFieldNPtrHeadStringTagFloat32NPtrOnly           opType = 2199
-	opStructFieldNPtrAnonymousHeadFloat32NPtr               opType = 2200
-	opStructFieldNPtrAnonymousHeadFloat32NPtrOnly           opType = 2201
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32NPtr      opType = 2202
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32NPtrOnly  opType = 2203
-	opStructFieldNPtrAnonymousHeadStringTagFloat32NPtr      opType = 2204
-	opStructFieldNPtrAnonymousHeadStringTagFloat32NPtrOnly  opType = 2205
-	opStructFieldHeadFloat64NPtr                            opType = 2206
-	opStructFieldHeadFloat64NPtrOnly                        opType = 2207
-	opStructFieldHeadOmitEmptyFloat64NPtr                   opType = 2208
-	opStructFieldHeadOmitEmptyFloat64NPtrOnly               opType = 2209
-	opStructFieldHeadStringTagFloat64NPtr                   opType = 2210
-	opStructFieldHeadStringTagFloat64NPtrOnly               opType = 2211
-	opStructFieldAnonymousHeadFloat64NPtr                   opType = 2212
-	opStructFieldAnonymousHeadFloat64NPtrOnly               opType = 2213
-	opStructFieldAnonymousHeadOmitEmptyFloat64NPtr          opType = 2214
-	opStructFieldAnonymousHeadOmitEmptyFloat64NPtrOnly      opType = 2215
-	opStructFieldAnonymousHeadStringTagFloat64NPtr          opType = 2216
-	opStructFieldAnonymousHeadStringTagFloat64NPtrOnly      opType = 2217
-	opStructFieldPtrHeadFloat64NPtr                         opType = 2218
-	opStructFieldPtrHeadFloat64NPtrOnly                     opType = 2219
-	opStructFieldPtrHeadOmitEmptyFloat64NPtr                opType = 2220
-	opStructFieldPtrHeadOmitEmptyFloat64NPtrOnly            opType = 2221
-	opStructFieldPtrHeadStringTagFloat64NPtr                opType = 2222
-	opStructFieldPtrHeadStringTagFloat64NPtrOnly            opType = 2223
-	opStructFieldPtrAnonymousHeadFloat64NPtr                opType = 2224
-	opStructFieldPtrAnonymousHeadFloat64NPtrOnly            opType = 2225
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat64NPtr       opType = 2226
-	opStructFieldPtrAnonymousHeadOmitEmptyFloat64NPtrOnly   opType = 2227
-	opStructFieldPtrAnonymousHeadStringTagFloat64NPtr       opType = 2228
-	opStructFieldPtrAnonymousHeadStringTagFloat64NPtrOnly   opType = 2229
-	opStructFieldNPtrHeadFloat64NPtr                        opType = 2230
-	opStructFieldNPtrHeadFloat64NPtrOnly                    opType = 2231
-	opStructFieldNPtrHeadOmitEmptyFloat64NPtr               opType = 2232
-	opStructFieldNPtrHeadOmitEmptyFloat64NPtrOnly           opType = 2233
-	opStructFieldNPtrHeadStringTagFloat64NPtr               opType = 2234
-	opStructFieldNPtrHeadStringTagFloat64NPtrOnly           opType = 2235
-	opStructFieldNPtrAnonymousHeadFloat64NPtr               opType = 2236
-	opStructFieldNPtrAnonymousHeadFloat64NPtrOnly           opType = 2237
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64NPtr      opType = 2238
-	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64NPtrOnly  opType = 2239
-	opStructFieldNPtrAnonymousHeadStringTagFloat64NPtr      opType = 2240
-	opStructFieldNPtrAnonymousHeadStringTagFloat64NPtrOnly  opType = 2241
-	opStructFieldHeadBoolNPtr                               opType = 2242
-	opStructFieldHeadBoolNPtrOnly                           opType = 2243
-	opStructFieldHeadOmitEmptyBoolNPtr                      opType = 2244
-	opStructFieldHeadOmitEmptyBoolNPtrOnly                  opType = 2245
-	opStructFieldHeadStringTagBoolNPtr                      opType = 2246
-	opStructFieldHeadStringTagBoolNPtrOnly                  opType = 2247
-	opStructFieldAnonymousHeadBoolNPtr                      opType = 2248
-	opStructFieldAnonymousHeadBoolNPtrOnly                  opType = 2249
-	opStructFieldAnonymousHeadOmitEmptyBoolNPtr             opType = 2250
-	opStructFieldAnonymousHeadOmitEmptyBoolNPtrOnly         opType = 2251
-	opStructFieldAnonymousHeadStringTagBoolNPtr             opType = 2252
-	opStructFieldAnonymousHeadStringTagBoolNPtrOnly         opType = 2253
-	opStructFieldPtrHeadBoolNPtr                            opType = 2254
-	opStructFieldPtrHeadBoolNPtrOnly                        opType = 2255
-	opStructFieldPtrHeadOmitEmptyBoolNPtr                   opType = 2256
-	opStructFieldPtrHeadOmitEmptyBoolNPtrOnly               opType = 2257
-	opStructFieldPtrHeadStringTagBoolNPtr                   opType = 2258
-	opStructFieldPtrHeadStringTagBoolNPtrOnly               opType = 2259
-	opStructFieldPtrAnonymousHeadBoolNPtr                   opType = 2260
-	opStructFieldPtrAnonymousHeadBoolNPtrOnly               opType = 2261
-	opStructFieldPtrAnonymousHeadOmitEmptyBoolNPtr          opType = 2262
-	opStructFieldPtrAnonymousHeadOmitEmptyBoolNPtrOnly      opType = 2263
-	opStructFieldPtrAnonymousHeadStringTagBoolNPtr          opType = 2264
-	opStructFieldPtrAnonymousHeadStringTagBoolNPtrOnly      opType = 2265
-	opStructFieldNPtrHeadBoolNPtr                           opType = 2266
-	opStructFieldNPtrHeadBoolNPtrOnly                       opType = 2267
-	opStructFieldNPtrHeadOmitEmptyBoolNPtr                  opType = 2268
-	opStructFieldNPtrHeadOmitEmptyBoolNPtrOnly              opType = 2269
-	opStructFieldNPtrHeadStringTagBoolNPtr                  opType = 2270
-	opStructFieldNPtrHeadStringTagBoolNPtrOnly              opType = 2271
-	opStructFieldNPtrAnonymousHeadBoolNPtr                  opType = 2272
-	opStructFieldNPtrAnonymousHeadBoolNPtrOnly              opType = 2273
-	opStructFieldNPtrAnonymousHeadOmitEmptyBoolNPtr         opType = 2274
-	opStructFieldNPtrAnonymousHeadOmitEmptyBoolNPtrOnly     opType = 2275
-	opStructFieldNPtrAnonymousHeadStringTagBoolNPtr         opType = 2276
-	opStructFieldNPtrAnonymousHeadStringTagBoolNPtrOnly     opType = 2277
-	opStructFieldHeadStringNPtr                             opType = 2278
-	opStructFieldHeadStringNPtrOnly                         opType = 2279
-	opStructFieldHeadOmitEmptyStringNPtr                    opType = 2280
-	opStructFieldHeadOmitEmptyStringNPtrOnly                opType = 2281
-	opStructFieldHeadStringTagStringNPtr                    opType = 2282
-	opStructFieldHeadStringTagStringNPtrOnly                opType = 2283
-	opStructFieldAnonymousHeadStringNPtr                    opType = 2284
-	opStructFieldAnonymousHeadStringNPtrOnly                opType = 2285
-	opStructFieldAnonymousHeadOmitEmptyStringNPtr           opType = 2286
-	opStructFieldAnonymousHeadOmitEmptyStringNPtrOnly       opType = 2287
-	opStructFieldAnonymousHeadStringTagStringNPtr           opType = 2288
-	opStructFieldAnonymousHeadStringTagStringNPtrOnly       opType = 2289
-	opStructFieldPtrHeadStringNPtr                          opType = 2290
-	opStructFieldPtrHeadStringNPtrOnly                      opType = 2291
-	opStructFieldPtrHeadOmitEmptyStringNPtr                 opType = 2292
-	opStructFieldPtrHeadOmitEmptyStringNPtrOnly             opType = 2293
-	opStructFieldPtrHeadStringTagStringNPtr                 opType = 2294
-	opStructFieldPtrHeadStringTagStringNPtrOnly             opType = 2295
-	opStructFieldPtrAnonymousHeadStringNPtr                 opType = 2296
-	opStructFieldPtrAnonymousHeadStringNPtrOnly             opType = 2297
-	opStructFieldPtrAnonymousHeadOmitEmptyStringNPtr        opType = 2298
-	opStructFieldPtrAnonymousHeadOmitEmptyStringNPtrOnly    opType = 2299
-	opStructFieldPtrAnonymousHeadStringTagStringNPtr        opType = 2300
-	opStructFieldPtrAnonymousHeadStringTagStringNPtrOnly    opType = 2301
-	opStructFieldNPtrHeadStringNPtr                         opType = 2302
-	opStructFieldNPtrHeadStringNPtrOnly                     opType = 2303
-	opStructFieldNPtrHeadOmitEmptyStringNPtr                opType = 2304
-	opStructFieldNPtrHeadOmitEmptyStringNPtrOnly            opType = 2305
-	opStructFieldNPtrHeadStringTagStringNPtr                opType = 2306
-	opStructFieldNPtrHeadStringTagStringNPtrOnly            opType = 2307
-	opStructFieldNPtrAnonymousHeadStringNPtr                opType = 2308
-	opStructFieldNPtrAnonymousHeadStringNPtrOnly            opType = 2309
-	opStructFieldNPtrAnonymousHeadOmitEmptyStringNPtr       opType = 2310
-	opStructFieldNPtrAnonymousHeadOmitEmptyStringNPtrOnly   opType = 2311
-	opStructFieldNPtrAnonymousHeadStringTagStringNPtr       opType = 2312
-	opStructFieldNPtrAnonymousHeadStringTagStringNPtrOnly   opType = 2313
-	opStructFieldHeadBytesNPtr                              opType = 2314
-	opStructFieldHeadBytesNPtrOnly                          opType = 2315
-	opStructFieldHeadOmitEmptyBytesNPtr                     opType = 2316
-	opStructFieldHeadOmitEmptyBytesNPtrOnly                 opType = 2317
-	opStructFieldHeadStringTagBytesNPtr                     opType = 2318
-	opStructFieldHeadStringTagBytesNPtrOnly                 opType = 2319
-	opStructFieldAnonymousHeadBytesNPtr                     opType = 2320
-	opStructFieldAnonymousHeadBytesNPtrOnly                 opType = 2321
-	opStructFieldAnonymousHeadOmitEmptyBytesNPtr            opType = 2322
-	opStructFieldAnonymousHeadOmitEmptyBytesNPtrOnly        opType = 2323
-	opStructFieldAnonymousHeadStringTagBytesNPtr            opType = 2324
-	opStructFieldAnonymousHeadStringTagBytesNPtrOnly        opType = 2325
-	opStructFieldPtrHeadBytesNPtr                           opType = 2326
-	opStructFieldPtrHeadBytesNPtrOnly                       opType = 2327
-	opStructFieldPtrHeadOmitEmptyBytesNPtr                  opType = 2328
-	opStructFieldPtrHeadOmitEmptyBytesNPtrOnly              opType = 2329
-	opStructFieldPtrHeadStringTagBytesNPtr                  opType = 2330
-	opStructFieldPtrHeadStringTagBytesNPtrOnly              opType = 2331
-	opStructFieldPtrAnonymousHeadBytesNPtr                  opType = 2332
-	opStructFieldPtrAnonymousHeadBytesNPtrOnly              opType = 2333
-	opStructFieldPtrAnonymousHeadOmitEmptyBytesNPtr         opType = 2334
-	opStructFieldPtrAnonymousHeadOmitEmptyBytesNPtrOnly     opType = 2335
-	opStructFieldPtrAnonymousHeadStringTagBytesNPtr         opType = 2336
-	opStructFieldPtrAnonymousHeadStringTagBytesNPtrOnly     opType = 2337
-	opStructFieldNPtrHeadBytesNPtr                          opType = 2338
-	opStructFieldNPtrHeadBytesNPtrOnly                      opType = 2339
-	opStructFieldNPtrHeadOmitEmptyBytesNPtr                 opType = 2340
-	opStructFieldNPtrHeadOmitEmptyBytesNPtrOnly             opType = 2341
-	opStructFieldNPtrHeadStringTagBytesNPtr                 opType = 2342
-	opStructFieldNPtrHeadStringTagBytesNPtrOnly             opType = 2343
-	opStructFieldNPtrAnonymousHeadBytesNPtr                 opType = 2344
-	opStructFieldNPtrAnonymousHeadBytesNPtrOnly             opType = 2345
-	opStructFieldNPtrAnonymousHeadOmitEmptyBytesNPtr        opType = 2346
-	opStructFieldNPtrAnonymousHeadOmitEmptyBytesNPtrOnly    opType = 2347
-	opStructFieldNPtrAnonymousHeadStringTagBytesNPtr        opType = 2348
-	opStructFieldNPtrAnonymousHeadStringTagBytesNPtrOnly    opType = 2349
-	opStructFieldHead                                       opType = 2350
-	opStructFieldHeadOnly                                   opType = 2351
-	opStructFieldHeadOmitEmpty                              opType = 2352
-	opStructFieldHeadOmitEmptyOnly                          opType = 2353
-	opStructFieldHeadStringTag                              opType = 2354
-	opStructFieldHeadStringTagOnly                          opType = 2355
-	opStructFieldAnonymousHead                              opType = 2356
-	opStructFieldAnonymousHeadOnly                          opType = 2357
-	opStructFieldAnonymousHeadOmitEmpty                     opType = 2358
-	opStructFieldAnonymousHeadOmitEmptyOnly                 opType = 2359
-	opStructFieldAnonymousHeadStringTag                     opType = 2360
-	opStructFieldAnonymousHeadStringTagOnly                 opType = 2361
-	opStructFieldPtrHead                                    opType = 2362
-	opStructFieldPtrHeadOnly                                opType = 2363
-	opStructFieldPtrHeadOmitEmpty                           opType = 2364
-	opStructFieldPtrHeadOmitEmptyOnly                       opType = 2365
-	opStructFieldPtrHeadStringTag                           opType = 2366
-	opStructFieldPtrHeadStringTagOnly                       opType = 2367
-	opStructFieldPtrAnonymousHead                           opType = 2368
-	opStructFieldPtrAnonymousHeadOnly                       opType = 2369
-	opStructFieldPtrAnonymousHeadOmitEmpty                  opType = 2370
-	opStructFieldPtrAnonymousHeadOmitEmptyOnly              opType = 2371
-	opStructFieldPtrAnonymousHeadStringTag                  opType = 2372
-	opStructFieldPtrAnonymousHeadStringTagOnly              opType = 2373
-	opStructFieldNPtrHead                                   opType = 2374
-	opStructFieldNPtrHeadOnly                               opType = 2375
-	opStructFieldNPtrHeadOmitEmpty                          opType = 2376
-	opStructFieldNPtrHeadOmitEmptyOnly                      opType = 2377
-	opStructFieldNPtrHeadStringTag                          opType = 2378
-	opStructFieldNPtrHeadStringTagOnly                      opType = 2379
-	opStructFieldNPtrAnonymousHead                          opType = 2380
-	opStructFieldNPtrAnonymousHeadOnly                      opType = 2381
-	opStructFieldNPtrAnonymousHeadOmitEmpty                 opType = 2382
-	opStructFieldNPtrAnonymousHeadOmitEmptyOnly             opType = 2383
-	opStructFieldNPtrAnonymousHeadStringTag                 opType = 2384
-	opStructFieldNPtrAnonymousHeadStringTagOnly             opType = 2385
-	opStructFieldInt                                        opType = 2386
-	opStructFieldOmitEmptyInt                               opType = 2387
-	opStructFieldStringTagInt                               opType = 2388
-	opStructFieldInt8                                       opType = 2389
-	opStructFieldOmitEmptyInt8                              opType = 2390
-	opStructFieldStringTagInt8                              opType = 2391
-	opStructFieldInt16                                      opType = 2392
-	opStructFieldOmitEmptyInt16                             opType = 2393
-	opStructFieldStringTagInt16                             opType = 2394
-	opStructFieldInt32                                      opType = 2395
-	opStructFieldOmitEmptyInt32                             opType = 2396
-	opStructFieldStringTagInt32                             opType = 2397
-	opStructFieldInt64                                      opType = 2398
-	opStructFieldOmitEmptyInt64                             opType = 2399
-	opStructFieldStringTagInt64                             opType = 2400
-	opStructFieldUint                                       opType = 2401
-	opStructFieldOmitEmptyUint                              opType = 2402
-	opStructFieldStringTagUint                              opType = 2403
-	opStructFieldUint8                                      opType = 2404
-	opStructFieldOmitEmptyUint8                             opType = 2405
-	opStructFieldStringTagUint8                             opType = 2406
-	opStructFieldUint16                                     opType = 2407
-	opStructFieldOmitEmptyUint16                            opType = 2408
-	opStructFieldStringTagUint16                            opType = 2409
-	opStructFieldUint32                                     opType = 2410
-	opStructFieldOmitEmptyUint32                            opType = 2411
-	opStructFieldStringTagUint32                            opType = 2412
-	opStructFieldUint64                                     opType = 2413
-	opStructFieldOmitEmptyUint64                            opType = 2414
-	opStructFieldStringTagUint64                            opType = 2415
-	opStructFieldFloat32                                    opType = 2416
-	opStructFieldOmitEmptyFloat32                           opType = 2417
-	opStructFieldStringTagFloat32                           opType = 2418
-	opStructFieldFloat64                                    opType = 2419
-	opStructFieldOmitEmptyFloat64                           opType = 2420
-	opStructFieldStringTagFloat64                           opType = 2421
-	opStructFieldBool                                       opType = 2422
-	opStructFieldOmitEmptyBool                              opType = 2423
-	opStructFieldStringTagBool                              opType = 2424
-	opStructFieldString                                     opType = 2425
-	opStructFieldOmitEmptyString                            opType = 2426
-	opStructFieldStringTagString                            opType = 2427
-	opStructFieldBytes                                      opType = 2428
-	opStructFieldOmitEmptyBytes                             opType = 2429
-	opStructFieldStringTagBytes                             opType = 2430
-	opStructFieldArray                                      opType = 2431
-	opStructFieldOmitEmptyArray                             opType = 2432
-	opStructFieldStringTagArray                             opType = 2433
-	opStructFieldMap                                        opType = 2434
-	opStructFieldOmitEmptyMap                               opType = 2435
-	opStructFieldStringTagMap                               opType = 2436
-	opStructFieldMapLoad                                    opType = 2437
-	opStructFieldOmitEmptyMapLoad                           opType = 2438
-	opStructFieldStringTagMapLoad                           opType = 2439
-	opStructFieldSlice                                      opType = 2440
-	opStructFieldOmitEmptySlice                             opType = 2441
-	opStructFieldStringTagSlice                             opType = 2442
-	opStructFieldStruct                                     opType = 2443
-	opStructFieldOmitEmptyStruct                            opType = 2444
-	opStructFieldStringTagStruct                            opType = 2445
-	opStructFieldMarshalJSON                                opType = 2446
-	opStructFieldOmitEmptyMarshalJSON                       opType = 2447
-	opStructFieldStringTagMarshalJSON                       opType = 2448
-	opStructFieldMarshalText                                opType = 2449
-	opStructFieldOmitEmptyMarshalText                       opType = 2450
-	opStructFieldStringTagMarshalText                       opType = 2451
-	opStructFieldRecursive                                  opType = 2452
-	opStructFieldOmitEmptyRecursive                         opType = 2453
-	opStructFieldStringTagRecursive                         opType = 2454
-	opStructFieldIntString                                  opType = 2455
-	opStructFieldOmitEmptyIntString                         opType = 2456
-	opStructFieldStringTagIntString                         opType = 2457
-	opStructFieldInt8String                                 opType = 2458
-	opStructFieldOmitEmptyInt8String                        opType = 2459
-	opStructFieldStringTagInt8String                        opType = 2460
-	opStructFieldInt16String                                opType = 2461
-	opStructFieldOmitEmptyInt16String                       opType = 2462
-	opStructFieldStringTagInt16String                       opType = 2463
-	opStructFieldInt32String                                opType = 2464
-	opStructFieldOmitEmptyInt32String                       opType = 2465
-	opStructFieldStringTagInt32String                       opType = 2466
-	opStructFieldInt64String                                opType = 2467
-	opStructFieldOmitEmptyInt64String                       opType = 2468
-	opStructFieldStringTagInt64String                       opType = 2469
-	opStructFieldUintString                                 opType = 2470
-	opStructFieldOmitEmptyUintString                        opType = 2471
-	opStructFieldStringTagUintString                        opType = 2472
-	opStructFieldUint8String                                opType = 2473
-	opStructFieldOmitEmptyUint8String                       opType = 2474
-	opStructFieldStringTagUint8String                       opType = 2475
-	opStructFieldUint16String                               opType = 2476
-	opStructFieldOmitEmptyUint16String                      opType = 2477
-	opStructFieldStringTagUint16String                      opType = 2478
-	opStructFieldUint32String                               opType = 2479
-	opStructFieldOmitEmptyUint32String                      opType = 2480
-	opStructFieldStringTagUint32String                      opType = 2481
-	opStructFieldUint64String                               opType = 2482
-	opStructFieldOmitEmptyUint64String                      opType = 2483
-	opStructFieldStringTagUint64String                      opType = 2484
-	opStructFieldIntPtr                                     opType = 2485
-	opStructFieldOmitEmptyIntPtr                            opType = 2486
-	opStructFieldStringTagIntPtr                            opType = 2487
-	opStructFieldInt8Ptr                                    opType = 2488
-	opStructFieldOmitEmptyInt8Ptr                           opType = 2489
-	opStructFieldStringTagInt8Ptr                           opType = 2490
-	opStructFieldInt16Ptr                                   opType = 2491
-	opStructFieldOmitEmptyInt16Ptr                          opType = 2492
-	opStructFieldStringTagInt16Ptr                          opType = 2493
-	opStructFieldInt32Ptr                                   opType = 2494
-	opStructFieldOmitEmptyInt32Ptr                          opType = 2495
-	opStructFieldStringTagInt32Ptr                          opType = 2496
-	opStructFieldInt64Ptr                                   opType = 2497
-	opStructFieldOmitEmptyInt64Ptr                          opType = 2498
-	opStructFieldStringTagInt64Ptr                          opType = 2499
-	opStructFieldUintPtr                                    opType = 2500
-	opStructFieldOmitEmptyUintPtr                           opType = 2501
-	opStructFieldStringTagUintPtr                           opType = 2502
-	opStructFieldUint8Ptr                                   opType = 2503
-	opStructFieldOmitEmptyUint8Ptr                          opType = 2504
-	opStructFieldStringTagUint8Ptr                          opType = 2505
-	opStructFieldUint16Ptr                                  opType = 2506
-	opStructFieldOmitEmptyUint16Ptr                         opType = 2507
-	opStructFieldStringTagUint16Ptr                         opType = 2508
-	opStructFieldUint32Ptr                                  opType = 2509
-	opStructFieldOmitEmptyUint32Ptr                         opType = 2510
-	opStructFieldStringTagUint32Ptr                         opType = 2511
-	opStructFieldUint64Ptr                                  opType = 2512
-	opStructFieldOmitEmptyUint64Ptr                         opType = 2513
-	opStructFieldStringTagUint64Ptr                         opType = 2514
-	opStructFieldFloat32Ptr                                 opType = 2515
-	opStructFieldOmitEmptyFloat32Ptr                        opType = 2516
-	opStructFieldStringTagFloat32Ptr                        opType = 2517
-	opStructFieldFloat64Ptr                                 opType = 2518
-	opStructFieldOmitEmptyFloat64Ptr                        opType = 2519
-	opStructFieldStringTagFloat64Ptr                        opType = 2520
-	opStructFieldBoolPtr                                    opType = 2521
-	opStructFieldOmitEmptyBoolPtr                           opType = 2522
-	opStructFieldStringTagBoolPtr                           opType = 2523
-	opStructFieldStringPtr                                  opType = 2524
-	opStructFieldOmitEmptyStringPtr                         opType = 2525
-	opStructFieldStringTagStringPtr                         opType = 2526
-	opStructFieldBytesPtr                                   opType = 2527
-	opStructFieldOmitEmptyBytesPtr                          opType = 2528
-	opStructFieldStringTagBytesPtr                          opType = 2529
-	opStructFieldIntNPtr                                    opType = 2530
-	opStructFieldOmitEmptyIntNPtr                           opType = 2531
-	opStructFieldStringTagIntNPtr                           opType = 2532
-	opStructFieldInt8NPtr                                   opType = 2533
-	opStructFieldOmitEmptyInt8NPtr                          opType = 2534
-	opStructFieldStringTagInt8NPtr                          opType = 2535
-	opStructFieldInt16NPtr                                  opType = 2536
-	opStructFieldOmitEmptyInt16NPtr                         opType = 2537
-	opStructFieldStringTagInt16NPtr                         opType = 2538
-	opStructFieldInt32NPtr                                  opType = 2539
-	opStructFieldOmitEmptyInt32NPtr                         opType = 2540
-	opStructFieldStringTagInt32NPtr                         opType = 2541
-	opStructFieldInt64NPtr                                  opType = 2542
-	opStructFieldOmitEmptyInt64NPtr                         opType = 2543
-	opStructFieldStringTagInt64NPtr                         opType = 2544
-	opStructFieldUintNPtr                                   opType = 2545
-	opStructFieldOmitEmptyUintNPtr                          opType = 2546
-	opStructFieldStringTagUintNPtr                          opType = 2547
-	opStructFieldUint8NPtr                                  opType = 2548
-	opStructFieldOmitEmptyUint8NPtr                         opType = 2549
-	opStructFieldStringTagUint8NPtr                         opType = 2550
-	opStructFieldUint16NPtr                                 opType = 2551
-	opStructFieldOmitEmptyUint16NPtr                        opType = 2552
-	opStructFieldStringTagUint16NPtr                        opType = 2553
-	opStructFieldUint32NPtr                                 opType = 2554
-	opStructFieldOmitEmptyUint32NPtr                        opType = 2555
-	opStructFieldStringTagUint32NPtr                        opType = 2556
-	opStructFieldUint64NPtr                                 opType = 2557
-	opStructFieldOmitEmptyUint64NPtr                        opType = 2558
-	opStructFieldStringTagUint64NPtr                        opType = 2559
-	opStructFieldFloat32NPtr                                opType = 2560
-	opStructFieldOmitEmptyFloat32NPtr                       opType = 2561
-	opStructFieldStringTagFloat32NPtr                       opType = 2562
-	opStructFieldFloat64NPtr                                opType = 2563
-	opStructFieldOmitEmptyFloat64NPtr                       opType = 2564
-	opStructFieldStringTagFloat64NPtr                       opType = 2565
-	opStructFieldBoolNPtr                                   opType = 2566
-	opStructFieldOmitEmptyBoolNPtr                          opType = 2567
-	opStructFieldStringTagBoolNPtr                          opType = 2568
-	opStructFieldStringNPtr                                 opType = 2569
-	opStructFieldOmitEmptyStringNPtr                        opType = 2570
-	opStructFieldStringTagStringNPtr                        opType = 2571
-	opStructFieldBytesNPtr                                  opType = 2572
-	opStructFieldOmitEmptyBytesNPtr                         opType = 2573
-	opStructFieldStringTagBytesNPtr                         opType = 2574
-	opStructField                                           opType = 2575
-	opStructFieldOmitEmpty                                  opType = 2576
-	opStructFieldStringTag                                  opType = 2577
-	opStructEndInt                                          opType = 2578
-	opStructEndOmitEmptyInt                                 opType = 2579
-	opStructEndStringTagInt                                 opType = 2580
-	opStructEndInt8                                         opType = 2581
-	opStructEndOmitEmptyInt8                                opType = 2582
-	opStructEndStringTagInt8                                opType = 2583
-	opStructEndInt16                                        opType = 2584
-	opStructEndOmitEmptyInt16                               opType = 2585
-	opStructEndStringTagInt16                               opType = 2586
-	opStructEndInt32                                        opType = 2587
-	opStructEndOmitEmptyInt32                               opType = 2588
-	opStructEndStringTagInt32                               opType = 2589
-	opStructEndInt64                                        opType = 2590
-	opStructEndOmitEmptyInt64                               opType = 2591
-	opStructEndStringTagInt64                               opType = 2592
-	opStructEndUint                                         opType = 2593
-	opStructEndOmitEmptyUint                                opType = 2594
-	opStructEndStringTagUint                                opType = 2595
-	opStructEndUint8                                        opType = 2596
-	opStructEndOmitEmptyUint8                               opType = 2597
-	opStructEndStringTagUint8                               opType = 2598
-	opStructEndUint16                                       opType = 2599
-	opStructEndOmitEmptyUint16                              opType = 2600
-	opStructEndStringTagUint16                              opType = 2601
-	opStructEndUint32                                       opType = 2602
-	opStructEndOmitEmptyUint32                              opType = 2603
-	opStructEndStringTagUint32                              opType = 2604
-	opStructEndUint64                                       opType = 2605
-	opStructEndOmitEmptyUint64                              opType = 2606
-	opStructEndStringTagUint64                              opType = 2607
-	opStructEndFloat32                                      opType = 2608
-	opStructEndOmitEmptyFloat32                             opType = 2609
-	opStructEndStringTagFloat32                             opType = 2610
-	opStructEndFloat64                                      opType = 2611
-	opStructEndOmitEmptyFloat64                             opType = 2612
-	opStructEndStringTagFloat64                             opType = 2613
-	opStructEndBool                                         opType = 2614
-	opStructEndOmitEmptyBool                                opType = 2615
-	opStructEndStringTagBool                                opType = 2616
-	opStructEndString                                       opType = 2617
-	opStructEndOmitEmptyString                              opType = 2618
-	opStructEndStringTagString                              opType = 2619
-	opStructEndBytes                                        opType = 2620
-	opStructEndOmitEmptyBytes                               opType = 2621
-	opStructEndStringTagBytes                               opType = 2622
-	opStructEndArray                                        opType = 2623
-	opStructEndOmitEmptyArray                               opType = 2624
-	opStructEndStringTagArray                               opType = 2625
-	opStructEndMap                                          opType = 2626
-	opStructEndOmitEmptyMap                                 opType = 2627
-	opStructEndStringTagMap                                 opType = 2628
-	opStructEndMapLoad                                      opType = 2629
-	opStructEndOmitEmptyMapLoad                             opType = 2630
-	opStructEndStringTagMapLoad                             opType = 2631
-	opStructEndSlice                                        opType = 2632
-	opStructEndOmitEmptySlice                               opType = 2633
-	opStructEndStringTagSlice                               opType = 2634
-	opStructEndStruct                                       opType = 2635
-	opStructEndOmitEmptyStruct                              opType = 2636
-	opStructEndStringTagStruct                              opType = 2637
-	opStructEndMarshalJSON                                  opType = 2638
-	opStructEndOmitEmptyMarshalJSON                         opType = 2639
-	opStructEndStringTagMarshalJSON                         opType = 2640
-	opStructEndMarshalText                                  opType = 2641
-	opStructEndOmitEmptyMarshalText                         opType = 2642
-	opStructEndStringTagMarshalText                         opType = 2643
-	opStructEndRecursive                                    opType = 2644
-	opStructEndOmitEmptyRecursive                           opType = 2645
-	opStructEndStringTagRecursive                           opType = 2646
-	opStructEndIntString                                    opType = 2647
-	opStructEndOmitEmptyIntString                           opType = 2648
-	opStructEndStringTagIntString                           opType = 2649
-	opStructEndInt8String                                   opType = 2650
-	opStructEndOmitEmptyInt8String                          opType = 2651
-	opStructEndStringTagInt8String                          opType = 2652
-	opStructEndInt16String                                  opType = 2653
-	opStructEndOmitEmptyInt16String                         opType = 2654
-	opStructEndStringTagInt16String                         opType = 2655
-	opStructEndInt32String                                  opType = 2656
-	opStructEndOmitEmptyInt32String                         opType = 2657
-	opStructEndStringTagInt32String                         opType = 2658
-	opStructEndInt64String                                  opType = 2659
-	opStructEndOmitEmptyInt64String                         opType = 2660
-	opStructEndStringTagInt64String                         opType = 2661
-	opStructEndUintString                                   opType = 2662
-	opStructEndOmitEmptyUintString                          opType = 2663
-	opStructEndStringTagUintString                          opType = 2664
-	opStructEndUint8String                                  opType = 2665
-	opStructEndOmitEmptyUint8String                         opType = 2666
-	opStructEndStringTagUint8String                         opType = 2667
-	opStructEndUint16String                                 opType = 2668
-	opStructEndOmitEmptyUint16String                        opType = 2669
-	opStructEndStringTagUint16String                        opType = 2670
-	opStructEndUint32String                                 opType = 2671
-	opStructEndOmitEmptyUint32String                        opType = 2672
-	opStructEndStringTagUint32String                        opType = 2673
-	opStructEndUint64String                                 opType = 2674
-	opStructEndOmitEmptyUint64String                        opType = 2675
-	opStructEndStringTagUint64String                        opType = 2676
-	opStructEndIntPtr                                       opType = 2677
-	opStructEndOmitEmptyIntPtr                              opType = 2678
-	opStructEndStringTagIntPtr                              opType = 2679
-	opStructEndInt8Ptr                                      opType = 2680
-	opStructEndOmitEmptyInt8Ptr                             opType = 2681
-	opStructEndStringTagInt8Ptr                             opType = 2682
-	opStructEndInt16Ptr                                     opType = 2683
-	opStructEndOmitEmptyInt16Ptr                            opType = 2684
-	opStructEndStringTagInt16Ptr                            opType = 2685
-	opStructEndInt32Ptr                                     opType = 2686
-	opStructEndOmitEmptyInt32Ptr                            opType = 2687
-	opStructEndStringTagInt32Ptr                            opType = 2688
-	opStructEndInt64Ptr                                     opType = 2689
-	opStructEndOmitEmptyInt64Ptr                            opType = 2690
-	opStructEndStringTagInt64Ptr                            opType = 2691
-	opStructEndUintPtr                                      opType = 2692
-	opStructEndOmitEmptyUintPtr                             opType = 2693
-	opStructEndStringTagUintPtr                             opType = 2694
-	opStructEndUint8Ptr                                     opType = 2695
-	opStructEndOmitEmptyUint8Ptr                            opType = 2696
-	opStructEndStringTagUint8Ptr                            opType = 2697
-	opStructEndUint16Ptr                                    opType = 2698
-	opStructEndOmitEmptyUint16Ptr                           opType = 2699
-	opStructEndStringTagUint16Ptr                           opType = 2700
-	opStructEndUint32Ptr                                    opType = 2701
-	opStructEndOmitEmptyUint32Ptr                           opType = 2702
-	opStructEndStringTagUint32Ptr                           opType = 2703
-	opStructEndUint64Ptr                                    opType = 2704
-	opStructEndOmitEmptyUint64Ptr                           opType = 2705
-	opStructEndStringTagUint64Ptr                           opType = 2706
-	opStructEndFloat32Ptr                                   opType = 2707
-	opStructEndOmitEmptyFloat32Ptr                          opType = 2708
-	opStructEndStringTagFloat32Ptr                          opType = 2709
-	opStructEndFloat64Ptr                                   opType = 2710
-	opStructEndOmitEmptyFloat64Ptr                          opType = 2711
-	opStructEndStringTagFloat64Ptr                          opType = 2712
-	opStructEndBoolPtr                                      opType = 2713
-	opStructEndOmitEmptyBoolPtr                             opType = 2714
-	opStructEndStringTagBoolPtr                             opType = 2715
-	opStructEndStringPtr                                    opType = 2716
-	opStructEndOmitEmptyStringPtr                           opType = 2717
-	opStructEndStringTagStringPtr                           opType = 2718
-	opStructEndBytesPtr                                     opType = 2719
-	opStructEndOmitEmptyBytesPtr                            opType = 2720
-	opStructEndStringTagBytesPtr                            opType = 2721
-	opStructEndIntNPtr                                      opType = 2722
-	opStructEndOmitEmptyIntNPtr                             opType = 2723
-	opStructEndStringTagIntNPtr                             opType = 2724
-	opStructEndInt8NPtr                                     opType = 2725
-	opStructEndOmitEmptyInt8NPtr                            opType = 2726
-	opStructEndStringTagInt8NPtr                            opType = 2727
-	opStructEndInt16NPtr                                    opType = 2728
-	opStructEndOmitEmptyInt16NPtr                           opType = 2729
-	opStructEndStringTagInt16NPtr                           opType = 2730
-	opStructEndInt32NPtr                                    opType = 2731
-	opStructEndOmitEmptyInt32NPtr                           opType = 2732
-	opStructEndStringTagInt32NPtr                           opType = 2733
-	opStructEndInt64NPtr                                    opType = 2734
-	opStructEndOmitEmptyInt64NPtr                           opType = 2735
-	opStructEndStringTagInt64NPtr                           opType = 2736
-	opStructEndUintNPtr                                     opType = 2737
-	opStructEndOmitEmptyUintNPtr                            opType = 2738
-	opStructEndStringTagUintNPtr                            opType = 2739
-	opStructEndUint8NPtr                                    opType = 2740
-	opStructEndOmitEmptyUint8NPtr                           opType = 2741
-	opStructEndStringTagUint8NPtr                           opType = 2742
-	opStructEndUint16NPtr                                   opType = 2743
-	opStructEndOmitEmptyUint16NPtr                          opType = 2744
-	opStructEndStringTagUint16NPtr                          opType = 2745
-	opStructEndUint32NPtr                                   opType = 2746
-	opStructEndOmitEmptyUint32NPtr                          opType = 2747
-	opStructEndStringTagUint32NPtr                          opType = 2748
-	opStructEndUint64NPtr                                   opType = 2749
-	opStructEndOmitEmptyUint64NPtr                          opType = 2750
-	opStructEndStringTagUint64NPtr                          opType = 2751
-	opStructEndFloat32NPtr                                  opType = 2752
-	opStructEndOmitEmptyFloat32NPtr                         opType = 2753
-	opStructEndStringTagFloat32NPtr                         opType = 2754
-	opStructEndFloat64NPtr                                  opType = 2755
-	opStructEndOmitEmptyFloat64NPtr                         opType = 2756
-	opStructEndStringTagFloat64NPtr                         opType = 2757
-	opStructEndBoolNPtr                                     opType = 2758
-	opStructEndOmitEmptyBoolNPtr                            opType = 2759
-	opStructEndStringTagBoolNPtr                            opType = 2760
-	opStructEndStringNPtr                                   opType = 2761
-	opStructEndOmitEmptyStringNPtr                          opType = 2762
-	opStructEndStringTagStringNPtr                          opType = 2763
-	opStructEndBytesNPtr                                    opType = 2764
-	opStructEndOmitEmptyBytesNPtr                           opType = 2765
-	opStructEndStringTagBytesNPtr                           opType = 2766
-	opStructEnd                                             opType = 2767
-	opStructEndOmitEmpty                                    opType = 2768
-	opStructEndStringTag                                    opType = 2769
+	opEnd                                                            opType = 0
+	opInterface                                                      opType = 1
+	opPtr                                                            opType = 2
+	opNPtr                                                           opType = 3
+	opSliceHead                                                      opType = 4
+	opRootSliceHead                                                  opType = 5
+	opSliceElem                                                      opType = 6
+	opRootSliceElem                                                  opType = 7
+	opSliceEnd                                                       opType = 8
+	opArrayHead                                                      opType = 9
+	opArrayElem                                                      opType = 10
+	opArrayEnd                                                       opType = 11
+	opMapHead                                                        opType = 12
+	opMapHeadLoad                                                    opType = 13
+	opMapKey                                                         opType = 14
+	opMapValue                                                       opType = 15
+	opMapEnd                                                         opType = 16
+	opSortedObject                                                   opType = 17
+	opSortedObjectEnd                                                opType = 18
+	opStructFieldRecursiveEnd                                        opType = 19
+	opStructAnonymousEnd                                             opType = 20
+	opCustom                                                         opType = 21
+	opStructFieldUnknown                                             opType = 22
+	opInt                                                            opType = 23
+	opInt8                                                           opType = 24
+	opInt16                                                          opType = 25
+	opInt32                                                          opType = 26
+	opInt64                                                          opType = 27
+	opUint                                                           opType = 28
+	opUint8                                                          opType = 29
+	opUint16                                                         opType = 30
+	opUint32                                                         opType = 31
+	opUint64                                                         opType = 32
+	opFloat32                                                        opType = 33
+	opFloat64                                                        opType = 34
+	opBool                                                           opType = 35
+	opString                                                         opType = 36
+	opBytes                                                          opType = 37
+	opArray                                                          opType = 38
+	opMap                                                            opType = 39
+	opMapLoad                                                        opType = 40
+	opSlice                                                          opType = 41
+	opStruct                                                         opType = 42
+	opMarshalJSON                                                    opType = 43
+	opMarshalText                                                    opType = 44
+	opRecursive                                                      opType = 45
+	opIntString                                                      opType = 46
+	opInt8String                                                     opType = 47
+	opInt16String                                                    opType = 48
+	opInt32String                                                    opType = 49
+	opInt64String                                                    opType = 50
+	opUintString                                                     opType = 51
+	opUint8String                                                    opType = 52
+	opUint16String                                                   opType = 53
+	opUint32String                                                   opType = 54
+	opUint64String                                                   opType = 55
+	opIntPtr                                                         opType = 56
+	opInt8Ptr                                                        opType = 57
+	opInt16Ptr                                                       opType = 58
+	opInt32Ptr                                                       opType = 59
+	opInt64Ptr                                                       opType = 60
+	opUintPtr                                                        opType = 61
+	opUint8Ptr                                                       opType = 62
+	opUint16Ptr                                                      opType = 63
+	opUint32Ptr                                                      opType = 64
+	opUint64Ptr                                                      opType = 65
+	opFloat32Ptr                                                     opType = 66
+	opFloat64Ptr                                                     opType = 67
+	opBoolPtr                                                        opType = 68
+	opStringPtr                                                      opType = 69
+	opBytesPtr                                                       opType = 70
+	opIntNPtr                                                        opType = 71
+	opInt8NPtr                                                       opType = 72
+	opInt16NPtr                                                      opType = 73
+	opInt32NPtr                                                      opType = 74
+	opInt64NPtr                                                      opType = 75
+	opUintNPtr                                                       opType = 76
+	opUint8NPtr                                                      opType = 77
+	opUint16NPtr                                                     opType = 78
+	opUint32NPtr                                                     opType = 79
+	opUint64NPtr                                                     opType = 80
+	opFloat32NPtr                                                    opType = 81
+	opFloat64NPtr                                                    opType = 82
+	opBoolNPtr                                                       opType = 83
+	opStringNPtr                                                     opType = 84
+	opBytesNPtr                                                      opType = 85
+	opStructFieldHeadInt                                             opType = 86
+	opStructFieldHeadIntOnly                                         opType = 87
+	opStructFieldHeadOmitEmptyInt                                    opType = 88
+	opStructFieldHeadOmitEmptyIntOnly                                opType = 89
+	opStructFieldHeadStringTagInt                                    opType = 90
+	opStructFieldHeadStringTagIntOnly                                opType = 91
+	opStructFieldHeadOmitEmptyStringTagInt                           opType = 92
+	opStructFieldHeadOmitEmptyStringTagIntOnly                       opType = 93
+	opStructFieldAnonymousHeadInt                                    opType = 94
+	opStructFieldAnonymousHeadIntOnly                                opType = 95
+	opStructFieldAnonymousHeadOmitEmptyInt                           opType = 96
+	opStructFieldAnonymousHeadOmitEmptyIntOnly                       opType = 97
+	opStructFieldAnonymousHeadStringTagInt                           opType = 98
+	opStructFieldAnonymousHeadStringTagIntOnly                       opType = 99
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt                  opType = 100
+	opStructFieldAnonymousHeadOmitEmptyStringTagIntOnly              opType = 101
+	opStructFieldPtrHeadInt                                          opType = 102
+	opStructFieldPtrHeadIntOnly                                      opType = 103
+	opStructFieldPtrHeadOmitEmptyInt                                 opType = 104
+	opStructFieldPtrHeadOmitEmptyIntOnly                             opType = 105
+	opStructFieldPtrHeadStringTagInt                                 opType = 106
+	opStructFieldPtrHeadStringTagIntOnly                             opType = 107
+	opStructFieldPtrHeadOmitEmptyStringTagInt                        opType = 108
+	opStructFieldPtrHeadOmitEmptyStringTagIntOnly                    opType = 109
+	opStructFieldPtrAnonymousHeadInt                                 opType = 110
+	opStructFieldPtrAnonymousHeadIntOnly                             opType = 111
+	opStructFieldPtrAnonymousHeadOmitEmptyInt                        opType = 112
+	opStructFieldPtrAnonymousHeadOmitEmptyIntOnly                    opType = 113
+	opStructFieldPtrAnonymousHeadStringTagInt                        opType = 114
+	opStructFieldPtrAnonymousHeadStringTagIntOnly                    opType = 115
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt               opType = 116
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntOnly           opType = 117
+	opStructFieldNPtrHeadInt                                         opType = 118
+	opStructFieldNPtrHeadIntOnly                                     opType = 119
+	opStructFieldNPtrHeadOmitEmptyInt                                opType = 120
+	opStructFieldNPtrHeadOmitEmptyIntOnly                            opType = 121
+	opStructFieldNPtrHeadStringTagInt                                opType = 122
+	opStructFieldNPtrHeadStringTagIntOnly                            opType = 123
+	opStructFieldNPtrHeadOmitEmptyStringTagInt                       opType = 124
+	opStructFieldNPtrHeadOmitEmptyStringTagIntOnly                   opType = 125
+	opStructFieldNPtrAnonymousHeadInt                                opType = 126
+	opStructFieldNPtrAnonymousHeadIntOnly                            opType = 127
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt                       opType = 128
+	opStructFieldNPtrAnonymousHeadOmitEmptyIntOnly                   opType = 129
+	opStructFieldNPtrAnonymousHeadStringTagInt                       opType = 130
+	opStructFieldNPtrAnonymousHeadStringTagIntOnly                   opType = 131
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt              opType = 132
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagIntOnly          opType = 133
+	opStructFieldHeadInt8                                            opType = 134
+	opStructFieldHeadInt8Only                                        opType = 135
+	opStructFieldHeadOmitEmptyInt8                                   opType = 136
+	opStructFieldHeadOmitEmptyInt8Only                               opType = 137
+	opStructFieldHeadStringTagInt8                                   opType = 138
+	opStructFieldHeadStringTagInt8Only                               opType = 139
+	opStructFieldHeadOmitEmptyStringTagInt8                          opType = 140
+	opStructFieldHeadOmitEmptyStringTagInt8Only                      opType = 141
+	opStructFieldAnonymousHeadInt8                                   opType = 142
+	opStructFieldAnonymousHeadInt8Only                               opType = 143
+	opStructFieldAnonymousHeadOmitEmptyInt8                          opType = 144
+	opStructFieldAnonymousHeadOmitEmptyInt8Only                      opType = 145
+	opStructFieldAnonymousHeadStringTagInt8                          opType = 146
+	opStructFieldAnonymousHeadStringTagInt8Only                      opType = 147
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt8                 opType = 148
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt8Only             opType = 149
+	opStructFieldPtrHeadInt8                                         opType = 150
+	opStructFieldPtrHeadInt8Only                                     opType = 151
+	opStructFieldPtrHeadOmitEmptyInt8                                opType = 152
+	opStructFieldPtrHeadOmitEmptyInt8Only                            opType = 153
+	opStructFieldPtrHeadStringTagInt8                                opType = 154
+	opStructFieldPtrHeadStringTagInt8Only                            opType = 155
+	opStructFieldPtrHeadOmitEmptyStringTagInt8                       opType = 156
+	opStructFieldPtrHeadOmitEmptyStringTagInt8Only                   opType = 157
+	opStructFieldPtrAnonymousHeadInt8                                opType = 158
+	opStructFieldPtrAnonymousHeadInt8Only                            opType = 159
+	opStructFieldPtrAnonymousHeadOmitEmptyInt8                       opType = 160
+	opStructFieldPtrAnonymousHeadOmitEmptyInt8Only                   opType = 161
+	opStructFieldPtrAnonymousHeadStringTagInt8                       opType = 162
+	opStructFieldPtrAnonymousHeadStringTagInt8Only                   opType = 163
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8              opType = 164
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Only          opType = 165
+	opStructFieldNPtrHeadInt8                                        opType = 166
+	opStructFieldNPtrHeadInt8Only                                    opType = 167
+	opStructFieldNPtrHeadOmitEmptyInt8                               opType = 168
+	opStructFieldNPtrHeadOmitEmptyInt8Only                           opType = 169
+	opStructFieldNPtrHeadStringTagInt8                               opType = 170
+	opStructFieldNPtrHeadStringTagInt8Only                           opType = 171
+	opStructFieldNPtrHeadOmitEmptyStringTagInt8                      opType = 172
+	opStructFieldNPtrHeadOmitEmptyStringTagInt8Only                  opType = 173
+	opStructFieldNPtrAnonymousHeadInt8                               opType = 174
+	opStructFieldNPtrAnonymousHeadInt8Only                           opType = 175
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt8                      opType = 176
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt8Only                  opType = 177
+	opStructFieldNPtrAnonymousHeadStringTagInt8                      opType = 178
+	opStructFieldNPtrAnonymousHeadStringTagInt8Only                  opType = 179
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8             opType = 180
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8Only         opType = 181
+	opStructFieldHeadInt16                                           opType = 182
+	opStructFieldHeadInt16Only                                       opType = 183
+	opStructFieldHeadOmitEmptyInt16                                  opType = 184
+	opStructFieldHeadOmitEmptyInt16Only                              opType = 185
+	opStructFieldHeadStringTagInt16                                  opType = 186
+	opStructFieldHeadStringTagInt16Only                              opType = 187
+	opStructFieldHeadOmitEmptyStringTagInt16                         opType = 188
+	opStructFieldHeadOmitEmptyStringTagInt16Only                     opType = 189
+	opStructFieldAnonymousHeadInt16                                  opType = 190
+	opStructFieldAnonymousHeadInt16Only                              opType = 191
+	opStructFieldAnonymousHeadOmitEmptyInt16                         opType = 192
+	opStructFieldAnonymousHeadOmitEmptyInt16Only                     opType = 193
+	opStructFieldAnonymousHeadStringTagInt16                         opType = 194
+	opStructFieldAnonymousHeadStringTagInt16Only                     opType = 195
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt16                opType = 196
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt16Only            opType = 197
+	opStructFieldPtrHeadInt16                                        opType = 198
+	opStructFieldPtrHeadInt16Only                                    opType = 199
+	opStructFieldPtrHeadOmitEmptyInt16                               opType = 200
+	opStructFieldPtrHeadOmitEmptyInt16Only                           opType = 201
+	opStructFieldPtrHeadStringTagInt16                               opType = 202
+	opStructFieldPtrHeadStringTagInt16Only                           opType = 203
+	opStructFieldPtrHeadOmitEmptyStringTagInt16                      opType = 204
+	opStructFieldPtrHeadOmitEmptyStringTagInt16Only                  opType = 205
+	opStructFieldPtrAnonymousHeadInt16                               opType = 206
+	opStructFieldPtrAnonymousHeadInt16Only                           opType = 207
+	opStructFieldPtrAnonymousHeadOmitEmptyInt16                      opType = 208
+	opStructFieldPtrAnonymousHeadOmitEmptyInt16Only                  opType = 209
+	opStructFieldPtrAnonymousHeadStringTagInt16                      opType = 210
+	opStructFieldPtrAnonymousHeadStringTagInt16Only                  opType = 211
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16             opType = 212
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Only         opType = 213
+	opStructFieldNPtrHeadInt16                                       opType = 214
+	opStructFieldNPtrHeadInt16Only                                   opType = 215
+	opStructFieldNPtrHeadOmitEmptyInt16                              opType = 216
+	opStructFieldNPtrHeadOmitEmptyInt16Only                          opType = 217
+	opStructFieldNPtrHeadStringTagInt16                              opType = 218
+	opStructFieldNPtrHeadStringTagInt16Only                          opType = 219
+	opStructFieldNPtrHeadOmitEmptyStringTagInt16                     opType = 220
+	opStructFieldNPtrHeadOmitEmptyStringTagInt16Only                 opType = 221
+	opStructFieldNPtrAnonymousHeadInt16                              opType = 222
+	opStructFieldNPtrAnonymousHeadInt16Only                          opType = 223
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt16                     opType = 224
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt16Only                 opType = 225
+	opStructFieldNPtrAnonymousHeadStringTagInt16                     opType = 226
+	opStructFieldNPtrAnonymousHeadStringTagInt16Only                 opType = 227
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16            opType = 228
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16Only        opType = 229
+	opStructFieldHeadInt32                                           opType = 230
+	opStructFieldHeadInt32Only                                       opType = 231
+	opStructFieldHeadOmitEmptyInt32                                  opType = 232
+	opStructFieldHeadOmitEmptyInt32Only                              opType = 233
+	opStructFieldHeadStringTagInt32                                  opType = 234
+	opStructFieldHeadStringTagInt32Only                              opType = 235
+	opStructFieldHeadOmitEmptyStringTagInt32                         opType = 236
+	opStructFieldHeadOmitEmptyStringTagInt32Only                     opType = 237
+	opStructFieldAnonymousHeadInt32                                  opType = 238
+	opStructFieldAnonymousHeadInt32Only                              opType = 239
+	opStructFieldAnonymousHeadOmitEmptyInt32                         opType = 240
+	opStructFieldAnonymousHeadOmitEmptyInt32Only                     opType = 241
+	opStructFieldAnonymousHeadStringTagInt32                         opType = 242
+	opStructFieldAnonymousHeadStringTagInt32Only                     opType = 243
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt32                opType = 244
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt32Only            opType = 245
+	opStructFieldPtrHeadInt32                                        opType = 246
+	opStructFieldPtrHeadInt32Only                                    opType = 247
+	opStructFieldPtrHeadOmitEmptyInt32                               opType = 248
+	opStructFieldPtrHeadOmitEmptyInt32Only                           opType = 249
+	opStructFieldPtrHeadStringTagInt32                               opType = 250
+	opStructFieldPtrHeadStringTagInt32Only                           opType = 251
+	opStructFieldPtrHeadOmitEmptyStringTagInt32                      opType = 252
+	opStructFieldPtrHeadOmitEmptyStringTagInt32Only                  opType = 253
+	opStructFieldPtrAnonymousHeadInt32                               opType = 254
+	opStructFieldPtrAnonymousHeadInt32Only                           opType = 255
+	opStructFieldPtrAnonymousHeadOmitEmptyInt32                      opType = 256
+	opStructFieldPtrAnonymousHeadOmitEmptyInt32Only                  opType = 257
+	opStructFieldPtrAnonymousHeadStringTagInt32                      opType = 258
+	opStructFieldPtrAnonymousHeadStringTagInt32Only                  opType = 259
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32             opType = 260
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Only         opType = 261
+	opStructFieldNPtrHeadInt32                                       opType = 262
+	opStructFieldNPtrHeadInt32Only                                   opType = 263
+	opStructFieldNPtrHeadOmitEmptyInt32                              opType = 264
+	opStructFieldNPtrHeadOmitEmptyInt32Only                          opType = 265
+	opStructFieldNPtrHeadStringTagInt32                              opType = 266
+	opStructFieldNPtrHeadStringTagInt32Only                          opType = 267
+	opStructFieldNPtrHeadOmitEmptyStringTagInt32                     opType = 268
+	opStructFieldNPtrHeadOmitEmptyStringTagInt32Only                 opType = 269
+	opStructFieldNPtrAnonymousHeadInt32                              opType = 270
+	opStructFieldNPtrAnonymousHeadInt32Only                          opType = 271
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt32                     opType = 272
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt32Only                 opType = 273
+	opStructFieldNPtrAnonymousHeadStringTagInt32                     opType = 274
+	opStructFieldNPtrAnonymousHeadStringTagInt32Only                 opType = 275
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32            opType = 276
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32Only        opType = 277
+	opStructFieldHeadInt64                                           opType = 278
+	opStructFieldHeadInt64Only                                       opType = 279
+	opStructFieldHeadOmitEmptyInt64                                  opType = 280
+	opStructFieldHeadOmitEmptyInt64Only                              opType = 281
+	opStructFieldHeadStringTagInt64                                  opType = 282
+	opStructFieldHeadStringTagInt64Only                              opType = 283
+	opStructFieldHeadOmitEmptyStringTagInt64                         opType = 284
+	opStructFieldHeadOmitEmptyStringTagInt64Only                     opType = 285
+	opStructFieldAnonymousHeadInt64                                  opType = 286
+	opStructFieldAnonymousHeadInt64Only                              opType = 287
+	opStructFieldAnonymousHeadOmitEmptyInt64                         opType = 288
+	opStructFieldAnonymousHeadOmitEmptyInt64Only                     opType = 289
+	opStructFieldAnonymousHeadStringTagInt64                         opType = 290
+	opStructFieldAnonymousHeadStringTagInt64Only                     opType = 291
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt64                opType = 292
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt64Only            opType = 293
+	opStructFieldPtrHeadInt64                                        opType = 294
+	opStructFieldPtrHeadInt64Only                                    opType = 295
+	opStructFieldPtrHeadOmitEmptyInt64                               opType = 296
+	opStructFieldPtrHeadOmitEmptyInt64Only                           opType = 297
+	opStructFieldPtrHeadStringTagInt64                               opType = 298
+	opStructFieldPtrHeadStringTagInt64Only                           opType = 299
+	opStructFieldPtrHeadOmitEmptyStringTagInt64                      opType = 300
+	opStructFieldPtrHeadOmitEmptyStringTagInt64Only                  opType = 301
+	opStructFieldPtrAnonymousHeadInt64                               opType = 302
+	opStructFieldPtrAnonymousHeadInt64Only                           opType = 303
+	opStructFieldPtrAnonymousHeadOmitEmptyInt64                      opType = 304
+	opStructFieldPtrAnonymousHeadOmitEmptyInt64Only                  opType = 305
+	opStructFieldPtrAnonymousHeadStringTagInt64                      opType = 306
+	opStructFieldPtrAnonymousHeadStringTagInt64Only                  opType = 307
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64             opType = 308
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Only         opType = 309
+	opStructFieldNPtrHeadInt64                                       opType = 310
+	opStructFieldNPtrHeadInt64Only                                   opType = 311
+	opStructFieldNPtrHeadOmitEmptyInt64                              opType = 312
+	opStructFieldNPtrHeadOmitEmptyInt64Only                          opType = 313
+	opStructFieldNPtrHeadStringTagInt64                              opType = 314
+	opStructFieldNPtrHeadStringTagInt64Only                          opType = 315
+	opStructFieldNPtrHeadOmitEmptyStringTagInt64                     opType = 316
+	opStructFieldNPtrHeadOmitEmptyStringTagInt64Only                 opType = 317
+	opStructFieldNPtrAnonymousHeadInt64                              opType = 318
+	opStructFieldNPtrAnonymousHeadInt64Only                          opType = 319
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt64                     opType = 320
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt64Only                 opType = 321
+	opStructFieldNPtrAnonymousHeadStringTagInt64                     opType = 322
+	opStructFieldNPtrAnonymousHeadStringTagInt64Only                 opType = 323
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64            opType = 324
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64Only        opType = 325
+	opStructFieldHeadUint                                            opType = 326
+	opStructFieldHeadUintOnly                                        opType = 327
+	opStructFieldHeadOmitEmptyUint                                   opType = 328
+	opStructFieldHeadOmitEmptyUintOnly                               opType = 329
+	opStructFieldHeadStringTagUint                                   opType = 330
+	opStructFieldHeadStringTagUintOnly                               opType = 331
+	opStructFieldHeadOmitEmptyStringTagUint                          opType = 332
+	opStructFieldHeadOmitEmptyStringTagUintOnly                      opType = 333
+	opStructFieldAnonymousHeadUint                                   opType = 334
+	opStructFieldAnonymousHeadUintOnly                               opType = 335
+	opStructFieldAnonymousHeadOmitEmptyUint                          opType = 336
+	opStructFieldAnonymousHeadOmitEmptyUintOnly                      opType = 337
+	opStructFieldAnonymousHeadStringTagUint                          opType = 338
+	opStructFieldAnonymousHeadStringTagUintOnly                      opType = 339
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint                 opType = 340
+	opStructFieldAnonymousHeadOmitEmptyStringTagUintOnly             opType = 341
+	opStructFieldPtrHeadUint                                         opType = 342
+	opStructFieldPtrHeadUintOnly                                     opType = 343
+	opStructFieldPtrHeadOmitEmptyUint                                opType = 344
+	opStructFieldPtrHeadOmitEmptyUintOnly                            opType = 345
+	opStructFieldPtrHeadStringTagUint                                opType = 346
+	opStructFieldPtrHeadStringTagUintOnly                            opType = 347
+	opStructFieldPtrHeadOmitEmptyStringTagUint                       opType = 348
+	opStructFieldPtrHeadOmitEmptyStringTagUintOnly                   opType = 349
+	opStructFieldPtrAnonymousHeadUint                                opType = 350
+	opStructFieldPtrAnonymousHeadUintOnly                            opType = 351
+	opStructFieldPtrAnonymousHeadOmitEmptyUint                       opType = 352
+	opStructFieldPtrAnonymousHeadOmitEmptyUintOnly                   opType = 353
+	opStructFieldPtrAnonymousHeadStringTagUint                       opType = 354
+	opStructFieldPtrAnonymousHeadStringTagUintOnly                   opType = 355
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint              opType = 356
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintOnly          opType = 357
+	opStructFieldNPtrHeadUint                                        opType = 358
+	opStructFieldNPtrHeadUintOnly                                    opType = 359
+	opStructFieldNPtrHeadOmitEmptyUint                               opType = 360
+	opStructFieldNPtrHeadOmitEmptyUintOnly                           opType = 361
+	opStructFieldNPtrHeadStringTagUint                               opType = 362
+	opStructFieldNPtrHeadStringTagUintOnly                           opType = 363
+	opStructFieldNPtrHeadOmitEmptyStringTagUint                      opType = 364
+	opStructFieldNPtrHeadOmitEmptyStringTagUintOnly                  opType = 365
+	opStructFieldNPtrAnonymousHeadUint                               opType = 366
+	opStructFieldNPtrAnonymousHeadUintOnly                           opType = 367
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint                      opType = 368
+	opStructFieldNPtrAnonymousHeadOmitEmptyUintOnly                  opType = 369
+	opStructFieldNPtrAnonymousHeadStringTagUint                      opType = 370
+	opStructFieldNPtrAnonymousHeadStringTagUintOnly                  opType = 371
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint             opType = 372
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUintOnly         opType = 373
+	opStructFieldHeadUint8                                           opType = 374
+	opStructFieldHeadUint8Only                                       opType = 375
+	opStructFieldHeadOmitEmptyUint8                                  opType = 376
+	opStructFieldHeadOmitEmptyUint8Only                              opType = 377
+	opStructFieldHeadStringTagUint8                                  opType = 378
+	opStructFieldHeadStringTagUint8Only                              opType = 379
+	opStructFieldHeadOmitEmptyStringTagUint8                         opType = 380
+	opStructFieldHeadOmitEmptyStringTagUint8Only                     opType = 381
+	opStructFieldAnonymousHeadUint8                                  opType = 382
+	opStructFieldAnonymousHeadUint8Only                              opType = 383
+	opStructFieldAnonymousHeadOmitEmptyUint8                         opType = 384
+	opStructFieldAnonymousHeadOmitEmptyUint8Only                     opType = 385
+	opStructFieldAnonymousHeadStringTagUint8                         opType = 386
+	opStructFieldAnonymousHeadStringTagUint8Only                     opType = 387
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint8                opType = 388
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint8Only            opType = 389
+	opStructFieldPtrHeadUint8                                        opType = 390
+	opStructFieldPtrHeadUint8Only                                    opType = 391
+	opStructFieldPtrHeadOmitEmptyUint8                               opType = 392
+	opStructFieldPtrHeadOmitEmptyUint8Only                           opType = 393
+	opStructFieldPtrHeadStringTagUint8                               opType = 394
+	opStructFieldPtrHeadStringTagUint8Only                           opType = 395
+	opStructFieldPtrHeadOmitEmptyStringTagUint8                      opType = 396
+	opStructFieldPtrHeadOmitEmptyStringTagUint8Only                  opType = 397
+	opStructFieldPtrAnonymousHeadUint8                               opType = 398
+	opStructFieldPtrAnonymousHeadUint8Only                           opType = 399
+	opStructFieldPtrAnonymousHeadOmitEmptyUint8                      opType = 400
+	opStructFieldPtrAnonymousHeadOmitEmptyUint8Only                  opType = 401
+	opStructFieldPtrAnonymousHeadStringTagUint8                      opType = 402
+	opStructFieldPtrAnonymousHeadStringTagUint8Only                  opType = 403
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8             opType = 404
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Only         opType = 405
+	opStructFieldNPtrHeadUint8                                       opType = 406
+	opStructFieldNPtrHeadUint8Only                                   opType = 407
+	opStructFieldNPtrHeadOmitEmptyUint8                              opType = 408
+	opStructFieldNPtrHeadOmitEmptyUint8Only                          opType = 409
+	opStructFieldNPtrHeadStringTagUint8                              opType = 410
+	opStructFieldNPtrHeadStringTagUint8Only                          opType = 411
+	opStructFieldNPtrHeadOmitEmptyStringTagUint8                     opType = 412
+	opStructFieldNPtrHeadOmitEmptyStringTagUint8Only                 opType = 413
+	opStructFieldNPtrAnonymousHeadUint8                              opType = 414
+	opStructFieldNPtrAnonymousHeadUint8Only                          opType = 415
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint8                     opType = 416
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint8Only                 opType = 417
+	opStructFieldNPtrAnonymousHeadStringTagUint8                     opType = 418
+	opStructFieldNPtrAnonymousHeadStringTagUint8Only                 opType = 419
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8            opType = 420
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8Only        opType = 421
+	opStructFieldHeadUint16                                          opType = 422
+	opStructFieldHeadUint16Only                                      opType = 423
+	opStructFieldHeadOmitEmptyUint16                                 opType = 424
+	opStructFieldHeadOmitEmptyUint16Only                             opType = 425
+	opStructFieldHeadStringTagUint16                                 opType = 426
+	opStructFieldHeadStringTagUint16Only                             opType = 427
+	opStructFieldHeadOmitEmptyStringTagUint16                        opType = 428
+	opStructFieldHeadOmitEmptyStringTagUint16Only                    opType = 429
+	opStructFieldAnonymousHeadUint16                                 opType = 430
+	opStructFieldAnonymousHeadUint16Only                             opType = 431
+	opStructFieldAnonymousHeadOmitEmptyUint16                        opType = 432
+	opStructFieldAnonymousHeadOmitEmptyUint16Only                    opType = 433
+	opStructFieldAnonymousHeadStringTagUint16                        opType = 434
+	opStructFieldAnonymousHeadStringTagUint16Only                    opType = 435
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint16               opType = 436
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint16Only           opType = 437
+	opStructFieldPtrHeadUint16                                       opType = 438
+	opStructFieldPtrHeadUint16Only                                   opType = 439
+	opStructFieldPtrHeadOmitEmptyUint16                              opType = 440
+	opStructFieldPtrHeadOmitEmptyUint16Only                          opType = 441
+	opStructFieldPtrHeadStringTagUint16                              opType = 442
+	opStructFieldPtrHeadStringTagUint16Only                          opType = 443
+	opStructFieldPtrHeadOmitEmptyStringTagUint16                     opType = 444
+	opStructFieldPtrHeadOmitEmptyStringTagUint16Only                 opType = 445
+	opStructFieldPtrAnonymousHeadUint16                              opType = 446
+	opStructFieldPtrAnonymousHeadUint16Only                          opType = 447
+	opStructFieldPtrAnonymousHeadOmitEmptyUint16                     opType = 448
+	opStructFieldPtrAnonymousHeadOmitEmptyUint16Only                 opType = 449
+	opStructFieldPtrAnonymousHeadStringTagUint16                     opType = 450
+	opStructFieldPtrAnonymousHeadStringTagUint16Only                 opType = 451
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16            opType = 452
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Only        opType = 453
+	opStructFieldNPtrHeadUint16                                      opType = 454
+	opStructFieldNPtrHeadUint16Only                                  opType = 455
+	opStructFieldNPtrHeadOmitEmptyUint16                             opType = 456
+	opStructFieldNPtrHeadOmitEmptyUint16Only                         opType = 457
+	opStructFieldNPtrHeadStringTagUint16                             opType = 458
+	opStructFieldNPtrHeadStringTagUint16Only                         opType = 459
+	opStructFieldNPtrHeadOmitEmptyStringTagUint16                    opType = 460
+	opStructFieldNPtrHeadOmitEmptyStringTagUint16Only                opType = 461
+	opStructFieldNPtrAnonymousHeadUint16                             opType = 462
+	opStructFieldNPtrAnonymousHeadUint16Only                         opType = 463
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint16                    opType = 464
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint16Only                opType = 465
+	opStructFieldNPtrAnonymousHeadStringTagUint16                    opType = 466
+	opStructFieldNPtrAnonymousHeadStringTagUint16Only                opType = 467
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16           opType = 468
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16Only       opType = 469
+	opStructFieldHeadUint32                                          opType = 470
+	opStructFieldHeadUint32Only                                      opType = 471
+	opStructFieldHeadOmitEmptyUint32                                 opType = 472
+	opStructFieldHeadOmitEmptyUint32Only                             opType = 473
+	opStructFieldHeadStringTagUint32                                 opType = 474
+	opStructFieldHeadStringTagUint32Only                             opType = 475
+	opStructFieldHeadOmitEmptyStringTagUint32                        opType = 476
+	opStructFieldHeadOmitEmptyStringTagUint32Only                    opType = 477
+	opStructFieldAnonymousHeadUint32                                 opType = 478
+	opStructFieldAnonymousHeadUint32Only                             opType = 479
+	opStructFieldAnonymousHeadOmitEmptyUint32                        opType = 480
+	opStructFieldAnonymousHeadOmitEmptyUint32Only                    opType = 481
+	opStructFieldAnonymousHeadStringTagUint32                        opType = 482
+	opStructFieldAnonymousHeadStringTagUint32Only                    opType = 483
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint32               opType = 484
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint32Only           opType = 485
+	opStructFieldPtrHeadUint32                                       opType = 486
+	opStructFieldPtrHeadUint32Only                                   opType = 487
+	opStructFieldPtrHeadOmitEmptyUint32                              opType = 488
+	opStructFieldPtrHeadOmitEmptyUint32Only                          opType = 489
+	opStructFieldPtrHeadStringTagUint32                              opType = 490
+	opStructFieldPtrHeadStringTagUint32Only                          opType = 491
+	opStructFieldPtrHeadOmitEmptyStringTagUint32                     opType = 492
+	opStructFieldPtrHeadOmitEmptyStringTagUint32Only                 opType = 493
+	opStructFieldPtrAnonymousHeadUint32                              opType = 494
+	opStructFieldPtrAnonymousHeadUint32Only                          opType = 495
+	opStructFieldPtrAnonymousHeadOmitEmptyUint32                     opType = 496
+	opStructFieldPtrAnonymousHeadOmitEmptyUint32Only                 opType = 497
+	opStructFieldPtrAnonymousHeadStringTagUint32                     opType = 498
+	opStructFieldPtrAnonymousHeadStringTagUint32Only                 opType = 499
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32            opType = 500
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Only        opType = 501
+	opStructFieldNPtrHeadUint32                                      opType = 502
+	opStructFieldNPtrHeadUint32Only                                  opType = 503
+	opStructFieldNPtrHeadOmitEmptyUint32                             opType = 504
+	opStructFieldNPtrHeadOmitEmptyUint32Only                         opType = 505
+	opStructFieldNPtrHeadStringTagUint32                             opType = 506
+	opStructFieldNPtrHeadStringTagUint32Only                         opType = 507
+	opStructFieldNPtrHeadOmitEmptyStringTagUint32                    opType = 508
+	opStructFieldNPtrHeadOmitEmptyStringTagUint32Only                opType = 509
+	opStructFieldNPtrAnonymousHeadUint32                             opType = 510
+	opStructFieldNPtrAnonymousHeadUint32Only                         opType = 511
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint32                    opType = 512
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint32Only                opType = 513
+	opStructFieldNPtrAnonymousHeadStringTagUint32                    opType = 514
+	opStructFieldNPtrAnonymousHeadStringTagUint32Only                opType = 515
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32           opType = 516
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32Only       opType = 517
+	opStructFieldHeadUint64                                          opType = 518
+	opStructFieldHeadUint64Only                                      opType = 519
+	opStructFieldHeadOmitEmptyUint64                                 opType = 520
+	opStructFieldHeadOmitEmptyUint64Only                             opType = 521
+	opStructFieldHeadStringTagUint64                                 opType = 522
+	opStructFieldHeadStringTagUint64Only                             opType = 523
+	opStructFieldHeadOmitEmptyStringTagUint64                        opType = 524
+	opStructFieldHeadOmitEmptyStringTagUint64Only                    opType = 525
+	opStructFieldAnonymousHeadUint64                                 opType = 526
+	opStructFieldAnonymousHeadUint64Only                             opType = 527
+	opStructFieldAnonymousHeadOmitEmptyUint64                        opType = 528
+	opStructFieldAnonymousHeadOmitEmptyUint64Only                    opType = 529
+	opStructFieldAnonymousHeadStringTagUint64                        opType = 530
+	opStructFieldAnonymousHeadStringTagUint64Only                    opType = 531
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint64               opType = 532
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint64Only           opType = 533
+	opStructFieldPtrHeadUint64                                       opType = 534
+	opStructFieldPtrHeadUint64Only                                   opType = 535
+	opStructFieldPtrHeadOmitEmptyUint64                              opType = 536
+	opStructFieldPtrHeadOmitEmptyUint64Only                          opType = 537
+	opStructFieldPtrHeadStringTagUint64                              opType = 538
+	opStructFieldPtrHeadStringTagUint64Only                          opType = 539
+	opStructFieldPtrHeadOmitEmptyStringTagUint64                     opType = 540
+	opStructFieldPtrHeadOmitEmptyStringTagUint64Only                 opType = 541
+	opStructFieldPtrAnonymousHeadUint64                              opType = 542
+	opStructFieldPtrAnonymousHeadUint64Only                          opType = 543
+	opStructFieldPtrAnonymousHeadOmitEmptyUint64                     opType = 544
+	opStructFieldPtrAnonymousHeadOmitEmptyUint64Only                 opType = 545
+	opStructFieldPtrAnonymousHeadStringTagUint64                     opType = 546
+	opStructFieldPtrAnonymousHeadStringTagUint64Only                 opType = 547
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64            opType = 548
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Only        opType = 549
+	opStructFieldNPtrHeadUint64                                      opType = 550
+	opStructFieldNPtrHeadUint64Only                                  opType = 551
+	opStructFieldNPtrHeadOmitEmptyUint64                             opType = 552
+	opStructFieldNPtrHeadOmitEmptyUint64Only                         opType = 553
+	opStructFieldNPtrHeadStringTagUint64                             opType = 554
+	opStructFieldNPtrHeadStringTagUint64Only                         opType = 555
+	opStructFieldNPtrHeadOmitEmptyStringTagUint64                    opType = 556
+	opStructFieldNPtrHeadOmitEmptyStringTagUint64Only                opType = 557
+	opStructFieldNPtrAnonymousHeadUint64                             opType = 558
+	opStructFieldNPtrAnonymousHeadUint64Only                         opType = 559
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint64                    opType = 560
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint64Only                opType = 561
+	opStructFieldNPtrAnonymousHeadStringTagUint64                    opType = 562
+	opStructFieldNPtrAnonymousHeadStringTagUint64Only                opType = 563
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64           opType = 564
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64Only       opType = 565
+	opStructFieldHeadFloat32                                         opType = 566
+	opStructFieldHeadFloat32Only                                     opType = 567
+	opStructFieldHeadOmitEmptyFloat32                                opType = 568
+	opStructFieldHeadOmitEmptyFloat32Only                            opType = 569
+	opStructFieldHeadStringTagFloat32                                opType = 570
+	opStructFieldHeadStringTagFloat32Only                            opType = 571
+	opStructFieldHeadOmitEmptyStringTagFloat32                       opType = 572
+	opStructFieldHeadOmitEmptyStringTagFloat32Only                   opType = 573
+	opStructFieldAnonymousHeadFloat32                                opType = 574
+	opStructFieldAnonymousHeadFloat32Only                            opType = 575
+	opStructFieldAnonymousHeadOmitEmptyFloat32                       opType = 576
+	opStructFieldAnonymousHeadOmitEmptyFloat32Only                   opType = 577
+	opStructFieldAnonymousHeadStringTagFloat32                       opType = 578
+	opStructFieldAnonymousHeadStringTagFloat32Only                   opType = 579
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat32              opType = 580
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat32Only          opType = 581
+	opStructFieldPtrHeadFloat32                                      opType = 582
+	opStructFieldPtrHeadFloat32Only                                  opType = 583
+	opStructFieldPtrHeadOmitEmptyFloat32                             opType = 584
+	opStructFieldPtrHeadOmitEmptyFloat32Only                         opType = 585
+	opStructFieldPtrHeadStringTagFloat32                             opType = 586
+	opStructFieldPtrHeadStringTagFloat32Only                         opType = 587
+	opStructFieldPtrHeadOmitEmptyStringTagFloat32                    opType = 588
+	opStructFieldPtrHeadOmitEmptyStringTagFloat32Only                opType = 589
+	opStructFieldPtrAnonymousHeadFloat32                             opType = 590
+	opStructFieldPtrAnonymousHeadFloat32Only                         opType = 591
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat32                    opType = 592
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat32Only                opType = 593
+	opStructFieldPtrAnonymousHeadStringTagFloat32                    opType = 594
+	opStructFieldPtrAnonymousHeadStringTagFloat32Only                opType = 595
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32           opType = 596
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Only       opType = 597
+	opStructFieldNPtrHeadFloat32                                     opType = 598
+	opStructFieldNPtrHeadFloat32Only                                 opType = 599
+	opStructFieldNPtrHeadOmitEmptyFloat32                            opType = 600
+	opStructFieldNPtrHeadOmitEmptyFloat32Only                        opType = 601
+	opStructFieldNPtrHeadStringTagFloat32                            opType = 602
+	opStructFieldNPtrHeadStringTagFloat32Only                        opType = 603
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat32                   opType = 604
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat32Only               opType = 605
+	opStructFieldNPtrAnonymousHeadFloat32                            opType = 606
+	opStructFieldNPtrAnonymousHeadFloat32Only                        opType = 607
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32                   opType = 608
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32Only               opType = 609
+	opStructFieldNPtrAnonymousHeadStringTagFloat32                   opType = 610
+	opStructFieldNPtrAnonymousHeadStringTagFloat32Only               opType = 611
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32          opType = 612
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32Only      opType = 613
+	opStructFieldHeadFloat64                                         opType = 614
+	opStructFieldHeadFloat64Only                                     opType = 615
+	opStructFieldHeadOmitEmptyFloat64                                opType = 616
+	opStructFieldHeadOmitEmptyFloat64Only                            opType = 617
+	opStructFieldHeadStringTagFloat64                                opType = 618
+	opStructFieldHeadStringTagFloat64Only                            opType = 619
+	opStructFieldHeadOmitEmptyStringTagFloat64                       opType = 620
+	opStructFieldHeadOmitEmptyStringTagFloat64Only                   opType = 621
+	opStructFieldAnonymousHeadFloat64                                opType = 622
+	opStructFieldAnonymousHeadFloat64Only                            opType = 623
+	opStructFieldAnonymousHeadOmitEmptyFloat64                       opType = 624
+	opStructFieldAnonymousHeadOmitEmptyFloat64Only                   opType = 625
+	opStructFieldAnonymousHeadStringTagFloat64                       opType = 626
+	opStructFieldAnonymousHeadStringTagFloat64Only                   opType = 627
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat64              opType = 628
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat64Only          opType = 629
+	opStructFieldPtrHeadFloat64                                      opType = 630
+	opStructFieldPtrHeadFloat64Only                                  opType = 631
+	opStructFieldPtrHeadOmitEmptyFloat64                             opType = 632
+	opStructFieldPtrHeadOmitEmptyFloat64Only                         opType = 633
+	opStructFieldPtrHeadStringTagFloat64                             opType = 634
+	opStructFieldPtrHeadStringTagFloat64Only                         opType = 635
+	opStructFieldPtrHeadOmitEmptyStringTagFloat64                    opType = 636
+	opStructFieldPtrHeadOmitEmptyStringTagFloat64Only                opType = 637
+	opStructFieldPtrAnonymousHeadFloat64                             opType = 638
+	opStructFieldPtrAnonymousHeadFloat64Only                         opType = 639
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat64                    opType = 640
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat64Only                opType = 641
+	opStructFieldPtrAnonymousHeadStringTagFloat64                    opType = 642
+	opStructFieldPtrAnonymousHeadStringTagFloat64Only                opType = 643
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64           opType = 644
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Only       opType = 645
+	opStructFieldNPtrHeadFloat64                                     opType = 646
+	opStructFieldNPtrHeadFloat64Only                                 opType = 647
+	opStructFieldNPtrHeadOmitEmptyFloat64                            opType = 648
+	opStructFieldNPtrHeadOmitEmptyFloat64Only                        opType = 649
+	opStructFieldNPtrHeadStringTagFloat64                            opType = 650
+	opStructFieldNPtrHeadStringTagFloat64Only                        opType = 651
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat64                   opType = 652
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat64Only               opType = 653
+	opStructFieldNPtrAnonymousHeadFloat64                            opType = 654
+	opStructFieldNPtrAnonymousHeadFloat64Only                        opType = 655
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64                   opType = 656
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64Only               opType = 657
+	opStructFieldNPtrAnonymousHeadStringTagFloat64                   opType = 658
+	opStructFieldNPtrAnonymousHeadStringTagFloat64Only               opType = 659
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64          opType = 660
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64Only      opType = 661
+	opStructFieldHeadBool                                            opType = 662
+	opStructFieldHeadBoolOnly                                        opType = 663
+	opStructFieldHeadOmitEmptyBool                                   opType = 664
+	opStructFieldHeadOmitEmptyBoolOnly                               opType = 665
+	opStructFieldHeadStringTagBool                                   opType = 666
+	opStructFieldHeadStringTagBoolOnly                               opType = 667
+	opStructFieldHeadOmitEmptyStringTagBool                          opType = 668
+	opStructFieldHeadOmitEmptyStringTagBoolOnly                      opType = 669
+	opStructFieldAnonymousHeadBool                                   opType = 670
+	opStructFieldAnonymousHeadBoolOnly                               opType = 671
+	opStructFieldAnonymousHeadOmitEmptyBool                          opType = 672
+	opStructFieldAnonymousHeadOmitEmptyBoolOnly                      opType = 673
+	opStructFieldAnonymousHeadStringTagBool                          opType = 674
+	opStructFieldAnonymousHeadStringTagBoolOnly                      opType = 675
+	opStructFieldAnonymousHeadOmitEmptyStringTagBool                 opType = 676
+	opStructFieldAnonymousHeadOmitEmptyStringTagBoolOnly             opType = 677
+	opStructFieldPtrHeadBool                                         opType = 678
+	opStructFieldPtrHeadBoolOnly                                     opType = 679
+	opStructFieldPtrHeadOmitEmptyBool                                opType = 680
+	opStructFieldPtrHeadOmitEmptyBoolOnly                            opType = 681
+	opStructFieldPtrHeadStringTagBool                                opType = 682
+	opStructFieldPtrHeadStringTagBoolOnly                            opType = 683
+	opStructFieldPtrHeadOmitEmptyStringTagBool                       opType = 684
+	opStructFieldPtrHeadOmitEmptyStringTagBoolOnly                   opType = 685
+	opStructFieldPtrAnonymousHeadBool                                opType = 686
+	opStructFieldPtrAnonymousHeadBoolOnly                            opType = 687
+	opStructFieldPtrAnonymousHeadOmitEmptyBool                       opType = 688
+	opStructFieldPtrAnonymousHeadOmitEmptyBoolOnly                   opType = 689
+	opStructFieldPtrAnonymousHeadStringTagBool                       opType = 690
+	opStructFieldPtrAnonymousHeadStringTagBoolOnly                   opType = 691
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBool              opType = 692
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBoolOnly          opType = 693
+	opStructFieldNPtrHeadBool                                        opType = 694
+	opStructFieldNPtrHeadBoolOnly                                    opType = 695
+	opStructFieldNPtrHeadOmitEmptyBool                               opType = 696
+	opStructFieldNPtrHeadOmitEmptyBoolOnly                           opType = 697
+	opStructFieldNPtrHeadStringTagBool                               opType = 698
+	opStructFieldNPtrHeadStringTagBoolOnly                           opType = 699
+	opStructFieldNPtrHeadOmitEmptyStringTagBool                      opType = 700
+	opStructFieldNPtrHeadOmitEmptyStringTagBoolOnly                  opType = 701
+	opStructFieldNPtrAnonymousHeadBool                               opType = 702
+	opStructFieldNPtrAnonymousHeadBoolOnly                           opType = 703
+	opStructFieldNPtrAnonymousHeadOmitEmptyBool                      opType = 704
+	opStructFieldNPtrAnonymousHeadOmitEmptyBoolOnly                  opType = 705
+	opStructFieldNPtrAnonymousHeadStringTagBool                      opType = 706
+	opStructFieldNPtrAnonymousHeadStringTagBoolOnly                  opType = 707
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBool             opType = 708
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolOnly         opType = 709
+	opStructFieldHeadString                                          opType = 710
+	opStructFieldHeadStringOnly                                      opType = 711
+	opStructFieldHeadOmitEmptyString                                 opType = 712
+	opStructFieldHeadOmitEmptyStringOnly                             opType = 713
+	opStructFieldHeadStringTagString                                 opType = 714
+	opStructFieldHeadStringTagStringOnly                             opType = 715
+	opStructFieldHeadOmitEmptyStringTagString                        opType = 716
+	opStructFieldHeadOmitEmptyStringTagStringOnly                    opType = 717
+	opStructFieldAnonymousHeadString                                 opType = 718
+	opStructFieldAnonymousHeadStringOnly                             opType = 719
+	opStructFieldAnonymousHeadOmitEmptyString                        opType = 720
+	opStructFieldAnonymousHeadOmitEmptyStringOnly                    opType = 721
+	opStructFieldAnonymousHeadStringTagString                        opType = 722
+	opStructFieldAnonymousHeadStringTagStringOnly                    opType = 723
+	opStructFieldAnonymousHeadOmitEmptyStringTagString               opType = 724
+	opStructFieldAnonymousHeadOmitEmptyStringTagStringOnly           opType = 725
+	opStructFieldPtrHeadString                                       opType = 726
+	opStructFieldPtrHeadStringOnly                                   opType = 727
+	opStructFieldPtrHeadOmitEmptyString                              opType = 728
+	opStructFieldPtrHeadOmitEmptyStringOnly                          opType = 729
+	opStructFieldPtrHeadStringTagString                              opType = 730
+	opStructFieldPtrHeadStringTagStringOnly                          opType = 731
+	opStructFieldPtrHeadOmitEmptyStringTagString                     opType = 732
+	opStructFieldPtrHeadOmitEmptyStringTagStringOnly                 opType = 733
+	opStructFieldPtrAnonymousHeadString                              opType = 734
+	opStructFieldPtrAnonymousHeadStringOnly                          opType = 735
+	opStructFieldPtrAnonymousHeadOmitEmptyString                     opType = 736
+	opStructFieldPtrAnonymousHeadOmitEmptyStringOnly                 opType = 737
+	opStructFieldPtrAnonymousHeadStringTagString                     opType = 738
+	opStructFieldPtrAnonymousHeadStringTagStringOnly                 opType = 739
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagString            opType = 740
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringOnly        opType = 741
+	opStructFieldNPtrHeadString                                      opType = 742
+	opStructFieldNPtrHeadStringOnly                                  opType = 743
+	opStructFieldNPtrHeadOmitEmptyString                             opType = 744
+	opStructFieldNPtrHeadOmitEmptyStringOnly                         opType = 745
+	opStructFieldNPtrHeadStringTagString                             opType = 746
+	opStructFieldNPtrHeadStringTagStringOnly                         opType = 747
+	opStructFieldNPtrHeadOmitEmptyStringTagString                    opType = 748
+	opStructFieldNPtrHeadOmitEmptyStringTagStringOnly                opType = 749
+	opStructFieldNPtrAnonymousHeadString                             opType = 750
+	opStructFieldNPtrAnonymousHeadStringOnly                         opType = 751
+	opStructFieldNPtrAnonymousHeadOmitEmptyString                    opType = 752
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringOnly                opType = 753
+	opStructFieldNPtrAnonymousHeadStringTagString                    opType = 754
+	opStructFieldNPtrAnonymousHeadStringTagStringOnly                opType = 755
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagString           opType = 756
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagStringOnly       opType = 757
+	opStructFieldHeadBytes                                           opType = 758
+	opStructFieldHeadBytesOnly                                       opType = 759
+	opStructFieldHeadOmitEmptyBytes                                  opType = 760
+	opStructFieldHeadOmitEmptyBytesOnly                              opType = 761
+	opStructFieldHeadStringTagBytes                                  opType = 762
+	opStructFieldHeadStringTagBytesOnly                              opType = 763
+	opStructFieldHeadOmitEmptyStringTagBytes                         opType = 764
+	opStructFieldHeadOmitEmptyStringTagBytesOnly                     opType = 765
+	opStructFieldAnonymousHeadBytes                                  opType = 766
+	opStructFieldAnonymousHeadBytesOnly                              opType = 767
+	opStructFieldAnonymousHeadOmitEmptyBytes                         opType = 768
+	opStructFieldAnonymousHeadOmitEmptyBytesOnly                     opType = 769
+	opStructFieldAnonymousHeadStringTagBytes                         opType = 770
+	opStructFieldAnonymousHeadStringTagBytesOnly                     opType = 771
+	opStructFieldAnonymousHeadOmitEmptyStringTagBytes                opType = 772
+	opStructFieldAnonymousHeadOmitEmptyStringTagBytesOnly            opType = 773
+	opStructFieldPtrHeadBytes                                        opType = 774
+	opStructFieldPtrHeadBytesOnly                                    opType = 775
+	opStructFieldPtrHeadOmitEmptyBytes                               opType = 776
+	opStructFieldPtrHeadOmitEmptyBytesOnly                           opType = 777
+	opStructFieldPtrHeadStringTagBytes                               opType = 778
+	opStructFieldPtrHeadStringTagBytesOnly                           opType = 779
+	opStructFieldPtrHeadOmitEmptyStringTagBytes                      opType = 780
+	opStructFieldPtrHeadOmitEmptyStringTagBytesOnly                  opType = 781
+	opStructFieldPtrAnonymousHeadBytes                               opType = 782
+	opStructFieldPtrAnonymousHeadBytesOnly                           opType = 783
+	opStructFieldPtrAnonymousHeadOmitEmptyBytes                      opType = 784
+	opStructFieldPtrAnonymousHeadOmitEmptyBytesOnly                  opType = 785
+	opStructFieldPtrAnonymousHeadStringTagBytes                      opType = 786
+	opStructFieldPtrAnonymousHeadStringTagBytesOnly                  opType = 787
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBytes             opType = 788
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBytesOnly         opType = 789
+	opStructFieldNPtrHeadBytes                                       opType = 790
+	opStructFieldNPtrHeadBytesOnly                                   opType = 791
+	opStructFieldNPtrHeadOmitEmptyBytes                              opType = 792
+	opStructFieldNPtrHeadOmitEmptyBytesOnly                          opType = 793
+	opStructFieldNPtrHeadStringTagBytes                              opType = 794
+	opStructFieldNPtrHeadStringTagBytesOnly                          opType = 795
+	opStructFieldNPtrHeadOmitEmptyStringTagBytes                     opType = 796
+	opStructFieldNPtrHeadOmitEmptyStringTagBytesOnly                 opType = 797
+	opStructFieldNPtrAnonymousHeadBytes                              opType = 798
+	opStructFieldNPtrAnonymousHeadBytesOnly                          opType = 799
+	opStructFieldNPtrAnonymousHeadOmitEmptyBytes                     opType = 800
+	opStructFieldNPtrAnonymousHeadOmitEmptyBytesOnly                 opType = 801
+	opStructFieldNPtrAnonymousHeadStringTagBytes                     opType = 802
+	opStructFieldNPtrAnonymousHeadStringTagBytesOnly                 opType = 803
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBytes            opType = 804
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesOnly        opType = 805
+	opStructFieldHeadArray                                           opType = 806
+	opStructFieldHeadArrayOnly                                       opType = 807
+	opStructFieldHeadOmitEmptyArray                                  opType = 808
+	opStructFieldHeadOmitEmptyArrayOnly                              opType = 809
+	opStructFieldHeadStringTagArray                                  opType = 810
+	opStructFieldHeadStringTagArrayOnly                              opType = 811
+	opStructFieldHeadOmitEmptyStringTagArray                         opType = 812
+	opStructFieldHeadOmitEmptyStringTagArrayOnly                     opType = 813
+	opStructFieldAnonymousHeadArray                                  opType = 814
+	opStructFieldAnonymousHeadArrayOnly                              opType = 815
+	opStructFieldAnonymousHeadOmitEmptyArray                         opType = 816
+	opStructFieldAnonymousHeadOmitEmptyArrayOnly                     opType = 817
+	opStructFieldAnonymousHeadStringTagArray                         opType = 818
+	opStructFieldAnonymousHeadStringTagArrayOnly                     opType = 819
+	opStructFieldAnonymousHeadOmitEmptyStringTagArray                opType = 820
+	opStructFieldAnonymousHeadOmitEmptyStringTagArrayOnly            opType = 821
+	opStructFieldPtrHeadArray                                        opType = 822
+	opStructFieldPtrHeadArrayOnly                                    opType = 823
+	opStructFieldPtrHeadOmitEmptyArray                               opType = 824
+	opStructFieldPtrHeadOmitEmptyArrayOnly                           opType = 825
+	opStructFieldPtrHeadStringTagArray                               opType = 826
+	opStructFieldPtrHeadStringTagArrayOnly                           opType = 827
+	opStructFieldPtrHeadOmitEmptyStringTagArray                      opType = 828
+	opStructFieldPtrHeadOmitEmptyStringTagArrayOnly                  opType = 829
+	opStructFieldPtrAnonymousHeadArray                               opType = 830
+	opStructFieldPtrAnonymousHeadArrayOnly                           opType = 831
+	opStructFieldPtrAnonymousHeadOmitEmptyArray                      opType = 832
+	opStructFieldPtrAnonymousHeadOmitEmptyArrayOnly                  opType = 833
+	opStructFieldPtrAnonymousHeadStringTagArray                      opType = 834
+	opStructFieldPtrAnonymousHeadStringTagArrayOnly                  opType = 835
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagArray             opType = 836
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagArrayOnly         opType = 837
+	opStructFieldNPtrHeadArray                                       opType = 838
+	opStructFieldNPtrHeadArrayOnly                                   opType = 839
+	opStructFieldNPtrHeadOmitEmptyArray                              opType = 840
+	opStructFieldNPtrHeadOmitEmptyArrayOnly                          opType = 841
+	opStructFieldNPtrHeadStringTagArray                              opType = 842
+	opStructFieldNPtrHeadStringTagArrayOnly                          opType = 843
+	opStructFieldNPtrHeadOmitEmptyStringTagArray                     opType = 844
+	opStructFieldNPtrHeadOmitEmptyStringTagArrayOnly                 opType = 845
+	opStructFieldNPtrAnonymousHeadArray                              opType = 846
+	opStructFieldNPtrAnonymousHeadArrayOnly                          opType = 847
+	opStructFieldNPtrAnonymousHeadOmitEmptyArray                     opType = 848
+	opStructFieldNPtrAnonymousHeadOmitEmptyArrayOnly                 opType = 849
+	opStructFieldNPtrAnonymousHeadStringTagArray                     opType = 850
+	opStructFieldNPtrAnonymousHeadStringTagArrayOnly                 opType = 851
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagArray            opType = 852
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagArrayOnly        opType = 853
+	opStructFieldHeadMap                                             opType = 854
+	opStructFieldHeadMapOnly                                         opType = 855
+	opStructFieldHeadOmitEmptyMap                                    opType = 856
+	opStructFieldHeadOmitEmptyMapOnly                                opType = 857
+	opStructFieldHeadStringTagMap                                    opType = 858
+	opStructFieldHeadStringTagMapOnly                                opType = 859
+	opStructFieldHeadOmitEmptyStringTagMap                           opType = 860
+	opStructFieldHeadOmitEmptyStringTagMapOnly                       opType = 861
+	opStructFieldAnonymousHeadMap                                    opType = 862
+	opStructFieldAnonymousHeadMapOnly                                opType = 863
+	opStructFieldAnonymousHeadOmitEmptyMap                           opType = 864
+	opStructFieldAnonymousHeadOmitEmptyMapOnly                       opType = 865
+	opStructFieldAnonymousHeadStringTagMap                           opType = 866
+	opStructFieldAnonymousHeadStringTagMapOnly                       opType = 867
+	opStructFieldAnonymousHeadOmitEmptyStringTagMap                  opType = 868
+	opStructFieldAnonymousHeadOmitEmptyStringTagMapOnly              opType = 869
+	opStructFieldPtrHeadMap                                          opType = 870
+	opStructFieldPtrHeadMapOnly                                      opType = 871
+	opStructFieldPtrHeadOmitEmptyMap                                 opType = 872
+	opStructFieldPtrHeadOmitEmptyMapOnly                             opType = 873
+	opStructFieldPtrHeadStringTagMap                                 opType = 874
+	opStructFieldPtrHeadStringTagMapOnly                             opType = 875
+	opStructFieldPtrHeadOmitEmptyStringTagMap                        opType = 876
+	opStructFieldPtrHeadOmitEmptyStringTagMapOnly                    opType = 877
+	opStructFieldPtrAnonymousHeadMap                                 opType = 878
+	opStructFieldPtrAnonymousHeadMapOnly                             opType = 879
+	opStructFieldPtrAnonymousHeadOmitEmptyMap                        opType = 880
+	opStructFieldPtrAnonymousHeadOmitEmptyMapOnly                    opType = 881
+	opStructFieldPtrAnonymousHeadStringTagMap                        opType = 882
+	opStructFieldPtrAnonymousHeadStringTagMapOnly                    opType = 883
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagMap               opType = 884
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagMapOnly           opType = 885
+	opStructFieldNPtrHeadMap                                         opType = 886
+	opStructFieldNPtrHeadMapOnly                                     opType = 887
+	opStructFieldNPtrHeadOmitEmptyMap                                opType = 888
+	opStructFieldNPtrHeadOmitEmptyMapOnly                            opType = 889
+	opStructFieldNPtrHeadStringTagMap                                opType = 890
+	opStructFieldNPtrHeadStringTagMapOnly                            opType = 891
+	opStructFieldNPtrHeadOmitEmptyStringTagMap                       opType = 892
+	opStructFieldNPtrHeadOmitEmptyStringTagMapOnly                   opType = 893
+	opStructFieldNPtrAnonymousHeadMap                                opType = 894
+	opStructFieldNPtrAnonymousHeadMapOnly                            opType = 895
+	opStructFieldNPtrAnonymousHeadOmitEmptyMap                       opType = 896
+	opStructFieldNPtrAnonymousHeadOmitEmptyMapOnly                   opType = 897
+	opStructFieldNPtrAnonymousHeadStringTagMap                       opType = 898
+	opStructFieldNPtrAnonymousHeadStringTagMapOnly                   opType = 899
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagMap              opType = 900
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagMapOnly          opType = 901
+	opStructFieldHeadMapLoad                                         opType = 902
+	opStructFieldHeadMapLoadOnly                                     opType = 903
+	opStructFieldHeadOmitEmptyMapLoad                                opType = 904
+	opStructFieldHeadOmitEmptyMapLoadOnly                            opType = 905
+	opStructFieldHeadStringTagMapLoad                                opType = 906
+	opStructFieldHeadStringTagMapLoadOnly                            opType = 907
+	opStructFieldHeadOmitEmptyStringTagMapLoad                       opType = 908
+	opStructFieldHeadOmitEmptyStringTagMapLoadOnly                   opType = 909
+	opStructFieldAnonymousHeadMapLoad                                opType = 910
+	opStructFieldAnonymousHeadMapLoadOnly                            opType = 911
+	opStructFieldAnonymousHeadOmitEmptyMapLoad                       opType = 912
+	opStructFieldAnonymousHeadOmitEmptyMapLoadOnly                   opType = 913
+	opStructFieldAnonymousHeadStringTagMapLoad                       opType = 914
+	opStructFieldAnonymousHeadStringTagMapLoadOnly                   opType = 915
+	opStructFieldAnonymousHeadOmitEmptyStringTagMapLoad              opType = 916
+	opStructFieldAnonymousHeadOmitEmptyStringTagMapLoadOnly          opType = 917
+	opStructFieldPtrHeadMapLoad                                      opType = 918
+	opStructFieldPtrHeadMapLoadOnly                                  opType = 919
+	opStructFieldPtrHeadOmitEmptyMapLoad                             opType = 920
+	opStructFieldPtrHeadOmitEmptyMapLoadOnly                         opType = 921
+	opStructFieldPtrHeadStringTagMapLoad                             opType = 922
+	opStructFieldPtrHeadStringTagMapLoadOnly                         opType = 923
+	opStructFieldPtrHeadOmitEmptyStringTagMapLoad                    opType = 924
+	opStructFieldPtrHeadOmitEmptyStringTagMapLoadOnly                opType = 925
+	opStructFieldPtrAnonymousHeadMapLoad                             opType = 926
+	opStructFieldPtrAnonymousHeadMapLoadOnly                         opType = 927
+	opStructFieldPtrAnonymousHeadOmitEmptyMapLoad                    opType = 928
+	opStructFieldPtrAnonymousHeadOmitEmptyMapLoadOnly                opType = 929
+	opStructFieldPtrAnonymousHeadStringTagMapLoad                    opType = 930
+	opStructFieldPtrAnonymousHeadStringTagMapLoadOnly                opType = 931
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagMapLoad           opType = 932
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagMapLoadOnly       opType = 933
+	opStructFieldNPtrHeadMapLoad                                     opType = 934
+	opStructFieldNPtrHeadMapLoadOnly                                 opType = 935
+	opStructFieldNPtrHeadOmitEmptyMapLoad                            opType = 936
+	opStructFieldNPtrHeadOmitEmptyMapLoadOnly                        opType = 937
+	opStructFieldNPtrHeadStringTagMapLoad                            opType = 938
+	opStructFieldNPtrHeadStringTagMapLoadOnly                        opType = 939
+	opStructFieldNPtrHeadOmitEmptyStringTagMapLoad                   opType = 940
+	opStructFieldNPtrHeadOmitEmptyStringTagMapLoadOnly               opType = 941
+	opStructFieldNPtrAnonymousHeadMapLoad                            opType = 942
+	opStructFieldNPtrAnonymousHeadMapLoadOnly                        opType = 943
+	opStructFieldNPtrAnonymousHeadOmitEmptyMapLoad                   opType = 944
+	opStructFieldNPtrAnonymousHeadOmitEmptyMapLoadOnly               opType = 945
+	opStructFieldNPtrAnonymousHeadStringTagMapLoad                   opType = 946
+	opStructFieldNPtrAnonymousHeadStringTagMapLoadOnly               opType = 947
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagMapLoad          opType = 948
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagMapLoadOnly      opType = 949
+	opStructFieldHeadSlice                                           opType = 950
+	opStructFieldHeadSliceOnly                                       opType = 951
+	opStructFieldHeadOmitEmptySlice                                  opType = 952
+	opStructFieldHeadOmitEmptySliceOnly                              opType = 953
+	opStructFieldHeadStringTagSlice                                  opType = 954
+	opStructFieldHeadStringTagSliceOnly                              opType = 955
+	opStructFieldHeadOmitEmptyStringTagSlice                         opType = 956
+	opStructFieldHeadOmitEmptyStringTagSliceOnly                     opType = 957
+	opStructFieldAnonymousHeadSlice                                  opType = 958
+	opStructFieldAnonymousHeadSliceOnly                              opType = 959
+	opStructFieldAnonymousHeadOmitEmptySlice                         opType = 960
+	opStructFieldAnonymousHeadOmitEmptySliceOnly                     opType = 961
+	opStructFieldAnonymousHeadStringTagSlice                         opType = 962
+	opStructFieldAnonymousHeadStringTagSliceOnly                     opType = 963
+	opStructFieldAnonymousHeadOmitEmptyStringTagSlice                opType = 964
+	opStructFieldAnonymousHeadOmitEmptyStringTagSliceOnly            opType = 965
+	opStructFieldPtrHeadSlice                                        opType = 966
+	opStructFieldPtrHeadSliceOnly                                    opType = 967
+	opStructFieldPtrHeadOmitEmptySlice                               opType = 968
+	opStructFieldPtrHeadOmitEmptySliceOnly                           opType = 969
+	opStructFieldPtrHeadStringTagSlice                               opType = 970
+	opStructFieldPtrHeadStringTagSliceOnly                           opType = 971
+	opStructFieldPtrHeadOmitEmptyStringTagSlice                      opType = 972
+	opStructFieldPtrHeadOmitEmptyStringTagSliceOnly                  opType = 973
+	opStructFieldPtrAnonymousHeadSlice                               opType = 974
+	opStructFieldPtrAnonymousHeadSliceOnly                           opType = 975
+	opStructFieldPtrAnonymousHeadOmitEmptySlice                      opType = 976
+	opStructFieldPtrAnonymousHeadOmitEmptySliceOnly                  opType = 977
+	opStructFieldPtrAnonymousHeadStringTagSlice                      opType = 978
+	opStructFieldPtrAnonymousHeadStringTagSliceOnly                  opType = 979
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagSlice             opType = 980
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagSliceOnly         opType = 981
+	opStructFieldNPtrHeadSlice                                       opType = 982
+	opStructFieldNPtrHeadSliceOnly                                   opType = 983
+	opStructFieldNPtrHeadOmitEmptySlice                              opType = 984
+	opStructFieldNPtrHeadOmitEmptySliceOnly                          opType = 985
+	opStructFieldNPtrHeadStringTagSlice                              opType = 986
+	opStructFieldNPtrHeadStringTagSliceOnly                          opType = 987
+	opStructFieldNPtrHeadOmitEmptyStringTagSlice                     opType = 988
+	opStructFieldNPtrHeadOmitEmptyStringTagSliceOnly                 opType = 989
+	opStructFieldNPtrAnonymousHeadSlice                              opType = 990
+	opStructFieldNPtrAnonymousHeadSliceOnly                          opType = 991
+	opStructFieldNPtrAnonymousHeadOmitEmptySlice                     opType = 992
+	opStructFieldNPtrAnonymousHeadOmitEmptySliceOnly                 opType = 993
+	opStructFieldNPtrAnonymousHeadStringTagSlice                     opType = 994
+	opStructFieldNPtrAnonymousHeadStringTagSliceOnly                 opType = 995
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagSlice            opType = 996
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagSliceOnly        opType = 997
+	opStructFieldHeadStruct                                          opType = 998
+	opStructFieldHeadStructOnly                                      opType = 999
+	opStructFieldHeadOmitEmptyStruct                                 opType = 1000
+	opStructFieldHeadOmitEmptyStructOnly                             opType = 1001
+	opStructFieldHeadStringTagStruct                                 opType = 1002
+	opStructFieldHeadStringTagStructOnly                             opType = 1003
+	opStructFieldHeadOmitEmptyStringTagStruct                        opType = 1004
+	opStructFieldHeadOmitEmptyStringTagStructOnly                    opType = 1005
+	opStructFieldAnonymousHeadStruct                                 opType = 1006
+	opStructFieldAnonymousHeadStructOnly                             opType = 1007
+	opStructFieldAnonymousHeadOmitEmptyStruct                        opType = 1008
+	opStructFieldAnonymousHeadOmitEmptyStructOnly                    opType = 1009
+	opStructFieldAnonymousHeadStringTagStruct                        opType = 1010
+	opStructFieldAnonymousHeadStringTagStructOnly                    opType = 1011
+	opStructFieldAnonymousHeadOmitEmptyStringTagStruct               opType = 1012
+	opStructFieldAnonymousHeadOmitEmptyStringTagStructOnly           opType = 1013
+	opStructFieldPtrHeadStruct                                       opType = 1014
+	opStructFieldPtrHeadStructOnly                                   opType = 1015
+	opStructFieldPtrHeadOmitEmptyStruct                              opType = 1016
+	opStructFieldPtrHeadOmitEmptyStructOnly                          opType = 1017
+	opStructFieldPtrHeadStringTagStruct                              opType = 1018
+	opStructFieldPtrHeadStringTagStructOnly                          opType = 1019
+	opStructFieldPtrHeadOmitEmptyStringTagStruct                     opType = 1020
+	opStructFieldPtrHeadOmitEmptyStringTagStructOnly                 opType = 1021
+	opStructFieldPtrAnonymousHeadStruct                              opType = 1022
+	opStructFieldPtrAnonymousHeadStructOnly                          opType = 1023
+	opStructFieldPtrAnonymousHeadOmitEmptyStruct                     opType = 1024
+	opStructFieldPtrAnonymousHeadOmitEmptyStructOnly                 opType = 1025
+	opStructFieldPtrAnonymousHeadStringTagStruct                     opType = 1026
+	opStructFieldPtrAnonymousHeadStringTagStructOnly                 opType = 1027
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagStruct            opType = 1028
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagStructOnly        opType = 1029
+	opStructFieldNPtrHeadStruct                                      opType = 1030
+	opStructFieldNPtrHeadStructOnly                                  opType = 1031
+	opStructFieldNPtrHeadOmitEmptyStruct                             opType = 1032
+	opStructFieldNPtrHeadOmitEmptyStructOnly                         opType = 1033
+	opStructFieldNPtrHeadStringTagStruct                             opType = 1034
+	opStructFieldNPtrHeadStringTagStructOnly                         opType = 1035
+	opStructFieldNPtrHeadOmitEmptyStringTagStruct                    opType = 1036
+	opStructFieldNPtrHeadOmitEmptyStringTagStructOnly                opType = 1037
+	opStructFieldNPtrAnonymousHeadStruct                             opType = 1038
+	opStructFieldNPtrAnonymousHeadStructOnly                         opType = 1039
+	opStructFieldNPtrAnonymousHeadOmitEmptyStruct                    opType = 1040
+	opStructFieldNPtrAnonymousHeadOmitEmptyStructOnly                opType = 1041
+	opStructFieldNPtrAnonymousHeadStringTagStruct                    opType = 1042
+	opStructFieldNPtrAnonymousHeadStringTagStructOnly                opType = 1043
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagStruct           opType = 1044
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagStructOnly       opType = 1045
+	opStructFieldHeadMarshalJSON                                     opType = 1046
+	opStructFieldHeadMarshalJSONOnly                                 opType = 1047
+	opStructFieldHeadOmitEmptyMarshalJSON                            opType = 1048
+	opStructFieldHeadOmitEmptyMarshalJSONOnly                        opType = 1049
+	opStructFieldHeadStringTagMarshalJSON                            opType = 1050
+	opStructFieldHeadStringTagMarshalJSONOnly                        opType = 1051
+	opStructFieldHeadOmitEmptyStringTagMarshalJSON                   opType = 1052
+	opStructFieldHeadOmitEmptyStringTagMarshalJSONOnly               opType = 1053
+	opStructFieldAnonymousHeadMarshalJSON                            opType = 1054
+	opStructFieldAnonymousHeadMarshalJSONOnly                        opType = 1055
+	opStructFieldAnonymousHeadOmitEmptyMarshalJSON                   opType = 1056
+	opStructFieldAnonymousHeadOmitEmptyMarshalJSONOnly               opType = 1057
+	opStructFieldAnonymousHeadStringTagMarshalJSON                   opType = 1058
+	opStructFieldAnonymousHeadStringTagMarshalJSONOnly               opType = 1059
+	opStructFieldAnonymousHeadOmitEmptyStringTagMarshalJSON          opType = 1060
+	opStructFieldAnonymousHeadOmitEmptyStringTagMarshalJSONOnly      opType = 1061
+	opStructFieldPtrHeadMarshalJSON                                  opType = 1062
+	opStructFieldPtrHeadMarshalJSONOnly                              opType = 1063
+	opStructFieldPtrHeadOmitEmptyMarshalJSON                         opType = 1064
+	opStructFieldPtrHeadOmitEmptyMarshalJSONOnly                     opType = 1065
+	opStructFieldPtrHeadStringTagMarshalJSON                         opType = 1066
+	opStructFieldPtrHeadStringTagMarshalJSONOnly                     opType = 1067
+	opStructFieldPtrHeadOmitEmptyStringTagMarshalJSON                opType = 1068
+	opStructFieldPtrHeadOmitEmptyStringTagMarshalJSONOnly            opType = 1069
+	opStructFieldPtrAnonymousHeadMarshalJSON                         opType = 1070
+	opStructFieldPtrAnonymousHeadMarshalJSONOnly                     opType = 1071
+	opStructFieldPtrAnonymousHeadOmitEmptyMarshalJSON                opType = 1072
+	opStructFieldPtrAnonymousHeadOmitEmptyMarshalJSONOnly            opType = 1073
+	opStructFieldPtrAnonymousHeadStringTagMarshalJSON                opType = 1074
+	opStructFieldPtrAnonymousHeadStringTagMarshalJSONOnly            opType = 1075
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalJSON       opType = 1076
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalJSONOnly   opType = 1077
+	opStructFieldNPtrHeadMarshalJSON                                 opType = 1078
+	opStructFieldNPtrHeadMarshalJSONOnly                             opType = 1079
+	opStructFieldNPtrHeadOmitEmptyMarshalJSON                        opType = 1080
+	opStructFieldNPtrHeadOmitEmptyMarshalJSONOnly                    opType = 1081
+	opStructFieldNPtrHeadStringTagMarshalJSON                        opType = 1082
+	opStructFieldNPtrHeadStringTagMarshalJSONOnly                    opType = 1083
+	opStructFieldNPtrHeadOmitEmptyStringTagMarshalJSON               opType = 1084
+	opStructFieldNPtrHeadOmitEmptyStringTagMarshalJSONOnly           opType = 1085
+	opStructFieldNPtrAnonymousHeadMarshalJSON                        opType = 1086
+	opStructFieldNPtrAnonymousHeadMarshalJSONOnly                    opType = 1087
+	opStructFieldNPtrAnonymousHeadOmitEmptyMarshalJSON               opType = 1088
+	opStructFieldNPtrAnonymousHeadOmitEmptyMarshalJSONOnly           opType = 1089
+	opStructFieldNPtrAnonymousHeadStringTagMarshalJSON               opType = 1090
+	opStructFieldNPtrAnonymousHeadStringTagMarshalJSONOnly           opType = 1091
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagMarshalJSON      opType = 1092
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagMarshalJSONOnly  opType = 1093
+	opStructFieldHeadMarshalText                                     opType = 1094
+	opStructFieldHeadMarshalTextOnly                                 opType = 1095
+	opStructFieldHeadOmitEmptyMarshalText                            opType = 1096
+	opStructFieldHeadOmitEmptyMarshalTextOnly                        opType = 1097
+	opStructFieldHeadStringTagMarshalText                            opType = 1098
+	opStructFieldHeadStringTagMarshalTextOnly                        opType = 1099
+	opStructFieldHeadOmitEmptyStringTagMarshalText                   opType = 1100
+	opStructFieldHeadOmitEmptyStringTagMarshalTextOnly               opType = 1101
+	opStructFieldAnonymousHeadMarshalText                            opType = 1102
+	opStructFieldAnonymousHeadMarshalTextOnly                        opType = 1103
+	opStructFieldAnonymousHeadOmitEmptyMarshalText                   opType = 1104
+	opStructFieldAnonymousHeadOmitEmptyMarshalTextOnly               opType = 1105
+	opStructFieldAnonymousHeadStringTagMarshalText                   opType = 1106
+	opStructFieldAnonymousHeadStringTagMarshalTextOnly               opType = 1107
+	opStructFieldAnonymousHeadOmitEmptyStringTagMarshalText          opType = 1108
+	opStructFieldAnonymousHeadOmitEmptyStringTagMarshalTextOnly      opType = 1109
+	opStructFieldPtrHeadMarshalText                                  opType = 1110
+	opStructFieldPtrHeadMarshalTextOnly                              opType = 1111
+	opStructFieldPtrHeadOmitEmptyMarshalText                         opType = 1112
+	opStructFieldPtrHeadOmitEmptyMarshalTextOnly                     opType = 1113
+	opStructFieldPtrHeadStringTagMarshalText                         opType = 1114
+	opStructFieldPtrHeadStringTagMarshalTextOnly                     opType = 1115
+	opStructFieldPtrHeadOmitEmptyStringTagMarshalText                opType = 1116
+	opStructFieldPtrHeadOmitEmptyStringTagMarshalTextOnly            opType = 1117
+	opStructFieldPtrAnonymousHeadMarshalText                         opType = 1118
+	opStructFieldPtrAnonymousHeadMarshalTextOnly                     opType = 1119
+	opStructFieldPtrAnonymousHeadOmitEmptyMarshalText                opType = 1120
+	opStructFieldPtrAnonymousHeadOmitEmptyMarshalTextOnly            opType = 1121
+	opStructFieldPtrAnonymousHeadStringTagMarshalText                opType = 1122
+	opStructFieldPtrAnonymousHeadStringTagMarshalTextOnly            opType = 1123
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalText       opType = 1124
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalTextOnly   opType = 1125
+	opStructFieldNPtrHeadMarshalText                                 opType = 1126
+	opStructFieldNPtrHeadMarshalTextOnly                             opType = 1127
+	opStructFieldNPtrHeadOmitEmptyMarshalText                        opType = 1128
+	opStructFieldNPtrHeadOmitEmptyMarshalTextOnly                    opType = 1129
+	opStructFieldNPtrHeadStringTagMarshalText                        opType = 1130
+	opStructFieldNPtrHeadStringTagMarshalTextOnly                    opType = 1131
+	opStructFieldNPtrHeadOmitEmptyStringTagMarshalText               opType = 1132
+	opStructFieldNPtrHeadOmitEmptyStringTagMarshalTextOnly           opType = 1133
+	opStructFieldNPtrAnonymousHeadMarshalText                        opType = 1134
+	opStructFieldNPtrAnonymousHeadMarshalTextOnly                    opType = 1135
+	opStructFieldNPtrAnonymousHeadOmitEmptyMarshalText               opType = 1136
+	opStructFieldNPtrAnonymousHeadOmitEmptyMarshalTextOnly           opType = 1137
+	opStructFieldNPtrAnonymousHeadStringTagMarshalText               opType = 1138
+	opStructFieldNPtrAnonymousHeadStringTagMarshalTextOnly           opType = 1139
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagMarshalText      opType = 1140
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagMarshalTextOnly  opType = 1141
+	opStructFieldHeadRecursive                                       opType = 1142
+	opStructFieldHeadRecursiveOnly                                   opType = 1143
+	opStructFieldHeadOmitEmptyRecursive                              opType = 1144
+	opStructFieldHeadOmitEmptyRecursiveOnly                          opType = 1145
+	opStructFieldHeadStringTagRecursive                              opType = 1146
+	opStructFieldHeadStringTagRecursiveOnly                          opType = 1147
+	opStructFieldHeadOmitEmptyStringTagRecursive                     opType = 1148
+	opStructFieldHeadOmitEmptyStringTagRecursiveOnly                 opType = 1149
+	opStructFieldAnonymousHeadRecursive                              opType = 1150
+	opStructFieldAnonymousHeadRecursiveOnly                          opType = 1151
+	opStructFieldAnonymousHeadOmitEmptyRecursive                     opType = 1152
+	opStructFieldAnonymousHeadOmitEmptyRecursiveOnly                 opType = 1153
+	opStructFieldAnonymousHeadStringTagRecursive                     opType = 1154
+	opStructFieldAnonymousHeadStringTagRecursiveOnly                 opType = 1155
+	opStructFieldAnonymousHeadOmitEmptyStringTagRecursive            opType = 1156
+	opStructFieldAnonymousHeadOmitEmptyStringTagRecursiveOnly        opType = 1157
+	opStructFieldPtrHeadRecursive                                    opType = 1158
+	opStructFieldPtrHeadRecursiveOnly                                opType = 1159
+	opStructFieldPtrHeadOmitEmptyRecursive                           opType = 1160
+	opStructFieldPtrHeadOmitEmptyRecursiveOnly                       opType = 1161
+	opStructFieldPtrHeadStringTagRecursive                           opType = 1162
+	opStructFieldPtrHeadStringTagRecursiveOnly                       opType = 1163
+	opStructFieldPtrHeadOmitEmptyStringTagRecursive                  opType = 1164
+	opStructFieldPtrHeadOmitEmptyStringTagRecursiveOnly              opType = 1165
+	opStructFieldPtrAnonymousHeadRecursive                           opType = 1166
+	opStructFieldPtrAnonymousHeadRecursiveOnly                       opType = 1167
+	opStructFieldPtrAnonymousHeadOmitEmptyRecursive                  opType = 1168
+	opStructFieldPtrAnonymousHeadOmitEmptyRecursiveOnly              opType = 1169
+	opStructFieldPtrAnonymousHeadStringTagRecursive                  opType = 1170
+	opStructFieldPtrAnonymousHeadStringTagRecursiveOnly              opType = 1171
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagRecursive         opType = 1172
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagRecursiveOnly     opType = 1173
+	opStructFieldNPtrHeadRecursive                                   opType = 1174
+	opStructFieldNPtrHeadRecursiveOnly                               opType = 1175
+	opStructFieldNPtrHeadOmitEmptyRecursive                          opType = 1176
+	opStructFieldNPtrHeadOmitEmptyRecursiveOnly                      opType = 1177
+	opStructFieldNPtrHeadStringTagRecursive                          opType = 1178
+	opStructFieldNPtrHeadStringTagRecursiveOnly                      opType = 1179
+	opStructFieldNPtrHeadOmitEmptyStringTagRecursive                 opType = 1180
+	opStructFieldNPtrHeadOmitEmptyStringTagRecursiveOnly             opType = 1181
+	opStructFieldNPtrAnonymousHeadRecursive                          opType = 1182
+	opStructFieldNPtrAnonymousHeadRecursiveOnly                      opType = 1183
+	opStructFieldNPtrAnonymousHeadOmitEmptyRecursive                 opType = 1184
+	opStructFieldNPtrAnonymousHeadOmitEmptyRecursiveOnly             opType = 1185
+	opStructFieldNPtrAnonymousHeadStringTagRecursive                 opType = 1186
+	opStructFieldNPtrAnonymousHeadStringTagRecursiveOnly             opType = 1187
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagRecursive        opType = 1188
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagRecursiveOnly    opType = 1189
+	opStructFieldHeadIntString                                       opType = 1190
+	opStructFieldHeadIntStringOnly                                   opType = 1191
+	opStructFieldHeadOmitEmptyIntString                              opType = 1192
+	opStructFieldHeadOmitEmptyIntStringOnly                          opType = 1193
+	opStructFieldHeadStringTagIntString                              opType = 1194
+	opStructFieldHeadStringTagIntStringOnly                          opType = 1195
+	opStructFieldHeadOmitEmptyStringTagIntString                     opType = 1196
+	opStructFieldHeadOmitEmptyStringTagIntStringOnly                 opType = 1197
+	opStructFieldAnonymousHeadIntString                              opType = 1198
+	opStructFieldAnonymousHeadIntStringOnly                          opType = 1199
+	opStructFieldAnonymousHeadOmitEmptyIntString                     opType = 1200
+	opStructFieldAnonymousHeadOmitEmptyIntStringOnly                 opType = 1201
+	opStructFieldAnonymousHeadStringTagIntString                     opType = 1202
+	opStructFieldAnonymousHeadStringTagIntStringOnly                 opType = 1203
+	opStructFieldAnonymousHeadOmitEmptyStringTagIntString            opType = 1204
+	opStructFieldAnonymousHeadOmitEmptyStringTagIntStringOnly        opType = 1205
+	opStructFieldPtrHeadIntString                                    opType = 1206
+	opStructFieldPtrHeadIntStringOnly                                opType = 1207
+	opStructFieldPtrHeadOmitEmptyIntString                           opType = 1208
+	opStructFieldPtrHeadOmitEmptyIntStringOnly                       opType = 1209
+	opStructFieldPtrHeadStringTagIntString                           opType = 1210
+	opStructFieldPtrHeadStringTagIntStringOnly                       opType = 1211
+	opStructFieldPtrHeadOmitEmptyStringTagIntString                  opType = 1212
+	opStructFieldPtrHeadOmitEmptyStringTagIntStringOnly              opType = 1213
+	opStructFieldPtrAnonymousHeadIntString                           opType = 1214
+	opStructFieldPtrAnonymousHeadIntStringOnly                       opType = 1215
+	opStructFieldPtrAnonymousHeadOmitEmptyIntString                  opType = 1216
+	opStructFieldPtrAnonymousHeadOmitEmptyIntStringOnly              opType = 1217
+	opStructFieldPtrAnonymousHeadStringTagIntString                  opType = 1218
+	opStructFieldPtrAnonymousHeadStringTagIntStringOnly              opType = 1219
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntString         opType = 1220
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntStringOnly     opType = 1221
+	opStructFieldNPtrHeadIntString                                   opType = 1222
+	opStructFieldNPtrHeadIntStringOnly                               opType = 1223
+	opStructFieldNPtrHeadOmitEmptyIntString                          opType = 1224
+	opStructFieldNPtrHeadOmitEmptyIntStringOnly                      opType = 1225
+	opStructFieldNPtrHeadStringTagIntString                          opType = 1226
+	opStructFieldNPtrHeadStringTagIntStringOnly                      opType = 1227
+	opStructFieldNPtrHeadOmitEmptyStringTagIntString                 opType = 1228
+	opStructFieldNPtrHeadOmitEmptyStringTagIntStringOnly             opType = 1229
+	opStructFieldNPtrAnonymousHeadIntString                          opType = 1230
+	opStructFieldNPtrAnonymousHeadIntStringOnly                      opType = 1231
+	opStructFieldNPtrAnonymousHeadOmitEmptyIntString                 opType = 1232
+	opStructFieldNPtrAnonymousHeadOmitEmptyIntStringOnly             opType = 1233
+	opStructFieldNPtrAnonymousHeadStringTagIntString                 opType = 1234
+	opStructFieldNPtrAnonymousHeadStringTagIntStringOnly             opType = 1235
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagIntString        opType = 1236
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagIntStringOnly    opType = 1237
+	opStructFieldHeadInt8String                                      opType = 1238
+	opStructFieldHeadInt8StringOnly                                  opType = 1239
+	opStructFieldHeadOmitEmptyInt8String                             opType = 1240
+	opStructFieldHeadOmitEmptyInt8StringOnly                         opType = 1241
+	opStructFieldHeadStringTagInt8String                             opType = 1242
+	opStructFieldHeadStringTagInt8StringOnly                         opType = 1243
+	opStructFieldHeadOmitEmptyStringTagInt8String                    opType = 1244
+	opStructFieldHeadOmitEmptyStringTagInt8StringOnly                opType = 1245
+	opStructFieldAnonymousHeadInt8String                             opType = 1246
+	opStructFieldAnonymousHeadInt8StringOnly                         opType = 1247
+	opStructFieldAnonymousHeadOmitEmptyInt8String                    opType = 1248
+	opStructFieldAnonymousHeadOmitEmptyInt8StringOnly                opType = 1249
+	opStructFieldAnonymousHeadStringTagInt8String                    opType = 1250
+	opStructFieldAnonymousHeadStringTagInt8StringOnly                opType = 1251
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt8String           opType = 1252
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt8StringOnly       opType = 1253
+	opStructFieldPtrHeadInt8String                                   opType = 1254
+	opStructFieldPtrHeadInt8StringOnly                               opType = 1255
+	opStructFieldPtrHeadOmitEmptyInt8String                          opType = 1256
+	opStructFieldPtrHeadOmitEmptyInt8StringOnly                      opType = 1257
+	opStructFieldPtrHeadStringTagInt8String                          opType = 1258
+	opStructFieldPtrHeadStringTagInt8StringOnly                      opType = 1259
+	opStructFieldPtrHeadOmitEmptyStringTagInt8String                 opType = 1260
+	opStructFieldPtrHeadOmitEmptyStringTagInt8StringOnly             opType = 1261
+	opStructFieldPtrAnonymousHeadInt8String                          opType = 1262
+	opStructFieldPtrAnonymousHeadInt8StringOnly                      opType = 1263
+	opStructFieldPtrAnonymousHeadOmitEmptyInt8String                 opType = 1264
+	opStructFieldPtrAnonymousHeadOmitEmptyInt8StringOnly             opType = 1265
+	opStructFieldPtrAnonymousHeadStringTagInt8String                 opType = 1266
+	opStructFieldPtrAnonymousHeadStringTagInt8StringOnly             opType = 1267
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8String        opType = 1268
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8StringOnly    opType = 1269
+	opStructFieldNPtrHeadInt8String                                  opType = 1270
+	opStructFieldNPtrHeadInt8StringOnly                              opType = 1271
+	opStructFieldNPtrHeadOmitEmptyInt8String                         opType = 1272
+	opStructFieldNPtrHeadOmitEmptyInt8StringOnly                     opType = 1273
+	opStructFieldNPtrHeadStringTagInt8String                         opType = 1274
+	opStructFieldNPtrHeadStringTagInt8StringOnly                     opType = 1275
+	opStructFieldNPtrHeadOmitEmptyStringTagInt8String                opType = 1276
+	opStructFieldNPtrHeadOmitEmptyStringTagInt8StringOnly            opType = 1277
+	opStructFieldNPtrAnonymousHeadInt8String                         opType = 1278
+	opStructFieldNPtrAnonymousHeadInt8StringOnly                     opType = 1279
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt8String                opType = 1280
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt8StringOnly            opType = 1281
+	opStructFieldNPtrAnonymousHeadStringTagInt8String                opType = 1282
+	opStructFieldNPtrAnonymousHeadStringTagInt8StringOnly            opType = 1283
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8String       opType = 1284
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8StringOnly   opType = 1285
+	opStructFieldHeadInt16String                                     opType = 1286
+	opStructFieldHeadInt16StringOnly                                 opType = 1287
+	opStructFieldHeadOmitEmptyInt16String                            opType = 1288
+	opStructFieldHeadOmitEmptyInt16StringOnly                        opType = 1289
+	opStructFieldHeadStringTagInt16String                            opType = 1290
+	opStructFieldHeadStringTagInt16StringOnly                        opType = 1291
+	opStructFieldHeadOmitEmptyStringTagInt16String                   opType = 1292
+	opStructFieldHeadOmitEmptyStringTagInt16StringOnly               opType = 1293
+	opStructFieldAnonymousHeadInt16String                            opType = 1294
+	opStructFieldAnonymousHeadInt16StringOnly                        opType = 1295
+	opStructFieldAnonymousHeadOmitEmptyInt16String                   opType = 1296
+	opStructFieldAnonymousHeadOmitEmptyInt16StringOnly               opType = 1297
+	opStructFieldAnonymousHeadStringTagInt16String                   opType = 1298
+	opStructFieldAnonymousHeadStringTagInt16StringOnly               opType = 1299
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt16String          opType = 1300
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt16StringOnly      opType = 1301
+	opStructFieldPtrHeadInt16String                                  opType = 1302
+	opStructFieldPtrHeadInt16StringOnly                              opType = 1303
+	opStructFieldPtrHeadOmitEmptyInt16String                         opType = 1304
+	opStructFieldPtrHeadOmitEmptyInt16StringOnly                     opType = 1305
+	opStructFieldPtrHeadStringTagInt16String                         opType = 1306
+	opStructFieldPtrHeadStringTagInt16StringOnly                     opType = 1307
+	opStructFieldPtrHeadOmitEmptyStringTagInt16String                opType = 1308
+	opStructFieldPtrHeadOmitEmptyStringTagInt16StringOnly            opType = 1309
+	opStructFieldPtrAnonymousHeadInt16String                         opType = 1310
+	opStructFieldPtrAnonymousHeadInt16StringOnly                     opType = 1311
+	opStructFieldPtrAnonymousHeadOmitEmptyInt16String                opType = 1312
+	opStructFieldPtrAnonymousHeadOmitEmptyInt16StringOnly            opType = 1313
+	opStructFieldPtrAnonymousHeadStringTagInt16String                opType = 1314
+	opStructFieldPtrAnonymousHeadStringTagInt16StringOnly            opType = 1315
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16String       opType = 1316
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16StringOnly   opType = 1317
+	opStructFieldNPtrHeadInt16String                                 opType = 1318
+	opStructFieldNPtrHeadInt16StringOnly                             opType = 1319
+	opStructFieldNPtrHeadOmitEmptyInt16String                        opType = 1320
+	opStructFieldNPtrHeadOmitEmptyInt16StringOnly                    opType = 1321
+	opStructFieldNPtrHeadStringTagInt16String                        opType = 1322
+	opStructFieldNPtrHeadStringTagInt16StringOnly                    opType = 1323
+	opStructFieldNPtrHeadOmitEmptyStringTagInt16String               opType = 1324
+	opStructFieldNPtrHeadOmitEmptyStringTagInt16StringOnly           opType = 1325
+	opStructFieldNPtrAnonymousHeadInt16String                        opType = 1326
+	opStructFieldNPtrAnonymousHeadInt16StringOnly                    opType = 1327
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt16String               opType = 1328
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt16StringOnly           opType = 1329
+	opStructFieldNPtrAnonymousHeadStringTagInt16String               opType = 1330
+	opStructFieldNPtrAnonymousHeadStringTagInt16StringOnly           opType = 1331
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16String      opType = 1332
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16StringOnly  opType = 1333
+	opStructFieldHeadInt32String                                     opType = 1334
+	opStructFieldHeadInt32StringOnly                                 opType = 1335
+	opStructFieldHeadOmitEmptyInt32String                            opType = 1336
+	opStructFieldHeadOmitEmptyInt32StringOnly                        opType = 1337
+	opStructFieldHeadStringTagInt32String                            opType = 1338
+	opStructFieldHeadStringTagInt32StringOnly                        opType = 1339
+	opStructFieldHeadOmitEmptyStringTagInt32String                   opType = 1340
+	opStructFieldHeadOmitEmptyStringTagInt32StringOnly               opType = 1341
+	opStructFieldAnonymousHeadInt32String                            opType = 1342
+	opStructFieldAnonymousHeadInt32StringOnly                        opType = 1343
+	opStructFieldAnonymousHeadOmitEmptyInt32String                   opType = 1344
+	opStructFieldAnonymousHeadOmitEmptyInt32StringOnly               opType = 1345
+	opStructFieldAnonymousHeadStringTagInt32String                   opType = 1346
+	opStructFieldAnonymousHeadStringTagInt32StringOnly               opType = 1347
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt32String          opType = 1348
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt32StringOnly      opType = 1349
+	opStructFieldPtrHeadInt32String                                  opType = 1350
+	opStructFieldPtrHeadInt32StringOnly                              opType = 1351
+	opStructFieldPtrHeadOmitEmptyInt32String                         opType = 1352
+	opStructFieldPtrHeadOmitEmptyInt32StringOnly                     opType = 1353
+	opStructFieldPtrHeadStringTagInt32String                         opType = 1354
+	opStructFieldPtrHeadStringTagInt32StringOnly                     opType = 1355
+	opStructFieldPtrHeadOmitEmptyStringTagInt32String                opType = 1356
+	opStructFieldPtrHeadOmitEmptyStringTagInt32StringOnly            opType = 1357
+	opStructFieldPtrAnonymousHeadInt32String                         opType = 1358
+	opStructFieldPtrAnonymousHeadInt32StringOnly                     opType = 1359
+	opStructFieldPtrAnonymousHeadOmitEmptyInt32String                opType = 1360
+	opStructFieldPtrAnonymousHeadOmitEmptyInt32StringOnly            opType = 1361
+	opStructFieldPtrAnonymousHeadStringTagInt32String                opType = 1362
+	opStructFieldPtrAnonymousHeadStringTagInt32StringOnly            opType = 1363
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32String       opType = 1364
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32StringOnly   opType = 1365
+	opStructFieldNPtrHeadInt32String                                 opType = 1366
+	opStructFieldNPtrHeadInt32StringOnly                             opType = 1367
+	opStructFieldNPtrHeadOmitEmptyInt32String                        opType = 1368
+	opStructFieldNPtrHeadOmitEmptyInt32StringOnly                    opType = 1369
+	opStructFieldNPtrHeadStringTagInt32String                        opType = 1370
+	opStructFieldNPtrHeadStringTagInt32StringOnly                    opType = 1371
+	opStructFieldNPtrHeadOmitEmptyStringTagInt32String               opType = 1372
+	opStructFieldNPtrHeadOmitEmptyStringTagInt32StringOnly           opType = 1373
+	opStructFieldNPtrAnonymousHeadInt32String                        opType = 1374
+	opStructFieldNPtrAnonymousHeadInt32StringOnly                    opType = 1375
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt32String               opType = 1376
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt32StringOnly           opType = 1377
+	opStructFieldNPtrAnonymousHeadStringTagInt32String               opType = 1378
+	opStructFieldNPtrAnonymousHeadStringTagInt32StringOnly           opType = 1379
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32String      opType = 1380
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32StringOnly  opType = 1381
+	opStructFieldHeadInt64String                                     opType = 1382
+	opStructFieldHeadInt64StringOnly                                 opType = 1383
+	opStructFieldHeadOmitEmptyInt64String                            opType = 1384
+	opStructFieldHeadOmitEmptyInt64StringOnly                        opType = 1385
+	opStructFieldHeadStringTagInt64String                            opType = 1386
+	opStructFieldHeadStringTagInt64StringOnly                        opType = 1387
+	opStructFieldHeadOmitEmptyStringTagInt64String                   opType = 1388
+	opStructFieldHeadOmitEmptyStringTagInt64StringOnly               opType = 1389
+	opStructFieldAnonymousHeadInt64String                            opType = 1390
+	opStructFieldAnonymousHeadInt64StringOnly                        opType = 1391
+	opStructFieldAnonymousHeadOmitEmptyInt64String                   opType = 1392
+	opStructFieldAnonymousHeadOmitEmptyInt64StringOnly               opType = 1393
+	opStructFieldAnonymousHeadStringTagInt64String                   opType = 1394
+	opStructFieldAnonymousHeadStringTagInt64StringOnly               opType = 1395
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt64String          opType = 1396
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt64StringOnly      opType = 1397
+	opStructFieldPtrHeadInt64String                                  opType = 1398
+	opStructFieldPtrHeadInt64StringOnly                              opType = 1399
+	opStructFieldPtrHeadOmitEmptyInt64String                         opType = 1400
+	opStructFieldPtrHeadOmitEmptyInt64StringOnly                     opType = 1401
+	opStructFieldPtrHeadStringTagInt64String                         opType = 1402
+	opStructFieldPtrHeadStringTagInt64StringOnly                     opType = 1403
+	opStructFieldPtrHeadOmitEmptyStringTagInt64String                opType = 1404
+	opStructFieldPtrHeadOmitEmptyStringTagInt64StringOnly            opType = 1405
+	opStructFieldPtrAnonymousHeadInt64String                         opType = 1406
+	opStructFieldPtrAnonymousHeadInt64StringOnly                     opType = 1407
+	opStructFieldPtrAnonymousHeadOmitEmptyInt64String                opType = 1408
+	opStructFieldPtrAnonymousHeadOmitEmptyInt64StringOnly            opType = 1409
+	opStructFieldPtrAnonymousHeadStringTagInt64String                opType = 1410
+	opStructFieldPtrAnonymousHeadStringTagInt64StringOnly            opType = 1411
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64String       opType = 1412
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64StringOnly   opType = 1413
+	opStructFieldNPtrHeadInt64String                                 opType = 1414
+	opStructFieldNPtrHeadInt64StringOnly                             opType = 1415
+	opStructFieldNPtrHeadOmitEmptyInt64String                        opType = 1416
+	opStructFieldNPtrHeadOmitEmptyInt64StringOnly                    opType = 1417
+	opStructFieldNPtrHeadStringTagInt64String                        opType = 1418
+	opStructFieldNPtrHeadStringTagInt64StringOnly                    opType = 1419
+	opStructFieldNPtrHeadOmitEmptyStringTagInt64String               opType = 1420
+	opStructFieldNPtrHeadOmitEmptyStringTagInt64StringOnly           opType = 1421
+	opStructFieldNPtrAnonymousHeadInt64String                        opType = 1422
+	opStructFieldNPtrAnonymousHeadInt64StringOnly                    opType = 1423
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt64String               opType = 1424
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt64StringOnly           opType = 1425
+	opStructFieldNPtrAnonymousHeadStringTagInt64String               opType = 1426
+	opStructFieldNPtrAnonymousHeadStringTagInt64StringOnly           opType = 1427
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64String      opType = 1428
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64StringOnly  opType = 1429
+	opStructFieldHeadUintString                                      opType = 1430
+	opStructFieldHeadUintStringOnly                                  opType = 1431
+	opStructFieldHeadOmitEmptyUintString                             opType = 1432
+	opStructFieldHeadOmitEmptyUintStringOnly                         opType = 1433
+	opStructFieldHeadStringTagUintString                             opType = 1434
+	opStructFieldHeadStringTagUintStringOnly                         opType = 1435
+	opStructFieldHeadOmitEmptyStringTagUintString                    opType = 1436
+	opStructFieldHeadOmitEmptyStringTagUintStringOnly                opType = 1437
+	opStructFieldAnonymousHeadUintString                             opType = 1438
+	opStructFieldAnonymousHeadUintStringOnly                         opType = 1439
+	opStructFieldAnonymousHeadOmitEmptyUintString                    opType = 1440
+	opStructFieldAnonymousHeadOmitEmptyUintStringOnly                opType = 1441
+	opStructFieldAnonymousHeadStringTagUintString                    opType = 1442
+	opStructFieldAnonymousHeadStringTagUintStringOnly                opType = 1443
+	opStructFieldAnonymousHeadOmitEmptyStringTagUintString           opType = 1444
+	opStructFieldAnonymousHeadOmitEmptyStringTagUintStringOnly       opType = 1445
+	opStructFieldPtrHeadUintString                                   opType = 1446
+	opStructFieldPtrHeadUintStringOnly                               opType = 1447
+	opStructFieldPtrHeadOmitEmptyUintString                          opType = 1448
+	opStructFieldPtrHeadOmitEmptyUintStringOnly                      opType = 1449
+	opStructFieldPtrHeadStringTagUintString                          opType = 1450
+	opStructFieldPtrHeadStringTagUintStringOnly                      opType = 1451
+	opStructFieldPtrHeadOmitEmptyStringTagUintString                 opType = 1452
+	opStructFieldPtrHeadOmitEmptyStringTagUintStringOnly             opType = 1453
+	opStructFieldPtrAnonymousHeadUintString                          opType = 1454
+	opStructFieldPtrAnonymousHeadUintStringOnly                      opType = 1455
+	opStructFieldPtrAnonymousHeadOmitEmptyUintString                 opType = 1456
+	opStructFieldPtrAnonymousHeadOmitEmptyUintStringOnly             opType = 1457
+	opStructFieldPtrAnonymousHeadStringTagUintString                 opType = 1458
+	opStructFieldPtrAnonymousHeadStringTagUintStringOnly             opType = 1459
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintString        opType = 1460
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintStringOnly    opType = 1461
+	opStructFieldNPtrHeadUintString                                  opType = 1462
+	opStructFieldNPtrHeadUintStringOnly                              opType = 1463
+	opStructFieldNPtrHeadOmitEmptyUintString                         opType = 1464
+	opStructFieldNPtrHeadOmitEmptyUintStringOnly                     opType = 1465
+	opStructFieldNPtrHeadStringTagUintString                         opType = 1466
+	opStructFieldNPtrHeadStringTagUintStringOnly                     opType = 1467
+	opStructFieldNPtrHeadOmitEmptyStringTagUintString                opType = 1468
+	opStructFieldNPtrHeadOmitEmptyStringTagUintStringOnly            opType = 1469
+	opStructFieldNPtrAnonymousHeadUintString                         opType = 1470
+	opStructFieldNPtrAnonymousHeadUintStringOnly                     opType = 1471
+	opStructFieldNPtrAnonymousHeadOmitEmptyUintString                opType = 1472
+	opStructFieldNPtrAnonymousHeadOmitEmptyUintStringOnly            opType = 1473
+	opStructFieldNPtrAnonymousHeadStringTagUintString                opType = 1474
+	opStructFieldNPtrAnonymousHeadStringTagUintStringOnly            opType = 1475
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUintString       opType = 1476
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUintStringOnly   opType = 1477
+	opStructFieldHeadUint8String                                     opType = 1478
+	opStructFieldHeadUint8StringOnly                                 opType = 1479
+	opStructFieldHeadOmitEmptyUint8String                            opType = 1480
+	opStructFieldHeadOmitEmptyUint8StringOnly                        opType = 1481
+	opStructFieldHeadStringTagUint8String                            opType = 1482
+	opStructFieldHeadStringTagUint8StringOnly                        opType = 1483
+	opStructFieldHeadOmitEmptyStringTagUint8String                   opType = 1484
+	opStructFieldHeadOmitEmptyStringTagUint8StringOnly               opType = 1485
+	opStructFieldAnonymousHeadUint8String                            opType = 1486
+	opStructFieldAnonymousHeadUint8StringOnly                        opType = 1487
+	opStructFieldAnonymousHeadOmitEmptyUint8String                   opType = 1488
+	opStructFieldAnonymousHeadOmitEmptyUint8StringOnly               opType = 1489
+	opStructFieldAnonymousHeadStringTagUint8String                   opType = 1490
+	opStructFieldAnonymousHeadStringTagUint8StringOnly               opType = 1491
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint8String          opType = 1492
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint8StringOnly      opType = 1493
+	opStructFieldPtrHeadUint8String                                  opType = 1494
+	opStructFieldPtrHeadUint8StringOnly                              opType = 1495
+	opStructFieldPtrHeadOmitEmptyUint8String                         opType = 1496
+	opStructFieldPtrHeadOmitEmptyUint8StringOnly                     opType = 1497
+	opStructFieldPtrHeadStringTagUint8String                         opType = 1498
+	opStructFieldPtrHeadStringTagUint8StringOnly                     opType = 1499
+	opStructFieldPtrHeadOmitEmptyStringTagUint8String                opType = 1500
+	opStructFieldPtrHeadOmitEmptyStringTagUint8StringOnly            opType = 1501
+	opStructFieldPtrAnonymousHeadUint8String                         opType = 1502
+	opStructFieldPtrAnonymousHeadUint8StringOnly                     opType = 1503
+	opStructFieldPtrAnonymousHeadOmitEmptyUint8String                opType = 1504
+	opStructFieldPtrAnonymousHeadOmitEmptyUint8StringOnly            opType = 1505
+	opStructFieldPtrAnonymousHeadStringTagUint8String                opType = 1506
+	opStructFieldPtrAnonymousHeadStringTagUint8StringOnly            opType = 1507
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8String       opType = 1508
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8StringOnly   opType = 1509
+	opStructFieldNPtrHeadUint8String                                 opType = 1510
+	opStructFieldNPtrHeadUint8StringOnly                             opType = 1511
+	opStructFieldNPtrHeadOmitEmptyUint8String                        opType = 1512
+	opStructFieldNPtrHeadOmitEmptyUint8StringOnly                    opType = 1513
+	opStructFieldNPtrHeadStringTagUint8String                        opType = 1514
+	opStructFieldNPtrHeadStringTagUint8StringOnly                    opType = 1515
+	opStructFieldNPtrHeadOmitEmptyStringTagUint8String               opType = 1516
+	opStructFieldNPtrHeadOmitEmptyStringTagUint8StringOnly           opType = 1517
+	opStructFieldNPtrAnonymousHeadUint8String                        opType = 1518
+	opStructFieldNPtrAnonymousHeadUint8StringOnly                    opType = 1519
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint8String               opType = 1520
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint8StringOnly           opType = 1521
+	opStructFieldNPtrAnonymousHeadStringTagUint8String               opType = 1522
+	opStructFieldNPtrAnonymousHeadStringTagUint8StringOnly           opType = 1523
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8String      opType = 1524
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8StringOnly  opType = 1525
+	opStructFieldHeadUint16String                                    opType = 1526
+	opStructFieldHeadUint16StringOnly                                opType = 1527
+	opStructFieldHeadOmitEmptyUint16String                           opType = 1528
+	opStructFieldHeadOmitEmptyUint16StringOnly                       opType = 1529
+	opStructFieldHeadStringTagUint16String                           opType = 1530
+	opStructFieldHeadStringTagUint16StringOnly                       opType = 1531
+	opStructFieldHeadOmitEmptyStringTagUint16String                  opType = 1532
+	opStructFieldHeadOmitEmptyStringTagUint16StringOnly              opType = 1533
+	opStructFieldAnonymousHeadUint16String                           opType = 1534
+	opStructFieldAnonymousHeadUint16StringOnly                       opType = 1535
+	opStructFieldAnonymousHeadOmitEmptyUint16String                  opType = 1536
+	opStructFieldAnonymousHeadOmitEmptyUint16StringOnly              opType = 1537
+	opStructFieldAnonymousHeadStringTagUint16String                  opType = 1538
+	opStructFieldAnonymousHeadStringTagUint16StringOnly              opType = 1539
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint16String         opType = 1540
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint16StringOnly     opType = 1541
+	opStructFieldPtrHeadUint16String                                 opType = 1542
+	opStructFieldPtrHeadUint16StringOnly                             opType = 1543
+	opStructFieldPtrHeadOmitEmptyUint16String                        opType = 1544
+	opStructFieldPtrHeadOmitEmptyUint16StringOnly                    opType = 1545
+	opStructFieldPtrHeadStringTagUint16String                        opType = 1546
+	opStructFieldPtrHeadStringTagUint16StringOnly                    opType = 1547
+	opStructFieldPtrHeadOmitEmptyStringTagUint16String               opType = 1548
+	opStructFieldPtrHeadOmitEmptyStringTagUint16StringOnly           opType = 1549
+	opStructFieldPtrAnonymousHeadUint16String                        opType = 1550
+	opStructFieldPtrAnonymousHeadUint16StringOnly                    opType = 1551
+	opStructFieldPtrAnonymousHeadOmitEmptyUint16String               opType = 1552
+	opStructFieldPtrAnonymousHeadOmitEmptyUint16StringOnly           opType = 1553
+	opStructFieldPtrAnonymousHeadStringTagUint16String               opType = 1554
+	opStructFieldPtrAnonymousHeadStringTagUint16StringOnly           opType = 1555
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16String      opType = 1556
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16StringOnly  opType = 1557
+	opStructFieldNPtrHeadUint16String                                opType = 1558
+	opStructFieldNPtrHeadUint16StringOnly                            opType = 1559
+	opStructFieldNPtrHeadOmitEmptyUint16String                       opType = 1560
+	opStructFieldNPtrHeadOmitEmptyUint16StringOnly                   opType = 1561
+	opStructFieldNPtrHeadStringTagUint16String                       opType = 1562
+	opStructFieldNPtrHeadStringTagUint16StringOnly                   opType = 1563
+	opStructFieldNPtrHeadOmitEmptyStringTagUint16String              opType = 1564
+	opStructFieldNPtrHeadOmitEmptyStringTagUint16StringOnly          opType = 1565
+	opStructFieldNPtrAnonymousHeadUint16String                       opType = 1566
+	opStructFieldNPtrAnonymousHeadUint16StringOnly                   opType = 1567
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint16String              opType = 1568
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint16StringOnly          opType = 1569
+	opStructFieldNPtrAnonymousHeadStringTagUint16String              opType = 1570
+	opStructFieldNPtrAnonymousHeadStringTagUint16StringOnly          opType = 1571
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16String     opType = 1572
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16StringOnly opType = 1573
+	opStructFieldHeadUint32String                                    opType = 1574
+	opStructFieldHeadUint32StringOnly                                opType = 1575
+	opStructFieldHeadOmitEmptyUint32String                           opType = 1576
+	opStructFieldHeadOmitEmptyUint32StringOnly                       opType = 1577
+	opStructFieldHeadStringTagUint32String                           opType = 1578
+	opStructFieldHeadStringTagUint32StringOnly                       opType = 1579
+	opStructFieldHeadOmitEmptyStringTagUint32String                  opType = 1580
+	opStructFieldHeadOmitEmptyStringTagUint32StringOnly              opType = 1581
+	opStructFieldAnonymousHeadUint32String                           opType = 1582
+	opStructFieldAnonymousHeadUint32StringOnly                       opType = 1583
+	opStructFieldAnonymousHeadOmitEmptyUint32String                  opType = 1584
+	opStructFieldAnonymousHeadOmitEmptyUint32StringOnly              opType = 1585
+	opStructFieldAnonymousHeadStringTagUint32String                  opType = 1586
+	opStructFieldAnonymousHeadStringTagUint32StringOnly              opType = 1587
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint32String         opType = 1588
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint32StringOnly     opType = 1589
+	opStructFieldPtrHeadUint32String                                 opType = 1590
+	opStructFieldPtrHeadUint32StringOnly                             opType = 1591
+	opStructFieldPtrHeadOmitEmptyUint32String                        opType = 1592
+	opStructFieldPtrHeadOmitEmptyUint32StringOnly                    opType = 1593
+	opStructFieldPtrHeadStringTagUint32String                        opType = 1594
+	opStructFieldPtrHeadStringTagUint32StringOnly                    opType = 1595
+	opStructFieldPtrHeadOmitEmptyStringTagUint32String               opType = 1596
+	opStructFieldPtrHeadOmitEmptyStringTagUint32StringOnly           opType = 1597
+	opStructFieldPtrAnonymousHeadUint32String                        opType = 1598
+	opStructFieldPtrAnonymousHeadUint32StringOnly                    opType = 1599
+	opStructFieldPtrAnonymousHeadOmitEmptyUint32String               opType = 1600
+	opStructFieldPtrAnonymousHeadOmitEmptyUint32StringOnly           opType = 1601
+	opStructFieldPtrAnonymousHeadStringTagUint32String               opType = 1602
+	opStructFieldPtrAnonymousHeadStringTagUint32StringOnly           opType = 1603
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32String      opType = 1604
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32StringOnly  opType = 1605
+	opStructFieldNPtrHeadUint32String                                opType = 1606
+	opStructFieldNPtrHeadUint32StringOnly                            opType = 1607
+	opStructFieldNPtrHeadOmitEmptyUint32String                       opType = 1608
+	opStructFieldNPtrHeadOmitEmptyUint32StringOnly                   opType = 1609
+	opStructFieldNPtrHeadStringTagUint32String                       opType = 1610
+	opStructFieldNPtrHeadStringTagUint32StringOnly                   opType = 1611
+	opStructFieldNPtrHeadOmitEmptyStringTagUint32String              opType = 1612
+	opStructFieldNPtrHeadOmitEmptyStringTagUint32StringOnly          opType = 1613
+	opStructFieldNPtrAnonymousHeadUint32String                       opType = 1614
+	opStructFieldNPtrAnonymousHeadUint32StringOnly                   opType = 1615
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint32String              opType = 1616
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint32StringOnly          opType = 1617
+	opStructFieldNPtrAnonymousHeadStringTagUint32String              opType = 1618
+	opStructFieldNPtrAnonymousHeadStringTagUint32StringOnly          opType = 1619
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32String     opType = 1620
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32StringOnly opType = 1621
+	opStructFieldHeadUint64String                                    opType = 1622
+	opStructFieldHeadUint64StringOnly                                opType = 1623
+	opStructFieldHeadOmitEmptyUint64String                           opType = 1624
+	opStructFieldHeadOmitEmptyUint64StringOnly                       opType = 1625
+	opStructFieldHeadStringTagUint64String                           opType = 1626
+	opStructFieldHeadStringTagUint64StringOnly                       opType = 1627
+	opStructFieldHeadOmitEmptyStringTagUint64String                  opType = 1628
+	opStructFieldHeadOmitEmptyStringTagUint64StringOnly              opType = 1629
+	opStructFieldAnonymousHeadUint64String                           opType = 1630
+	opStructFieldAnonymousHeadUint64StringOnly                       opType = 1631
+	opStructFieldAnonymousHeadOmitEmptyUint64String                  opType = 1632
+	opStructFieldAnonymousHeadOmitEmptyUint64StringOnly              opType = 1633
+	opStructFieldAnonymousHeadStringTagUint64String                  opType = 1634
+	opStructFieldAnonymousHeadStringTagUint64StringOnly              opType = 1635
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint64String         opType = 1636
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint64StringOnly     opType = 1637
+	opStructFieldPtrHeadUint64String                                 opType = 1638
+	opStructFieldPtrHeadUint64StringOnly                             opType = 1639
+	opStructFieldPtrHeadOmitEmptyUint64String                        opType = 1640
+	opStructFieldPtrHeadOmitEmptyUint64StringOnly                    opType = 1641
+	opStructFieldPtrHeadStringTagUint64String                        opType = 1642
+	opStructFieldPtrHeadStringTagUint64StringOnly                    opType = 1643
+	opStructFieldPtrHeadOmitEmptyStringTagUint64String               opType = 1644
+	opStructFieldPtrHeadOmitEmptyStringTagUint64StringOnly           opType = 1645
+	opStructFieldPtrAnonymousHeadUint64String                        opType = 1646
+	opStructFieldPtrAnonymousHeadUint64StringOnly                    opType = 1647
+	opStructFieldPtrAnonymousHeadOmitEmptyUint64String               opType = 1648
+	opStructFieldPtrAnonymousHeadOmitEmptyUint64StringOnly           opType = 1649
+	opStructFieldPtrAnonymousHeadStringTagUint64String               opType = 1650
+	opStructFieldPtrAnonymousHeadStringTagUint64StringOnly           opType = 1651
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64String      opType = 1652
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64StringOnly  opType = 1653
+	opStructFieldNPtrHeadUint64String                                opType = 1654
+	opStructFieldNPtrHeadUint64StringOnly                            opType = 1655
+	opStructFieldNPtrHeadOmitEmptyUint64String                       opType = 1656
+	opStructFieldNPtrHeadOmitEmptyUint64StringOnly                   opType = 1657
+	opStructFieldNPtrHeadStringTagUint64String                       opType = 1658
+	opStructFieldNPtrHeadStringTagUint64StringOnly                   opType = 1659
+	opStructFieldNPtrHeadOmitEmptyStringTagUint64String              opType = 1660
+	opStructFieldNPtrHeadOmitEmptyStringTagUint64StringOnly          opType = 1661
+	opStructFieldNPtrAnonymousHeadUint64String                       opType = 1662
+	opStructFieldNPtrAnonymousHeadUint64StringOnly                   opType = 1663
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint64String              opType = 1664
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint64StringOnly          opType = 1665
+	opStructFieldNPtrAnonymousHeadStringTagUint64String              opType = 1666
+	opStructFieldNPtrAnonymousHeadStringTagUint64StringOnly          opType = 1667
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64String     opType = 1668
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64StringOnly opType = 1669
+	opStructFieldHeadIntPtr                                          opType = 1670
+	opStructFieldHeadIntPtrOnly                                      opType = 1671
+	opStructFieldHeadOmitEmptyIntPtr                                 opType = 1672
+	opStructFieldHeadOmitEmptyIntPtrOnly                             opType = 1673
+	opStructFieldHeadStringTagIntPtr                                 opType = 1674
+	opStructFieldHeadStringTagIntPtrOnly                             opType = 1675
+	opStructFieldHeadOmitEmptyStringTagIntPtr                        opType = 1676
+	opStructFieldHeadOmitEmptyStringTagIntPtrOnly                    opType = 1677
+	opStructFieldAnonymousHeadIntPtr                                 opType = 1678
+	opStructFieldAnonymousHeadIntPtrOnly                             opType = 1679
+	opStructFieldAnonymousHeadOmitEmptyIntPtr                        opType = 1680
+	opStructFieldAnonymousHeadOmitEmptyIntPtrOnly                    opType = 1681
+	opStructFieldAnonymousHeadStringTagIntPtr                        opType = 1682
+	opStructFieldAnonymousHeadStringTagIntPtrOnly                    opType = 1683
+	opStructFieldAnonymousHeadOmitEmptyStringTagIntPtr               opType = 1684
+	opStructFieldAnonymousHeadOmitEmptyStringTagIntPtrOnly           opType = 1685
+	opStructFieldPtrHeadIntPtr                                       opType = 1686
+	opStructFieldPtrHeadIntPtrOnly                                   opType = 1687
+	opStructFieldPtrHeadOmitEmptyIntPtr                              opType = 1688
+	opStructFieldPtrHeadOmitEmptyIntPtrOnly                          opType = 1689
+	opStructFieldPtrHeadStringTagIntPtr                              opType = 1690
+	opStructFieldPtrHeadStringTagIntPtrOnly                          opType = 1691
+	opStructFieldPtrHeadOmitEmptyStringTagIntPtr                     opType = 1692
+	opStructFieldPtrHeadOmitEmptyStringTagIntPtrOnly                 opType = 1693
+	opStructFieldPtrAnonymousHeadIntPtr                              opType = 1694
+	opStructFieldPtrAnonymousHeadIntPtrOnly                          opType = 1695
+	opStructFieldPtrAnonymousHeadOmitEmptyIntPtr                     opType = 1696
+	opStructFieldPtrAnonymousHeadOmitEmptyIntPtrOnly                 opType = 1697
+	opStructFieldPtrAnonymousHeadStringTagIntPtr                     opType = 1698
+	opStructFieldPtrAnonymousHeadStringTagIntPtrOnly                 opType = 1699
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtr            opType = 1700
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtrOnly        opType = 1701
+	opStructFieldNPtrHeadIntPtr                                      opType = 1702
+	opStructFieldNPtrHeadIntPtrOnly                                  opType = 1703
+	opStructFieldNPtrHeadOmitEmptyIntPtr                             opType = 1704
+	opStructFieldNPtrHeadOmitEmptyIntPtrOnly                         opType = 1705
+	opStructFieldNPtrHeadStringTagIntPtr                             opType = 1706
+	opStructFieldNPtrHeadStringTagIntPtrOnly                         opType = 1707
+	opStructFieldNPtrHeadOmitEmptyStringTagIntPtr                    opType = 1708
+	opStructFieldNPtrHeadOmitEmptyStringTagIntPtrOnly                opType = 1709
+	opStructFieldNPtrAnonymousHeadIntPtr                             opType = 1710
+	opStructFieldNPtrAnonymousHeadIntPtrOnly                         opType = 1711
+	opStructFieldNPtrAnonymousHeadOmitEmptyIntPtr                    opType = 1712
+	opStructFieldNPtrAnonymousHeadOmitEmptyIntPtrOnly                opType = 1713
+	opStructFieldNPtrAnonymousHeadStringTagIntPtr                    opType = 1714
+	opStructFieldNPtrAnonymousHeadStringTagIntPtrOnly                opType = 1715
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagIntPtr           opType = 1716
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagIntPtrOnly       opType = 1717
+	opStructFieldHeadInt8Ptr                                         opType = 1718
+	opStructFieldHeadInt8PtrOnly                                     opType = 1719
+	opStructFieldHeadOmitEmptyInt8Ptr                                opType = 1720
+	opStructFieldHeadOmitEmptyInt8PtrOnly                            opType = 1721
+	opStructFieldHeadStringTagInt8Ptr                                opType = 1722
+	opStructFieldHeadStringTagInt8PtrOnly                            opType = 1723
+	opStructFieldHeadOmitEmptyStringTagInt8Ptr                       opType = 1724
+	opStructFieldHeadOmitEmptyStringTagInt8PtrOnly                   opType = 1725
+	opStructFieldAnonymousHeadInt8Ptr                                opType = 1726
+	opStructFieldAnonymousHeadInt8PtrOnly                            opType = 1727
+	opStructFieldAnonymousHeadOmitEmptyInt8Ptr                       opType = 1728
+	opStructFieldAnonymousHeadOmitEmptyInt8PtrOnly                   opType = 1729
+	opStructFieldAnonymousHeadStringTagInt8Ptr                       opType = 1730
+	opStructFieldAnonymousHeadStringTagInt8PtrOnly                   opType = 1731
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt8Ptr              opType = 1732
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt8PtrOnly          opType = 1733
+	opStructFieldPtrHeadInt8Ptr                                      opType = 1734
+	opStructFieldPtrHeadInt8PtrOnly                                  opType = 1735
+	opStructFieldPtrHeadOmitEmptyInt8Ptr                             opType = 1736
+	opStructFieldPtrHeadOmitEmptyInt8PtrOnly                         opType = 1737
+	opStructFieldPtrHeadStringTagInt8Ptr                             opType = 1738
+	opStructFieldPtrHeadStringTagInt8PtrOnly                         opType = 1739
+	opStructFieldPtrHeadOmitEmptyStringTagInt8Ptr                    opType = 1740
+	opStructFieldPtrHeadOmitEmptyStringTagInt8PtrOnly                opType = 1741
+	opStructFieldPtrAnonymousHeadInt8Ptr                             opType = 1742
+	opStructFieldPtrAnonymousHeadInt8PtrOnly                         opType = 1743
+	opStructFieldPtrAnonymousHeadOmitEmptyInt8Ptr                    opType = 1744
+	opStructFieldPtrAnonymousHeadOmitEmptyInt8PtrOnly                opType = 1745
+	opStructFieldPtrAnonymousHeadStringTagInt8Ptr                    opType = 1746
+	opStructFieldPtrAnonymousHeadStringTagInt8PtrOnly                opType = 1747
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Ptr           opType = 1748
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8PtrOnly       opType = 1749
+	opStructFieldNPtrHeadInt8Ptr                                     opType = 1750
+	opStructFieldNPtrHeadInt8PtrOnly                                 opType = 1751
+	opStructFieldNPtrHeadOmitEmptyInt8Ptr                            opType = 1752
+	opStructFieldNPtrHeadOmitEmptyInt8PtrOnly                        opType = 1753
+	opStructFieldNPtrHeadStringTagInt8Ptr                            opType = 1754
+	opStructFieldNPtrHeadStringTagInt8PtrOnly                        opType = 1755
+	opStructFieldNPtrHeadOmitEmptyStringTagInt8Ptr                   opType = 1756
+	opStructFieldNPtrHeadOmitEmptyStringTagInt8PtrOnly               opType = 1757
+	opStructFieldNPtrAnonymousHeadInt8Ptr                            opType = 1758
+	opStructFieldNPtrAnonymousHeadInt8PtrOnly                        opType = 1759
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt8Ptr                   opType = 1760
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt8PtrOnly               opType = 1761
+	opStructFieldNPtrAnonymousHeadStringTagInt8Ptr                   opType = 1762
+	opStructFieldNPtrAnonymousHeadStringTagInt8PtrOnly               opType = 1763
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8Ptr          opType = 1764
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8PtrOnly      opType = 1765
+	opStructFieldHeadInt16Ptr                                        opType = 1766
+	opStructFieldHeadInt16PtrOnly                                    opType = 1767
+	opStructFieldHeadOmitEmptyInt16Ptr                               opType = 1768
+	opStructFieldHeadOmitEmptyInt16PtrOnly                           opType = 1769
+	opStructFieldHeadStringTagInt16Ptr                               opType = 1770
+	opStructFieldHeadStringTagInt16PtrOnly                           opType = 1771
+	opStructFieldHeadOmitEmptyStringTagInt16Ptr                      opType = 1772
+	opStructFieldHeadOmitEmptyStringTagInt16PtrOnly                  opType = 1773
+	opStructFieldAnonymousHeadInt16Ptr                               opType = 1774
+	opStructFieldAnonymousHeadInt16PtrOnly                           opType = 1775
+	opStructFieldAnonymousHeadOmitEmptyInt16Ptr                      opType = 1776
+	opStructFieldAnonymousHeadOmitEmptyInt16PtrOnly                  opType = 1777
+	opStructFieldAnonymousHeadStringTagInt16Ptr                      opType = 1778
+	opStructFieldAnonymousHeadStringTagInt16PtrOnly                  opType = 1779
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt16Ptr             opType = 1780
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt16PtrOnly         opType = 1781
+	opStructFieldPtrHeadInt16Ptr                                     opType = 1782
+	opStructFieldPtrHeadInt16PtrOnly                                 opType = 1783
+	opStructFieldPtrHeadOmitEmptyInt16Ptr                            opType = 1784
+	opStructFieldPtrHeadOmitEmptyInt16PtrOnly                        opType = 1785
+	opStructFieldPtrHeadStringTagInt16Ptr                            opType = 1786
+	opStructFieldPtrHeadStringTagInt16PtrOnly                        opType = 1787
+	opStructFieldPtrHeadOmitEmptyStringTagInt16Ptr                   opType = 1788
+	opStructFieldPtrHeadOmitEmptyStringTagInt16PtrOnly               opType = 1789
+	opStructFieldPtrAnonymousHeadInt16Ptr                            opType = 1790
+	opStructFieldPtrAnonymousHeadInt16PtrOnly                        opType = 1791
+	opStructFieldPtrAnonymousHeadOmitEmptyInt16Ptr                   opType = 1792
+	opStructFieldPtrAnonymousHeadOmitEmptyInt16PtrOnly               opType = 1793
+	opStructFieldPtrAnonymousHeadStringTagInt16Ptr                   opType = 1794
+	opStructFieldPtrAnonymousHeadStringTagInt16PtrOnly               opType = 1795
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Ptr          opType = 1796
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16PtrOnly      opType = 1797
+	opStructFieldNPtrHeadInt16Ptr                                    opType = 1798
+	opStructFieldNPtrHeadInt16PtrOnly                                opType = 1799
+	opStructFieldNPtrHeadOmitEmptyInt16Ptr                           opType = 1800
+	opStructFieldNPtrHeadOmitEmptyInt16PtrOnly                       opType = 1801
+	opStructFieldNPtrHeadStringTagInt16Ptr                           opType = 1802
+	opStructFieldNPtrHeadStringTagInt16PtrOnly                       opType = 1803
+	opStructFieldNPtrHeadOmitEmptyStringTagInt16Ptr                  opType = 1804
+	opStructFieldNPtrHeadOmitEmptyStringTagInt16PtrOnly              opType = 1805
+	opStructFieldNPtrAnonymousHeadInt16Ptr                           opType = 1806
+	opStructFieldNPtrAnonymousHeadInt16PtrOnly                       opType = 1807
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt16Ptr                  opType = 1808
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt16PtrOnly              opType = 1809
+	opStructFieldNPtrAnonymousHeadStringTagInt16Ptr                  opType = 1810
+	opStructFieldNPtrAnonymousHeadStringTagInt16PtrOnly              opType = 1811
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16Ptr         opType = 1812
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16PtrOnly     opType = 1813
+	opStructFieldHeadInt32Ptr                                        opType = 1814
+	opStructFieldHeadInt32PtrOnly                                    opType = 1815
+	opStructFieldHeadOmitEmptyInt32Ptr                               opType = 1816
+	opStructFieldHeadOmitEmptyInt32PtrOnly                           opType = 1817
+	opStructFieldHeadStringTagInt32Ptr                               opType = 1818
+	opStructFieldHeadStringTagInt32PtrOnly                           opType = 1819
+	opStructFieldHeadOmitEmptyStringTagInt32Ptr                      opType = 1820
+	opStructFieldHeadOmitEmptyStringTagInt32PtrOnly                  opType = 1821
+	opStructFieldAnonymousHeadInt32Ptr                               opType = 1822
+	opStructFieldAnonymousHeadInt32PtrOnly                           opType = 1823
+	opStructFieldAnonymousHeadOmitEmptyInt32Ptr                      opType = 1824
+	opStructFieldAnonymousHeadOmitEmptyInt32PtrOnly                  opType = 1825
+	opStructFieldAnonymousHeadStringTagInt32Ptr                      opType = 1826
+	opStructFieldAnonymousHeadStringTagInt32PtrOnly                  opType = 1827
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt32Ptr             opType = 1828
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt32PtrOnly         opType = 1829
+	opStructFieldPtrHeadInt32Ptr                                     opType = 1830
+	opStructFieldPtrHeadInt32PtrOnly                                 opType = 1831
+	opStructFieldPtrHeadOmitEmptyInt32Ptr                            opType = 1832
+	opStructFieldPtrHeadOmitEmptyInt32PtrOnly                        opType = 1833
+	opStructFieldPtrHeadStringTagInt32Ptr                            opType = 1834
+	opStructFieldPtrHeadStringTagInt32PtrOnly                        opType = 1835
+	opStructFieldPtrHeadOmitEmptyStringTagInt32Ptr                   opType = 1836
+	opStructFieldPtrHeadOmitEmptyStringTagInt32PtrOnly               opType = 1837
+	opStructFieldPtrAnonymousHeadInt32Ptr                            opType = 1838
+	opStructFieldPtrAnonymousHeadInt32PtrOnly                        opType = 1839
+	opStructFieldPtrAnonymousHeadOmitEmptyInt32Ptr                   opType = 1840
+	opStructFieldPtrAnonymousHeadOmitEmptyInt32PtrOnly               opType = 1841
+	opStructFieldPtrAnonymousHeadStringTagInt32Ptr                   opType = 1842
+	opStructFieldPtrAnonymousHeadStringTagInt32PtrOnly               opType = 1843
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Ptr          opType = 1844
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32PtrOnly      opType = 1845
+	opStructFieldNPtrHeadInt32Ptr                                    opType = 1846
+	opStructFieldNPtrHeadInt32PtrOnly                                opType = 1847
+	opStructFieldNPtrHeadOmitEmptyInt32Ptr                           opType = 1848
+	opStructFieldNPtrHeadOmitEmptyInt32PtrOnly                       opType = 1849
+	opStructFieldNPtrHeadStringTagInt32Ptr                           opType = 1850
+	opStructFieldNPtrHeadStringTagInt32PtrOnly                       opType = 1851
+	opStructFieldNPtrHeadOmitEmptyStringTagInt32Ptr                  opType = 1852
+	opStructFieldNPtrHeadOmitEmptyStringTagInt32PtrOnly              opType = 1853
+	opStructFieldNPtrAnonymousHeadInt32Ptr                           opType = 1854
+	opStructFieldNPtrAnonymousHeadInt32PtrOnly                       opType = 1855
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt32Ptr                  opType = 1856
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt32PtrOnly              opType = 1857
+	opStructFieldNPtrAnonymousHeadStringTagInt32Ptr                  opType = 1858
+	opStructFieldNPtrAnonymousHeadStringTagInt32PtrOnly              opType = 1859
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32Ptr         opType = 1860
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32PtrOnly     opType = 1861
+	opStructFieldHeadInt64Ptr                                        opType = 1862
+	opStructFieldHeadInt64PtrOnly                                    opType = 1863
+	opStructFieldHeadOmitEmptyInt64Ptr                               opType = 1864
+	opStructFieldHeadOmitEmptyInt64PtrOnly                           opType = 1865
+	opStructFieldHeadStringTagInt64Ptr                               opType = 1866
+	opStructFieldHeadStringTagInt64PtrOnly                           opType = 1867
+	opStructFieldHeadOmitEmptyStringTagInt64Ptr                      opType = 1868
+	opStructFieldHeadOmitEmptyStringTagInt64PtrOnly                  opType = 1869
+	opStructFieldAnonymousHeadInt64Ptr                               opType = 1870
+	opStructFieldAnonymousHeadInt64PtrOnly                           opType = 1871
+	opStructFieldAnonymousHeadOmitEmptyInt64Ptr                      opType = 1872
+	opStructFieldAnonymousHeadOmitEmptyInt64PtrOnly                  opType = 1873
+	opStructFieldAnonymousHeadStringTagInt64Ptr                      opType = 1874
+	opStructFieldAnonymousHeadStringTagInt64PtrOnly                  opType = 1875
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt64Ptr             opType = 1876
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt64PtrOnly         opType = 1877
+	opStructFieldPtrHeadInt64Ptr                                     opType = 1878
+	opStructFieldPtrHeadInt64PtrOnly                                 opType = 1879
+	opStructFieldPtrHeadOmitEmptyInt64Ptr                            opType = 1880
+	opStructFieldPtrHeadOmitEmptyInt64PtrOnly                        opType = 1881
+	opStructFieldPtrHeadStringTagInt64Ptr                            opType = 1882
+	opStructFieldPtrHeadStringTagInt64PtrOnly                        opType = 1883
+	opStructFieldPtrHeadOmitEmptyStringTagInt64Ptr                   opType = 1884
+	opStructFieldPtrHeadOmitEmptyStringTagInt64PtrOnly               opType = 1885
+	opStructFieldPtrAnonymousHeadInt64Ptr                            opType = 1886
+	opStructFieldPtrAnonymousHeadInt64PtrOnly                        opType = 1887
+	opStructFieldPtrAnonymousHeadOmitEmptyInt64Ptr                   opType = 1888
+	opStructFieldPtrAnonymousHeadOmitEmptyInt64PtrOnly               opType = 1889
+	opStructFieldPtrAnonymousHeadStringTagInt64Ptr                   opType = 1890
+	opStructFieldPtrAnonymousHeadStringTagInt64PtrOnly               opType = 1891
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Ptr          opType = 1892
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64PtrOnly      opType = 1893
+	opStructFieldNPtrHeadInt64Ptr                                    opType = 1894
+	opStructFieldNPtrHeadInt64PtrOnly                                opType = 1895
+	opStructFieldNPtrHeadOmitEmptyInt64Ptr                           opType = 1896
+	opStructFieldNPtrHeadOmitEmptyInt64PtrOnly                       opType = 1897
+	opStructFieldNPtrHeadStringTagInt64Ptr                           opType = 1898
+	opStructFieldNPtrHeadStringTagInt64PtrOnly                       opType = 1899
+	opStructFieldNPtrHeadOmitEmptyStringTagInt64Ptr                  opType = 1900
+	opStructFieldNPtrHeadOmitEmptyStringTagInt64PtrOnly              opType = 1901
+	opStructFieldNPtrAnonymousHeadInt64Ptr                           opType = 1902
+	opStructFieldNPtrAnonymousHeadInt64PtrOnly                       opType = 1903
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt64Ptr                  opType = 1904
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt64PtrOnly              opType = 1905
+	opStructFieldNPtrAnonymousHeadStringTagInt64Ptr                  opType = 1906
+	opStructFieldNPtrAnonymousHeadStringTagInt64PtrOnly              opType = 1907
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64Ptr         opType = 1908
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64PtrOnly     opType = 1909
+	opStructFieldHeadUintPtr                                         opType = 1910
+	opStructFieldHeadUintPtrOnly                                     opType = 1911
+	opStructFieldHeadOmitEmptyUintPtr                                opType = 1912
+	opStructFieldHeadOmitEmptyUintPtrOnly                            opType = 1913
+	opStructFieldHeadStringTagUintPtr                                opType = 1914
+	opStructFieldHeadStringTagUintPtrOnly                            opType = 1915
+	opStructFieldHeadOmitEmptyStringTagUintPtr                       opType = 1916
+	opStructFieldHeadOmitEmptyStringTagUintPtrOnly                   opType = 1917
+	opStructFieldAnonymousHeadUintPtr                                opType = 1918
+	opStructFieldAnonymousHeadUintPtrOnly                            opType = 1919
+	opStructFieldAnonymousHeadOmitEmptyUintPtr                       opType = 1920
+	opStructFieldAnonymousHeadOmitEmptyUintPtrOnly                   opType = 1921
+	opStructFieldAnonymousHeadStringTagUintPtr                       opType = 1922
+	opStructFieldAnonymousHeadStringTagUintPtrOnly                   opType = 1923
+	opStructFieldAnonymousHeadOmitEmptyStringTagUintPtr              opType = 1924
+	opStructFieldAnonymousHeadOmitEmptyStringTagUintPtrOnly          opType = 1925
+	opStructFieldPtrHeadUintPtr                                      opType = 1926
+	opStructFieldPtrHeadUintPtrOnly                                  opType = 1927
+	opStructFieldPtrHeadOmitEmptyUintPtr                             opType = 1928
+	opStructFieldPtrHeadOmitEmptyUintPtrOnly                         opType = 1929
+	opStructFieldPtrHeadStringTagUintPtr                             opType = 1930
+	opStructFieldPtrHeadStringTagUintPtrOnly                         opType = 1931
+	opStructFieldPtrHeadOmitEmptyStringTagUintPtr                    opType = 1932
+	opStructFieldPtrHeadOmitEmptyStringTagUintPtrOnly                opType = 1933
+	opStructFieldPtrAnonymousHeadUintPtr                             opType = 1934
+	opStructFieldPtrAnonymousHeadUintPtrOnly                         opType = 1935
+	opStructFieldPtrAnonymousHeadOmitEmptyUintPtr                    opType = 1936
+	opStructFieldPtrAnonymousHeadOmitEmptyUintPtrOnly                opType = 1937
+	opStructFieldPtrAnonymousHeadStringTagUintPtr                    opType = 1938
+	opStructFieldPtrAnonymousHeadStringTagUintPtrOnly                opType = 1939
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtr           opType = 1940
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtrOnly       opType = 1941
+	opStructFieldNPtrHeadUintPtr                                     opType = 1942
+	opStructFieldNPtrHeadUintPtrOnly                                 opType = 1943
+	opStructFieldNPtrHeadOmitEmptyUintPtr                            opType = 1944
+	opStructFieldNPtrHeadOmitEmptyUintPtrOnly                        opType = 1945
+	opStructFieldNPtrHeadStringTagUintPtr                            opType = 1946
+	opStructFieldNPtrHeadStringTagUintPtrOnly                        opType = 1947
+	opStructFieldNPtrHeadOmitEmptyStringTagUintPtr                   opType = 1948
+	opStructFieldNPtrHeadOmitEmptyStringTagUintPtrOnly               opType = 1949
+	opStructFieldNPtrAnonymousHeadUintPtr                            opType = 1950
+	opStructFieldNPtrAnonymousHeadUintPtrOnly                        opType = 1951
+	opStructFieldNPtrAnonymousHeadOmitEmptyUintPtr                   opType = 1952
+	opStructFieldNPtrAnonymousHeadOmitEmptyUintPtrOnly               opType = 1953
+	opStructFieldNPtrAnonymousHeadStringTagUintPtr                   opType = 1954
+	opStructFieldNPtrAnonymousHeadStringTagUintPtrOnly               opType = 1955
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUintPtr          opType = 1956
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUintPtrOnly      opType = 1957
+	opStructFieldHeadUint8Ptr                                        opType = 1958
+	opStructFieldHeadUint8PtrOnly                                    opType = 1959
+	opStructFieldHeadOmitEmptyUint8Ptr                               opType = 1960
+	opStructFieldHeadOmitEmptyUint8PtrOnly                           opType = 1961
+	opStructFieldHeadStringTagUint8Ptr                               opType = 1962
+	opStructFieldHeadStringTagUint8PtrOnly                           opType = 1963
+	opStructFieldHeadOmitEmptyStringTagUint8Ptr                      opType = 1964
+	opStructFieldHeadOmitEmptyStringTagUint8PtrOnly                  opType = 1965
+	opStructFieldAnonymousHeadUint8Ptr                               opType = 1966
+	opStructFieldAnonymousHeadUint8PtrOnly                           opType = 1967
+	opStructFieldAnonymousHeadOmitEmptyUint8Ptr                      opType = 1968
+	opStructFieldAnonymousHeadOmitEmptyUint8PtrOnly                  opType = 1969
+	opStructFieldAnonymousHeadStringTagUint8Ptr                      opType = 1970
+	opStructFieldAnonymousHeadStringTagUint8PtrOnly                  opType = 1971
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint8Ptr             opType = 1972
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint8PtrOnly         opType = 1973
+	opStructFieldPtrHeadUint8Ptr                                     opType = 1974
+	opStructFieldPtrHeadUint8PtrOnly                                 opType = 1975
+	opStructFieldPtrHeadOmitEmptyUint8Ptr                            opType = 1976
+	opStructFieldPtrHeadOmitEmptyUint8PtrOnly                        opType = 1977
+	opStructFieldPtrHeadStringTagUint8Ptr                            opType = 1978
+	opStructFieldPtrHeadStringTagUint8PtrOnly                        opType = 1979
+	opStructFieldPtrHeadOmitEmptyStringTagUint8Ptr                   opType = 1980
+	opStructFieldPtrHeadOmitEmptyStringTagUint8PtrOnly               opType = 1981
+	opStructFieldPtrAnonymousHeadUint8Ptr                            opType = 1982
+	opStructFieldPtrAnonymousHeadUint8PtrOnly                        opType = 1983
+	opStructFieldPtrAnonymousHeadOmitEmptyUint8Ptr                   opType = 1984
+	opStructFieldPtrAnonymousHeadOmitEmptyUint8PtrOnly               opType = 1985
+	opStructFieldPtrAnonymousHeadStringTagUint8Ptr                   opType = 1986
+	opStructFieldPtrAnonymousHeadStringTagUint8PtrOnly               opType = 1987
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Ptr          opType = 1988
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8PtrOnly      opType = 1989
+	opStructFieldNPtrHeadUint8Ptr                                    opType = 1990
+	opStructFieldNPtrHeadUint8PtrOnly                                opType = 1991
+	opStructFieldNPtrHeadOmitEmptyUint8Ptr                           opType = 1992
+	opStructFieldNPtrHeadOmitEmptyUint8PtrOnly                       opType = 1993
+	opStructFieldNPtrHeadStringTagUint8Ptr                           opType = 1994
+	opStructFieldNPtrHeadStringTagUint8PtrOnly                       opType = 1995
+	opStructFieldNPtrHeadOmitEmptyStringTagUint8Ptr                  opType = 1996
+	opStructFieldNPtrHeadOmitEmptyStringTagUint8PtrOnly              opType = 1997
+	opStructFieldNPtrAnonymousHeadUint8Ptr                           opType = 1998
+	opStructFieldNPtrAnonymousHeadUint8PtrOnly                       opType = 1999
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint8Ptr                  opType = 2000
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint8PtrOnly              opType = 2001
+	opStructFieldNPtrAnonymousHeadStringTagUint8Ptr                  opType = 2002
+	opStructFieldNPtrAnonymousHeadStringTagUint8PtrOnly              opType = 2003
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8Ptr         opType = 2004
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8PtrOnly     opType = 2005
+	opStructFieldHeadUint16Ptr                                       opType = 2006
+	opStructFieldHeadUint16PtrOnly                                   opType = 2007
+	opStructFieldHeadOmitEmptyUint16Ptr                              opType = 2008
+	opStructFieldHeadOmitEmptyUint16PtrOnly                          opType = 2009
+	opStructFieldHeadStringTagUint16Ptr                              opType = 2010
+	opStructFieldHeadStringTagUint16PtrOnly                          opType = 2011
+	opStructFieldHeadOmitEmptyStringTagUint16Ptr                     opType = 2012
+	opStructFieldHeadOmitEmptyStringTagUint16PtrOnly                 opType = 2013
+	opStructFieldAnonymousHeadUint16Ptr                              opType = 2014
+	opStructFieldAnonymousHeadUint16PtrOnly                          opType = 2015
+	opStructFieldAnonymousHeadOmitEmptyUint16Ptr                     opType = 2016
+	opStructFieldAnonymousHeadOmitEmptyUint16PtrOnly                 opType = 2017
+	opStructFieldAnonymousHeadStringTagUint16Ptr                     opType = 2018
+	opStructFieldAnonymousHeadStringTagUint16PtrOnly                 opType = 2019
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint16Ptr            opType = 2020
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint16PtrOnly        opType = 2021
+	opStructFieldPtrHeadUint16Ptr                                    opType = 2022
+	opStructFieldPtrHeadUint16PtrOnly                                opType = 2023
+	opStructFieldPtrHeadOmitEmptyUint16Ptr                           opType = 2024
+	opStructFieldPtrHeadOmitEmptyUint16PtrOnly                       opType = 2025
+	opStructFieldPtrHeadStringTagUint16Ptr                           opType = 2026
+	opStructFieldPtrHeadStringTagUint16PtrOnly                       opType = 2027
+	opStructFieldPtrHeadOmitEmptyStringTagUint16Ptr                  opType = 2028
+	opStructFieldPtrHeadOmitEmptyStringTagUint16PtrOnly              opType = 2029
+	opStructFieldPtrAnonymousHeadUint16Ptr                           opType = 2030
+	opStructFieldPtrAnonymousHeadUint16PtrOnly                       opType = 2031
+	opStructFieldPtrAnonymousHeadOmitEmptyUint16Ptr                  opType = 2032
+	opStructFieldPtrAnonymousHeadOmitEmptyUint16PtrOnly              opType = 2033
+	opStructFieldPtrAnonymousHeadStringTagUint16Ptr                  opType = 2034
+	opStructFieldPtrAnonymousHeadStringTagUint16PtrOnly              opType = 2035
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Ptr         opType = 2036
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16PtrOnly     opType = 2037
+	opStructFieldNPtrHeadUint16Ptr                                   opType = 2038
+	opStructFieldNPtrHeadUint16PtrOnly                               opType = 2039
+	opStructFieldNPtrHeadOmitEmptyUint16Ptr                          opType = 2040
+	opStructFieldNPtrHeadOmitEmptyUint16PtrOnly                      opType = 2041
+	opStructFieldNPtrHeadStringTagUint16Ptr                          opType = 2042
+	opStructFieldNPtrHeadStringTagUint16PtrOnly                      opType = 2043
+	opStructFieldNPtrHeadOmitEmptyStringTagUint16Ptr                 opType = 2044
+	opStructFieldNPtrHeadOmitEmptyStringTagUint16PtrOnly             opType = 2045
+	opStructFieldNPtrAnonymousHeadUint16Ptr                          opType = 2046
+	opStructFieldNPtrAnonymousHeadUint16PtrOnly                      opType = 2047
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint16Ptr                 opType = 2048
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint16PtrOnly             opType = 2049
+	opStructFieldNPtrAnonymousHeadStringTagUint16Ptr                 opType = 2050
+	opStructFieldNPtrAnonymousHeadStringTagUint16PtrOnly             opType = 2051
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16Ptr        opType = 2052
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16PtrOnly    opType = 2053
+	opStructFieldHeadUint32Ptr                                       opType = 2054
+	opStructFieldHeadUint32PtrOnly                                   opType = 2055
+	opStructFieldHeadOmitEmptyUint32Ptr                              opType = 2056
+	opStructFieldHeadOmitEmptyUint32PtrOnly                          opType = 2057
+	opStructFieldHeadStringTagUint32Ptr                              opType = 2058
+	opStructFieldHeadStringTagUint32PtrOnly                          opType = 2059
+	opStructFieldHeadOmitEmptyStringTagUint32Ptr                     opType = 2060
+	opStructFieldHeadOmitEmptyStringTagUint32PtrOnly                 opType = 2061
+	opStructFieldAnonymousHeadUint32Ptr                              opType = 2062
+	opStructFieldAnonymousHeadUint32PtrOnly                          opType = 2063
+	opStructFieldAnonymousHeadOmitEmptyUint32Ptr                     opType = 2064
+	opStructFieldAnonymousHeadOmitEmptyUint32PtrOnly                 opType = 2065
+	opStructFieldAnonymousHeadStringTagUint32Ptr                     opType = 2066
+	opStructFieldAnonymousHeadStringTagUint32PtrOnly                 opType = 2067
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint32Ptr            opType = 2068
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint32PtrOnly        opType = 2069
+	opStructFieldPtrHeadUint32Ptr                                    opType = 2070
+	opStructFieldPtrHeadUint32PtrOnly                                opType = 2071
+	opStructFieldPtrHeadOmitEmptyUint32Ptr                           opType = 2072
+	opStructFieldPtrHeadOmitEmptyUint32PtrOnly                       opType = 2073
+	opStructFieldPtrHeadStringTagUint32Ptr                           opType = 2074
+	opStructFieldPtrHeadStringTagUint32PtrOnly                       opType = 2075
+	opStructFieldPtrHeadOmitEmptyStringTagUint32Ptr                  opType = 2076
+	opStructFieldPtrHeadOmitEmptyStringTagUint32PtrOnly              opType = 2077
+	opStructFieldPtrAnonymousHeadUint32Ptr                           opType = 2078
+	opStructFieldPtrAnonymousHeadUint32PtrOnly                       opType = 2079
+	opStructFieldPtrAnonymousHeadOmitEmptyUint32Ptr                  opType = 2080
+	opStructFieldPtrAnonymousHeadOmitEmptyUint32PtrOnly              opType = 2081
+	opStructFieldPtrAnonymousHeadStringTagUint32Ptr                  opType = 2082
+	opStructFieldPtrAnonymousHeadStringTagUint32PtrOnly              opType = 2083
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Ptr         opType = 2084
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32PtrOnly     opType = 2085
+	opStructFieldNPtrHeadUint32Ptr                                   opType = 2086
+	opStructFieldNPtrHeadUint32PtrOnly                               opType = 2087
+	opStructFieldNPtrHeadOmitEmptyUint32Ptr                          opType = 2088
+	opStructFieldNPtrHeadOmitEmptyUint32PtrOnly                      opType = 2089
+	opStructFieldNPtrHeadStringTagUint32Ptr                          opType = 2090
+	opStructFieldNPtrHeadStringTagUint32PtrOnly                      opType = 2091
+	opStructFieldNPtrHeadOmitEmptyStringTagUint32Ptr                 opType = 2092
+	opStructFieldNPtrHeadOmitEmptyStringTagUint32PtrOnly             opType = 2093
+	opStructFieldNPtrAnonymousHeadUint32Ptr                          opType = 2094
+	opStructFieldNPtrAnonymousHeadUint32PtrOnly                      opType = 2095
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint32Ptr                 opType = 2096
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint32PtrOnly             opType = 2097
+	opStructFieldNPtrAnonymousHeadStringTagUint32Ptr                 opType = 2098
+	opStructFieldNPtrAnonymousHeadStringTagUint32PtrOnly             opType = 2099
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32Ptr        opType = 2100
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32PtrOnly    opType = 2101
+	opStructFieldHeadUint64Ptr                                       opType = 2102
+	opStructFieldHeadUint64PtrOnly                                   opType = 2103
+	opStructFieldHeadOmitEmptyUint64Ptr                              opType = 2104
+	opStructFieldHeadOmitEmptyUint64PtrOnly                          opType = 2105
+	opStructFieldHeadStringTagUint64Ptr                              opType = 2106
+	opStructFieldHeadStringTagUint64PtrOnly                          opType = 2107
+	opStructFieldHeadOmitEmptyStringTagUint64Ptr                     opType = 2108
+	opStructFieldHeadOmitEmptyStringTagUint64PtrOnly                 opType = 2109
+	opStructFieldAnonymousHeadUint64Ptr                              opType = 2110
+	opStructFieldAnonymousHeadUint64PtrOnly                          opType = 2111
+	opStructFieldAnonymousHeadOmitEmptyUint64Ptr                     opType = 2112
+	opStructFieldAnonymousHeadOmitEmptyUint64PtrOnly                 opType = 2113
+	opStructFieldAnonymousHeadStringTagUint64Ptr                     opType = 2114
+	opStructFieldAnonymousHeadStringTagUint64PtrOnly                 opType = 2115
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint64Ptr            opType = 2116
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint64PtrOnly        opType = 2117
+	opStructFieldPtrHeadUint64Ptr                                    opType = 2118
+	opStructFieldPtrHeadUint64PtrOnly                                opType = 2119
+	opStructFieldPtrHeadOmitEmptyUint64Ptr                           opType = 2120
+	opStructFieldPtrHeadOmitEmptyUint64PtrOnly                       opType = 2121
+	opStructFieldPtrHeadStringTagUint64Ptr                           opType = 2122
+	opStructFieldPtrHeadStringTagUint64PtrOnly                       opType = 2123
+	opStructFieldPtrHeadOmitEmptyStringTagUint64Ptr                  opType = 2124
+	opStructFieldPtrHeadOmitEmptyStringTagUint64PtrOnly              opType = 2125
+	opStructFieldPtrAnonymousHeadUint64Ptr                           opType = 2126
+	opStructFieldPtrAnonymousHeadUint64PtrOnly                       opType = 2127
+	opStructFieldPtrAnonymousHeadOmitEmptyUint64Ptr                  opType = 2128
+	opStructFieldPtrAnonymousHeadOmitEmptyUint64PtrOnly              opType = 2129
+	opStructFieldPtrAnonymousHeadStringTagUint64Ptr                  opType = 2130
+	opStructFieldPtrAnonymousHeadStringTagUint64PtrOnly              opType = 2131
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Ptr         opType = 2132
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64PtrOnly     opType = 2133
+	opStructFieldNPtrHeadUint64Ptr                                   opType = 2134
+	opStructFieldNPtrHeadUint64PtrOnly                               opType = 2135
+	opStructFieldNPtrHeadOmitEmptyUint64Ptr                          opType = 2136
+	opStructFieldNPtrHeadOmitEmptyUint64PtrOnly                      opType = 2137
+	opStructFieldNPtrHeadStringTagUint64Ptr                          opType = 2138
+	opStructFieldNPtrHeadStringTagUint64PtrOnly                      opType = 2139
+	opStructFieldNPtrHeadOmitEmptyStringTagUint64Ptr                 opType = 2140
+	opStructFieldNPtrHeadOmitEmptyStringTagUint64PtrOnly             opType = 2141
+	opStructFieldNPtrAnonymousHeadUint64Ptr                          opType = 2142
+	opStructFieldNPtrAnonymousHeadUint64PtrOnly                      opType = 2143
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint64Ptr                 opType = 2144
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint64PtrOnly             opType = 2145
+	opStructFieldNPtrAnonymousHeadStringTagUint64Ptr                 opType = 2146
+	opStructFieldNPtrAnonymousHeadStringTagUint64PtrOnly             opType = 2147
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64Ptr        opType = 2148
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64PtrOnly    opType = 2149
+	opStructFieldHeadFloat32Ptr                                      opType = 2150
+	opStructFieldHeadFloat32PtrOnly                                  opType = 2151
+	opStructFieldHeadOmitEmptyFloat32Ptr                             opType = 2152
+	opStructFieldHeadOmitEmptyFloat32PtrOnly                         opType = 2153
+	opStructFieldHeadStringTagFloat32Ptr                             opType = 2154
+	opStructFieldHeadStringTagFloat32PtrOnly                         opType = 2155
+	opStructFieldHeadOmitEmptyStringTagFloat32Ptr                    opType = 2156
+	opStructFieldHeadOmitEmptyStringTagFloat32PtrOnly                opType = 2157
+	opStructFieldAnonymousHeadFloat32Ptr                             opType = 2158
+	opStructFieldAnonymousHeadFloat32PtrOnly                         opType = 2159
+	opStructFieldAnonymousHeadOmitEmptyFloat32Ptr                    opType = 2160
+	opStructFieldAnonymousHeadOmitEmptyFloat32PtrOnly                opType = 2161
+	opStructFieldAnonymousHeadStringTagFloat32Ptr                    opType = 2162
+	opStructFieldAnonymousHeadStringTagFloat32PtrOnly                opType = 2163
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat32Ptr           opType = 2164
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat32PtrOnly       opType = 2165
+	opStructFieldPtrHeadFloat32Ptr                                   opType = 2166
+	opStructFieldPtrHeadFloat32PtrOnly                               opType = 2167
+	opStructFieldPtrHeadOmitEmptyFloat32Ptr                          opType = 2168
+	opStructFieldPtrHeadOmitEmptyFloat32PtrOnly                      opType = 2169
+	opStructFieldPtrHeadStringTagFloat32Ptr                          opType = 2170
+	opStructFieldPtrHeadStringTagFloat32PtrOnly                      opType = 2171
+	opStructFieldPtrHeadOmitEmptyStringTagFloat32Ptr                 opType = 2172
+	opStructFieldPtrHeadOmitEmptyStringTagFloat32PtrOnly             opType = 2173
+	opStructFieldPtrAnonymousHeadFloat32Ptr                          opType = 2174
+	opStructFieldPtrAnonymousHeadFloat32PtrOnly                      opType = 2175
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat32Ptr                 opType = 2176
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat32PtrOnly             opType = 2177
+	opStructFieldPtrAnonymousHeadStringTagFloat32Ptr                 opType = 2178
+	opStructFieldPtrAnonymousHeadStringTagFloat32PtrOnly             opType = 2179
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Ptr        opType = 2180
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32PtrOnly    opType = 2181
+	opStructFieldNPtrHeadFloat32Ptr                                  opType = 2182
+	opStructFieldNPtrHeadFloat32PtrOnly                              opType = 2183
+	opStructFieldNPtrHeadOmitEmptyFloat32Ptr                         opType = 2184
+	opStructFieldNPtrHeadOmitEmptyFloat32PtrOnly                     opType = 2185
+	opStructFieldNPtrHeadStringTagFloat32Ptr                         opType = 2186
+	opStructFieldNPtrHeadStringTagFloat32PtrOnly                     opType = 2187
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat32Ptr                opType = 2188
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat32PtrOnly            opType = 2189
+	opStructFieldNPtrAnonymousHeadFloat32Ptr                         opType = 2190
+	opStructFieldNPtrAnonymousHeadFloat32PtrOnly                     opType = 2191
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32Ptr                opType = 2192
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32PtrOnly            opType = 2193
+	opStructFieldNPtrAnonymousHeadStringTagFloat32Ptr                opType = 2194
+	opStructFieldNPtrAnonymousHeadStringTagFloat32PtrOnly            opType = 2195
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32Ptr       opType = 2196
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32PtrOnly   opType = 2197
+	opStructFieldHeadFloat64Ptr                                      opType = 2198
+	opStructFieldHeadFloat64PtrOnly                                  opType = 2199
+	opStructFieldHeadOmitEmptyFloat64Ptr                             opType = 2200
+	opStructFieldHeadOmitEmptyFloat64PtrOnly                         opType = 2201
+	opStructFieldHeadStringTagFloat64Ptr                             opType = 2202
+	opStructFieldHeadStringTagFloat64PtrOnly                         opType = 2203
+	opStructFieldHeadOmitEmptyStringTagFloat64Ptr                    opType = 2204
+	opStructFieldHeadOmitEmptyStringTagFloat64PtrOnly                opType = 2205
+	opStructFieldAnonymousHeadFloat64Ptr                             opType = 2206
+	opStructFieldAnonymousHeadFloat64PtrOnly                         opType = 2207
+	opStructFieldAnonymousHeadOmitEmptyFloat64Ptr                    opType = 2208
+	opStructFieldAnonymousHeadOmitEmptyFloat64PtrOnly                opType = 2209
+	opStructFieldAnonymousHeadStringTagFloat64Ptr                    opType = 2210
+	opStructFieldAnonymousHeadStringTagFloat64PtrOnly                opType = 2211
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat64Ptr           opType = 2212
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat64PtrOnly       opType = 2213
+	opStructFieldPtrHeadFloat64Ptr                                   opType = 2214
+	opStructFieldPtrHeadFloat64PtrOnly                               opType = 2215
+	opStructFieldPtrHeadOmitEmptyFloat64Ptr                          opType = 2216
+	opStructFieldPtrHeadOmitEmptyFloat64PtrOnly                      opType = 2217
+	opStructFieldPtrHeadStringTagFloat64Ptr                          opType = 2218
+	opStructFieldPtrHeadStringTagFloat64PtrOnly                      opType = 2219
+	opStructFieldPtrHeadOmitEmptyStringTagFloat64Ptr                 opType = 2220
+	opStructFieldPtrHeadOmitEmptyStringTagFloat64PtrOnly             opType = 2221
+	opStructFieldPtrAnonymousHeadFloat64Ptr                          opType = 2222
+	opStructFieldPtrAnonymousHeadFloat64PtrOnly                      opType = 2223
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat64Ptr                 opType = 2224
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat64PtrOnly             opType = 2225
+	opStructFieldPtrAnonymousHeadStringTagFloat64Ptr                 opType = 2226
+	opStructFieldPtrAnonymousHeadStringTagFloat64PtrOnly             opType = 2227
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Ptr        opType = 2228
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64PtrOnly    opType = 2229
+	opStructFieldNPtrHeadFloat64Ptr                                  opType = 2230
+	opStructFieldNPtrHeadFloat64PtrOnly                              opType = 2231
+	opStructFieldNPtrHeadOmitEmptyFloat64Ptr                         opType = 2232
+	opStructFieldNPtrHeadOmitEmptyFloat64PtrOnly                     opType = 2233
+	opStructFieldNPtrHeadStringTagFloat64Ptr                         opType = 2234
+	opStructFieldNPtrHeadStringTagFloat64PtrOnly                     opType = 2235
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat64Ptr                opType = 2236
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat64PtrOnly            opType = 2237
+	opStructFieldNPtrAnonymousHeadFloat64Ptr                         opType = 2238
+	opStructFieldNPtrAnonymousHeadFloat64PtrOnly                     opType = 2239
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64Ptr                opType = 2240
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64PtrOnly            opType = 2241
+	opStructFieldNPtrAnonymousHeadStringTagFloat64Ptr                opType = 2242
+	opStructFieldNPtrAnonymousHeadStringTagFloat64PtrOnly            opType = 2243
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64Ptr       opType = 2244
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64PtrOnly   opType = 2245
+	opStructFieldHeadBoolPtr                                         opType = 2246
+	opStructFieldHeadBoolPtrOnly                                     opType = 2247
+	opStructFieldHeadOmitEmptyBoolPtr                                opType = 2248
+	opStructFieldHeadOmitEmptyBoolPtrOnly                            opType = 2249
+	opStructFieldHeadStringTagBoolPtr                                opType = 2250
+	opStructFieldHeadStringTagBoolPtrOnly                            opType = 2251
+	opStructFieldHeadOmitEmptyStringTagBoolPtr                       opType = 2252
+	opStructFieldHeadOmitEmptyStringTagBoolPtrOnly                   opType = 2253
+	opStructFieldAnonymousHeadBoolPtr                                opType = 2254
+	opStructFieldAnonymousHeadBoolPtrOnly                            opType = 2255
+	opStructFieldAnonymousHeadOmitEmptyBoolPtr                       opType = 2256
+	opStructFieldAnonymousHeadOmitEmptyBoolPtrOnly                   opType = 2257
+	opStructFieldAnonymousHeadStringTagBoolPtr                       opType = 2258
+	opStructFieldAnonymousHeadStringTagBoolPtrOnly                   opType = 2259
+	opStructFieldAnonymousHeadOmitEmptyStringTagBoolPtr              opType = 2260
+	opStructFieldAnonymousHeadOmitEmptyStringTagBoolPtrOnly          opType = 2261
+	opStructFieldPtrHeadBoolPtr                                      opType = 2262
+	opStructFieldPtrHeadBoolPtrOnly                                  opType = 2263
+	opStructFieldPtrHeadOmitEmptyBoolPtr                             opType = 2264
+	opStructFieldPtrHeadOmitEmptyBoolPtrOnly                         opType = 2265
+	opStructFieldPtrHeadStringTagBoolPtr                             opType = 2266
+	opStructFieldPtrHeadStringTagBoolPtrOnly                         opType = 2267
+	opStructFieldPtrHeadOmitEmptyStringTagBoolPtr                    opType = 2268
+	opStructFieldPtrHeadOmitEmptyStringTagBoolPtrOnly                opType = 2269
+	opStructFieldPtrAnonymousHeadBoolPtr                             opType = 2270
+	opStructFieldPtrAnonymousHeadBoolPtrOnly                         opType = 2271
+	opStructFieldPtrAnonymousHeadOmitEmptyBoolPtr                    opType = 2272
+	opStructFieldPtrAnonymousHeadOmitEmptyBoolPtrOnly                opType = 2273
+	opStructFieldPtrAnonymousHeadStringTagBoolPtr                    opType = 2274
+	opStructFieldPtrAnonymousHeadStringTagBoolPtrOnly                opType = 2275
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBoolPtr           opType = 2276
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBoolPtrOnly       opType = 2277
+	opStructFieldNPtrHeadBoolPtr                                     opType = 2278
+	opStructFieldNPtrHeadBoolPtrOnly                                 opType = 2279
+	opStructFieldNPtrHeadOmitEmptyBoolPtr                            opType = 2280
+	opStructFieldNPtrHeadOmitEmptyBoolPtrOnly                        opType = 2281
+	opStructFieldNPtrHeadStringTagBoolPtr                            opType = 2282
+	opStructFieldNPtrHeadStringTagBoolPtrOnly                        opType = 2283
+	opStructFieldNPtrHeadOmitEmptyStringTagBoolPtr                   opType = 2284
+	opStructFieldNPtrHeadOmitEmptyStringTagBoolPtrOnly               opType = 2285
+	opStructFieldNPtrAnonymousHeadBoolPtr                            opType = 2286
+	opStructFieldNPtrAnonymousHeadBoolPtrOnly                        opType = 2287
+	opStructFieldNPtrAnonymousHeadOmitEmptyBoolPtr                   opType = 2288
+	opStructFieldNPtrAnonymousHeadOmitEmptyBoolPtrOnly               opType = 2289
+	opStructFieldNPtrAnonymousHeadStringTagBoolPtr                   opType = 2290
+	opStructFieldNPtrAnonymousHeadStringTagBoolPtrOnly               opType = 2291
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolPtr          opType = 2292
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolPtrOnly      opType = 2293
+	opStructFieldHeadStringPtr                                       opType = 2294
+	opStructFieldHeadStringPtrOnly                                   opType = 2295
+	opStructFieldHeadOmitEmptyStringPtr                              opType = 2296
+	opStructFieldHeadOmitEmptyStringPtrOnly                          opType = 2297
+	opStructFieldHeadStringTagStringPtr                              opType = 2298
+	opStructFieldHeadStringTagStringPtrOnly                          opType = 2299
+	opStructFieldHeadOmitEmptyStringTagStringPtr                     opType = 2300
+	opStructFieldHeadOmitEmptyStringTagStringPtrOnly                 opType = 2301
+	opStructFieldAnonymousHeadStringPtr                              opType = 2302
+	opStructFieldAnonymousHeadStringPtrOnly                          opType = 2303
+	opStructFieldAnonymousHeadOmitEmptyStringPtr                     opType = 2304
+	opStructFieldAnonymousHeadOmitEmptyStringPtrOnly                 opType = 2305
+	opStructFieldAnonymousHeadStringTagStringPtr                     opType = 2306
+	opStructFieldAnonymousHeadStringTagStringPtrOnly                 opType = 2307
+	opStructFieldAnonymousHeadOmitEmptyStringTagStringPtr            opType = 2308
+	opStructFieldAnonymousHeadOmitEmptyStringTagStringPtrOnly        opType = 2309
+	opStructFieldPtrHeadStringPtr                                    opType = 2310
+	opStructFieldPtrHeadStringPtrOnly                                opType = 2311
+	opStructFieldPtrHeadOmitEmptyStringPtr                           opType = 2312
+	opStructFieldPtrHeadOmitEmptyStringPtrOnly                       opType = 2313
+	opStructFieldPtrHeadStringTagStringPtr                           opType = 2314
+	opStructFieldPtrHeadStringTagStringPtrOnly                       opType = 2315
+	opStructFieldPtrHeadOmitEmptyStringTagStringPtr                  opType = 2316
+	opStructFieldPtrHeadOmitEmptyStringTagStringPtrOnly              opType = 2317
+	opStructFieldPtrAnonymousHeadStringPtr                           opType = 2318
+	opStructFieldPtrAnonymousHeadStringPtrOnly                       opType = 2319
+	opStructFieldPtrAnonymousHeadOmitEmptyStringPtr                  opType = 2320
+	opStructFieldPtrAnonymousHeadOmitEmptyStringPtrOnly              opType = 2321
+	opStructFieldPtrAnonymousHeadStringTagStringPtr                  opType = 2322
+	opStructFieldPtrAnonymousHeadStringTagStringPtrOnly              opType = 2323
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtr         opType = 2324
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtrOnly     opType = 2325
+	opStructFieldNPtrHeadStringPtr                                   opType = 2326
+	opStructFieldNPtrHeadStringPtrOnly                               opType = 2327
+	opStructFieldNPtrHeadOmitEmptyStringPtr                          opType = 2328
+	opStructFieldNPtrHeadOmitEmptyStringPtrOnly                      opType = 2329
+	opStructFieldNPtrHeadStringTagStringPtr                          opType = 2330
+	opStructFieldNPtrHeadStringTagStringPtrOnly                      opType = 2331
+	opStructFieldNPtrHeadOmitEmptyStringTagStringPtr                 opType = 2332
+	opStructFieldNPtrHeadOmitEmptyStringTagStringPtrOnly             opType = 2333
+	opStructFieldNPtrAnonymousHeadStringPtr                          opType = 2334
+	opStructFieldNPtrAnonymousHeadStringPtrOnly                      opType = 2335
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringPtr                 opType = 2336
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringPtrOnly             opType = 2337
+	opStructFieldNPtrAnonymousHeadStringTagStringPtr                 opType = 2338
+	opStructFieldNPtrAnonymousHeadStringTagStringPtrOnly             opType = 2339
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagStringPtr        opType = 2340
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagStringPtrOnly    opType = 2341
+	opStructFieldHeadBytesPtr                                        opType = 2342
+	opStructFieldHeadBytesPtrOnly                                    opType = 2343
+	opStructFieldHeadOmitEmptyBytesPtr                               opType = 2344
+	opStructFieldHeadOmitEmptyBytesPtrOnly                           opType = 2345
+	opStructFieldHeadStringTagBytesPtr                               opType = 2346
+	opStructFieldHeadStringTagBytesPtrOnly                           opType = 2347
+	opStructFieldHeadOmitEmptyStringTagBytesPtr                      opType = 2348
+	opStructFieldHeadOmitEmptyStringTagBytesPtrOnly                  opType = 2349
+	opStructFieldAnonymousHeadBytesPtr                               opType = 2350
+	opStructFieldAnonymousHeadBytesPtrOnly                           opType = 2351
+	opStructFieldAnonymousHeadOmitEmptyBytesPtr                      opType = 2352
+	opStructFieldAnonymousHeadOmitEmptyBytesPtrOnly                  opType = 2353
+	opStructFieldAnonymousHeadStringTagBytesPtr                      opType = 2354
+	opStructFieldAnonymousHeadStringTagBytesPtrOnly                  opType = 2355
+	opStructFieldAnonymousHeadOmitEmptyStringTagBytesPtr             opType = 2356
+	opStructFieldAnonymousHeadOmitEmptyStringTagBytesPtrOnly         opType = 2357
+	opStructFieldPtrHeadBytesPtr                                     opType = 2358
+	opStructFieldPtrHeadBytesPtrOnly                                 opType = 2359
+	opStructFieldPtrHeadOmitEmptyBytesPtr                            opType = 2360
+	opStructFieldPtrHeadOmitEmptyBytesPtrOnly                        opType = 2361
+	opStructFieldPtrHeadStringTagBytesPtr                            opType = 2362
+	opStructFieldPtrHeadStringTagBytesPtrOnly                        opType = 2363
+	opStructFieldPtrHeadOmitEmptyStringTagBytesPtr                   opType = 2364
+	opStructFieldPtrHeadOmitEmptyStringTagBytesPtrOnly               opType = 2365
+	opStructFieldPtrAnonymousHeadBytesPtr                            opType = 2366
+	opStructFieldPtrAnonymousHeadBytesPtrOnly                        opType = 2367
+	opStructFieldPtrAnonymousHeadOmitEmptyBytesPtr                   opType = 2368
+	opStructFieldPtrAnonymousHeadOmitEmptyBytesPtrOnly               opType = 2369
+	opStructFieldPtrAnonymousHeadStringTagBytesPtr                   opType = 2370
+	opStructFieldPtrAnonymousHeadStringTagBytesPtrOnly               opType = 2371
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBytesPtr          opType = 2372
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBytesPtrOnly      opType = 2373
+	opStructFieldNPtrHeadBytesPtr                                    opType = 2374
+	opStructFieldNPtrHeadBytesPtrOnly                                opType = 2375
+	opStructFieldNPtrHeadOmitEmptyBytesPtr                           opType = 2376
+	opStructFieldNPtrHeadOmitEmptyBytesPtrOnly                       opType = 2377
+	opStructFieldNPtrHeadStringTagBytesPtr                           opType = 2378
+	opStructFieldNPtrHeadStringTagBytesPtrOnly                       opType = 2379
+	opStructFieldNPtrHeadOmitEmptyStringTagBytesPtr                  opType = 2380
+	opStructFieldNPtrHeadOmitEmptyStringTagBytesPtrOnly              opType = 2381
+	opStructFieldNPtrAnonymousHeadBytesPtr                           opType = 2382
+	opStructFieldNPtrAnonymousHeadBytesPtrOnly                       opType = 2383
+	opStructFieldNPtrAnonymousHeadOmitEmptyBytesPtr                  opType = 2384
+	opStructFieldNPtrAnonymousHeadOmitEmptyBytesPtrOnly              opType = 2385
+	opStructFieldNPtrAnonymousHeadStringTagBytesPtr                  opType = 2386
+	opStructFieldNPtrAnonymousHeadStringTagBytesPtrOnly              opType = 2387
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesPtr         opType = 2388
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesPtrOnly     opType = 2389
+	opStructFieldHeadIntNPtr                                         opType = 2390
+	opStructFieldHeadIntNPtrOnly                                     opType = 2391
+	opStructFieldHeadOmitEmptyIntNPtr                                opType = 2392
+	opStructFieldHeadOmitEmptyIntNPtrOnly                            opType = 2393
+	opStructFieldHeadStringTagIntNPtr                                opType = 2394
+	opStructFieldHeadStringTagIntNPtrOnly                            opType = 2395
+	opStructFieldHeadOmitEmptyStringTagIntNPtr                       opType = 2396
+	opStructFieldHeadOmitEmptyStringTagIntNPtrOnly                   opType = 2397
+	opStructFieldAnonymousHeadIntNPtr                                opType = 2398
+	opStructFieldAnonymousHeadIntNPtrOnly                            opType = 2399
+	opStructFieldAnonymousHeadOmitEmptyIntNPtr                       opType = 2400
+	opStructFieldAnonymousHeadOmitEmptyIntNPtrOnly                   opType = 2401
+	opStructFieldAnonymousHeadStringTagIntNPtr                       opType = 2402
+	opStructFieldAnonymousHeadStringTagIntNPtrOnly                   opType = 2403
+	opStructFieldAnonymousHeadOmitEmptyStringTagIntNPtr              opType = 2404
+	opStructFieldAnonymousHeadOmitEmptyStringTagIntNPtrOnly          opType = 2405
+	opStructFieldPtrHeadIntNPtr                                      opType = 2406
+	opStructFieldPtrHeadIntNPtrOnly                                  opType = 2407
+	opStructFieldPtrHeadOmitEmptyIntNPtr                             opType = 2408
+	opStructFieldPtrHeadOmitEmptyIntNPtrOnly                         opType = 2409
+	opStructFieldPtrHeadStringTagIntNPtr                             opType = 2410
+	opStructFieldPtrHeadStringTagIntNPtrOnly                         opType = 2411
+	opStructFieldPtrHeadOmitEmptyStringTagIntNPtr                    opType = 2412
+	opStructFieldPtrHeadOmitEmptyStringTagIntNPtrOnly                opType = 2413
+	opStructFieldPtrAnonymousHeadIntNPtr                             opType = 2414
+	opStructFieldPtrAnonymousHeadIntNPtrOnly                         opType = 2415
+	opStructFieldPtrAnonymousHeadOmitEmptyIntNPtr                    opType = 2416
+	opStructFieldPtrAnonymousHeadOmitEmptyIntNPtrOnly                opType = 2417
+	opStructFieldPtrAnonymousHeadStringTagIntNPtr                    opType = 2418
+	opStructFieldPtrAnonymousHeadStringTagIntNPtrOnly                opType = 2419
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntNPtr           opType = 2420
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntNPtrOnly       opType = 2421
+	opStructFieldNPtrHeadIntNPtr                                     opType = 2422
+	opStructFieldNPtrHeadIntNPtrOnly                                 opType = 2423
+	opStructFieldNPtrHeadOmitEmptyIntNPtr                            opType = 2424
+	opStructFieldNPtrHeadOmitEmptyIntNPtrOnly                        opType = 2425
+	opStructFieldNPtrHeadStringTagIntNPtr                            opType = 2426
+	opStructFieldNPtrHeadStringTagIntNPtrOnly                        opType = 2427
+	opStructFieldNPtrHeadOmitEmptyStringTagIntNPtr                   opType = 2428
+	opStructFieldNPtrHeadOmitEmptyStringTagIntNPtrOnly               opType = 2429
+	opStructFieldNPtrAnonymousHeadIntNPtr                            opType = 2430
+	opStructFieldNPtrAnonymousHeadIntNPtrOnly                        opType = 2431
+	opStructFieldNPtrAnonymousHeadOmitEmptyIntNPtr                   opType = 2432
+	opStructFieldNPtrAnonymousHeadOmitEmptyIntNPtrOnly               opType = 2433
+	opStructFieldNPtrAnonymousHeadStringTagIntNPtr                   opType = 2434
+	opStructFieldNPtrAnonymousHeadStringTagIntNPtrOnly               opType = 2435
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagIntNPtr          opType = 2436
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagIntNPtrOnly      opType = 2437
+	opStructFieldHeadInt8NPtr                                        opType = 2438
+	opStructFieldHeadInt8NPtrOnly                                    opType = 2439
+	opStructFieldHeadOmitEmptyInt8NPtr                               opType = 2440
+	opStructFieldHeadOmitEmptyInt8NPtrOnly                           opType = 2441
+	opStructFieldHeadStringTagInt8NPtr                               opType = 2442
+	opStructFieldHeadStringTagInt8NPtrOnly                           opType = 2443
+	opStructFieldHeadOmitEmptyStringTagInt8NPtr                      opType = 2444
+	opStructFieldHeadOmitEmptyStringTagInt8NPtrOnly                  opType = 2445
+	opStructFieldAnonymousHeadInt8NPtr                               opType = 2446
+	opStructFieldAnonymousHeadInt8NPtrOnly                           opType = 2447
+	opStructFieldAnonymousHeadOmitEmptyInt8NPtr                      opType = 2448
+	opStructFieldAnonymousHeadOmitEmptyInt8NPtrOnly                  opType = 2449
+	opStructFieldAnonymousHeadStringTagInt8NPtr                      opType = 2450
+	opStructFieldAnonymousHeadStringTagInt8NPtrOnly                  opType = 2451
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt8NPtr             opType = 2452
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt8NPtrOnly         opType = 2453
+	opStructFieldPtrHeadInt8NPtr                                     opType = 2454
+	opStructFieldPtrHeadInt8NPtrOnly                                 opType = 2455
+	opStructFieldPtrHeadOmitEmptyInt8NPtr                            opType = 2456
+	opStructFieldPtrHeadOmitEmptyInt8NPtrOnly                        opType = 2457
+	opStructFieldPtrHeadStringTagInt8NPtr                            opType = 2458
+	opStructFieldPtrHeadStringTagInt8NPtrOnly                        opType = 2459
+	opStructFieldPtrHeadOmitEmptyStringTagInt8NPtr                   opType = 2460
+	opStructFieldPtrHeadOmitEmptyStringTagInt8NPtrOnly               opType = 2461
+	opStructFieldPtrAnonymousHeadInt8NPtr                            opType = 2462
+	opStructFieldPtrAnonymousHeadInt8NPtrOnly                        opType = 2463
+	opStructFieldPtrAnonymousHeadOmitEmptyInt8NPtr                   opType = 2464
+	opStructFieldPtrAnonymousHeadOmitEmptyInt8NPtrOnly               opType = 2465
+	opStructFieldPtrAnonymousHeadStringTagInt8NPtr                   opType = 2466
+	opStructFieldPtrAnonymousHeadStringTagInt8NPtrOnly               opType = 2467
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8NPtr          opType = 2468
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8NPtrOnly      opType = 2469
+	opStructFieldNPtrHeadInt8NPtr                                    opType = 2470
+	opStructFieldNPtrHeadInt8NPtrOnly                                opType = 2471
+	opStructFieldNPtrHeadOmitEmptyInt8NPtr                           opType = 2472
+	opStructFieldNPtrHeadOmitEmptyInt8NPtrOnly                       opType = 2473
+	opStructFieldNPtrHeadStringTagInt8NPtr                           opType = 2474
+	opStructFieldNPtrHeadStringTagInt8NPtrOnly                       opType = 2475
+	opStructFieldNPtrHeadOmitEmptyStringTagInt8NPtr                  opType = 2476
+	opStructFieldNPtrHeadOmitEmptyStringTagInt8NPtrOnly              opType = 2477
+	opStructFieldNPtrAnonymousHeadInt8NPtr                           opType = 2478
+	opStructFieldNPtrAnonymousHeadInt8NPtrOnly                       opType = 2479
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt8NPtr                  opType = 2480
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt8NPtrOnly              opType = 2481
+	opStructFieldNPtrAnonymousHeadStringTagInt8NPtr                  opType = 2482
+	opStructFieldNPtrAnonymousHeadStringTagInt8NPtrOnly              opType = 2483
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8NPtr         opType = 2484
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt8NPtrOnly     opType = 2485
+	opStructFieldHeadInt16NPtr                                       opType = 2486
+	opStructFieldHeadInt16NPtrOnly                                   opType = 2487
+	opStructFieldHeadOmitEmptyInt16NPtr                              opType = 2488
+	opStructFieldHeadOmitEmptyInt16NPtrOnly                          opType = 2489
+	opStructFieldHeadStringTagInt16NPtr                              opType = 2490
+	opStructFieldHeadStringTagInt16NPtrOnly                          opType = 2491
+	opStructFieldHeadOmitEmptyStringTagInt16NPtr                     opType = 2492
+	opStructFieldHeadOmitEmptyStringTagInt16NPtrOnly                 opType = 2493
+	opStructFieldAnonymousHeadInt16NPtr                              opType = 2494
+	opStructFieldAnonymousHeadInt16NPtrOnly                          opType = 2495
+	opStructFieldAnonymousHeadOmitEmptyInt16NPtr                     opType = 2496
+	opStructFieldAnonymousHeadOmitEmptyInt16NPtrOnly                 opType = 2497
+	opStructFieldAnonymousHeadStringTagInt16NPtr                     opType = 2498
+	opStructFieldAnonymousHeadStringTagInt16NPtrOnly                 opType = 2499
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt16NPtr            opType = 2500
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt16NPtrOnly        opType = 2501
+	opStructFieldPtrHeadInt16NPtr                                    opType = 2502
+	opStructFieldPtrHeadInt16NPtrOnly                                opType = 2503
+	opStructFieldPtrHeadOmitEmptyInt16NPtr                           opType = 2504
+	opStructFieldPtrHeadOmitEmptyInt16NPtrOnly                       opType = 2505
+	opStructFieldPtrHeadStringTagInt16NPtr                           opType = 2506
+	opStructFieldPtrHeadStringTagInt16NPtrOnly                       opType = 2507
+	opStructFieldPtrHeadOmitEmptyStringTagInt16NPtr                  opType = 2508
+	opStructFieldPtrHeadOmitEmptyStringTagInt16NPtrOnly              opType = 2509
+	opStructFieldPtrAnonymousHeadInt16NPtr                           opType = 2510
+	opStructFieldPtrAnonymousHeadInt16NPtrOnly                       opType = 2511
+	opStructFieldPtrAnonymousHeadOmitEmptyInt16NPtr                  opType = 2512
+	opStructFieldPtrAnonymousHeadOmitEmptyInt16NPtrOnly              opType = 2513
+	opStructFieldPtrAnonymousHeadStringTagInt16NPtr                  opType = 2514
+	opStructFieldPtrAnonymousHeadStringTagInt16NPtrOnly              opType = 2515
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16NPtr         opType = 2516
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16NPtrOnly     opType = 2517
+	opStructFieldNPtrHeadInt16NPtr                                   opType = 2518
+	opStructFieldNPtrHeadInt16NPtrOnly                               opType = 2519
+	opStructFieldNPtrHeadOmitEmptyInt16NPtr                          opType = 2520
+	opStructFieldNPtrHeadOmitEmptyInt16NPtrOnly                      opType = 2521
+	opStructFieldNPtrHeadStringTagInt16NPtr                          opType = 2522
+	opStructFieldNPtrHeadStringTagInt16NPtrOnly                      opType = 2523
+	opStructFieldNPtrHeadOmitEmptyStringTagInt16NPtr                 opType = 2524
+	opStructFieldNPtrHeadOmitEmptyStringTagInt16NPtrOnly             opType = 2525
+	opStructFieldNPtrAnonymousHeadInt16NPtr                          opType = 2526
+	opStructFieldNPtrAnonymousHeadInt16NPtrOnly                      opType = 2527
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt16NPtr                 opType = 2528
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt16NPtrOnly             opType = 2529
+	opStructFieldNPtrAnonymousHeadStringTagInt16NPtr                 opType = 2530
+	opStructFieldNPtrAnonymousHeadStringTagInt16NPtrOnly             opType = 2531
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16NPtr        opType = 2532
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt16NPtrOnly    opType = 2533
+	opStructFieldHeadInt32NPtr                                       opType = 2534
+	opStructFieldHeadInt32NPtrOnly                                   opType = 2535
+	opStructFieldHeadOmitEmptyInt32NPtr                              opType = 2536
+	opStructFieldHeadOmitEmptyInt32NPtrOnly                          opType = 2537
+	opStructFieldHeadStringTagInt32NPtr                              opType = 2538
+	opStructFieldHeadStringTagInt32NPtrOnly                          opType = 2539
+	opStructFieldHeadOmitEmptyStringTagInt32NPtr                     opType = 2540
+	opStructFieldHeadOmitEmptyStringTagInt32NPtrOnly                 opType = 2541
+	opStructFieldAnonymousHeadInt32NPtr                              opType = 2542
+	opStructFieldAnonymousHeadInt32NPtrOnly                          opType = 2543
+	opStructFieldAnonymousHeadOmitEmptyInt32NPtr                     opType = 2544
+	opStructFieldAnonymousHeadOmitEmptyInt32NPtrOnly                 opType = 2545
+	opStructFieldAnonymousHeadStringTagInt32NPtr                     opType = 2546
+	opStructFieldAnonymousHeadStringTagInt32NPtrOnly                 opType = 2547
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt32NPtr            opType = 2548
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt32NPtrOnly        opType = 2549
+	opStructFieldPtrHeadInt32NPtr                                    opType = 2550
+	opStructFieldPtrHeadInt32NPtrOnly                                opType = 2551
+	opStructFieldPtrHeadOmitEmptyInt32NPtr                           opType = 2552
+	opStructFieldPtrHeadOmitEmptyInt32NPtrOnly                       opType = 2553
+	opStructFieldPtrHeadStringTagInt32NPtr                           opType = 2554
+	opStructFieldPtrHeadStringTagInt32NPtrOnly                       opType = 2555
+	opStructFieldPtrHeadOmitEmptyStringTagInt32NPtr                  opType = 2556
+	opStructFieldPtrHeadOmitEmptyStringTagInt32NPtrOnly              opType = 2557
+	opStructFieldPtrAnonymousHeadInt32NPtr                           opType = 2558
+	opStructFieldPtrAnonymousHeadInt32NPtrOnly                       opType = 2559
+	opStructFieldPtrAnonymousHeadOmitEmptyInt32NPtr                  opType = 2560
+	opStructFieldPtrAnonymousHeadOmitEmptyInt32NPtrOnly              opType = 2561
+	opStructFieldPtrAnonymousHeadStringTagInt32NPtr                  opType = 2562
+	opStructFieldPtrAnonymousHeadStringTagInt32NPtrOnly              opType = 2563
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32NPtr         opType = 2564
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32NPtrOnly     opType = 2565
+	opStructFieldNPtrHeadInt32NPtr                                   opType = 2566
+	opStructFieldNPtrHeadInt32NPtrOnly                               opType = 2567
+	opStructFieldNPtrHeadOmitEmptyInt32NPtr                          opType = 2568
+	opStructFieldNPtrHeadOmitEmptyInt32NPtrOnly                      opType = 2569
+	opStructFieldNPtrHeadStringTagInt32NPtr                          opType = 2570
+	opStructFieldNPtrHeadStringTagInt32NPtrOnly                      opType = 2571
+	opStructFieldNPtrHeadOmitEmptyStringTagInt32NPtr                 opType = 2572
+	opStructFieldNPtrHeadOmitEmptyStringTagInt32NPtrOnly             opType = 2573
+	opStructFieldNPtrAnonymousHeadInt32NPtr                          opType = 2574
+	opStructFieldNPtrAnonymousHeadInt32NPtrOnly                      opType = 2575
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt32NPtr                 opType = 2576
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt32NPtrOnly             opType = 2577
+	opStructFieldNPtrAnonymousHeadStringTagInt32NPtr                 opType = 2578
+	opStructFieldNPtrAnonymousHeadStringTagInt32NPtrOnly             opType = 2579
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32NPtr        opType = 2580
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt32NPtrOnly    opType = 2581
+	opStructFieldHeadInt64NPtr                                       opType = 2582
+	opStructFieldHeadInt64NPtrOnly                                   opType = 2583
+	opStructFieldHeadOmitEmptyInt64NPtr                              opType = 2584
+	opStructFieldHeadOmitEmptyInt64NPtrOnly                          opType = 2585
+	opStructFieldHeadStringTagInt64NPtr                              opType = 2586
+	opStructFieldHeadStringTagInt64NPtrOnly                          opType = 2587
+	opStructFieldHeadOmitEmptyStringTagInt64NPtr                     opType = 2588
+	opStructFieldHeadOmitEmptyStringTagInt64NPtrOnly                 opType = 2589
+	opStructFieldAnonymousHeadInt64NPtr                              opType = 2590
+	opStructFieldAnonymousHeadInt64NPtrOnly                          opType = 2591
+	opStructFieldAnonymousHeadOmitEmptyInt64NPtr                     opType = 2592
+	opStructFieldAnonymousHeadOmitEmptyInt64NPtrOnly                 opType = 2593
+	opStructFieldAnonymousHeadStringTagInt64NPtr                     opType = 2594
+	opStructFieldAnonymousHeadStringTagInt64NPtrOnly                 opType = 2595
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt64NPtr            opType = 2596
+	opStructFieldAnonymousHeadOmitEmptyStringTagInt64NPtrOnly        opType = 2597
+	opStructFieldPtrHeadInt64NPtr                                    opType = 2598
+	opStructFieldPtrHeadInt64NPtrOnly                                opType = 2599
+	opStructFieldPtrHeadOmitEmptyInt64NPtr                           opType = 2600
+	opStructFieldPtrHeadOmitEmptyInt64NPtrOnly                       opType = 2601
+	opStructFieldPtrHeadStringTagInt64NPtr                           opType = 2602
+	opStructFieldPtrHeadStringTagInt64NPtrOnly                       opType = 2603
+	opStructFieldPtrHeadOmitEmptyStringTagInt64NPtr                  opType = 2604
+	opStructFieldPtrHeadOmitEmptyStringTagInt64NPtrOnly              opType = 2605
+	opStructFieldPtrAnonymousHeadInt64NPtr                           opType = 2606
+	opStructFieldPtrAnonymousHeadInt64NPtrOnly                       opType = 2607
+	opStructFieldPtrAnonymousHeadOmitEmptyInt64NPtr                  opType = 2608
+	opStructFieldPtrAnonymousHeadOmitEmptyInt64NPtrOnly              opType = 2609
+	opStructFieldPtrAnonymousHeadStringTagInt64NPtr                  opType = 2610
+	opStructFieldPtrAnonymousHeadStringTagInt64NPtrOnly              opType = 2611
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64NPtr         opType = 2612
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64NPtrOnly     opType = 2613
+	opStructFieldNPtrHeadInt64NPtr                                   opType = 2614
+	opStructFieldNPtrHeadInt64NPtrOnly                               opType = 2615
+	opStructFieldNPtrHeadOmitEmptyInt64NPtr                          opType = 2616
+	opStructFieldNPtrHeadOmitEmptyInt64NPtrOnly                      opType = 2617
+	opStructFieldNPtrHeadStringTagInt64NPtr                          opType = 2618
+	opStructFieldNPtrHeadStringTagInt64NPtrOnly                      opType = 2619
+	opStructFieldNPtrHeadOmitEmptyStringTagInt64NPtr                 opType = 2620
+	opStructFieldNPtrHeadOmitEmptyStringTagInt64NPtrOnly             opType = 2621
+	opStructFieldNPtrAnonymousHeadInt64NPtr                          opType = 2622
+	opStructFieldNPtrAnonymousHeadInt64NPtrOnly                      opType = 2623
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt64NPtr                 opType = 2624
+	opStructFieldNPtrAnonymousHeadOmitEmptyInt64NPtrOnly             opType = 2625
+	opStructFieldNPtrAnonymousHeadStringTagInt64NPtr                 opType = 2626
+	opStructFieldNPtrAnonymousHeadStringTagInt64NPtrOnly             opType = 2627
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64NPtr        opType = 2628
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagInt64NPtrOnly    opType = 2629
+	opStructFieldHeadUintNPtr                                        opType = 2630
+	opStructFieldHeadUintNPtrOnly                                    opType = 2631
+	opStructFieldHeadOmitEmptyUintNPtr                               opType = 2632
+	opStructFieldHeadOmitEmptyUintNPtrOnly                           opType = 2633
+	opStructFieldHeadStringTagUintNPtr                               opType = 2634
+	opStructFieldHeadStringTagUintNPtrOnly                           opType = 2635
+	opStructFieldHeadOmitEmptyStringTagUintNPtr                      opType = 2636
+	opStructFieldHeadOmitEmptyStringTagUintNPtrOnly                  opType = 2637
+	opStructFieldAnonymousHeadUintNPtr                               opType = 2638
+	opStructFieldAnonymousHeadUintNPtrOnly                           opType = 2639
+	opStructFieldAnonymousHeadOmitEmptyUintNPtr                      opType = 2640
+	opStructFieldAnonymousHeadOmitEmptyUintNPtrOnly                  opType = 2641
+	opStructFieldAnonymousHeadStringTagUintNPtr                      opType = 2642
+	opStructFieldAnonymousHeadStringTagUintNPtrOnly                  opType = 2643
+	opStructFieldAnonymousHeadOmitEmptyStringTagUintNPtr             opType = 2644
+	opStructFieldAnonymousHeadOmitEmptyStringTagUintNPtrOnly         opType = 2645
+	opStructFieldPtrHeadUintNPtr                                     opType = 2646
+	opStructFieldPtrHeadUintNPtrOnly                                 opType = 2647
+	opStructFieldPtrHeadOmitEmptyUintNPtr                            opType = 2648
+	opStructFieldPtrHeadOmitEmptyUintNPtrOnly                        opType = 2649
+	opStructFieldPtrHeadStringTagUintNPtr                            opType = 2650
+	opStructFieldPtrHeadStringTagUintNPtrOnly                        opType = 2651
+	opStructFieldPtrHeadOmitEmptyStringTagUintNPtr                   opType = 2652
+	opStructFieldPtrHeadOmitEmptyStringTagUintNPtrOnly               opType = 2653
+	opStructFieldPtrAnonymousHeadUintNPtr                            opType = 2654
+	opStructFieldPtrAnonymousHeadUintNPtrOnly                        opType = 2655
+	opStructFieldPtrAnonymousHeadOmitEmptyUintNPtr                   opType = 2656
+	opStructFieldPtrAnonymousHeadOmitEmptyUintNPtrOnly               opType = 2657
+	opStructFieldPtrAnonymousHeadStringTagUintNPtr                   opType = 2658
+	opStructFieldPtrAnonymousHeadStringTagUintNPtrOnly               opType = 2659
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintNPtr          opType = 2660
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintNPtrOnly      opType = 2661
+	opStructFieldNPtrHeadUintNPtr                                    opType = 2662
+	opStructFieldNPtrHeadUintNPtrOnly                                opType = 2663
+	opStructFieldNPtrHeadOmitEmptyUintNPtr                           opType = 2664
+	opStructFieldNPtrHeadOmitEmptyUintNPtrOnly                       opType = 2665
+	opStructFieldNPtrHeadStringTagUintNPtr                           opType = 2666
+	opStructFieldNPtrHeadStringTagUintNPtrOnly                       opType = 2667
+	opStructFieldNPtrHeadOmitEmptyStringTagUintNPtr                  opType = 2668
+	opStructFieldNPtrHeadOmitEmptyStringTagUintNPtrOnly              opType = 2669
+	opStructFieldNPtrAnonymousHeadUintNPtr                           opType = 2670
+	opStructFieldNPtrAnonymousHeadUintNPtrOnly                       opType = 2671
+	opStructFieldNPtrAnonymousHeadOmitEmptyUintNPtr                  opType = 2672
+	opStructFieldNPtrAnonymousHeadOmitEmptyUintNPtrOnly              opType = 2673
+	opStructFieldNPtrAnonymousHeadStringTagUintNPtr                  opType = 2674
+	opStructFieldNPtrAnonymousHeadStringTagUintNPtrOnly              opType = 2675
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUintNPtr         opType = 2676
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUintNPtrOnly     opType = 2677
+	opStructFieldHeadUint8NPtr                                       opType = 2678
+	opStructFieldHeadUint8NPtrOnly                                   opType = 2679
+	opStructFieldHeadOmitEmptyUint8NPtr                              opType = 2680
+	opStructFieldHeadOmitEmptyUint8NPtrOnly                          opType = 2681
+	opStructFieldHeadStringTagUint8NPtr                              opType = 2682
+	opStructFieldHeadStringTagUint8NPtrOnly                          opType = 2683
+	opStructFieldHeadOmitEmptyStringTagUint8NPtr                     opType = 2684
+	opStructFieldHeadOmitEmptyStringTagUint8NPtrOnly                 opType = 2685
+	opStructFieldAnonymousHeadUint8NPtr                              opType = 2686
+	opStructFieldAnonymousHeadUint8NPtrOnly                          opType = 2687
+	opStructFieldAnonymousHeadOmitEmptyUint8NPtr                     opType = 2688
+	opStructFieldAnonymousHeadOmitEmptyUint8NPtrOnly                 opType = 2689
+	opStructFieldAnonymousHeadStringTagUint8NPtr                     opType = 2690
+	opStructFieldAnonymousHeadStringTagUint8NPtrOnly                 opType = 2691
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint8NPtr            opType = 2692
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint8NPtrOnly        opType = 2693
+	opStructFieldPtrHeadUint8NPtr                                    opType = 2694
+	opStructFieldPtrHeadUint8NPtrOnly                                opType = 2695
+	opStructFieldPtrHeadOmitEmptyUint8NPtr                           opType = 2696
+	opStructFieldPtrHeadOmitEmptyUint8NPtrOnly                       opType = 2697
+	opStructFieldPtrHeadStringTagUint8NPtr                           opType = 2698
+	opStructFieldPtrHeadStringTagUint8NPtrOnly                       opType = 2699
+	opStructFieldPtrHeadOmitEmptyStringTagUint8NPtr                  opType = 2700
+	opStructFieldPtrHeadOmitEmptyStringTagUint8NPtrOnly              opType = 2701
+	opStructFieldPtrAnonymousHeadUint8NPtr                           opType = 2702
+	opStructFieldPtrAnonymousHeadUint8NPtrOnly                       opType = 2703
+	opStructFieldPtrAnonymousHeadOmitEmptyUint8NPtr                  opType = 2704
+	opStructFieldPtrAnonymousHeadOmitEmptyUint8NPtrOnly              opType = 2705
+	opStructFieldPtrAnonymousHeadStringTagUint8NPtr                  opType = 2706
+	opStructFieldPtrAnonymousHeadStringTagUint8NPtrOnly              opType = 2707
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8NPtr         opType = 2708
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8NPtrOnly     opType = 2709
+	opStructFieldNPtrHeadUint8NPtr                                   opType = 2710
+	opStructFieldNPtrHeadUint8NPtrOnly                               opType = 2711
+	opStructFieldNPtrHeadOmitEmptyUint8NPtr                          opType = 2712
+	opStructFieldNPtrHeadOmitEmptyUint8NPtrOnly                      opType = 2713
+	opStructFieldNPtrHeadStringTagUint8NPtr                          opType = 2714
+	opStructFieldNPtrHeadStringTagUint8NPtrOnly                      opType = 2715
+	opStructFieldNPtrHeadOmitEmptyStringTagUint8NPtr                 opType = 2716
+	opStructFieldNPtrHeadOmitEmptyStringTagUint8NPtrOnly             opType = 2717
+	opStructFieldNPtrAnonymousHeadUint8NPtr                          opType = 2718
+	opStructFieldNPtrAnonymousHeadUint8NPtrOnly                      opType = 2719
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint8NPtr                 opType = 2720
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint8NPtrOnly             opType = 2721
+	opStructFieldNPtrAnonymousHeadStringTagUint8NPtr                 opType = 2722
+	opStructFieldNPtrAnonymousHeadStringTagUint8NPtrOnly             opType = 2723
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8NPtr        opType = 2724
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint8NPtrOnly    opType = 2725
+	opStructFieldHeadUint16NPtr                                      opType = 2726
+	opStructFieldHeadUint16NPtrOnly                                  opType = 2727
+	opStructFieldHeadOmitEmptyUint16NPtr                             opType = 2728
+	opStructFieldHeadOmitEmptyUint16NPtrOnly                         opType = 2729
+	opStructFieldHeadStringTagUint16NPtr                             opType = 2730
+	opStructFieldHeadStringTagUint16NPtrOnly                         opType = 2731
+	opStructFieldHeadOmitEmptyStringTagUint16NPtr                    opType = 2732
+	opStructFieldHeadOmitEmptyStringTagUint16NPtrOnly                opType = 2733
+	opStructFieldAnonymousHeadUint16NPtr                             opType = 2734
+	opStructFieldAnonymousHeadUint16NPtrOnly                         opType = 2735
+	opStructFieldAnonymousHeadOmitEmptyUint16NPtr                    opType = 2736
+	opStructFieldAnonymousHeadOmitEmptyUint16NPtrOnly                opType = 2737
+	opStructFieldAnonymousHeadStringTagUint16NPtr                    opType = 2738
+	opStructFieldAnonymousHeadStringTagUint16NPtrOnly                opType = 2739
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint16NPtr           opType = 2740
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint16NPtrOnly       opType = 2741
+	opStructFieldPtrHeadUint16NPtr                                   opType = 2742
+	opStructFieldPtrHeadUint16NPtrOnly                               opType = 2743
+	opStructFieldPtrHeadOmitEmptyUint16NPtr                          opType = 2744
+	opStructFieldPtrHeadOmitEmptyUint16NPtrOnly                      opType = 2745
+	opStructFieldPtrHeadStringTagUint16NPtr                          opType = 2746
+	opStructFieldPtrHeadStringTagUint16NPtrOnly                      opType = 2747
+	opStructFieldPtrHeadOmitEmptyStringTagUint16NPtr                 opType = 2748
+	opStructFieldPtrHeadOmitEmptyStringTagUint16NPtrOnly             opType = 2749
+	opStructFieldPtrAnonymousHeadUint16NPtr                          opType = 2750
+	opStructFieldPtrAnonymousHeadUint16NPtrOnly                      opType = 2751
+	opStructFieldPtrAnonymousHeadOmitEmptyUint16NPtr                 opType = 2752
+	opStructFieldPtrAnonymousHeadOmitEmptyUint16NPtrOnly             opType = 2753
+	opStructFieldPtrAnonymousHeadStringTagUint16NPtr                 opType = 2754
+	opStructFieldPtrAnonymousHeadStringTagUint16NPtrOnly             opType = 2755
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16NPtr        opType = 2756
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16NPtrOnly    opType = 2757
+	opStructFieldNPtrHeadUint16NPtr                                  opType = 2758
+	opStructFieldNPtrHeadUint16NPtrOnly                              opType = 2759
+	opStructFieldNPtrHeadOmitEmptyUint16NPtr                         opType = 2760
+	opStructFieldNPtrHeadOmitEmptyUint16NPtrOnly                     opType = 2761
+	opStructFieldNPtrHeadStringTagUint16NPtr                         opType = 2762
+	opStructFieldNPtrHeadStringTagUint16NPtrOnly                     opType = 2763
+	opStructFieldNPtrHeadOmitEmptyStringTagUint16NPtr                opType = 2764
+	opStructFieldNPtrHeadOmitEmptyStringTagUint16NPtrOnly            opType = 2765
+	opStructFieldNPtrAnonymousHeadUint16NPtr                         opType = 2766
+	opStructFieldNPtrAnonymousHeadUint16NPtrOnly                     opType = 2767
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint16NPtr                opType = 2768
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint16NPtrOnly            opType = 2769
+	opStructFieldNPtrAnonymousHeadStringTagUint16NPtr                opType = 2770
+	opStructFieldNPtrAnonymousHeadStringTagUint16NPtrOnly            opType = 2771
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16NPtr       opType = 2772
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint16NPtrOnly   opType = 2773
+	opStructFieldHeadUint32NPtr                                      opType = 2774
+	opStructFieldHeadUint32NPtrOnly                                  opType = 2775
+	opStructFieldHeadOmitEmptyUint32NPtr                             opType = 2776
+	opStructFieldHeadOmitEmptyUint32NPtrOnly                         opType = 2777
+	opStructFieldHeadStringTagUint32NPtr                             opType = 2778
+	opStructFieldHeadStringTagUint32NPtrOnly                         opType = 2779
+	opStructFieldHeadOmitEmptyStringTagUint32NPtr                    opType = 2780
+	opStructFieldHeadOmitEmptyStringTagUint32NPtrOnly                opType = 2781
+	opStructFieldAnonymousHeadUint32NPtr                             opType = 2782
+	opStructFieldAnonymousHeadUint32NPtrOnly                         opType = 2783
+	opStructFieldAnonymousHeadOmitEmptyUint32NPtr                    opType = 2784
+	opStructFieldAnonymousHeadOmitEmptyUint32NPtrOnly                opType = 2785
+	opStructFieldAnonymousHeadStringTagUint32NPtr                    opType = 2786
+	opStructFieldAnonymousHeadStringTagUint32NPtrOnly                opType = 2787
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint32NPtr           opType = 2788
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint32NPtrOnly       opType = 2789
+	opStructFieldPtrHeadUint32NPtr                                   opType = 2790
+	opStructFieldPtrHeadUint32NPtrOnly                               opType = 2791
+	opStructFieldPtrHeadOmitEmptyUint32NPtr                          opType = 2792
+	opStructFieldPtrHeadOmitEmptyUint32NPtrOnly                      opType = 2793
+	opStructFieldPtrHeadStringTagUint32NPtr                          opType = 2794
+	opStructFieldPtrHeadStringTagUint32NPtrOnly                      opType = 2795
+	opStructFieldPtrHeadOmitEmptyStringTagUint32NPtr                 opType = 2796
+	opStructFieldPtrHeadOmitEmptyStringTagUint32NPtrOnly             opType = 2797
+	opStructFieldPtrAnonymousHeadUint32NPtr                          opType = 2798
+	opStructFieldPtrAnonymousHeadUint32NPtrOnly                      opType = 2799
+	opStructFieldPtrAnonymousHeadOmitEmptyUint32NPtr                 opType = 2800
+	opStructFieldPtrAnonymousHeadOmitEmptyUint32NPtrOnly             opType = 2801
+	opStructFieldPtrAnonymousHeadStringTagUint32NPtr                 opType = 2802
+	opStructFieldPtrAnonymousHeadStringTagUint32NPtrOnly             opType = 2803
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32NPtr        opType = 2804
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32NPtrOnly    opType = 2805
+	opStructFieldNPtrHeadUint32NPtr                                  opType = 2806
+	opStructFieldNPtrHeadUint32NPtrOnly                              opType = 2807
+	opStructFieldNPtrHeadOmitEmptyUint32NPtr                         opType = 2808
+	opStructFieldNPtrHeadOmitEmptyUint32NPtrOnly                     opType = 2809
+	opStructFieldNPtrHeadStringTagUint32NPtr                         opType = 2810
+	opStructFieldNPtrHeadStringTagUint32NPtrOnly                     opType = 2811
+	opStructFieldNPtrHeadOmitEmptyStringTagUint32NPtr                opType = 2812
+	opStructFieldNPtrHeadOmitEmptyStringTagUint32NPtrOnly            opType = 2813
+	opStructFieldNPtrAnonymousHeadUint32NPtr                         opType = 2814
+	opStructFieldNPtrAnonymousHeadUint32NPtrOnly                     opType = 2815
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint32NPtr                opType = 2816
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint32NPtrOnly            opType = 2817
+	opStructFieldNPtrAnonymousHeadStringTagUint32NPtr                opType = 2818
+	opStructFieldNPtrAnonymousHeadStringTagUint32NPtrOnly            opType = 2819
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32NPtr       opType = 2820
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint32NPtrOnly   opType = 2821
+	opStructFieldHeadUint64NPtr                                      opType = 2822
+	opStructFieldHeadUint64NPtrOnly                                  opType = 2823
+	opStructFieldHeadOmitEmptyUint64NPtr                             opType = 2824
+	opStructFieldHeadOmitEmptyUint64NPtrOnly                         opType = 2825
+	opStructFieldHeadStringTagUint64NPtr                             opType = 2826
+	opStructFieldHeadStringTagUint64NPtrOnly                         opType = 2827
+	opStructFieldHeadOmitEmptyStringTagUint64NPtr                    opType = 2828
+	opStructFieldHeadOmitEmptyStringTagUint64NPtrOnly                opType = 2829
+	opStructFieldAnonymousHeadUint64NPtr                             opType = 2830
+	opStructFieldAnonymousHeadUint64NPtrOnly                         opType = 2831
+	opStructFieldAnonymousHeadOmitEmptyUint64NPtr                    opType = 2832
+	opStructFieldAnonymousHeadOmitEmptyUint64NPtrOnly                opType = 2833
+	opStructFieldAnonymousHeadStringTagUint64NPtr                    opType = 2834
+	opStructFieldAnonymousHeadStringTagUint64NPtrOnly                opType = 2835
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint64NPtr           opType = 2836
+	opStructFieldAnonymousHeadOmitEmptyStringTagUint64NPtrOnly       opType = 2837
+	opStructFieldPtrHeadUint64NPtr                                   opType = 2838
+	opStructFieldPtrHeadUint64NPtrOnly                               opType = 2839
+	opStructFieldPtrHeadOmitEmptyUint64NPtr                          opType = 2840
+	opStructFieldPtrHeadOmitEmptyUint64NPtrOnly                      opType = 2841
+	opStructFieldPtrHeadStringTagUint64NPtr                          opType = 2842
+	opStructFieldPtrHeadStringTagUint64NPtrOnly                      opType = 2843
+	opStructFieldPtrHeadOmitEmptyStringTagUint64NPtr                 opType = 2844
+	opStructFieldPtrHeadOmitEmptyStringTagUint64NPtrOnly             opType = 2845
+	opStructFieldPtrAnonymousHeadUint64NPtr                          opType = 2846
+	opStructFieldPtrAnonymousHeadUint64NPtrOnly                      opType = 2847
+	opStructFieldPtrAnonymousHeadOmitEmptyUint64NPtr                 opType = 2848
+	opStructFieldPtrAnonymousHeadOmitEmptyUint64NPtrOnly             opType = 2849
+	opStructFieldPtrAnonymousHeadStringTagUint64NPtr                 opType = 2850
+	opStructFieldPtrAnonymousHeadStringTagUint64NPtrOnly             opType = 2851
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64NPtr        opType = 2852
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64NPtrOnly    opType = 2853
+	opStructFieldNPtrHeadUint64NPtr                                  opType = 2854
+	opStructFieldNPtrHeadUint64NPtrOnly                              opType = 2855
+	opStructFieldNPtrHeadOmitEmptyUint64NPtr                         opType = 2856
+	opStructFieldNPtrHeadOmitEmptyUint64NPtrOnly                     opType = 2857
+	opStructFieldNPtrHeadStringTagUint64NPtr                         opType = 2858
+	opStructFieldNPtrHeadStringTagUint64NPtrOnly                     opType = 2859
+	opStructFieldNPtrHeadOmitEmptyStringTagUint64NPtr                opType = 2860
+	opStructFieldNPtrHeadOmitEmptyStringTagUint64NPtrOnly            opType = 2861
+	opStructFieldNPtrAnonymousHeadUint64NPtr                         opType = 2862
+	opStructFieldNPtrAnonymousHeadUint64NPtrOnly                     opType = 2863
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint64NPtr                opType = 2864
+	opStructFieldNPtrAnonymousHeadOmitEmptyUint64NPtrOnly            opType = 2865
+	opStructFieldNPtrAnonymousHeadStringTagUint64NPtr                opType = 2866
+	opStructFieldNPtrAnonymousHeadStringTagUint64NPtrOnly            opType = 2867
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64NPtr       opType = 2868
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagUint64NPtrOnly   opType = 2869
+	opStructFieldHeadFloat32NPtr                                     opType = 2870
+	opStructFieldHeadFloat32NPtrOnly                                 opType = 2871
+	opStructFieldHeadOmitEmptyFloat32NPtr                            opType = 2872
+	opStructFieldHeadOmitEmptyFloat32NPtrOnly                        opType = 2873
+	opStructFieldHeadStringTagFloat32NPtr                            opType = 2874
+	opStructFieldHeadStringTagFloat32NPtrOnly                        opType = 2875
+	opStructFieldHeadOmitEmptyStringTagFloat32NPtr                   opType = 2876
+	opStructFieldHeadOmitEmptyStringTagFloat32NPtrOnly               opType = 2877
+	opStructFieldAnonymousHeadFloat32NPtr                            opType = 2878
+	opStructFieldAnonymousHeadFloat32NPtrOnly                        opType = 2879
+	opStructFieldAnonymousHeadOmitEmptyFloat32NPtr                   opType = 2880
+	opStructFieldAnonymousHeadOmitEmptyFloat32NPtrOnly               opType = 2881
+	opStructFieldAnonymousHeadStringTagFloat32NPtr                   opType = 2882
+	opStructFieldAnonymousHeadStringTagFloat32NPtrOnly               opType = 2883
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat32NPtr          opType = 2884
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat32NPtrOnly      opType = 2885
+	opStructFieldPtrHeadFloat32NPtr                                  opType = 2886
+	opStructFieldPtrHeadFloat32NPtrOnly                              opType = 2887
+	opStructFieldPtrHeadOmitEmptyFloat32NPtr                         opType = 2888
+	opStructFieldPtrHeadOmitEmptyFloat32NPtrOnly                     opType = 2889
+	opStructFieldPtrHeadStringTagFloat32NPtr                         opType = 2890
+	opStructFieldPtrHeadStringTagFloat32NPtrOnly                     opType = 2891
+	opStructFieldPtrHeadOmitEmptyStringTagFloat32NPtr                opType = 2892
+	opStructFieldPtrHeadOmitEmptyStringTagFloat32NPtrOnly            opType = 2893
+	opStructFieldPtrAnonymousHeadFloat32NPtr                         opType = 2894
+	opStructFieldPtrAnonymousHeadFloat32NPtrOnly                     opType = 2895
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat32NPtr                opType = 2896
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat32NPtrOnly            opType = 2897
+	opStructFieldPtrAnonymousHeadStringTagFloat32NPtr                opType = 2898
+	opStructFieldPtrAnonymousHeadStringTagFloat32NPtrOnly            opType = 2899
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32NPtr       opType = 2900
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32NPtrOnly   opType = 2901
+	opStructFieldNPtrHeadFloat32NPtr                                 opType = 2902
+	opStructFieldNPtrHeadFloat32NPtrOnly                             opType = 2903
+	opStructFieldNPtrHeadOmitEmptyFloat32NPtr                        opType = 2904
+	opStructFieldNPtrHeadOmitEmptyFloat32NPtrOnly                    opType = 2905
+	opStructFieldNPtrHeadStringTagFloat32NPtr                        opType = 2906
+	opStructFieldNPtrHeadStringTagFloat32NPtrOnly                    opType = 2907
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat32NPtr               opType = 2908
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat32NPtrOnly           opType = 2909
+	opStructFieldNPtrAnonymousHeadFloat32NPtr                        opType = 2910
+	opStructFieldNPtrAnonymousHeadFloat32NPtrOnly                    opType = 2911
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32NPtr               opType = 2912
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat32NPtrOnly           opType = 2913
+	opStructFieldNPtrAnonymousHeadStringTagFloat32NPtr               opType = 2914
+	opStructFieldNPtrAnonymousHeadStringTagFloat32NPtrOnly           opType = 2915
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32NPtr      opType = 2916
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat32NPtrOnly  opType = 2917
+	opStructFieldHeadFloat64NPtr                                     opType = 2918
+	opStructFieldHeadFloat64NPtrOnly                                 opType = 2919
+	opStructFieldHeadOmitEmptyFloat64NPtr                            opType = 2920
+	opStructFieldHeadOmitEmptyFloat64NPtrOnly                        opType = 2921
+	opStructFieldHeadStringTagFloat64NPtr                            opType = 2922
+	opStructFieldHeadStringTagFloat64NPtrOnly                        opType = 2923
+	opStructFieldHeadOmitEmptyStringTagFloat64NPtr                   opType = 2924
+	opStructFieldHeadOmitEmptyStringTagFloat64NPtrOnly               opType = 2925
+	opStructFieldAnonymousHeadFloat64NPtr                            opType = 2926
+	opStructFieldAnonymousHeadFloat64NPtrOnly                        opType = 2927
+	opStructFieldAnonymousHeadOmitEmptyFloat64NPtr                   opType = 2928
+	opStructFieldAnonymousHeadOmitEmptyFloat64NPtrOnly               opType = 2929
+	opStructFieldAnonymousHeadStringTagFloat64NPtr                   opType = 2930
+	opStructFieldAnonymousHeadStringTagFloat64NPtrOnly               opType = 2931
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat64NPtr          opType = 2932
+	opStructFieldAnonymousHeadOmitEmptyStringTagFloat64NPtrOnly      opType = 2933
+	opStructFieldPtrHeadFloat64NPtr                                  opType = 2934
+	opStructFieldPtrHeadFloat64NPtrOnly                              opType = 2935
+	opStructFieldPtrHeadOmitEmptyFloat64NPtr                         opType = 2936
+	opStructFieldPtrHeadOmitEmptyFloat64NPtrOnly                     opType = 2937
+	opStructFieldPtrHeadStringTagFloat64NPtr                         opType = 2938
+	opStructFieldPtrHeadStringTagFloat64NPtrOnly                     opType = 2939
+	opStructFieldPtrHeadOmitEmptyStringTagFloat64NPtr                opType = 2940
+	opStructFieldPtrHeadOmitEmptyStringTagFloat64NPtrOnly            opType = 2941
+	opStructFieldPtrAnonymousHeadFloat64NPtr                         opType = 2942
+	opStructFieldPtrAnonymousHeadFloat64NPtrOnly                     opType = 2943
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat64NPtr                opType = 2944
+	opStructFieldPtrAnonymousHeadOmitEmptyFloat64NPtrOnly            opType = 2945
+	opStructFieldPtrAnonymousHeadStringTagFloat64NPtr                opType = 2946
+	opStructFieldPtrAnonymousHeadStringTagFloat64NPtrOnly            opType = 2947
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64NPtr       opType = 2948
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64NPtrOnly   opType = 2949
+	opStructFieldNPtrHeadFloat64NPtr                                 opType = 2950
+	opStructFieldNPtrHeadFloat64NPtrOnly                             opType = 2951
+	opStructFieldNPtrHeadOmitEmptyFloat64NPtr                        opType = 2952
+	opStructFieldNPtrHeadOmitEmptyFloat64NPtrOnly                    opType = 2953
+	opStructFieldNPtrHeadStringTagFloat64NPtr                        opType = 2954
+	opStructFieldNPtrHeadStringTagFloat64NPtrOnly                    opType = 2955
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat64NPtr               opType = 2956
+	opStructFieldNPtrHeadOmitEmptyStringTagFloat64NPtrOnly           opType = 2957
+	opStructFieldNPtrAnonymousHeadFloat64NPtr                        opType = 2958
+	opStructFieldNPtrAnonymousHeadFloat64NPtrOnly                    opType = 2959
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64NPtr               opType = 2960
+	opStructFieldNPtrAnonymousHeadOmitEmptyFloat64NPtrOnly           opType = 2961
+	opStructFieldNPtrAnonymousHeadStringTagFloat64NPtr               opType = 2962
+	opStructFieldNPtrAnonymousHeadStringTagFloat64NPtrOnly           opType = 2963
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64NPtr      opType = 2964
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagFloat64NPtrOnly  opType = 2965
+	opStructFieldHeadBoolNPtr                                        opType = 2966
+	opStructFieldHeadBoolNPtrOnly                                    opType = 2967
+	opStructFieldHeadOmitEmptyBoolNPtr                               opType = 2968
+	opStructFieldHeadOmitEmptyBoolNPtrOnly                           opType = 2969
+	opStructFieldHeadStringTagBoolNPtr                               opType = 2970
+	opStructFieldHeadStringTagBoolNPtrOnly                           opType = 2971
+	opStructFieldHeadOmitEmptyStringTagBoolNPtr                      opType = 2972
+	opStructFieldHeadOmitEmptyStringTagBoolNPtrOnly                  opType = 2973
+	opStructFieldAnonymousHeadBoolNPtr                               opType = 2974
+	opStructFieldAnonymousHeadBoolNPtrOnly                           opType = 2975
+	opStructFieldAnonymousHeadOmitEmptyBoolNPtr                      opType = 2976
+	opStructFieldAnonymousHeadOmitEmptyBoolNPtrOnly                  opType = 2977
+	opStructFieldAnonymousHeadStringTagBoolNPtr                      opType = 2978
+	opStructFieldAnonymousHeadStringTagBoolNPtrOnly                  opType = 2979
+	opStructFieldAnonymousHeadOmitEmptyStringTagBoolNPtr             opType = 2980
+	opStructFieldAnonymousHeadOmitEmptyStringTagBoolNPtrOnly         opType = 2981
+	opStructFieldPtrHeadBoolNPtr                                     opType = 2982
+	opStructFieldPtrHeadBoolNPtrOnly                                 opType = 2983
+	opStructFieldPtrHeadOmitEmptyBoolNPtr                            opType = 2984
+	opStructFieldPtrHeadOmitEmptyBoolNPtrOnly                        opType = 2985
+	opStructFieldPtrHeadStringTagBoolNPtr                            opType = 2986
+	opStructFieldPtrHeadStringTagBoolNPtrOnly                        opType = 2987
+	opStructFieldPtrHeadOmitEmptyStringTagBoolNPtr                   opType = 2988
+	opStructFieldPtrHeadOmitEmptyStringTagBoolNPtrOnly               opType = 2989
+	opStructFieldPtrAnonymousHeadBoolNPtr                            opType = 2990
+	opStructFieldPtrAnonymousHeadBoolNPtrOnly                        opType = 2991
+	opStructFieldPtrAnonymousHeadOmitEmptyBoolNPtr                   opType = 2992
+	opStructFieldPtrAnonymousHeadOmitEmptyBoolNPtrOnly               opType = 2993
+	opStructFieldPtrAnonymousHeadStringTagBoolNPtr                   opType = 2994
+	opStructFieldPtrAnonymousHeadStringTagBoolNPtrOnly               opType = 2995
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBoolNPtr          opType = 2996
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBoolNPtrOnly      opType = 2997
+	opStructFieldNPtrHeadBoolNPtr                                    opType = 2998
+	opStructFieldNPtrHeadBoolNPtrOnly                                opType = 2999
+	opStructFieldNPtrHeadOmitEmptyBoolNPtr                           opType = 3000
+	opStructFieldNPtrHeadOmitEmptyBoolNPtrOnly                       opType = 3001
+	opStructFieldNPtrHeadStringTagBoolNPtr                           opType = 3002
+	opStructFieldNPtrHeadStringTagBoolNPtrOnly                       opType = 3003
+	opStructFieldNPtrHeadOmitEmptyStringTagBoolNPtr                  opType = 3004
+	opStructFieldNPtrHeadOmitEmptyStringTagBoolNPtrOnly              opType = 3005
+	opStructFieldNPtrAnonymousHeadBoolNPtr                           opType = 3006
+	opStructFieldNPtrAnonymousHeadBoolNPtrOnly                       opType = 3007
+	opStructFieldNPtrAnonymousHeadOmitEmptyBoolNPtr                  opType = 3008
+	opStructFieldNPtrAnonymousHeadOmitEmptyBoolNPtrOnly              opType = 3009
+	opStructFieldNPtrAnonymousHeadStringTagBoolNPtr                  opType = 3010
+	opStructFieldNPtrAnonymousHeadStringTagBoolNPtrOnly              opType = 3011
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolNPtr         opType = 3012
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBoolNPtrOnly     opType = 3013
+	opStructFieldHeadStringNPtr                                      opType = 3014
+	opStructFieldHeadStringNPtrOnly                                  opType = 3015
+	opStructFieldHeadOmitEmptyStringNPtr                             opType = 3016
+	opStructFieldHeadOmitEmptyStringNPtrOnly                         opType = 3017
+	opStructFieldHeadStringTagStringNPtr                             opType = 3018
+	opStructFieldHeadStringTagStringNPtrOnly                         opType = 3019
+	opStructFieldHeadOmitEmptyStringTagStringNPtr                    opType = 3020
+	opStructFieldHeadOmitEmptyStringTagStringNPtrOnly                opType = 3021
+	opStructFieldAnonymousHeadStringNPtr                             opType = 3022
+	opStructFieldAnonymousHeadStringNPtrOnly                         opType = 3023
+	opStructFieldAnonymousHeadOmitEmptyStringNPtr                    opType = 3024
+	opStructFieldAnonymousHeadOmitEmptyStringNPtrOnly                opType = 3025
+	opStructFieldAnonymousHeadStringTagStringNPtr                    opType = 3026
+	opStructFieldAnonymousHeadStringTagStringNPtrOnly                opType = 3027
+	opStructFieldAnonymousHeadOmitEmptyStringTagStringNPtr           opType = 3028
+	opStructFieldAnonymousHeadOmitEmptyStringTagStringNPtrOnly       opType = 3029
+	opStructFieldPtrHeadStringNPtr                                   opType = 3030
+	opStructFieldPtrHeadStringNPtrOnly                               opType = 3031
+	opStructFieldPtrHeadOmitEmptyStringNPtr                          opType = 3032
+	opStructFieldPtrHeadOmitEmptyStringNPtrOnly                      opType = 3033
+	opStructFieldPtrHeadStringTagStringNPtr                          opType = 3034
+	opStructFieldPtrHeadStringTagStringNPtrOnly                      opType = 3035
+	opStructFieldPtrHeadOmitEmptyStringTagStringNPtr                 opType = 3036
+	opStructFieldPtrHeadOmitEmptyStringTagStringNPtrOnly             opType = 3037
+	opStructFieldPtrAnonymousHeadStringNPtr                          opType = 3038
+	opStructFieldPtrAnonymousHeadStringNPtrOnly                      opType = 3039
+	opStructFieldPtrAnonymousHeadOmitEmptyStringNPtr                 opType = 3040
+	opStructFieldPtrAnonymousHeadOmitEmptyStringNPtrOnly             opType = 3041
+	opStructFieldPtrAnonymousHeadStringTagStringNPtr                 opType = 3042
+	opStructFieldPtrAnonymousHeadStringTagStringNPtrOnly             opType = 3043
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringNPtr        opType = 3044
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringNPtrOnly    opType = 3045
+	opStructFieldNPtrHeadStringNPtr                                  opType = 3046
+	opStructFieldNPtrHeadStringNPtrOnly                              opType = 3047
+	opStructFieldNPtrHeadOmitEmptyStringNPtr                         opType = 3048
+	opStructFieldNPtrHeadOmitEmptyStringNPtrOnly                     opType = 3049
+	opStructFieldNPtrHeadStringTagStringNPtr                         opType = 3050
+	opStructFieldNPtrHeadStringTagStringNPtrOnly                     opType = 3051
+	opStructFieldNPtrHeadOmitEmptyStringTagStringNPtr                opType = 3052
+	opStructFieldNPtrHeadOmitEmptyStringTagStringNPtrOnly            opType = 3053
+	opStructFieldNPtrAnonymousHeadStringNPtr                         opType = 3054
+	opStructFieldNPtrAnonymousHeadStringNPtrOnly                     opType = 3055
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringNPtr                opType = 3056
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringNPtrOnly            opType = 3057
+	opStructFieldNPtrAnonymousHeadStringTagStringNPtr                opType = 3058
+	opStructFieldNPtrAnonymousHeadStringTagStringNPtrOnly            opType = 3059
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagStringNPtr       opType = 3060
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagStringNPtrOnly   opType = 3061
+	opStructFieldHeadBytesNPtr                                       opType = 3062
+	opStructFieldHeadBytesNPtrOnly                                   opType = 3063
+	opStructFieldHeadOmitEmptyBytesNPtr                              opType = 3064
+	opStructFieldHeadOmitEmptyBytesNPtrOnly                          opType = 3065
+	opStructFieldHeadStringTagBytesNPtr                              opType = 3066
+	opStructFieldHeadStringTagBytesNPtrOnly                          opType = 3067
+	opStructFieldHeadOmitEmptyStringTagBytesNPtr                     opType = 3068
+	opStructFieldHeadOmitEmptyStringTagBytesNPtrOnly                 opType = 3069
+	opStructFieldAnonymousHeadBytesNPtr                              opType = 3070
+	opStructFieldAnonymousHeadBytesNPtrOnly                          opType = 3071
+	opStructFieldAnonymousHeadOmitEmptyBytesNPtr                     opType = 3072
+	opStructFieldAnonymousHeadOmitEmptyBytesNPtrOnly                 opType = 3073
+	opStructFieldAnonymousHeadStringTagBytesNPtr                     opType = 3074
+	opStructFieldAnonymousHeadStringTagBytesNPtrOnly                 opType = 3075
+	opStructFieldAnonymousHeadOmitEmptyStringTagBytesNPtr            opType = 3076
+	opStructFieldAnonymousHeadOmitEmptyStringTagBytesNPtrOnly        opType = 3077
+	opStructFieldPtrHeadBytesNPtr                                    opType = 3078
+	opStructFieldPtrHeadBytesNPtrOnly                                opType = 3079
+	opStructFieldPtrHeadOmitEmptyBytesNPtr                           opType = 3080
+	opStructFieldPtrHeadOmitEmptyBytesNPtrOnly                       opType = 3081
+	opStructFieldPtrHeadStringTagBytesNPtr                           opType = 3082
+	opStructFieldPtrHeadStringTagBytesNPtrOnly                       opType = 3083
+	opStructFieldPtrHeadOmitEmptyStringTagBytesNPtr                  opType = 3084
+	opStructFieldPtrHeadOmitEmptyStringTagBytesNPtrOnly              opType = 3085
+	opStructFieldPtrAnonymousHeadBytesNPtr                           opType = 3086
+	opStructFieldPtrAnonymousHeadBytesNPtrOnly                       opType = 3087
+	opStructFieldPtrAnonymousHeadOmitEmptyBytesNPtr                  opType = 3088
+	opStructFieldPtrAnonymousHeadOmitEmptyBytesNPtrOnly              opType = 3089
+	opStructFieldPtrAnonymousHeadStringTagBytesNPtr                  opType = 3090
+	opStructFieldPtrAnonymousHeadStringTagBytesNPtrOnly              opType = 3091
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBytesNPtr         opType = 3092
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagBytesNPtrOnly     opType = 3093
+	opStructFieldNPtrHeadBytesNPtr                                   opType = 3094
+	opStructFieldNPtrHeadBytesNPtrOnly                               opType = 3095
+	opStructFieldNPtrHeadOmitEmptyBytesNPtr                          opType = 3096
+	opStructFieldNPtrHeadOmitEmptyBytesNPtrOnly                      opType = 3097
+	opStructFieldNPtrHeadStringTagBytesNPtr                          opType = 3098
+	opStructFieldNPtrHeadStringTagBytesNPtrOnly                      opType = 3099
+	opStructFieldNPtrHeadOmitEmptyStringTagBytesNPtr                 opType = 3100
+	opStructFieldNPtrHeadOmitEmptyStringTagBytesNPtrOnly             opType = 3101
+	opStructFieldNPtrAnonymousHeadBytesNPtr                          opType = 3102
+	opStructFieldNPtrAnonymousHeadBytesNPtrOnly                      opType = 3103
+	opStructFieldNPtrAnonymousHeadOmitEmptyBytesNPtr                 opType = 3104
+	opStructFieldNPtrAnonymousHeadOmitEmptyBytesNPtrOnly             opType = 3105
+	opStructFieldNPtrAnonymousHeadStringTagBytesNPtr                 opType = 3106
+	opStructFieldNPtrAnonymousHeadStringTagBytesNPtrOnly             opType = 3107
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesNPtr        opType = 3108
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagBytesNPtrOnly    opType = 3109
+	opStructFieldHead                                                opType = 3110
+	opStructFieldHeadOnly                                            opType = 3111
+	opStructFieldHeadOmitEmpty                                       opType = 3112
+	opStructFieldHeadOmitEmptyOnly                                   opType = 3113
+	opStructFieldHeadStringTag                                       opType = 3114
+	opStructFieldHeadStringTagOnly                                   opType = 3115
+	opStructFieldHeadOmitEmptyStringTag                              opType = 3116
+	opStructFieldHeadOmitEmptyStringTagOnly                          opType = 3117
+	opStructFieldAnonymousHead                                       opType = 3118
+	opStructFieldAnonymousHeadOnly                                   opType = 3119
+	opStructFieldAnonymousHeadOmitEmpty                              opType = 3120
+	opStructFieldAnonymousHeadOmitEmptyOnly                          opType = 3121
+	opStructFieldAnonymousHeadStringTag                              opType = 3122
+	opStructFieldAnonymousHeadStringTagOnly                          opType = 3123
+	opStructFieldAnonymousHeadOmitEmptyStringTag                     opType = 3124
+	opStructFieldAnonymousHeadOmitEmptyStringTagOnly                 opType = 3125
+	opStructFieldPtrHead                                             opType = 3126
+	opStructFieldPtrHeadOnly                                         opType = 3127
+	opStructFieldPtrHeadOmitEmpty                                    opType = 3128
+	opStructFieldPtrHeadOmitEmptyOnly                                opType = 3129
+	opStructFieldPtrHeadStringTag                                    opType = 3130
+	opStructFieldPtrHeadStringTagOnly                                opType = 3131
+	opStructFieldPtrHeadOmitEmptyStringTag                           opType = 3132
+	opStructFieldPtrHeadOmitEmptyStringTagOnly                       opType = 3133
+	opStructFieldPtrAnonymousHead                                    opType = 3134
+	opStructFieldPtrAnonymousHeadOnly                                opType = 3135
+	opStructFieldPtrAnonymousHeadOmitEmpty                           opType = 3136
+	opStructFieldPtrAnonymousHeadOmitEmptyOnly                       opType = 3137
+	opStructFieldPtrAnonymousHeadStringTag                           opType = 3138
+	opStructFieldPtrAnonymousHeadStringTagOnly                       opType = 3139
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTag                  opType = 3140
+	opStructFieldPtrAnonymousHeadOmitEmptyStringTagOnly              opType = 3141
+	opStructFieldNPtrHead                                            opType = 3142
+	opStructFieldNPtrHeadOnly                                        opType = 3143
+	opStructFieldNPtrHeadOmitEmpty                                   opType = 3144
+	opStructFieldNPtrHeadOmitEmptyOnly                               opType = 3145
+	opStructFieldNPtrHeadStringTag                                   opType = 3146
+	opStructFieldNPtrHeadStringTagOnly                               opType = 3147
+	opStructFieldNPtrHeadOmitEmptyStringTag                          opType = 3148
+	opStructFieldNPtrHeadOmitEmptyStringTagOnly                      opType = 3149
+	opStructFieldNPtrAnonymousHead                                   opType = 3150
+	opStructFieldNPtrAnonymousHeadOnly                               opType = 3151
+	opStructFieldNPtrAnonymousHeadOmitEmpty                          opType = 3152
+	opStructFieldNPtrAnonymousHeadOmitEmptyOnly                      opType = 3153
+	opStructFieldNPtrAnonymousHeadStringTag                          opType = 3154
+	opStructFieldNPtrAnonymousHeadStringTagOnly                      opType = 3155
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTag                 opType = 3156
+	opStructFieldNPtrAnonymousHeadOmitEmptyStringTagOnly             opType = 3157
+	opStructFieldInt                                                 opType = 3158
+	opStructFieldOmitEmptyInt                                        opType = 3159
+	opStructFieldStringTagInt                                        opType = 3160
+	opStructFieldOmitEmptyStringTagInt                               opType = 3161
+	opStructFieldInt8                                                opType = 3162
+	opStructFieldOmitEmptyInt8                                       opType = 3163
+	opStructFieldStringTagInt8                                       opType = 3164
+	opStructFieldOmitEmptyStringTagInt8                              opType = 3165
+	opStructFieldInt16                                               opType = 3166
+	opStructFieldOmitEmptyInt16                                      opType = 3167
+	opStructFieldStringTagInt16                                      opType = 3168
+	opStructFieldOmitEmptyStringTagInt16                             opType = 3169
+	opStructFieldInt32                                               opType = 3170
+	opStructFieldOmitEmptyInt32                                      opType = 3171
+	opStructFieldStringTagInt32                                      opType = 3172
+	opStructFieldOmitEmptyStringTagInt32                             opType = 3173
+	opStructFieldInt64                                               opType = 3174
+	opStructFieldOmitEmptyInt64                                      opType = 3175
+	opStructFieldStringTagInt64                                      opType = 3176
+	opStructFieldOmitEmptyStringTagInt64                             opType = 3177
+	opStructFieldUint                                                opType = 3178
+	opStructFieldOmitEmptyUint                                       opType = 3179
+	opStructFieldStringTagUint                                       opType = 3180
+	opStructFieldOmitEmptyStringTagUint                              opType = 3181
+	opStructFieldUint8                                               opType = 3182
+	opStructFieldOmitEmptyUint8                                      opType = 3183
+	opStructFieldStringTagUint8                                      opType = 3184
+	opStructFieldOmitEmptyStringTagUint8                             opType = 3185
+	opStructFieldUint16                                              opType = 3186
+	opStructFieldOmitEmptyUint16                                     opType = 3187
+	opStructFieldStringTagUint16                                     opType = 3188
+	opStructFieldOmitEmptyStringTagUint16                            opType = 3189
+	opStructFieldUint32                                              opType = 3190
+	opStructFieldOmitEmptyUint32                                     opType = 3191
+	opStructFieldStringTagUint32                                     opType = 3192
+	opStructFieldOmitEmptyStringTagUint32                            opType = 3193
+	opStructFieldUint64                                              opType = 3194
+	opStructFieldOmitEmptyUint64                                     opType = 3195
+	opStructFieldStringTagUint64                                     opType = 3196
+	opStructFieldOmitEmptyStringTagUint64                            opType = 3197
+	opStructFieldFloat32                                             opType = 3198
+	opStructFieldOmitEmptyFloat32                                    opType = 3199
+	opStructFieldStringTagFloat32                                    opType = 3200
+	opStructFieldOmitEmptyStringTagFloat32                           opType = 3201
+	opStructFieldFloat64                                             opType = 3202
+	opStructFieldOmitEmptyFloat64                                    opType = 3203
+	opStructFieldStringTagFloat64                                    opType = 3204
+	opStructFieldOmitEmptyStringTagFloat64                           opType = 3205
+	opStructFieldBool                                                opType = 3206
+	opStructFieldOmitEmptyBool                                       opType = 3207
+	opStructFieldStringTagBool                                       opType = 3208
+	opStructFieldOmitEmptyStringTagBool                              opType = 3209
+	opStructFieldString                                              opType = 3210
+	opStructFieldOmitEmptyString                                     opType = 3211
+	opStructFieldStringTagString                                     opType = 3212
+	opStructFieldOmitEmptyStringTagString                            opType = 3213
+	opStructFieldBytes                                               opType = 3214
+	opStructFieldOmitEmptyBytes                                      opType = 3215
+	opStructFieldStringTagBytes                                      opType = 3216
+	opStructFieldOmitEmptyStringTagBytes                             opType = 3217
+	opStructFieldArray                                               opType = 3218
+	opStructFieldOmitEmptyArray                                      opType = 3219
+	opStructFieldStringTagArray                                      opType = 3220
+	opStructFieldOmitEmptyStringTagArray                             opType = 3221
+	opStructFieldMap                                                 opType = 3222
+	opStructFieldOmitEmptyMap                                        opType = 3223
+	opStructFieldStringTagMap                                        opType = 3224
+	opStructFieldOmitEmptyStringTagMap                               opType = 3225
+	opStructFieldMapLoad                                             opType = 3226
+	opStructFieldOmitEmptyMapLoad                                    opType = 3227
+	opStructFieldStringTagMapLoad                                    opType = 3228
+	opStructFieldOmitEmptyStringTagMapLoad                           opType = 3229
+	opStructFieldSlice                                               opType = 3230
+	opStructFieldOmitEmptySlice                                      opType = 3231
+	opStructFieldStringTagSlice                                      opType = 3232
+	opStructFieldOmitEmptyStringTagSlice                             opType = 3233
+	opStructFieldStruct                                              opType = 3234
+	opStructFieldOmitEmptyStruct                                     opType = 3235
+	opStructFieldStringTagStruct                                     opType = 3236
+	opStructFieldOmitEmptyStringTagStruct                            opType = 3237
+	opStructFieldMarshalJSON                                         opType = 3238
+	opStructFieldOmitEmptyMarshalJSON                                opType = 3239
+	opStructFieldStringTagMarshalJSON                                opType = 3240
+	opStructFieldOmitEmptyStringTagMarshalJSON                       opType = 3241
+	opStructFieldMarshalText                                         opType = 3242
+	opStructFieldOmitEmptyMarshalText                                opType = 3243
+	opStructFieldStringTagMarshalText                                opType = 3244
+	opStructFieldOmitEmptyStringTagMarshalText                       opType = 3245
+	opStructFieldRecursive                                           opType = 3246
+	opStructFieldOmitEmptyRecursive                                  opType = 3247
+	opStructFieldStringTagRecursive                                  opType = 3248
+	opStructFieldOmitEmptyStringTagRecursive                         opType = 3249
+	opStructFieldIntString                                           opType = 3250
+	opStructFieldOmitEmptyIntString                                  opType = 3251
+	opStructFieldStringTagIntString                                  opType = 3252
+	opStructFieldOmitEmptyStringTagIntString                         opType = 3253
+	opStructFieldInt8String                                          opType = 3254
+	opStructFieldOmitEmptyInt8String                                 opType = 3255
+	opStructFieldStringTagInt8String                                 opType = 3256
+	opStructFieldOmitEmptyStringTagInt8String                        opType = 3257
+	opStructFieldInt16String                                         opType = 3258
+	opStructFieldOmitEmptyInt16String                                opType = 3259
+	opStructFieldStringTagInt16String                                opType = 3260
+	opStructFieldOmitEmptyStringTagInt16String                       opType = 3261
+	opStructFieldInt32String                                         opType = 3262
+	opStructFieldOmitEmptyInt32String                                opType = 3263
+	opStructFieldStringTagInt32String                                opType = 3264
+	opStructFieldOmitEmptyStringTagInt32String                       opType = 3265
+	opStructFieldInt64String                                         opType = 3266
+	opStructFieldOmitEmptyInt64String                                opType = 3267
+	opStructFieldStringTagInt64String                                opType = 3268
+	opStructFieldOmitEmptyStringTagInt64String                       opType = 3269
+	opStructFieldUintString                                          opType = 3270
+	opStructFieldOmitEmptyUintString                                 opType = 3271
+	opStructFieldStringTagUintString                                 opType = 3272
+	opStructFieldOmitEmptyStringTagUintString                        opType = 3273
+	opStructFieldUint8String                                         opType = 3274
+	opStructFieldOmitEmptyUint8String                                opType = 3275
+	opStructFieldStringTagUint8String                                opType = 3276
+	opStructFieldOmitEmptyStringTagUint8String                       opType = 3277
+	opStructFieldUint16String                                        opType = 3278
+	opStructFieldOmitEmptyUint16String                               opType = 3279
+	opStructFieldStringTagUint16String                               opType = 3280
+	opStructFieldOmitEmptyStringTagUint16String                      opType = 3281
+	opStructFieldUint32String                                        opType = 3282
+	opStructFieldOmitEmptyUint32String                               opType = 3283
+	opStructFieldStringTagUint32String                               opType = 3284
+	opStructFieldOmitEmptyStringTagUint32String                      opType = 3285
+	opStructFieldUint64String                                        opType = 3286
+	opStructFieldOmitEmptyUint64String                               opType = 3287
+	opStructFieldStringTagUint64String                               opType = 3288
+	opStructFieldOmitEmptyStringTagUint64String                      opType = 3289
+	opStructFieldIntPtr                                              opType = 3290
+	opStructFieldOmitEmptyIntPtr                                     opType = 3291
+	opStructFieldStringTagIntPtr                                     opType = 3292
+	opStructFieldOmitEmptyStringTagIntPtr                            opType = 3293
+	opStructFieldInt8Ptr                                             opType = 3294
+	opStructFieldOmitEmptyInt8Ptr                                    opType = 3295
+	opStructFieldStringTagInt8Ptr                                    opType = 3296
+	opStructFieldOmitEmptyStringTagInt8Ptr                           opType = 3297
+	opStructFieldInt16Ptr                                            opType = 3298
+	opStructFieldOmitEmptyInt16Ptr                                   opType = 3299
+	opStructFieldStringTagInt16Ptr                                   opType = 3300
+	opStructFieldOmitEmptyStringTagInt16Ptr                          opType = 3301
+	opStructFieldInt32Ptr                                            opType = 3302
+	opStructFieldOmitEmptyInt32Ptr                                   opType = 3303
+	opStructFieldStringTagInt32Ptr                                   opType = 3304
+	opStructFieldOmitEmptyStringTagInt32Ptr                          opType = 3305
+	opStructFieldInt64Ptr                                            opType = 3306
+	opStructFieldOmitEmptyInt64Ptr                                   opType = 3307
+	opStructFieldStringTagInt64Ptr                                   opType = 3308
+	opStructFieldOmitEmptyStringTagInt64Ptr                          opType = 3309
+	opStructFieldUintPtr                                             opType = 3310
+	opStructFieldOmitEmptyUintPtr                                    opType = 3311
+	opStructFieldStringTagUintPtr                                    opType = 3312
+	opStructFieldOmitEmptyStringTagUintPtr                           opType = 3313
+	opStructFieldUint8Ptr                                            opType = 3314
+	opStructFieldOmitEmptyUint8Ptr                                   opType = 3315
+	opStructFieldStringTagUint8Ptr                                   opType = 3316
+	opStructFieldOmitEmptyStringTagUint8Ptr                          opType = 3317
+	opStructFieldUint16Ptr                                           opType = 3318
+	opStructFieldOmitEmptyUint16Ptr                                  opType = 3319
+	opStructFieldStringTagUint16Ptr                                  opType = 3320
+	opStructFieldOmitEmptyStringTagUint16Ptr                         opType = 3321
+	opStructFieldUint32Ptr                                           opType = 3322
+	opStructFieldOmitEmptyUint32Ptr                                  opType = 3323
+	opStructFieldStringTagUint32Ptr                                  opType = 3324
+	opStructFieldOmitEmptyStringTagUint32Ptr                         opType = 3325
+	opStructFieldUint64Ptr                                           opType = 3326
+	opStructFieldOmitEmptyUint64Ptr                                  opType = 3327
+	opStructFieldStringTagUint64Ptr                                  opType = 3328
+	opStructFieldOmitEmptyStringTagUint64Ptr                         opType = 3329
+	opStructFieldFloat32Ptr                                          opType = 3330
+	opStructFieldOmitEmptyFloat32Ptr                                 opType = 3331
+	opStructFieldStringTagFloat32Ptr                                 opType = 3332
+	opStructFieldOmitEmptyStringTagFloat32Ptr                        opType = 3333
+	opStructFieldFloat64Ptr                                          opType = 3334
+	opStructFieldOmitEmptyFloat64Ptr                                 opType = 3335
+	opStructFieldStringTagFloat64Ptr                                 opType = 3336
+	opStructFieldOmitEmptyStringTagFloat64Ptr                        opType = 3337
+	opStructFieldBoolPtr                                             opType = 3338
+	opStructFieldOmitEmptyBoolPtr                                    opType = 3339
+	opStructFieldStringTagBoolPtr                                    opType = 3340
+	opStructFieldOmitEmptyStringTagBoolPtr                           opType = 3341
+	opStructFieldStringPtr                                           opType = 3342
+	opStructFieldOmitEmptyStringPtr                                  opType = 3343
+	opStructFieldStringTagStringPtr                                  opType = 3344
+	opStructFieldOmitEmptyStringTagStringPtr                         opType = 3345
+	opStructFieldBytesPtr                                            opType = 3346
+	opStructFieldOmitEmptyBytesPtr                                   opType = 3347
+	opStructFieldStringTagBytesPtr                                   opType = 3348
+	opStructFieldOmitEmptyStringTagBytesPtr                          opType = 3349
+	opStructFieldIntNPtr                                             opType = 3350
+	opStructFieldOmitEmptyIntNPtr                                    opType = 3351
+	opStructFieldStringTagIntNPtr                                    opType = 3352
+	opStructFieldOmitEmptyStringTagIntNPtr                           opType = 3353
+	opStructFieldInt8NPtr                                            opType = 3354
+	opStructFieldOmitEmptyInt8NPtr                                   opType = 3355
+	opStructFieldStringTagInt8NPtr                                   opType = 3356
+	opStructFieldOmitEmptyStringTagInt8NPtr                          opType = 3357
+	opStructFieldInt16NPtr                                           opType = 3358
+	opStructFieldOmitEmptyInt16NPtr                                  opType = 3359
+	opStructFieldStringTagInt16NPtr                                  opType = 3360
+	opStructFieldOmitEmptyStringTagInt16NPtr                         opType = 3361
+	opStructFieldInt32NPtr                                           opType = 3362
+	opStructFieldOmitEmptyInt32NPtr                                  opType = 3363
+	opStructFieldStringTagInt32NPtr                                  opType = 3364
+	opStructFieldOmitEmptyStringTagInt32NPtr                         opType = 3365
+	opStructFieldInt64NPtr                                           opType = 3366
+	opStructFieldOmitEmptyInt64NPtr                                  opType = 3367
+	opStructFieldStringTagInt64NPtr                                  opType = 3368
+	opStructFieldOmitEmptyStringTagInt64NPtr                         opType = 3369
+	opStructFieldUintNPtr                                            opType = 3370
+	opStructFieldOmitEmptyUintNPtr                                   opType = 3371
+	opStructFieldStringTagUintNPtr                                   opType = 3372
+	opStructFieldOmitEmptyStringTagUintNPtr                          opType = 3373
+	opStructFieldUint8NPtr                                           opType = 3374
+	opStructFieldOmitEmptyUint8NPtr                                  opType = 3375
+	opStructFieldStringTagUint8NPtr                                  opType = 3376
+	opStructFieldOmitEmptyStringTagUint8NPtr                         opType = 3377
+	opStructFieldUint16NPtr                                          opType = 3378
+	opStructFieldOmitEmptyUint16NPtr                                 opType = 3379
+	opStructFieldStringTagUint16NPtr                                 opType = 3380
+	opStructFieldOmitEmptyStringTagUint16NPtr                        opType = 3381
+	opStructFieldUint32NPtr                                          opType = 3382
+	opStructFieldOmitEmptyUint32NPtr                                 opType = 3383
+	opStructFieldStringTagUint32NPtr                                 opType = 3384
+	opStructFieldOmitEmptyStringTagUint32NPtr                        opType = 3385
+	opStructFieldUint64NPtr                                          opType = 3386
+	opStructFieldOmitEmptyUint64NPtr                                 opType = 3387
+	opStructFieldStringTagUint64NPtr                                 opType = 3388
+	opStructFieldOmitEmptyStringTagUint64NPtr                        opType = 3389
+	opStructFieldFloat32NPtr                                         opType = 3390
+	opStructFieldOmitEmptyFloat32NPtr                                opType = 3391
+	opStructFieldStringTagFloat32NPtr                                opType = 3392
+	opStructFieldOmitEmptyStringTagFloat32NPtr                       opType = 3393
+	opStructFieldFloat64NPtr                                         opType = 3394
+	opStructFieldOmitEmptyFloat64NPtr                                opType = 3395
+	opStructFieldStringTagFloat64NPtr                                opType = 3396
+	opStructFieldOmitEmptyStringTagFloat64NPtr                       opType = 3397
+	opStructFieldBoolNPtr                                            opType = 3398
+	opStructFieldOmitEmptyBoolNPtr                                   opType = 3399
+	opStructFieldStringTagBoolNPtr                                   opType = 3400
+	opStructFieldOmitEmptyStringTagBoolNPtr                          opType = 3401
+	opStructFieldStringNPtr                                          opType = 3402
+	opStructFieldOmitEmptyStringNPtr                                 opType = 3403
+	opStructFieldStringTagStringNPtr                                 opType = 3404
+	opStructFieldOmitEmptyStringTagStringNPtr                        opType = 3405
+	opStructFieldBytesNPtr                                           opType = 3406
+	opStructFieldOmitEmptyBytesNPtr                                  opType = 3407
+	opStructFieldStringTagBytesNPtr                                  opType = 3408
+	opStructFieldOmitEmptyStringTagBytesNPtr                         opType = 3409
+	opStructField                                                    opType = 3410
+	opStructFieldOmitEmpty                                           opType = 3411
+	opStructFieldStringTag                                           opType = 3412
+	opStructFieldOmitEmptyStringTag                                  opType = 3413
+	opStructEndInt                                                   opType = 3414
+	opStructEndOmitEmptyInt                                          opType = 3415
+	opStructEndStringTagInt                                          opType = 3416
+	opStructEndOmitEmptyStringTagInt                                 opType = 3417
+	opStructEndInt8                                                  opType = 3418
+	opStructEndOmitEmptyInt8                                         opType = 3419
+	opStructEndStringTagInt8                                         opType = 3420
+	opStructEndOmitEmptyStringTagInt8                                opType = 3421
+	opStructEndInt16                                                 opType = 3422
+	opStructEndOmitEmptyInt16                                        opType = 3423
+	opStructEndStringTagInt16                                        opType = 3424
+	opStructEndOmitEmptyStringTagInt16                               opType = 3425
+	opStructEndInt32                                                 opType = 3426
+	opStructEndOmitEmptyInt32                                        opType = 3427
+	opStructEndStringTagInt32                                        opType = 3428
+	opStructEndOmitEmptyStringTagInt32                               opType = 3429
+	opStructEndInt64                                                 opType = 3430
+	opStructEndOmitEmptyInt64                                        opType = 3431
+	opStructEndStringTagInt64                                        opType = 3432
+	opStructEndOmitEmptyStringTagInt64                               opType = 3433
+	opStructEndUint                                                  opType = 3434
+	opStructEndOmitEmptyUint                                         opType = 3435
+	opStructEndStringTagUint                                         opType = 3436
+	opStructEndOmitEmptyStringTagUint                                opType = 3437
+	opStructEndUint8                                                 opType = 3438
+	opStructEndOmitEmptyUint8                                        opType = 3439
+	opStructEndStringTagUint8                                        opType = 3440
+	opStructEndOmitEmptyStringTagUint8                               opType = 3441
+	opStructEndUint16                                                opType = 3442
+	opStructEndOmitEmptyUint16                                       opType = 3443
+	opStructEndStringTagUint16                                       opType = 3444
+	opStructEndOmitEmptyStringTagUint16                              opType = 3445
+	opStructEndUint32                                                opType = 3446
+	opStructEndOmitEmptyUint32                                       opType = 3447
+	opStructEndStringTagUint32                                       opType = 3448
+	opStructEndOmitEmptyStringTagUint32                              opType = 3449
+	opStructEndUint64                                                opType = 3450
+	opStructEndOmitEmptyUint64                                       opType = 3451
+	opStructEndStringTagUint64                                       opType = 3452
+	opStructEndOmitEmptyStringTagUint64                              opType = 3453
+	opStructEndFloat32                                               opType = 3454
+	opStructEndOmitEmptyFloat32                                      opType = 3455
+	opStructEndStringTagFloat32                                      opType = 3456
+	opStructEndOmitEmptyStringTagFloat32                             opType = 3457
+	opStructEndFloat64                                               opType = 3458
+	opStructEndOmitEmptyFloat64                                      opType = 3459
+	opStructEndStringTagFloat64                                      opType = 3460
+	opStructEndOmitEmptyStringTagFloat64                             opType = 3461
+	opStructEndBool                                                  opType = 3462
+	opStructEndOmitEmptyBool                                         opType = 3463
+	opStructEndStringTagBool                                         opType = 3464
+	opStructEndOmitEmptyStringTagBool                                opType = 3465
+	opStructEndString                                                opType = 3466
+	opStructEndOmitEmptyString                                       opType = 3467
+	opStructEndStringTagString                                       opType = 3468
+	opStructEndOmitEmptyStringTagString                              opType = 3469
+	opStructEndBytes                                                 opType = 3470
+	opStructEndOmitEmptyBytes                                        opType = 3471
+	opStructEndStringTagBytes                                        opType = 3472
+	opStructEndOmitEmptyStringTagBytes                               opType = 3473
+	opStructEndArray                                                 opType = 3474
+	opStructEndOmitEmptyArray                                        opType = 3475
+	opStructEndStringTagArray                                        opType = 3476
+	opStructEndOmitEmptyStringTagArray                               opType = 3477
+	opStructEndMap                                                   opType = 3478
+	opStructEndOmitEmptyMap                                          opType = 3479
+	opStructEndStringTagMap                                          opType = 3480
+	opStructEndOmitEmptyStringTagMap                                 opType = 3481
+	opStructEndMapLoad                                               opType = 3482
+	opStructEndOmitEmptyMapLoad                                      opType = 3483
+	opStructEndStringTagMapLoad                                      opType = 3484
+	opStructEndOmitEmptyStringTagMapLoad                             opType = 3485
+	opStructEndSlice                                                 opType = 3486
+	opStructEndOmitEmptySlice                                        opType = 3487
+	opStructEndStringTagSlice                                        opType = 3488
+	opStructEndOmitEmptyStringTagSlice                               opType = 3489
+	opStructEndStruct                                                opType = 3490
+	opStructEndOmitEmptyStruct                                       opType = 3491
+	opStructEndStringTagStruct                                       opType = 3492
+	opStructEndOmitEmptyStringTagStruct                              opType = 3493
+	opStructEndMarshalJSON                                           opType = 3494
+	opStructEndOmitEmptyMarshalJSON                                  opType = 3495
+	opStructEndStringTagMarshalJSON                                  opType = 3496
+	opStructEndOmitEmptyStringTagMarshalJSON                         opType = 3497
+	opStructEndMarshalText                                           opType = 3498
+	opStructEndOmitEmptyMarshalText                                  opType = 3499
+	opStructEndStringTagMarshalText                                  opType = 3500
+	opStructEndOmitEmptyStringTagMarshalText                         opType = 3501
+	opStructEndRecursive                                             opType = 3502
+	opStructEndOmitEmptyRecursive                                    opType = 3503
+	opStructEndStringTagRecursive                                    opType = 3504
+	opStructEndOmitEmptyStringTagRecursive                           opType = 3505
+	opStructEndIntString                                             opType = 3506
+	opStructEndOmitEmptyIntString                                    opType = 3507
+	opStructEndStringTagIntString                                    opType = 3508
+	opStructEndOmitEmptyStringTagIntString                           opType = 3509
+	opStructEndInt8String                                            opType = 3510
+	opStructEndOmitEmptyInt8String                                   opType = 3511
+	opStructEndStringTagInt8String                                   opType = 3512
+	opStructEndOmitEmptyStringTagInt8String                          opType = 3513
+	opStructEndInt16String                                           opType = 3514
+	opStructEndOmitEmptyInt16String                                  opType = 3515
+	opStructEndStringTagInt16String                                  opType = 3516
+	opStructEndOmitEmptyStringTagInt16String                         opType = 3517
+	opStructEndInt32String                                           opType = 3518
+	opStructEndOmitEmptyInt32String                                  opType = 3519
+	opStructEndStringTagInt32String                                  opType = 3520
+	opStructEndOmitEmptyStringTagInt32String                         opType = 3521
+	opStructEndInt64String                                           opType = 3522
+	opStructEndOmitEmptyInt64String                                  opType = 3523
+	opStructEndStringTagInt64String                                  opType = 3524
+	opStructEndOmitEmptyStringTagInt64String                         opType = 3525
+	opStructEndUintString                                            opType = 3526
+	opStructEndOmitEmptyUintString                                   opType = 3527
+	opStructEndStringTagUintString                                   opType = 3528
+	opStructEndOmitEmptyStringTagUintString                          opType = 3529
+	opStructEndUint8String                                           opType = 3530
+	opStructEndOmitEmptyUint8String                                  opType = 3531
+	opStructEndStringTagUint8String                                  opType = 3532
+	opStructEndOmitEmptyStringTagUint8String                         opType = 3533
+	opStructEndUint16String                                          opType = 3534
+	opStructEndOmitEmptyUint16String                                 opType = 3535
+	opStructEndStringTagUint16String                                 opType = 3536
+	opStructEndOmitEmptyStringTagUint16String                        opType = 3537
+	opStructEndUint32String                                          opType = 3538
+	opStructEndOmitEmptyUint32String                                 opType = 3539
+	opStructEndStringTagUint32String                                 opType = 3540
+	opStructEndOmitEmptyStringTagUint32String                        opType = 3541
+	opStructEndUint64String                                          opType = 3542
+	opStructEndOmitEmptyUint64String                                 opType = 3543
+	opStructEndStringTagUint64String                                 opType = 3544
+	opStructEndOmitEmptyStringTagUint64String                        opType = 3545
+	opStructEndIntPtr                                                opType = 3546
+	opStructEndOmitEmptyIntPtr                                       opType = 3547
+	opStructEndStringTagIntPtr                                       opType = 3548
+	opStructEndOmitEmptyStringTagIntPtr                              opType = 3549
+	opStructEndInt8Ptr                                               opType = 3550
+	opStructEndOmitEmptyInt8Ptr                                      opType = 3551
+	opStructEndStringTagInt8Ptr                                      opType = 3552
+	opStructEndOmitEmptyStringTagInt8Ptr                             opType = 3553
+	opStructEndInt16Ptr                                              opType = 3554
+	opStructEndOmitEmptyInt16Ptr                                     opType = 3555
+	opStructEndStringTagInt16Ptr                                     opType = 3556
+	opStructEndOmitEmptyStringTagInt16Ptr                            opType = 3557
+	opStructEndInt32Ptr                                              opType = 3558
+	opStructEndOmitEmptyInt32Ptr                                     opType = 3559
+	opStructEndStringTagInt32Ptr                                     opType = 3560
+	opStructEndOmitEmptyStringTagInt32Ptr                            opType = 3561
+	opStructEndInt64Ptr                                              opType = 3562
+	opStructEndOmitEmptyInt64Ptr                                     opType = 3563
+	opStructEndStringTagInt64Ptr                                     opType = 3564
+	opStructEndOmitEmptyStringTagInt64Ptr                            opType = 3565
+	opStructEndUintPtr                                               opType = 3566
+	opStructEndOmitEmptyUintPtr                                      opType = 3567
+	opStructEndStringTagUintPtr                                      opType = 3568
+	opStructEndOmitEmptyStringTagUintPtr                             opType = 3569
+	opStructEndUint8Ptr                                              opType = 3570
+	opStructEndOmitEmptyUint8Ptr                                     opType = 3571
+	opStructEndStringTagUint8Ptr                                     opType = 3572
+	opStructEndOmitEmptyStringTagUint8Ptr                            opType = 3573
+	opStructEndUint16Ptr                                             opType = 3574
+	opStructEndOmitEmptyUint16Ptr                                    opType = 3575
+	opStructEndStringTagUint16Ptr                                    opType = 3576
+	opStructEndOmitEmptyStringTagUint16Ptr                           opType = 3577
+	opStructEndUint32Ptr                                             opType = 3578
+	opStructEndOmitEmptyUint32Ptr                                    opType = 3579
+	opStructEndStringTagUint32Ptr                                    opType = 3580
+	opStructEndOmitEmptyStringTagUint32Ptr                           opType = 3581
+	opStructEndUint64Ptr                                             opType = 3582
+	opStructEndOmitEmptyUint64Ptr                                    opType = 3583
+	opStructEndStringTagUint64Ptr                                    opType = 3584
+	opStructEndOmitEmptyStringTagUint64Ptr                           opType = 3585
+	opStructEndFloat32Ptr                                            opType = 3586
+	opStructEndOmitEmptyFloat32Ptr                                   opType = 3587
+	opStructEndStringTagFloat32Ptr                                   opType = 3588
+	opStructEndOmitEmptyStringTagFloat32Ptr                          opType = 3589
+	opStructEndFloat64Ptr                                            opType = 3590
+	opStructEndOmitEmptyFloat64Ptr                                   opType = 3591
+	opStructEndStringTagFloat64Ptr                                   opType = 3592
+	opStructEndOmitEmptyStringTagFloat64Ptr                          opType = 3593
+	opStructEndBoolPtr                                               opType = 3594
+	opStructEndOmitEmptyBoolPtr                                      opType = 3595
+	opStructEndStringTagBoolPtr                                      opType = 3596
+	opStructEndOmitEmptyStringTagBoolPtr                             opType = 3597
+	opStructEndStringPtr                                             opType = 3598
+	opStructEndOmitEmptyStringPtr                                    opType = 3599
+	opStructEndStringTagStringPtr                                    opType = 3600
+	opStructEndOmitEmptyStringTagStringPtr                           opType = 3601
+	opStructEndBytesPtr                                              opType = 3602
+	opStructEndOmitEmptyBytesPtr                                     opType = 3603
+	opStructEndStringTagBytesPtr                                     opType = 3604
+	opStructEndOmitEmptyStringTagBytesPtr                            opType = 3605
+	opStructEndIntNPtr                                               opType = 3606
+	opStructEndOmitEmptyIntNPtr                                      opType = 3607
+	opStructEndStringTagIntNPtr                                      opType = 3608
+	opStructEndOmitEmptyStringTagIntNPtr                             opType = 3609
+	opStructEndInt8NPtr                                              opType = 3610
+	opStructEndOmitEmptyInt8NPtr                                     opType = 3611
+	opStructEndStringTagInt8NPtr                                     opType = 3612
+	opStructEndOmitEmptyStringTagInt8NPtr                            opType = 3613
+	opStructEndInt16NPtr                                             opType = 3614
+	opStructEndOmitEmptyInt16NPtr                                    opType = 3615
+	opStructEndStringTagInt16NPtr                                    opType = 3616
+	opStructEndOmitEmptyStringTagInt16NPtr                           opType = 3617
+	opStructEndInt32NPtr                                             opType = 3618
+	opStructEndOmitEmptyInt32NPtr                                    opType = 3619
+	opStructEndStringTagInt32NPtr                                    opType = 3620
+	opStructEndOmitEmptyStringTagInt32NPtr                           opType = 3621
+	opStructEndInt64NPtr                                             opType = 3622
+	opStructEndOmitEmptyInt64NPtr                                    opType = 3623
+	opStructEndStringTagInt64NPtr                                    opType = 3624
+	opStructEndOmitEmptyStringTagInt64NPtr                           opType = 3625
+	opStructEndUintNPtr                                              opType = 3626
+	opStructEndOmitEmptyUintNPtr                                     opType = 3627
+	opStructEndStringTagUintNPtr                                     opType = 3628
+	opStructEndOmitEmptyStringTagUintNPtr                            opType = 3629
+	opStructEndUint8NPtr                                             opType = 3630
+	opStructEndOmitEmptyUint8NPtr                                    opType = 3631
+	opStructEndStringTagUint8NPtr                                    opType = 3632
+	opStructEndOmitEmptyStringTagUint8NPtr                           opType = 3633
+	opStructEndUint16NPtr                                            opType = 3634
+	opStructEndOmitEmptyUint16NPtr                                   opType = 3635
+	opStructEndStringTagUint16NPtr                                   opType = 3636
+	opStructEndOmitEmptyStringTagUint16NPtr                          opType = 3637
+	opStructEndUint32NPtr                                            opType = 3638
+	opStructEndOmitEmptyUint32NPtr                                   opType = 3639
+	opStructEndStringTagUint32NPtr                                   opType = 3640
+	opStructEndOmitEmptyStringTagUint32NPtr                          opType = 3641
+	opStructEndUint64NPtr                                            opType = 3642
+	opStructEndOmitEmptyUint64NPtr                                   opType = 3643
+	opStructEndStringTagUint64NPtr                                   opType = 3644
+	opStructEndOmitEmptyStringTagUint64NPtr                          opType = 3645
+	opStructEndFloat32NPtr                                           opType = 3646
+	opStructEndOmitEmptyFloat32NPtr                                  opType = 3647
+	opStructEndStringTagFloat32NPtr                                  opType = 3648
+	opStructEndOmitEmptyStringTagFloat32NPtr                         opType = 3649
+	opStructEndFloat64NPtr                                           opType = 3650
+	opStructEndOmitEmptyFloat64NPtr                                  opType = 3651
+	opStructEndStringTagFloat64NPtr                                  opType = 3652
+	opStructEndOmitEmptyStringTagFloat64NPtr                         opType = 3653
+	opStructEndBoolNPtr                                              opType = 3654
+	opStructEndOmitEmptyBoolNPtr                                     opType = 3655
+	opStructEndStringTagBoolNPtr                                     opType = 3656
+	opStructEndOmitEmptyStringTagBoolNPtr                            opType = 3657
+	opStructEndStringNPtr                                            opType = 3658
+	opStructEndOmitEmptyStringNPtr                                   opType = 3659
+	opStructEndStringTagStringNPtr                                   opType = 3660
+	opStructEndOmitEmptyStringTagStringNPtr                          opType = 3661
+	opStructEndBytesNPtr                                             opType = 3662
+	opStructEndOmitEmptyBytesNPtr                                    opType = 3663
+	opStructEndStringTagBytesNPtr                                    opType = 3664
+	opStructEndOmitEmptyStringTagBytesNPtr                           opType = 3665
+	opStructEnd                                                      opType = 3666
+	opStructEndOmitEmpty                                             opType = 3667
+	opStructEndStringTag                                             opType = 3668
+	opStructEndOmitEmptyStringTag                                    opType = 3669
 )
 
 func (t opType) String() string {
-	if int(t) >= 2770 {
+	if int(t) >= 3670 {
 		return ""
 	}
 	return opTypeStrings[int(t)]
@@ -5626,7 +7426,7 @@ func (t opType) headToPtrHead() opType {
 	}
 	suffix := "Ptr" + t.String()[idx+len("Field"):]
 
-	const toPtrOffset = 12
+	const toPtrOffset = 16
 	if strings.Contains(opType(int(t)+toPtrOffset).String(), suffix) {
 		return opType(int(t) + toPtrOffset)
 	}
@@ -5647,7 +7447,7 @@ func (t opType) headToNPtrHead() opType {
 	}
 	suffix := "NPtr" + t.String()[idx+len("Field"):]
 
-	const toPtrOffset = 24
+	const toPtrOffset = 32
 	if strings.Contains(opType(int(t)+toPtrOffset).String(), suffix) {
 		return opType(int(t) + toPtrOffset)
 	}
@@ -5655,7 +7455,7 @@ func (t opType) headToNPtrHead() opType {
 }
 
 func (t opType) headToAnonymousHead() opType {
-	const toAnonymousOffset = 6
+	const toAnonymousOffset = 8
 	if strings.Contains(opType(int(t)+toAnonymousOffset).String(), "Anonymous") {
 		return opType(int(t) + toAnonymousOffset)
 	}
@@ -5680,7 +7480,7 @@ func (t opType) headToStringTagHead() opType {
 }
 
 func (t opType) headToOnlyHead() opType {
-	if strings.HasSuffix(t.String(), "Head") || strings.HasSuffix(t.String(), "HeadOmitEmpty") || strings.HasSuffix(t.String(), "HeadStringTag") {
+	if strings.HasSuffix(t.String(), "Head") || strings.HasSuffix(t.String(), "HeadOmitEmpty") || strings.HasSuffix(t.String(), "HeadStringTag") || strings.HasSuffix(t.String(), "HeadOmitEmptyStringTag") {
 		return t
 	}
 
@@ -5698,7 +7498,7 @@ func (t opType) ptrHeadToHead() opType {
 	}
 	suffix := t.String()[idx+len("Ptr"):]
 
-	const toPtrOffset = 12
+	const toPtrOffset = 16
 	if strings.Contains(opType(int(t)-toPtrOffset).String(), suffix) {
 		return opType(int(t) - toPtrOffset)
 	}
@@ -5713,342 +7513,456 @@ func (t opType) fieldToEnd() opType {
 		return opStructEndOmitEmptyInt
 	case opStructFieldStringTagInt:
 		return opStructEndStringTagInt
+	case opStructFieldOmitEmptyStringTagInt:
+		return opStructEndOmitEmptyStringTagInt
 	case opStructFieldInt8:
 		return opStructEndInt8
 	case opStructFieldOmitEmptyInt8:
 		return opStructEndOmitEmptyInt8
 	case opStructFieldStringTagInt8:
 		return opStructEndStringTagInt8
+	case opStructFieldOmitEmptyStringTagInt8:
+		return opStructEndOmitEmptyStringTagInt8
 	case opStructFieldInt16:
 		return opStructEndInt16
 	case opStructFieldOmitEmptyInt16:
 		return opStructEndOmitEmptyInt16
 	case opStructFieldStringTagInt16:
 		return opStructEndStringTagInt16
+	case opStructFieldOmitEmptyStringTagInt16:
+		return opStructEndOmitEmptyStringTagInt16
 	case opStructFieldInt32:
 		return opStructEndInt32
 	case opStructFieldOmitEmptyInt32:
 		return opStructEndOmitEmptyInt32
 	case opStructFieldStringTagInt32:
 		return opStructEndStringTagInt32
+	case opStructFieldOmitEmptyStringTagInt32:
+		return opStructEndOmitEmptyStringTagInt32
 	case opStructFieldInt64:
 		return opStructEndInt64
 	case opStructFieldOmitEmptyInt64:
 		return opStructEndOmitEmptyInt64
 	case opStructFieldStringTagInt64:
 		return opStructEndStringTagInt64
+	case opStructFieldOmitEmptyStringTagInt64:
+		return opStructEndOmitEmptyStringTagInt64
 	case opStructFieldUint:
 		return opStructEndUint
 	case opStructFieldOmitEmptyUint:
 		return opStructEndOmitEmptyUint
 	case opStructFieldStringTagUint:
 		return opStructEndStringTagUint
+	case opStructFieldOmitEmptyStringTagUint:
+		return opStructEndOmitEmptyStringTagUint
 	case opStructFieldUint8:
 		return opStructEndUint8
 	case opStructFieldOmitEmptyUint8:
 		return opStructEndOmitEmptyUint8
 	case opStructFieldStringTagUint8:
 		return opStructEndStringTagUint8
+	case opStructFieldOmitEmptyStringTagUint8:
+		return opStructEndOmitEmptyStringTagUint8
 	case opStructFieldUint16:
 		return opStructEndUint16
 	case opStructFieldOmitEmptyUint16:
 		return opStructEndOmitEmptyUint16
 	case opStructFieldStringTagUint16:
 		return opStructEndStringTagUint16
+	case opStructFieldOmitEmptyStringTagUint16:
+		return opStructEndOmitEmptyStringTagUint16
 	case opStructFieldUint32:
 		return opStructEndUint32
 	case opStructFieldOmitEmptyUint32:
 		return opStructEndOmitEmptyUint32
 	case opStructFieldStringTagUint32:
 		return opStructEndStringTagUint32
+	case opStructFieldOmitEmptyStringTagUint32:
+		return opStructEndOmitEmptyStringTagUint32
 	case opStructFieldUint64:
 		return opStructEndUint64
 	case opStructFieldOmitEmptyUint64:
 		return opStructEndOmitEmptyUint64
 	case opStructFieldStringTagUint64:
 		return opStructEndStringTagUint64
+	case opStructFieldOmitEmptyStringTagUint64:
+		return opStructEndOmitEmptyStringTagUint64
 	case opStructFieldFloat32:
 		return opStructEndFloat32
 	case opStructFieldOmitEmptyFloat32:
 		return opStructEndOmitEmptyFloat32
 	case opStructFieldStringTagFloat32:
 		return opStructEndStringTagFloat32
+	case opStructFieldOmitEmptyStringTagFloat32:
+		return opStructEndOmitEmptyStringTagFloat32
 	case opStructFieldFloat64:
 		return opStructEndFloat64
 	case opStructFieldOmitEmptyFloat64:
 		return opStructEndOmitEmptyFloat64
 	case opStructFieldStringTagFloat64:
 		return opStructEndStringTagFloat64
+	case opStructFieldOmitEmptyStringTagFloat64:
+		return opStructEndOmitEmptyStringTagFloat64
 	case opStructFieldBool:
 		return opStructEndBool
 	case opStructFieldOmitEmptyBool:
 		return opStructEndOmitEmptyBool
 	case opStructFieldStringTagBool:
 		return opStructEndStringTagBool
+	case opStructFieldOmitEmptyStringTagBool:
+		return opStructEndOmitEmptyStringTagBool
 	case opStructFieldString:
 		return opStructEndString
 	case opStructFieldOmitEmptyString:
 		return opStructEndOmitEmptyString
 	case opStructFieldStringTagString:
 		return opStructEndStringTagString
+	case opStructFieldOmitEmptyStringTagString:
+		return opStructEndOmitEmptyStringTagString
 	case opStructFieldBytes:
 		return opStructEndBytes
 	case opStructFieldOmitEmptyBytes:
 		return opStructEndOmitEmptyBytes
 	case opStructFieldStringTagBytes:
 		return opStructEndStringTagBytes
+	case opStructFieldOmitEmptyStringTagBytes:
+		return opStructEndOmitEmptyStringTagBytes
 	case opStructFieldMarshalJSON:
 		return opStructEndMarshalJSON
 	case opStructFieldOmitEmptyMarshalJSON:
 		return opStructEndOmitEmptyMarshalJSON
 	case opStructFieldStringTagMarshalJSON:
 		return opStructEndStringTagMarshalJSON
+	case opStructFieldOmitEmptyStringTagMarshalJSON:
+		return opStructEndOmitEmptyStringTagMarshalJSON
 	case opStructFieldMarshalText:
 		return opStructEndMarshalText
 	case opStructFieldOmitEmptyMarshalText:
 		return opStructEndOmitEmptyMarshalText
 	case opStructFieldStringTagMarshalText:
 		return opStructEndStringTagMarshalText
+	case opStructFieldOmitEmptyStringTagMarshalText:
+		return opStructEndOmitEmptyStringTagMarshalText
 	case opStructFieldIntString:
 		return opStructEndIntString
 	case opStructFieldOmitEmptyIntString:
 		return opStructEndOmitEmptyIntString
 	case opStructFieldStringTagIntString:
 		return opStructEndStringTagIntString
+	case opStructFieldOmitEmptyStringTagIntString:
+		return opStructEndOmitEmptyStringTagIntString
 	case opStructFieldInt8String:
 		return opStructEndInt8String
 	case opStructFieldOmitEmptyInt8String:
 		return opStructEndOmitEmptyInt8String
 	case opStructFieldStringTagInt8String:
 		return opStructEndStringTagInt8String
+	case opStructFieldOmitEmptyStringTagInt8String:
+		return opStructEndOmitEmptyStringTagInt8String
 	case opStructFieldInt16String:
 		return opStructEndInt16String
 	case opStructFieldOmitEmptyInt16String:
 		return opStructEndOmitEmptyInt16String
 	case opStructFieldStringTagInt16String:
 		return opStructEndStringTagInt16String
+	case opStructFieldOmitEmptyStringTagInt16String:
+		return opStructEndOmitEmptyStringTagInt16String
 	case opStructFieldInt32String:
 		return opStructEndInt32String
 	case opStructFieldOmitEmptyInt32String:
 		return opStructEndOmitEmptyInt32String
 	case opStructFieldStringTagInt32String:
 		return opStructEndStringTagInt32String
+	case opStructFieldOmitEmptyStringTagInt32String:
+		return opStructEndOmitEmptyStringTagInt32String
 	case opStructFieldInt64String:
 		return opStructEndInt64String
 	case opStructFieldOmitEmptyInt64String:
 		return opStructEndOmitEmptyInt64String
 	case opStructFieldStringTagInt64String:
 		return opStructEndStringTagInt64String
+	case opStructFieldOmitEmptyStringTagInt64String:
+		return opStructEndOmitEmptyStringTagInt64String
 	case opStructFieldUintString:
 		return opStructEndUintString
 	case opStructFieldOmitEmptyUintString:
 		return opStructEndOmitEmptyUintString
 	case opStructFieldStringTagUintString:
 		return opStructEndStringTagUintString
+	case opStructFieldOmitEmptyStringTagUintString:
+		return opStructEndOmitEmptyStringTagUintString
 	case opStructFieldUint8String:
 		return opStructEndUint8String
 	case opStructFieldOmitEmptyUint8String:
 		return opStructEndOmitEmptyUint8String
 	case opStructFieldStringTagUint8String:
 		return opStructEndStringTagUint8String
+	case opStructFieldOmitEmptyStringTagUint8String:
+		return opStructEndOmitEmptyStringTagUint8String
 	case opStructFieldUint16String:
 		return opStructEndUint16String
 	case opStructFieldOmitEmptyUint16String:
 		return opStructEndOmitEmptyUint16String
 	case opStructFieldStringTagUint16String:
 		return opStructEndStringTagUint16String
+	case opStructFieldOmitEmptyStringTagUint16String:
+		return opStructEndOmitEmptyStringTagUint16String
 	case opStructFieldUint32String:
 		return opStructEndUint32String
 	case opStructFieldOmitEmptyUint32String:
 		return opStructEndOmitEmptyUint32String
 	case opStructFieldStringTagUint32String:
 		return opStructEndStringTagUint32String
+	case opStructFieldOmitEmptyStringTagUint32String:
+		return opStructEndOmitEmptyStringTagUint32String
 	case opStructFieldUint64String:
 		return opStructEndUint64String
 	case opStructFieldOmitEmptyUint64String:
 		return opStructEndOmitEmptyUint64String
 	case opStructFieldStringTagUint64String:
 		return opStructEndStringTagUint64String
+	case opStructFieldOmitEmptyStringTagUint64String:
+		return opStructEndOmitEmptyStringTagUint64String
 	case opStructFieldIntPtr:
 		return opStructEndIntPtr
 	case opStructFieldOmitEmptyIntPtr:
 		return opStructEndOmitEmptyIntPtr
 	case opStructFieldStringTagIntPtr:
 		return opStructEndStringTagIntPtr
+	case opStructFieldOmitEmptyStringTagIntPtr:
+		return opStructEndOmitEmptyStringTagIntPtr
 	case opStructFieldInt8Ptr:
 		return opStructEndInt8Ptr
 	case opStructFieldOmitEmptyInt8Ptr:
 		return opStructEndOmitEmptyInt8Ptr
 	case opStructFieldStringTagInt8Ptr:
 		return opStructEndStringTagInt8Ptr
+	case opStructFieldOmitEmptyStringTagInt8Ptr:
+		return opStructEndOmitEmptyStringTagInt8Ptr
 	case opStructFieldInt16Ptr:
 		return opStructEndInt16Ptr
 	case opStructFieldOmitEmptyInt16Ptr:
 		return opStructEndOmitEmptyInt16Ptr
 	case opStructFieldStringTagInt16Ptr:
 		return opStructEndStringTagInt16Ptr
+	case opStructFieldOmitEmptyStringTagInt16Ptr:
+		return opStructEndOmitEmptyStringTagInt16Ptr
 	case opStructFieldInt32Ptr:
 		return opStructEndInt32Ptr
 	case opStructFieldOmitEmptyInt32Ptr:
 		return opStructEndOmitEmptyInt32Ptr
 	case opStructFieldStringTagInt32Ptr:
 		return opStructEndStringTagInt32Ptr
+	case opStructFieldOmitEmptyStringTagInt32Ptr:
+		return opStructEndOmitEmptyStringTagInt32Ptr
 	case opStructFieldInt64Ptr:
 		return opStructEndInt64Ptr
 	case opStructFieldOmitEmptyInt64Ptr:
 		return opStructEndOmitEmptyInt64Ptr
 	case opStructFieldStringTagInt64Ptr:
 		return opStructEndStringTagInt64Ptr
+	case opStructFieldOmitEmptyStringTagInt64Ptr:
+		return opStructEndOmitEmptyStringTagInt64Ptr
 	case opStructFieldUintPtr:
 		return opStructEndUintPtr
 	case opStructFieldOmitEmptyUintPtr:
 		return opStructEndOmitEmptyUintPtr
 	case opStructFieldStringTagUintPtr:
 		return opStructEndStringTagUintPtr
+	case opStructFieldOmitEmptyStringTagUintPtr:
+		return opStructEndOmitEmptyStringTagUintPtr
 	case opStructFieldUint8Ptr:
 		return opStructEndUint8Ptr
 	case opStructFieldOmitEmptyUint8Ptr:
 		return opStructEndOmitEmptyUint8Ptr
 	case opStructFieldStringTagUint8Ptr:
 		return opStructEndStringTagUint8Ptr
+	case opStructFieldOmitEmptyStringTagUint8Ptr:
+		return opStructEndOmitEmptyStringTagUint8Ptr
 	case opStructFieldUint16Ptr:
 		return opStructEndUint16Ptr
 	case opStructFieldOmitEmptyUint16Ptr:
 		return opStructEndOmitEmptyUint16Ptr
 	case opStructFieldStringTagUint16Ptr:
 		return opStructEndStringTagUint16Ptr
+	case opStructFieldOmitEmptyStringTagUint16Ptr:
+		return opStructEndOmitEmptyStringTagUint16Ptr
 	case opStructFieldUint32Ptr:
 		return opStructEndUint32Ptr
 	case opStructFieldOmitEmptyUint32Ptr:
 		return opStructEndOmitEmptyUint32Ptr
 	case opStructFieldStringTagUint32Ptr:
 		return opStructEndStringTagUint32Ptr
+	case opStructFieldOmitEmptyStringTagUint32Ptr:
+		return opStructEndOmitEmptyStringTagUint32Ptr
 	case opStructFieldUint64Ptr:
 		return opStructEndUint64Ptr
 	case opStructFieldOmitEmptyUint64Ptr:
 		return opStructEndOmitEmptyUint64Ptr
 	case opStructFieldStringTagUint64Ptr:
 		return opStructEndStringTagUint64Ptr
+	case opStructFieldOmitEmptyStringTagUint64Ptr:
+		return opStructEndOmitEmptyStringTagUint64Ptr
 	case opStructFieldFloat32Ptr:
 		return opStructEndFloat32Ptr
 	case opStructFieldOmitEmptyFloat32Ptr:
 		return opStructEndOmitEmptyFloat32Ptr
 	case opStructFieldStringTagFloat32Ptr:
 		return opStructEndStringTagFloat32Ptr
+	case opStructFieldOmitEmptyStringTagFloat32Ptr:
+		return opStructEndOmitEmptyStringTagFloat32Ptr
 	case opStructFieldFloat64Ptr:
 		return opStructEndFloat64Ptr
 	case opStructFieldOmitEmptyFloat64Ptr:
 		return opStructEndOmitEmptyFloat64Ptr
 	case opStructFieldStringTagFloat64Ptr:
 		return opStructEndStringTagFloat64Ptr
+	case opStructFieldOmitEmptyStringTagFloat64Ptr:
+		return opStructEndOmitEmptyStringTagFloat64Ptr
 	case opStructFieldBoolPtr:
 		return opStructEndBoolPtr
 	case opStructFieldOmitEmptyBoolPtr:
 		return opStructEndOmitEmptyBoolPtr
 	case opStructFieldStringTagBoolPtr:
 		return opStructEndStringTagBoolPtr
+	case opStructFieldOmitEmptyStringTagBoolPtr:
+		return opStructEndOmitEmptyStringTagBoolPtr
 	case opStructFieldStringPtr:
 		return opStructEndStringPtr
 	case opStructFieldOmitEmptyStringPtr:
 		return opStructEndOmitEmptyStringPtr
 	case opStructFieldStringTagStringPtr:
 		return opStructEndStringTagStringPtr
+	case opStructFieldOmitEmptyStringTagStringPtr:
+		return opStructEndOmitEmptyStringTagStringPtr
 	case opStructFieldBytesPtr:
 		return opStructEndBytesPtr
 	case opStructFieldOmitEmptyBytesPtr:
 		return opStructEndOmitEmptyBytesPtr
 	case opStructFieldStringTagBytesPtr:
 		return opStructEndStringTagBytesPtr
+	case opStructFieldOmitEmptyStringTagBytesPtr:
+		return opStructEndOmitEmptyStringTagBytesPtr
 	case opStructFieldIntNPtr:
 		return opStructEndIntNPtr
 	case opStructFieldOmitEmptyIntNPtr:
 		return opStructEndOmitEmptyIntNPtr
 	case opStructFieldStringTagIntNPtr:
 		return opStructEndStringTagIntNPtr
+	case opStructFieldOmitEmptyStringTagIntNPtr:
+		return opStructEndOmitEmptyStringTagIntNPtr
 	case opStructFieldInt8NPtr:
 		return opStructEndInt8NPtr
 	case opStructFieldOmitEmptyInt8NPtr:
 		return opStructEndOmitEmptyInt8NPtr
 	case opStructFieldStringTagInt8NPtr:
 		return opStructEndStringTagInt8NPtr
+	case opStructFieldOmitEmptyStringTagInt8NPtr:
+		return opStructEndOmitEmptyStringTagInt8NPtr
 	case opStructFieldInt16NPtr:
 		return opStructEndInt16NPtr
 	case opStructFieldOmitEmptyInt16NPtr:
 		return opStructEndOmitEmptyInt16NPtr
 	case opStructFieldStringTagInt16NPtr:
 		return opStructEndStringTagInt16NPtr
+	case opStructFieldOmitEmptyStringTagInt16NPtr:
+		return opStructEndOmitEmptyStringTagInt16NPtr
 	case opStructFieldInt32NPtr:
 		return opStructEndInt32NPtr
 	case opStructFieldOmitEmptyInt32NPtr:
 		return opStructEndOmitEmptyInt32NPtr
 	case opStructFieldStringTagInt32NPtr:
 		return opStructEndStringTagInt32NPtr
+	case opStructFieldOmitEmptyStringTagInt32NPtr:
+		return opStructEndOmitEmptyStringTagInt32NPtr
 	case opStructFieldInt64NPtr:
 		return opStructEndInt64NPtr
 	case opStructFieldOmitEmptyInt64NPtr:
 		return opStructEndOmitEmptyInt64NPtr
 	case opStructFieldStringTagInt64NPtr:
 		return opStructEndStringTagInt64NPtr
+	case opStructFieldOmitEmptyStringTagInt64NPtr:
+		return opStructEndOmitEmptyStringTagInt64NPtr
 	case opStructFieldUintNPtr:
 		return opStructEndUintNPtr
 	case opStructFieldOmitEmptyUintNPtr:
 		return opStructEndOmitEmptyUintNPtr
 	case opStructFieldStringTagUintNPtr:
 		return opStructEndStringTagUintNPtr
+	case opStructFieldOmitEmptyStringTagUintNPtr:
+		return opStructEndOmitEmptyStringTagUintNPtr
 	case opStructFieldUint8NPtr:
 		return opStructEndUint8NPtr
 	case opStructFieldOmitEmptyUint8NPtr:
 		return opStructEndOmitEmptyUint8NPtr
 	case opStructFieldStringTagUint8NPtr:
 		return opStructEndStringTagUint8NPtr
+	case opStructFieldOmitEmptyStringTagUint8NPtr:
+		return opStructEndOmitEmptyStringTagUint8NPtr
 	case opStructFieldUint16NPtr:
 		return opStructEndUint16NPtr
 	case opStructFieldOmitEmptyUint16NPtr:
 		return opStructEndOmitEmptyUint16NPtr
 	case opStructFieldStringTagUint16NPtr:
 		return opStructEndStringTagUint16NPtr
+	case opStructFieldOmitEmptyStringTagUint16NPtr:
+		return opStructEndOmitEmptyStringTagUint16NPtr
 	case opStructFieldUint32NPtr:
 		return opStructEndUint32NPtr
 	case opStructFieldOmitEmptyUint32NPtr:
 		return opStructEndOmitEmptyUint32NPtr
 	case opStructFieldStringTagUint32NPtr:
 		return opStructEndStringTagUint32NPtr
+	case opStructFieldOmitEmptyStringTagUint32NPtr:
+		return opStructEndOmitEmptyStringTagUint32NPtr
 	case opStructFieldUint64NPtr:
 		return opStructEndUint64NPtr
 	case opStructFieldOmitEmptyUint64NPtr:
 		return opStructEndOmitEmptyUint64NPtr
 	case opStructFieldStringTagUint64NPtr:
 		return opStructEndStringTagUint64NPtr
+	case opStructFieldOmitEmptyStringTagUint64NPtr:
+		return opStructEndOmitEmptyStringTagUint64NPtr
 	case opStructFieldFloat32NPtr:
 		return opStructEndFloat32NPtr
 	case opStructFieldOmitEmptyFloat32NPtr:
 		return opStructEndOmitEmptyFloat32NPtr
 	case opStructFieldStringTagFloat32NPtr:
 		return opStructEndStringTagFloat32NPtr
+	case opStructFieldOmitEmptyStringTagFloat32NPtr:
+		return opStructEndOmitEmptyStringTagFloat32NPtr
 	case opStructFieldFloat64NPtr:
 		return opStructEndFloat64NPtr
 	case opStructFieldOmitEmptyFloat64NPtr:
 		return opStructEndOmitEmptyFloat64NPtr
 	case opStructFieldStringTagFloat64NPtr:
 		return opStructEndStringTagFloat64NPtr
+	case opStructFieldOmitEmptyStringTagFloat64NPtr:
+		return opStructEndOmitEmptyStringTagFloat64NPtr
 	case opStructFieldBoolNPtr:
 		return opStructEndBoolNPtr
 	case opStructFieldOmitEmptyBoolNPtr:
 		return opStructEndOmitEmptyBoolNPtr
 	case opStructFieldStringTagBoolNPtr:
 		return opStructEndStringTagBoolNPtr
+	case opStructFieldOmitEmptyStringTagBoolNPtr:
+		return opStructEndOmitEmptyStringTagBoolNPtr
 	case opStructFieldStringNPtr:
 		return opStructEndStringNPtr
 	case opStructFieldOmitEmptyStringNPtr:
 		return opStructEndOmitEmptyStringNPtr
 	case opStructFieldStringTagStringNPtr:
 		return opStructEndStringTagStringNPtr
+	case opStructFieldOmitEmptyStringTagStringNPtr:
+		return opStructEndOmitEmptyStringTagStringNPtr
 	case opStructFieldBytesNPtr:
 		return opStructEndBytesNPtr
 	case opStructFieldOmitEmptyBytesNPtr:
 		return opStructEndOmitEmptyBytesNPtr
 	case opStructFieldStringTagBytesNPtr:
 		return opStructEndStringTagBytesNPtr
+	case opStructFieldOmitEmptyStringTagBytesNPtr:
+		return opStructEndOmitEmptyStringTagBytesNPtr
 	}
 	return t
 }
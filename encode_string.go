@@ -375,6 +375,45 @@ func escapeIndex(s string) int {
 	return -1
 }
 
+// htmlEscapeStop is the set of the bytes at which HTMLEscape stops in a string literal.
+// 0xE2 is the first byte of U+2028 and U+2029 in UTF-8.
+var htmlEscapeStop = [256]bool{
+	'"':  true,
+	'\\': true,
+	'<':  true,
+	'>':  true,
+	'&':  true,
+	0xE2: true,
+}
+
+// htmlEscapeIndex finds the index of the first byte in `s` which is in htmlEscapeStop.
+// If no bytes in `s` are in it, the return value is -1.
+func htmlEscapeIndex(s string) int {
+	chunks := stringToUint64Slice(s)
+	for k, n := range chunks {
+		// the masks are only exact for ASCII bytes, so the bytes from the first
+		// candidate in the chunk are checked with htmlEscapeStop.
+		mask := n | contains(n, '"') | contains(n, '\\') | contains(n, '<') | contains(n, '>') | contains(n, '&')
+		if (mask & msb) == 0 {
+			continue
+		}
+		for i := k*8 + bits.TrailingZeros64(mask&msb)/8; i < (k+1)*8; i++ {
+			if htmlEscapeStop[s[i]] {
+				return i
+			}
+		}
+	}
+
+	valLen := len(s)
+	for i := len(chunks) * 8; i < valLen; i++ {
+		if htmlEscapeStop[s[i]] {
+			return i
+		}
+	}
+
+	return -1
+}
+
 // below return a mask that can be used to determine if any of the bytes
 // in `n` are below `b`. If a byte's MSB is set in the mask then that byte was
 // below `b`. The result is only valid if `b`, and each byte in `n`, is below
@@ -635,3 +674,12 @@ func encodeNoEscapedString(buf []byte, s string) []byte {
 
 	return append(append(buf, s[i:]...), '"')
 }
+
+// encodeNoEscapedStringWithOption is like encodeNoEscapedString but escapes s like the canonical form does
+// for EncodeOptionCanonical.
+func encodeNoEscapedStringWithOption(buf []byte, s string, opt EncodeOption) []byte {
+	if opt&EncodeOptionCanonical != 0 {
+		return appendCanonicalString(buf, s)
+	}
+	return encodeNoEscapedString(buf, s)
+}
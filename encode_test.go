@@ -2,11 +2,14 @@ package json_test
 
 import (
 	"bytes"
+	"context"
 	"encoding"
+	stdjson "encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"math"
+	"math/big"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -546,9 +549,87 @@ func Test_MarshalIndent(t *testing.T) {
 			result := "{\n-\t\"a\": 1,\n-\t\"b\": 2.1,\n-\t\"c\": {\n-\t\t\"E\": 10,\n-\t\t\"F\": 11\n-\t},\n-\t\"d\": 4\n-}"
 			assertEq(t, "map[string]interface{}", result, string(bytes))
 		})
+		t.Run("omitempty field", func(t *testing.T) {
+			type T struct {
+				A int               `json:"a"`
+				M map[string]string `json:"m,omitempty"`
+				B int               `json:"b"`
+			}
+			bytes, err := json.MarshalIndent(T{M: map[string]string{"x": "y"}}, prefix, indent)
+			assertErr(t, err)
+			result := "{\n-\t\"a\": 0,\n-\t\"m\": {\n-\t\t\"x\": \"y\"\n-\t},\n-\t\"b\": 0\n-}"
+			assertEq(t, "omitempty field", result, string(bytes))
+		})
 	})
 }
 
+func TestMarshalIndentEmptyValues(t *testing.T) {
+	type P struct {
+		X int `json:"x"`
+	}
+	type T struct {
+		P *P `json:"p,omitempty"`
+	}
+	tests := []struct {
+		name     string
+		v        interface{}
+		before   string // output when empty slices, empty maps and struct heads were indented twice
+		expected string
+	}{
+		{
+			name:     "empty map",
+			v:        map[string]int{},
+			before:   " {}",
+			expected: "{}",
+		},
+		{
+			name:     "empty map element",
+			v:        []map[string]int{{}},
+			before:   "[\n   {}\n]",
+			expected: "[\n {}\n]",
+		},
+		{
+			name:     "empty slice element",
+			v:        [][]int{{}, {1}},
+			before:   "[\n  [],\n [\n  1\n ]\n]",
+			expected: "[\n [],\n [\n  1\n ]\n]",
+		},
+		{
+			name:     "empty values in interfaces",
+			v:        []interface{}{map[string]int{}, []int{}},
+			before:   "[\n   {},\n  []\n]",
+			expected: "[\n {},\n []\n]",
+		},
+		{
+			name:     "omitempty struct head",
+			v:        []T{{}, {P: &P{}}},
+			before:   "[\n  {},\n  {\n  \"p\": {\n   \"x\": 0\n  }\n }\n]",
+			expected: "[\n {},\n {\n  \"p\": {\n   \"x\": 0\n  }\n }\n]",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.MarshalIndent(test.v, "", " ")
+			assertErr(t, err)
+			assertEq(t, "escaped", test.expected, string(got))
+
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", " ")
+			assertErr(t, enc.Encode(test.v))
+			assertEq(t, "not escaped", test.expected+"\n", buf.String())
+
+			if test.before == test.expected {
+				t.Fatal("before must be the previous output")
+			}
+			expected, err := stdjson.MarshalIndent(test.v, "", " ")
+			assertErr(t, err)
+			assertEq(t, "encoding/json", string(expected), string(got))
+		})
+	}
+}
+
 type StringTag struct {
 	BoolStr    bool        `json:",string"`
 	IntStr     int64       `json:",string"`
@@ -1286,6 +1367,45 @@ func TestHTMLEscape(t *testing.T) {
 	}
 }
 
+func TestHTMLEscapePreservesInput(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{
+			in:   `{ "z" : 1.50 , "a":[ 1e3 , "x" ] }`,
+			want: `{ "z" : 1.50 , "a":[ 1e3 , "x" ] }`,
+		},
+		{
+			in:   `{"a\"<":"\\<b>&amp;</b>\"&"}`,
+			want: `{"a\"\u003c":"\\\u003cb\u003e\u0026amp;\u003c/b\u003e\"\u0026"}`,
+		},
+		{
+			in:   `<>& ["long string with markup: <script>alert('x & y')</script>"] <>&`,
+			want: `<>& ["long string with markup: \u003cscript\u003ealert('x \u0026 y')\u003c/script\u003e"] <>&`,
+		},
+		{
+			in:   "[\"\u00e9\u00e9\u00e9\u00e9 \u20ac\u2027\u2028\u2029\u202a\", \u2028]",
+			want: "[\"\u00e9\u00e9\u00e9\u00e9 \u20ac\u2027\\u2028\\u2029\u202a\", \u2028]",
+		},
+		{
+			in:   "[\"<\xe2\x80",
+			want: "[\"\\u003c\xe2\x80",
+		},
+		{
+			in:   `{"invalid": <>`,
+			want: `{"invalid": <>`,
+		},
+	}
+	for _, tt := range tests {
+		var b bytes.Buffer
+		b.WriteString("dst:")
+		json.HTMLEscape(&b, []byte(tt.in))
+		if got := b.String(); got != "dst:"+tt.want {
+			t.Errorf("HTMLEscape(%q) = %q; want %q", tt.in, got, "dst:"+tt.want)
+		}
+	}
+}
+
 type BugA struct {
 	S string
 }
@@ -1674,3 +1794,887 @@ func TestOmitEmpty(t *testing.T) {
 		t.Errorf(" got: %s\nwant: %s\n", got, optionalsExpected)
 	}
 }
+
+func TestOmitEmptyString(t *testing.T) {
+	type T struct {
+		I  int     `json:"i,omitempty,string"`
+		U  uint8   `json:"u,string,omitempty"`
+		F  float64 `json:"f,omitempty,string"`
+		B  bool    `json:"b,string,omitempty"`
+		S  string  `json:"s,omitempty,string"`
+		SP *string `json:"sp,omitempty,string"`
+		IP *int    `json:"ip,string,omitempty"`
+	}
+	type C struct {
+		A  int
+		F  []int           `json:"f,omitempty,string"`
+		G  [1]int          `json:"g,omitempty,string"`
+		N  json.Number     `json:"n,string,omitempty"`
+		M  map[string]int  `json:"m,omitempty,string"`
+		S  struct{ X int } `json:"s,omitempty,string"`
+		N2 json.Number     `json:"n2,omitempty,string"`
+	}
+	i := 3
+	s := "y"
+	tests := []struct {
+		name     string
+		data     interface{}
+		expected string
+	}{
+		{
+			name:     "zero",
+			data:     T{},
+			expected: `{}`,
+		},
+		{
+			name:     "non zero",
+			data:     T{I: -1, U: 2, F: 1.5, B: true, S: "x", IP: &i, SP: &s},
+			expected: `{"i":"-1","u":"2","f":"1.5","b":"true","s":"\"x\"","sp":"\"y\"","ip":"3"}`,
+		},
+		{
+			name:     "head zero",
+			data:     T{S: "x"},
+			expected: `{"s":"\"x\""}`,
+		},
+		{
+			name:     "composite",
+			data:     C{A: 1, F: []int{1}, G: [1]int{2}, N: "12", M: map[string]int{"a": 1}, S: struct{ X int }{3}, N2: "4"},
+			expected: `{"A":1,"f":[1],"g":[2],"n":"12","m":{"a":1},"s":{"X":3},"n2":"4"}`,
+		},
+		{
+			name:     "composite nil",
+			data:     C{A: 1, G: [1]int{2}, N: "12", S: struct{ X int }{3}, N2: "4"},
+			expected: `{"A":1,"g":[2],"n":"12","s":{"X":3},"n2":"4"}`,
+		},
+	}
+	for _, test := range tests {
+		for _, htmlEscape := range []bool{true, false} {
+			for _, indent := range []bool{true, false} {
+				var buf bytes.Buffer
+				enc := json.NewEncoder(&buf)
+				enc.SetEscapeHTML(htmlEscape)
+				if indent {
+					enc.SetIndent("", "")
+				}
+				if err := enc.Encode(test.data); err != nil {
+					t.Fatalf("%s(htmlEscape:%v,indent:%v): %v", test.name, htmlEscape, indent, err)
+				}
+				var got bytes.Buffer
+				if err := json.Compact(&got, buf.Bytes()); err != nil {
+					t.Fatal(err)
+				}
+				if got.String() != test.expected {
+					t.Errorf("%s(htmlEscape:%v,indent:%v): got %s, want %s", test.name, htmlEscape, indent, got.String(), test.expected)
+				}
+			}
+		}
+	}
+
+	var v T
+	if err := json.Unmarshal([]byte(`{"i":"-1","u":"2","f":"1.5","b":"true","s":"\"x\"","ip":"3"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.I != -1 || v.U != 2 || v.F != 1.5 || !v.B || v.S != "x" || v.IP == nil || *v.IP != 3 {
+		t.Errorf("unexpected decoded value %+v", v)
+	}
+}
+
+func TestEncodeNonFiniteFloat(t *testing.T) {
+	type T struct {
+		A float64
+		B float32
+		C float64 `json:",string"`
+		D float64 `json:",omitempty"`
+	}
+	inf := math.Inf(1)
+	v := T{A: math.NaN(), B: float32(inf), C: -inf, D: inf}
+	t.Run("error", func(t *testing.T) {
+		for _, v := range []interface{}{v, float32(inf), []float64{math.NaN()}} {
+			_, err := json.Marshal(v)
+			var unsupported *json.UnsupportedValueError
+			if !errors.As(err, &unsupported) {
+				t.Fatalf("expected UnsupportedValueError but got %v", err)
+			}
+		}
+	})
+	tests := []struct {
+		name     string
+		opt      json.EncodeOptionFunc
+		expected string
+	}{
+		{"null", json.NonFiniteFloatAsNull(), `{"A":null,"B":null,"C":null,"D":null}`},
+		{"string", json.NonFiniteFloatAsString(), `{"A":"NaN","B":"Infinity","C":"-Infinity","D":"Infinity"}`},
+		{"clamp", json.ClampNonFiniteFloat(), `{"A":0,"B":3.4028235e+38,"C":"-1.7976931348623157e+308","D":1.7976931348623157e+308}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.MarshalWithOption(v, test.opt)
+			assertErr(t, err)
+			assertEq(t, "marshal", test.expected, string(got))
+
+			got, err = json.MarshalWithOption(&v, test.opt)
+			assertErr(t, err)
+			assertEq(t, "marshal pointer", test.expected, string(got))
+
+			got, err = json.MarshalIndentWithOption(v, "", "", test.opt)
+			assertErr(t, err)
+			var buf bytes.Buffer
+			assertErr(t, json.Compact(&buf, got))
+			assertEq(t, "marshal indent", test.expected, buf.String())
+		})
+	}
+}
+
+type keyNamingEmbedded struct {
+	LastName string
+}
+
+type keyNamingT struct {
+	UserID     int
+	HTTPServer string
+	Name       string `json:"NAME"`
+	First_Name string
+	keyNamingEmbedded
+}
+
+func TestEncodeKeyNaming(t *testing.T) {
+	v := keyNamingT{UserID: 1, HTTPServer: "a", Name: "b", First_Name: "c", keyNamingEmbedded: keyNamingEmbedded{LastName: "d"}}
+	tests := []struct {
+		name     string
+		opt      json.EncodeOptionFunc
+		expected string
+	}{
+		{"snake case", json.SnakeCaseKeys(), `{"user_id":1,"http_server":"a","NAME":"b","first_name":"c","last_name":"d"}`},
+		{"camel case", json.CamelCaseKeys(), `{"userId":1,"httpServer":"a","NAME":"b","firstName":"c","lastName":"d"}`},
+		{"kebab case", json.KebabCaseKeys(), `{"user-id":1,"http-server":"a","NAME":"b","first-name":"c","last-name":"d"}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.MarshalWithOption(v, test.opt)
+			assertErr(t, err)
+			assertEq(t, "marshal", test.expected, string(got))
+
+			got, err = json.MarshalWithOption([]interface{}{v}, test.opt)
+			assertErr(t, err)
+			assertEq(t, "marshal interface", "["+test.expected+"]", string(got))
+
+			var buf bytes.Buffer
+			assertErr(t, json.NewEncoder(&buf).EncodeWithOption(&v, test.opt))
+			assertEq(t, "encoder", test.expected+"\n", buf.String())
+		})
+	}
+	got, err := json.Marshal(v)
+	assertErr(t, err)
+	assertEq(t, "default", `{"UserID":1,"HTTPServer":"a","NAME":"b","First_Name":"c","LastName":"d"}`, string(got))
+}
+
+func TestEncodeCamelCaseKeysMultiByte(t *testing.T) {
+	v := struct {
+		Size_éclair int
+	}{Size_éclair: 1}
+	got, err := json.MarshalWithOption(v, json.CamelCaseKeys())
+	assertErr(t, err)
+	assertEq(t, "marshal", `{"sizeÉclair":1}`, string(got))
+}
+
+type fieldsOwner struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type fieldsItem struct {
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+}
+
+type fieldsEmbedded struct {
+	Version int `json:"version"`
+}
+
+type fieldsT struct {
+	ID    int            `json:"id"`
+	Name  string         `json:"name"`
+	Owner *fieldsOwner   `json:"owner"`
+	Items []fieldsItem   `json:"items"`
+	Attrs map[string]int `json:"attrs"`
+	Extra interface{}    `json:"extra"`
+	fieldsEmbedded
+}
+
+func TestEncodeFields(t *testing.T) {
+	v := fieldsT{
+		ID:             1,
+		Name:           "a",
+		Owner:          &fieldsOwner{Name: "b", Email: "c"},
+		Items:          []fieldsItem{{ID: 2, Label: "d"}, {ID: 3, Label: "e"}},
+		Attrs:          map[string]int{"x": 4, "y": 5, "z": 6},
+		Extra:          fieldsItem{ID: 7, Label: "f"},
+		fieldsEmbedded: fieldsEmbedded{Version: 8},
+	}
+	tests := []struct {
+		name     string
+		paths    []string
+		expected string
+	}{
+		{"struct fields", []string{"id", "name", "owner.email"}, `{"id":1,"name":"a","owner":{"email":"c"}}`},
+		{"whole value", []string{"owner", "owner.email"}, `{"owner":{"name":"b","email":"c"}}`},
+		{"slice elements", []string{"items.id"}, `{"items":[{"id":2},{"id":3}]}`},
+		{"map keys", []string{"id", "attrs.x", "attrs.z"}, `{"id":1,"attrs":{"x":4,"z":6}}`},
+		{"interface value", []string{"extra.label"}, `{"extra":{"label":"f"}}`},
+		{"embedded struct", []string{"version", "id"}, `{"id":1,"version":8}`},
+		{"unknown keys", []string{"unknown", "owner.unknown"}, `{"owner":{}}`},
+		{"nothing", nil, `{}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mask := json.NewFieldMask(test.paths...)
+			for i := 0; i < 2; i++ {
+				got, err := json.MarshalWithFieldMask(&v, mask)
+				assertErr(t, err)
+				assertEq(t, "marshal", test.expected, string(got))
+			}
+
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetFieldMask(mask)
+			assertErr(t, enc.Encode(v))
+			assertEq(t, "encoder", test.expected+"\n", buf.String())
+		})
+	}
+	t.Run("map", func(t *testing.T) {
+		m := map[string]interface{}{"a": 1, "b": fieldsOwner{Name: "c", Email: "d"}, "e": 2}
+		got, err := json.MarshalWithFieldMask(m, json.NewFieldMask("a", "e"))
+		assertErr(t, err)
+		assertEq(t, "sorted", `{"a":1,"e":2}`, string(got))
+
+		got, err = json.MarshalWithFieldMask(m, json.NewFieldMask("b.email"))
+		assertErr(t, err)
+		assertEq(t, "value", `{"b":{"email":"d"}}`, string(got))
+
+		got, err = json.MarshalWithFieldMask(m, json.NewFieldMask("b.name"), json.UnorderedMap())
+		assertErr(t, err)
+		assertEq(t, "unordered", `{"b":{"name":"c"}}`, string(got))
+
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", " ")
+		enc.SetFieldMask(json.NewFieldMask("e"))
+		assertErr(t, enc.Encode(m))
+		assertEq(t, "indent", "{\n \"e\": 2\n}\n", buf.String())
+	})
+	t.Run("encoder", func(t *testing.T) {
+		m := map[string]int{"a": 1, "b": 2}
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetFieldMask(json.NewFieldMask("a"))
+		assertErr(t, enc.Encode(m))
+		enc.SetFieldMask(nil)
+		assertErr(t, enc.Encode(m))
+		assertEq(t, "reset", "{\"a\":1}\n{\"a\":1,\"b\":2}\n", buf.String())
+	})
+	got, err := json.Marshal(v.Owner)
+	assertErr(t, err)
+	assertEq(t, "without mask", `{"name":"b","email":"c"}`, string(got))
+}
+
+func TestEncodeCanonical(t *testing.T) {
+	v := struct {
+		Z   float64            `json:"z"`
+		A   string             `json:"a"`
+		M   map[string]float64 `json:"m"`
+		Emb struct {
+			Y bool `json:"y"`
+			X int  `json:"x"`
+		} `json:"emb"`
+	}{
+		Z: 1e21,
+		A: "<\u2028>",
+		M: map[string]float64{"\u00f6": 0.1, "\r": 1e-7, "1": -0.0},
+	}
+	v.Emb.Y = true
+	v.Emb.X = 2
+	expected := `{"a":"<` + "\u2028" + `>","emb":{"x":2,"y":true},"m":{"\r":1e-7,"1":0,"ö":0.1},"z":1e+21}`
+
+	got, err := json.MarshalWithOption(v, json.Canonical())
+	assertErr(t, err)
+	assertEq(t, "marshal", expected, string(got))
+
+	got, err = json.MarshalIndentWithOption(v, "", "  ", json.Canonical())
+	assertErr(t, err)
+	assertEq(t, "marshal indent", expected, string(got))
+
+	got, err = json.MarshalNoEscape(v, json.Canonical())
+	assertErr(t, err)
+	assertEq(t, "marshal no escape", expected, string(got))
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	enc.SetChunkSize(8)
+	assertErr(t, enc.EncodeWithOption(&v, json.Canonical()))
+	assertEq(t, "encoder", expected+"\n", buf.String())
+}
+
+type canonicalEmbedded struct {
+	B int    `json:"b"`
+	D string `json:"d"`
+}
+
+type canonicalMarshaler struct{}
+
+func (canonicalMarshaler) MarshalJSON() ([]byte, error) {
+	return []byte(`{ "y" : 1.50, "x" : [ 1E2 ] }`), nil
+}
+
+func TestEncodeCanonicalValues(t *testing.T) {
+	type item struct {
+		E string `json:"e"`
+		*canonicalEmbedded
+		A float32            `json:"a"`
+		C int64              `json:"c"`
+		F uint64             `json:"f"`
+		G canonicalMarshaler `json:"g"`
+		H json.RawMessage    `json:"h"`
+		S float64            `json:"s,string"`
+		I []interface{}      `json:"i"`
+	}
+	v := item{
+		E:                 "\b\f\u2028<",
+		canonicalEmbedded: &canonicalEmbedded{B: 1, D: "d"},
+		A:                 0.1,
+		C:                 9007199254740993,
+		F:                 math.MaxUint64,
+		H:                 json.RawMessage(`{"b":2,"a":1}`),
+		S:                 1e-7,
+		I:                 []interface{}{1e21, struct{ Z, Y int }{}},
+	}
+	expected := `{"a":0.1,"b":1,"c":9007199254740992,"d":"d","e":"\b\f` + "\u2028" + `<",` +
+		`"f":18446744073709552000,"g":{"x":[100],"y":1.5},"h":{"a":1,"b":2},"i":[1e+21,{"Y":0,"Z":0}],"s":"1e-07"}`
+
+	got, err := json.MarshalWithOption(v, json.Canonical())
+	assertErr(t, err)
+	assertEq(t, "marshal", expected, string(got))
+
+	v.canonicalEmbedded = nil
+	got, err = json.MarshalWithOption(&v, json.Canonical())
+	assertErr(t, err)
+	assertEq(t, "nil embedded", `{"a":0.1,"c":9007199254740992,"e":"\b\f`+"\u2028"+`<",`+
+		`"f":18446744073709552000,"g":{"x":[100],"y":1.5},"h":{"a":1,"b":2},"i":[1e+21,{"Y":0,"Z":0}],"s":"1e-07"}`, string(got))
+
+	t.Run("recursive", func(t *testing.T) {
+		type node struct {
+			Z []node `json:"z"`
+			canonicalEmbedded
+		}
+		got, err := json.MarshalWithOption(&node{Z: []node{{canonicalEmbedded: canonicalEmbedded{B: 2}}}}, json.Canonical())
+		assertErr(t, err)
+		assertEq(t, "recursive", `{"b":0,"d":"","z":[{"b":2,"d":"","z":null}]}`, string(got))
+	})
+	t.Run("chunk size", func(t *testing.T) {
+		items := make([]item, 100)
+		for i := range items {
+			items[i] = item{E: "e", canonicalEmbedded: &canonicalEmbedded{B: i}, C: int64(i)}
+		}
+		expected, err := json.MarshalWithOption(items, json.Canonical())
+		assertErr(t, err)
+
+		var w chunkWriter
+		enc := json.NewEncoder(&w)
+		enc.SetChunkSize(256)
+		assertErr(t, enc.EncodeWithOption(items, json.Canonical()))
+		assertEq(t, "chunked", string(expected)+"\n", w.buf.String())
+		if w.writes < len(expected)/512 {
+			t.Fatalf("expected the encoding to be written in chunks but got %d writes", w.writes)
+		}
+	})
+}
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{
+			name: "rfc 8785 example",
+			src: `{
+  "numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+  "string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+  "literals": [null, true, false]
+}`,
+			expected: `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+		},
+		{
+			name:     "utf-16 key order",
+			src:      `{"\u20ac":1,"\r":2,"\ufb33":3,"1":4,"\ud83d\ude00":5,"\u0080":6,"\u00f6":7}`,
+			expected: "{\"\\r\":2,\"1\":4,\"\u0080\":6,\"ö\":7,\"€\":1,\"😀\":5,\"\ufb33\":3}",
+		},
+		{"integers", `[-0, 9007199254740993, 1e21, 123456789012345678901, -1.5e-7]`, `[0,9007199254740992,1e+21,123456789012345680000,-1.5e-7]`},
+		{"empty", ` { "a" : [ ] , "b" : { } } `, `{"a":[],"b":{}}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.Canonicalize([]byte(test.src))
+			assertErr(t, err)
+			assertEq(t, "canonical", test.expected, string(got))
+		})
+	}
+	for _, src := range []string{
+		`{"a":1,"a":2}`,
+		`"\ud83d"`,
+		`"\udead\u0041"`,
+		"\"\xff\"",
+		"\"\x01\"",
+		`[1,]`,
+		`{"a" 1}`,
+		`01`,
+		`1.`,
+		`1e400`,
+		`tru`,
+		`{} {}`,
+		``,
+	} {
+		if _, err := json.Canonicalize([]byte(src)); err == nil {
+			t.Errorf("expected error for %q", src)
+		} else if _, ok := err.(*json.SyntaxError); !ok {
+			t.Errorf("expected SyntaxError for %q but got %T", src, err)
+		}
+	}
+	t.Run("duplicate key", func(t *testing.T) {
+		_, err := json.Canonicalize([]byte(`{"b":1,"a":2,"c":3,"a":4,"b":5}`))
+		serr, ok := err.(*json.SyntaxError)
+		if !ok {
+			t.Fatalf("expected SyntaxError but got %v", err)
+		}
+		assertEq(t, "error", `json: duplicate object key "a"`, serr.Error())
+		assertEq(t, "offset", int64(19), serr.Offset)
+
+		// the keys are checked in O(n log n)
+		var buf bytes.Buffer
+		buf.WriteByte('{')
+		for i := 0; i < 200000; i++ {
+			fmt.Fprintf(&buf, `"k%d":0,`, i)
+		}
+		buf.WriteString(`"k0":0}`)
+		_, err = json.Canonicalize(buf.Bytes())
+		if _, ok := err.(*json.SyntaxError); !ok {
+			t.Fatalf("expected SyntaxError but got %v", err)
+		}
+	})
+}
+
+type omitZeroPoint struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type omitZeroFlag struct{ set bool }
+
+func (f omitZeroFlag) IsZero() bool { return !f.set }
+
+type omitZeroCounter struct {
+	N int `json:"n"`
+}
+
+// IsZero treats negative counts as unset.
+func (c *omitZeroCounter) IsZero() bool { return c.N < 0 }
+
+type omitZeroT struct {
+	ID      int             `json:"id,omitzero"`
+	Time    time.Time       `json:"time,omitzero"`
+	Point   omitZeroPoint   `json:"point,omitzero"`
+	Arr     [2]int          `json:"arr,omitzero"`
+	Slice   []int           `json:"slice,omitzero"`
+	Map     map[string]int  `json:"map,omitzero"`
+	Flag    omitZeroFlag    `json:"flag,omitzero"`
+	Counter omitZeroCounter `json:"counter,omitzero"`
+	TimePtr *time.Time      `json:"timePtr,omitzero"`
+	Str     string          `json:"str,omitzero,string"`
+}
+
+func TestEncodeOmitZero(t *testing.T) {
+	date := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name     string
+		v        omitZeroT
+		expected string
+	}{
+		{"zero value", omitZeroT{}, `{"counter":{"n":0}}`},
+		{"zero by IsZero", omitZeroT{Counter: omitZeroCounter{N: -1}, TimePtr: &time.Time{}}, `{}`},
+		{
+			"non-zero",
+			omitZeroT{
+				ID:      1,
+				Time:    date,
+				Point:   omitZeroPoint{Y: 2},
+				Arr:     [2]int{0, 3},
+				Slice:   []int{},
+				Map:     map[string]int{},
+				Flag:    omitZeroFlag{set: true},
+				Counter: omitZeroCounter{N: 4},
+				TimePtr: &date,
+				Str:     "a",
+			},
+			`{"id":1,"time":"2020-01-02T03:04:05Z","point":{"x":0,"y":2},"arr":[0,3],"slice":[],"map":{},"flag":{},"counter":{"n":4},"timePtr":"2020-01-02T03:04:05Z","str":"\"a\""}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.Marshal(test.v)
+			assertErr(t, err)
+			assertEq(t, "value", test.expected, string(got))
+
+			got, err = json.Marshal(&test.v)
+			assertErr(t, err)
+			assertEq(t, "pointer", test.expected, string(got))
+
+			got, err = json.Marshal([]interface{}{test.v})
+			assertErr(t, err)
+			assertEq(t, "interface", "["+test.expected+"]", string(got))
+		})
+	}
+	t.Run("indent", func(t *testing.T) {
+		v := struct {
+			Point omitZeroPoint `json:"point,omitzero"`
+			Time  time.Time     `json:"time,omitzero"`
+			Flag  omitZeroFlag  `json:"flag,omitzero"`
+		}{Time: date}
+		got, err := json.MarshalIndent(v, "", " ")
+		assertErr(t, err)
+		assertEq(t, "indent", "{\n \"time\": \"2020-01-02T03:04:05Z\"\n}", string(got))
+
+		got, err = json.MarshalIndent([]struct {
+			Point omitZeroPoint `json:"point,omitzero"`
+		}{{}, {Point: omitZeroPoint{X: 1}}}, "", " ")
+		assertErr(t, err)
+		assertEq(t, "slice", "[\n {},\n {\n  \"point\": {\n   \"x\": 1,\n   \"y\": 0\n  }\n }\n]", string(got))
+
+		got, err = json.MarshalIndent(struct {
+			A int            `json:"a"`
+			M map[string]int `json:"m,omitzero"`
+		}{M: map[string]int{}}, "", " ")
+		assertErr(t, err)
+		assertEq(t, "empty map", "{\n \"a\": 0,\n \"m\": {}\n}", string(got))
+
+		got, err = json.MarshalIndent(struct {
+			M map[string]int `json:"m,omitzero"`
+		}{M: map[string]int{}}, "", " ")
+		assertErr(t, err)
+		assertEq(t, "only empty map", "{\n \"m\": {}\n}", string(got))
+	})
+	t.Run("scalar and pointer fields", func(t *testing.T) {
+		type T struct {
+			A int
+			P *int        `json:",omitzero"`
+			F float64     `json:",omitzero"`
+			S string      `json:",omitzero"`
+			I interface{} `json:",omitzero"`
+			B int
+		}
+		got, err := json.Marshal(T{A: 1, B: 2})
+		assertErr(t, err)
+		assertEq(t, "zero", `{"A":1,"B":2}`, string(got))
+
+		// like reflect.Value.IsZero, negative zero isn't zero
+		got, err = json.Marshal(T{A: 1, F: math.Copysign(0, -1), B: 2})
+		assertErr(t, err)
+		assertEq(t, "negative zero", `{"A":1,"F":-0,"B":2}`, string(got))
+
+		got, err = json.Marshal(struct {
+			F float32 `json:",omitzero"`
+		}{F: float32(math.Copysign(0, -1))})
+		assertErr(t, err)
+		assertEq(t, "negative zero float32", `{"F":-0}`, string(got))
+
+		n := 0
+		got, err = json.Marshal(&T{A: 1, P: &n, F: 0.5, S: "s", I: 0, B: 2})
+		assertErr(t, err)
+		assertEq(t, "non-zero", `{"A":1,"P":0,"F":0.5,"S":"s","I":0,"B":2}`, string(got))
+	})
+	t.Run("only field stored in the interface word", func(t *testing.T) {
+		type M struct {
+			M map[string]int `json:"m,omitzero"`
+		}
+		type P struct {
+			A *omitZeroFlag `json:"a,omitzero"`
+		}
+		type C struct {
+			C *omitZeroCounter `json:"c,omitzero"`
+		}
+		type R struct {
+			N *R `json:"n,omitzero"`
+		}
+		tests := []struct {
+			name     string
+			v        interface{}
+			expected string
+		}{
+			{"map", M{M: map[string]int{"a": 1}}, `{"m":{"a":1}}`},
+			{"empty map", M{M: map[string]int{}}, `{"m":{}}`},
+			{"nil map", M{}, `{}`},
+			{"pointer", P{A: &omitZeroFlag{set: true}}, `{"a":{}}`},
+			{"zero by IsZero", P{A: &omitZeroFlag{}}, `{}`},
+			{"nil pointer", P{}, `{}`},
+			{"pointer receiver", C{C: &omitZeroCounter{N: 1}}, `{"c":{"n":1}}`},
+			{"zero by pointer receiver", C{C: &omitZeroCounter{N: -1}}, `{}`},
+			{"pointer to struct", &P{A: &omitZeroFlag{set: true}}, `{"a":{}}`},
+			{"pointer to struct zero by IsZero", &P{A: &omitZeroFlag{}}, `{}`},
+			{"pointer to struct nil pointer", &P{}, `{}`},
+			{"pointer to struct zero by pointer receiver", &C{C: &omitZeroCounter{N: -1}}, `{}`},
+			{"pointer to struct with map", &M{M: map[string]int{"a": 1}}, `{"m":{"a":1}}`},
+		}
+		for _, test := range tests {
+			got, err := json.Marshal(test.v)
+			assertErr(t, err)
+			assertEq(t, test.name, test.expected, string(got))
+
+			got, err = json.MarshalIndent(test.v, "", "")
+			assertErr(t, err)
+			var buf bytes.Buffer
+			assertErr(t, json.Compact(&buf, got))
+			assertEq(t, test.name+" indent", test.expected, buf.String())
+		}
+
+		got, err := json.Marshal(R{N: &R{N: &R{}}})
+		assertErr(t, err)
+		assertEq(t, "recursive", `{"n":{"n":{}}}`, string(got))
+
+		got, err = json.Marshal(R{})
+		assertErr(t, err)
+		assertEq(t, "nil recursive", `{}`, string(got))
+	})
+}
+
+type nilAsEmptyT struct {
+	ID    int               `json:"id"`
+	Tags  []string          `json:"tags,nonil"`
+	Attrs map[string]string `json:"attrs,nonil"`
+	Refs  *[]int            `json:"refs,nonil"`
+	Items []int             `json:"items"`
+	Meta  map[string]int    `json:"meta"`
+	Raw   []byte            `json:"raw,nonil"`
+}
+
+func TestEncodeNilAsEmpty(t *testing.T) {
+	t.Run("tag", func(t *testing.T) {
+		got, err := json.Marshal(nilAsEmptyT{})
+		assertErr(t, err)
+		assertEq(t, "value", `{"id":0,"tags":[],"attrs":{},"refs":null,"items":null,"meta":null,"raw":null}`, string(got))
+
+		refs := []int(nil)
+		got, err = json.Marshal(&nilAsEmptyT{Refs: &refs})
+		assertErr(t, err)
+		assertEq(t, "value", `{"id":0,"tags":[],"attrs":{},"refs":[],"items":null,"meta":null,"raw":null}`, string(got))
+	})
+	t.Run("option", func(t *testing.T) {
+		got, err := json.MarshalWithOption(nilAsEmptyT{}, json.NilAsEmpty())
+		assertErr(t, err)
+		assertEq(t, "value", `{"id":0,"tags":[],"attrs":{},"refs":null,"items":[],"meta":{},"raw":null}`, string(got))
+
+		got, err = json.MarshalWithOption([]int(nil), json.NilAsEmpty())
+		assertErr(t, err)
+		assertEq(t, "slice", `[]`, string(got))
+
+		got, err = json.MarshalWithOption(map[string]int(nil), json.NilAsEmpty())
+		assertErr(t, err)
+		assertEq(t, "map", `{}`, string(got))
+
+		got, err = json.MarshalWithOption(struct {
+			A [][]int `json:"a"`
+		}{A: [][]int{nil, {1}}}, json.NilAsEmpty())
+		assertErr(t, err)
+		assertEq(t, "nested", `{"a":[[],[1]]}`, string(got))
+	})
+	t.Run("indent", func(t *testing.T) {
+		got, err := json.MarshalIndent([]int(nil), "", "  ")
+		assertErr(t, err)
+		assertEq(t, "slice", `null`, string(got))
+
+		got, err = json.MarshalIndentWithOption([]int(nil), "", "  ", json.NilAsEmpty())
+		assertErr(t, err)
+		assertEq(t, "slice", `[]`, string(got))
+
+		got, err = json.MarshalIndent(struct {
+			ID    int               `json:"id"`
+			Tags  []string          `json:"tags,nonil"`
+			Attrs map[string]string `json:"attrs,nonil"`
+			Items []int             `json:"items"`
+			Meta  map[string]int    `json:"meta"`
+			Nums  []int             `json:"nums,nonil"`
+		}{Nums: []int{1}}, "", "  ")
+		assertErr(t, err)
+		assertEq(t, "struct", "{\n  \"id\": 0,\n  \"tags\": [],\n  \"attrs\": {},\n  \"items\": null,\n  \"meta\": null,\n  \"nums\": [\n    1\n  ]\n}", string(got))
+	})
+	t.Run("encoder", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		assertErr(t, enc.EncodeWithOption(nilAsEmptyT{}, json.NilAsEmpty()))
+		assertEq(t, "value", `{"id":0,"tags":[],"attrs":{},"refs":null,"items":[],"meta":{},"raw":null}`+"\n", buf.String())
+	})
+}
+
+type registeredID [2]byte
+
+// registeredRef is stored directly in the data word of an interface.
+type registeredRef struct{ p *int }
+
+func TestRegisterEncoder(t *testing.T) {
+	json.RegisterEncoder(reflect.TypeOf(registeredID{}), func(b []byte, v interface{}) ([]byte, error) {
+		id := v.(registeredID)
+		return append(b, fmt.Sprintf(`"%x"`, id[:])...), nil
+	})
+	json.RegisterEncoder(reflect.TypeOf(registeredRef{}), func(b []byte, v interface{}) ([]byte, error) {
+		ref := v.(registeredRef)
+		if ref.p == nil {
+			return append(b, `"none"`...), nil
+		}
+		return strconv.AppendInt(b, int64(*ref.p), 10), nil
+	})
+
+	n := 7
+	id := registeredID{1, 2}
+	ref := registeredRef{p: &n}
+	type T struct {
+		A registeredID
+		B *registeredID
+		C registeredRef
+		D *registeredRef
+		E *registeredID `json:",omitempty"`
+		F []registeredID
+		G map[string]registeredRef
+		H interface{}
+	}
+	tests := []struct {
+		name     string
+		data     interface{}
+		expected string
+	}{
+		{"value", id, `"0102"`},
+		{"pointer", &id, `"0102"`},
+		{"direct value", ref, `7`},
+		{"direct pointer", &ref, `7`},
+		{"direct zero value", registeredRef{}, `"none"`},
+		{"direct zero fields", struct {
+			C registeredRef
+			D *registeredRef
+		}{}, `{"C":"none","D":null}`},
+		{
+			name:     "fields",
+			data:     T{A: id, B: &id, C: ref, D: &ref, F: []registeredID{id}, G: map[string]registeredRef{"x": ref}, H: ref},
+			expected: `{"A":"0102","B":"0102","C":7,"D":7,"F":["0102"],"G":{"x":7},"H":7}`,
+		},
+		{"only pointer field", struct{ B *registeredID }{&id}, `{"B":"0102"}`},
+		{"only direct field", struct{ C registeredRef }{ref}, `{"C":7}`},
+		{"pointer to struct", &struct{ C registeredRef }{ref}, `{"C":7}`},
+		{"slice of pointers", []*registeredID{&id, nil}, `["0102",null]`},
+		{"direct array", [1]registeredRef{ref}, `[7]`},
+		{"only direct array field", struct{ A [1]registeredRef }{[1]registeredRef{ref}}, `{"A":[7]}`},
+		{"only direct struct field", struct{ S struct{ C registeredRef } }{struct{ C registeredRef }{ref}}, `{"S":{"C":7}}`},
+		{"pointer to direct array", &[1]registeredRef{ref}, `[7]`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.Marshal(test.data)
+			assertErr(t, err)
+			assertEq(t, "marshal", test.expected, string(got))
+			got, err = json.MarshalNoEscape(test.data)
+			assertErr(t, err)
+			assertEq(t, "marshal without escape", test.expected, string(got))
+		})
+	}
+	t.Run("indent", func(t *testing.T) {
+		got, err := json.MarshalIndent(struct {
+			A int
+			B *registeredID
+		}{1, &id}, "", " ")
+		assertErr(t, err)
+		assertEq(t, "indent", "{\n \"A\": 1,\n \"B\": \"0102\"\n}", string(got))
+	})
+	t.Run("encoder", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.RegisterEncoder(reflect.TypeOf(time.Time{}), func(b []byte, v interface{}) ([]byte, error) {
+			return strconv.AppendInt(b, v.(time.Time).Unix(), 10), nil
+		})
+		tm := time.Unix(100, 0).UTC()
+		assertErr(t, enc.Encode(struct {
+			T time.Time
+			P *time.Time
+			I interface{}
+		}{tm, &tm, tm}))
+		assertEq(t, "encoder", "{\"T\":100,\"P\":100,\"I\":100}\n", buf.String())
+
+		got, err := json.Marshal(tm)
+		assertErr(t, err)
+		assertEq(t, "marshal is not affected", `"1970-01-01T00:01:40Z"`, string(got))
+	})
+	t.Run("nil pointer type", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.RegisterEncoder(reflect.TypeOf(&big.Int{}), func(b []byte, v interface{}) ([]byte, error) {
+			return append(b, fmt.Sprint(v)...), nil
+		})
+		var nilInt *big.Int
+		assertErr(t, enc.Encode(struct {
+			A []*big.Int
+			B *big.Int
+			C interface{}
+		}{[]*big.Int{big.NewInt(1), nil}, nil, nilInt}))
+		assertErr(t, enc.Encode(nilInt))
+		assertEq(t, "encoder", "{\"A\":[1,null],\"B\":null,\"C\":null}\nnull\n", buf.String())
+	})
+	t.Run("error", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.RegisterEncoder(reflect.TypeOf(registeredID{}), func(b []byte, v interface{}) ([]byte, error) {
+			return nil, errors.New("failed")
+		})
+		err := enc.Encode([]registeredID{id})
+		var marshalerErr *json.MarshalerError
+		if !errors.As(err, &marshalerErr) {
+			t.Fatalf("expected MarshalerError but got %v", err)
+		}
+	})
+}
+
+type marshalerContextKey struct{}
+
+type marshalerContext struct{}
+
+func (*marshalerContext) MarshalJSONContext(ctx context.Context) ([]byte, error) {
+	v, ok := ctx.Value(marshalerContextKey{}).(string)
+	if !ok {
+		return nil, fmt.Errorf("missing context value")
+	}
+	return []byte(strconv.Quote(v)), nil
+}
+
+func TestMarshalContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), marshalerContextKey{}, "hello")
+	t.Run("marshaler", func(t *testing.T) {
+		b, err := json.MarshalContext(ctx, &marshalerContext{})
+		assertErr(t, err)
+		assertEq(t, "marshal", `"hello"`, string(b))
+	})
+	t.Run("field", func(t *testing.T) {
+		v := struct {
+			A *marshalerContext `json:"a"`
+			B []*marshalerContext
+		}{A: &marshalerContext{}, B: []*marshalerContext{{}}}
+		b, err := json.MarshalContext(ctx, v)
+		assertErr(t, err)
+		assertEq(t, "marshal", `{"a":"hello","B":["hello"]}`, string(b))
+	})
+	t.Run("encoder", func(t *testing.T) {
+		var buf bytes.Buffer
+		assertErr(t, json.NewEncoder(&buf).EncodeContext(ctx, &marshalerContext{}))
+		assertEq(t, "encode", "\"hello\"\n", buf.String())
+	})
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := json.MarshalContext(ctx, []int{1, 2, 3}); err != context.Canceled {
+			t.Fatalf("expected context.Canceled but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,220 @@
+package json
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// encodeTokenState holds the state of an object or array opened by the token-level Encoder API.
+type encodeTokenState struct {
+	isObject bool
+	count    int  // number of elements already written
+	hasKey   bool // an object key was written and its value is pending
+}
+
+var (
+	errTokenKeyOutsideObject = errors.New("json: object key written outside of object")
+	errTokenKeyExpected      = errors.New("json: object key expected")
+	errTokenValueExpected    = errors.New("json: object value expected after key")
+	errTokenUnexpectedEnd    = errors.New("json: unexpected end of object or array")
+	errTokenIncomplete       = errors.New("json: Encode called before all objects and arrays are closed")
+)
+
+// BeginObject writes '{' and opens a new object.
+// Members are written by WriteKey followed by a value.
+func (e *Encoder) BeginObject() error {
+	return e.beginToken('{')
+}
+
+// EndObject writes '}' and closes the object opened by BeginObject.
+func (e *Encoder) EndObject() error {
+	return e.endToken('}')
+}
+
+// BeginArray writes '[' and opens a new array.
+func (e *Encoder) BeginArray() error {
+	return e.beginToken('[')
+}
+
+// EndArray writes ']' and closes the array opened by BeginArray.
+func (e *Encoder) EndArray() error {
+	return e.endToken(']')
+}
+
+// WriteKey writes the key of the next member of the current object.
+func (e *Encoder) WriteKey(key string) error {
+	n := len(e.tokens)
+	if n == 0 || !e.tokens[n-1].isObject {
+		return errTokenKeyOutsideObject
+	}
+	state := &e.tokens[n-1]
+	if state.hasKey {
+		return errTokenValueExpected
+	}
+	b := e.appendTokenSeparator(e.tokenBuf[:0], state)
+	if e.enabledHTMLEscape {
+		b = encodeEscapedString(b, key)
+	} else {
+		b = encodeNoEscapedString(b, key)
+	}
+	b = append(b, ':')
+	if e.enabledIndent {
+		b = append(b, ' ')
+	}
+	state.hasKey = true
+	return e.writeToken(b)
+}
+
+// WriteValue writes the JSON encoding of v as the next value.
+//
+// See the documentation for Marshal for details about the conversion of Go values to JSON.
+func (e *Encoder) WriteValue(v interface{}) error {
+	b, err := e.beforeValue(e.tokenBuf[:0])
+	if err != nil {
+		return err
+	}
+	ctx := takeEncodeRuntimeContext()
+	ctx.encoders = e.encoders
+	var opt EncodeOption
+	if e.enabledHTMLEscape {
+		opt |= EncodeOptionHTMLEscape
+	}
+	var buf []byte
+	if e.enabledIndent {
+		buf, err = encodeIndent(ctx, v, e.prefix, e.indentStr, opt)
+		if err == nil {
+			buf = buf[:len(buf)-2]
+			if depth := len(e.tokens); depth > 0 {
+				buf = e.indentValue(buf, depth)
+			}
+		}
+	} else {
+		buf, err = encode(ctx, v, opt)
+		if err == nil {
+			buf = buf[:len(buf)-1]
+		}
+	}
+	if err != nil {
+		releaseEncodeRuntimeContext(ctx)
+		return err
+	}
+	b = append(b, buf...)
+	releaseEncodeRuntimeContext(ctx)
+	return e.writeToken(e.afterValue(b))
+}
+
+// WriteToken writes t, which is one of the token types returned by Decoder.Token.
+// A string written where an object key is expected is written as the key.
+func (e *Encoder) WriteToken(t Token) error {
+	switch v := t.(type) {
+	case Delim:
+		switch v {
+		case '{', '[':
+			return e.beginToken(byte(v))
+		case '}', ']':
+			return e.endToken(byte(v))
+		}
+		return fmt.Errorf("json: invalid delimiter %q", rune(v))
+	case string:
+		if n := len(e.tokens); n > 0 && e.tokens[n-1].isObject && !e.tokens[n-1].hasKey {
+			return e.WriteKey(v)
+		}
+		return e.WriteValue(v)
+	case nil, bool, float64, Number:
+		return e.WriteValue(v)
+	}
+	return fmt.Errorf("json: unsupported token type %T", t)
+}
+
+func (e *Encoder) beginToken(c byte) error {
+	b, err := e.beforeValue(e.tokenBuf[:0])
+	if err != nil {
+		return err
+	}
+	e.tokens = append(e.tokens, encodeTokenState{isObject: c == '{'})
+	return e.writeToken(append(b, c))
+}
+
+func (e *Encoder) endToken(c byte) error {
+	n := len(e.tokens)
+	if n == 0 || e.tokens[n-1].isObject != (c == '}') {
+		return errTokenUnexpectedEnd
+	}
+	state := e.tokens[n-1]
+	if state.hasKey {
+		return errTokenValueExpected
+	}
+	e.tokens = e.tokens[:n-1]
+	b := e.tokenBuf[:0]
+	if e.enabledIndent && state.count > 0 {
+		b = e.appendTokenIndent(b, n-1)
+	}
+	b = append(b, c)
+	return e.writeToken(e.afterValue(b))
+}
+
+// beforeValue validates that a value can be written and appends its separator.
+func (e *Encoder) beforeValue(b []byte) ([]byte, error) {
+	n := len(e.tokens)
+	if n == 0 {
+		return b, nil
+	}
+	state := &e.tokens[n-1]
+	if state.isObject {
+		if !state.hasKey {
+			return nil, errTokenKeyExpected
+		}
+		return b, nil
+	}
+	return e.appendTokenSeparator(b, state), nil
+}
+
+// afterValue updates the state of the enclosing object or array after a value,
+// or terminates a completed top-level value with a newline like Encode.
+func (e *Encoder) afterValue(b []byte) []byte {
+	n := len(e.tokens)
+	if n == 0 {
+		return append(b, '\n')
+	}
+	state := &e.tokens[n-1]
+	state.hasKey = false
+	state.count++
+	return b
+}
+
+func (e *Encoder) appendTokenSeparator(b []byte, state *encodeTokenState) []byte {
+	if state.count > 0 {
+		b = append(b, ',')
+	}
+	if e.enabledIndent {
+		b = e.appendTokenIndent(b, len(e.tokens))
+	}
+	return b
+}
+
+func (e *Encoder) appendTokenIndent(b []byte, depth int) []byte {
+	b = append(b, '\n')
+	b = append(b, e.prefix...)
+	for i := 0; i < depth; i++ {
+		b = append(b, e.indentStr...)
+	}
+	return b
+}
+
+// indentValue shifts the lines of an indented value to the current nesting depth.
+func (e *Encoder) indentValue(buf []byte, depth int) []byte {
+	if bytes.IndexByte(buf, '\n') < 0 {
+		return buf
+	}
+	newline := append([]byte{'\n'}, e.prefix...)
+	return bytes.Replace(buf, newline, e.appendTokenIndent(nil, depth), -1)
+}
+
+func (e *Encoder) writeToken(b []byte) error {
+	e.tokenBuf = b
+	if _, err := e.w.Write(b); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package json
+
+import (
+	"reflect"
+	"sort"
+	"unsafe"
+)
+
+// unknownFieldEntry is an entry of the map of a field with the unknown option.
+type unknownFieldEntry struct {
+	key   string
+	value uintptr // the pointer passed to the code of the map value
+}
+
+// encodeUnknownFieldEntries returns the entries of the map of the unknown field code which are written
+// as the members of the object. They are sorted by key unless EncodeOptionUnorderedMap is set.
+func encodeUnknownFieldEntries(code *opcode, ctxptr uintptr, opt EncodeOption) []unknownFieldEntry {
+	p := load(ctxptr, code.headIdx)
+	if !code.isDirectIface {
+		if p == 0 {
+			return nil
+		}
+		p = ptrToPtr(p + code.offset)
+	}
+	if p == 0 {
+		return nil
+	}
+	m := ptrToUnsafePtr(p)
+	n := maplen(m)
+	if n == 0 {
+		return nil
+	}
+	isDirectIface := encodeIsDirectIface(code.typ.Elem())
+	entries := make([]unknownFieldEntry, 0, n)
+	iter := mapiterinit(code.typ, m)
+	for key := mapiterkey(iter); key != nil; key = mapiterkey(iter) {
+		k := *(*string)(key)
+		if _, exists := code.fieldKeys[k]; exists {
+			// the key of a struct field is written once from the field
+		} else if code.fields == nil || code.fields.hasKey(k) {
+			value := uintptr(mapitervalue(iter))
+			if isDirectIface {
+				value = ptrToPtr(value)
+			}
+			entries = append(entries, unknownFieldEntry{key: k, value: value})
+		}
+		mapiternext(iter)
+	}
+	if opt&EncodeOptionUnorderedMap == 0 {
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+	}
+	return entries
+}
+
+// encodeStructFieldKeys returns the keys of the fields of typ including the promoted ones.
+// The map of a field with the unknown option never writes an entry with one of them.
+func encodeStructFieldKeys(typ *rtype, naming keyNaming) map[string]struct{} {
+	keys := map[string]struct{}{}
+	seen := map[*rtype]struct{}{}
+	var add func(*rtype)
+	add = func(typ *rtype) {
+		seen[typ] = struct{}{}
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if isIgnoredStructField(field) {
+				continue
+			}
+			tag := structTagFromField(field, naming)
+			if tag.isUnknown {
+				continue
+			}
+			if tag.isPromoted() {
+				fieldType := type2rtype(field.Type)
+				if fieldType.Kind() == reflect.Ptr {
+					fieldType = fieldType.Elem()
+				}
+				if fieldType.Kind() == reflect.Struct {
+					if _, exists := seen[fieldType]; !exists {
+						// recursive definitions are skipped
+						add(fieldType)
+					}
+					continue
+				}
+			}
+			keys[tag.key] = struct{}{}
+		}
+	}
+	add(typ)
+	return keys
+}
+
+// encodeUnknownFieldValueCodeSet returns the code set of the values of the map of the unknown field code.
+func encodeUnknownFieldValueCodeSet(ctx *encodeRuntimeContext, code *opcode, opt EncodeOption) (*opcodeSet, error) {
+	return ctx.compileToGetCodeSet(uintptr(unsafe.Pointer(code.typ.Elem())), opt, code.fields.mapValue())
+}
+
+// encodeRunUnknownFieldValue runs the code set of a map value in the pointers after the ones of codeSet
+// like the interface operation. The value is followed by a comma.
+func encodeRunUnknownFieldValue(
+	ctx *encodeRuntimeContext, b []byte, codeSet, valueCodeSet *opcodeSet, ptrOffset uintptr, entry unknownFieldEntry, opt EncodeOption,
+	run func(*encodeRuntimeContext, []byte, *opcodeSet, EncodeOption) ([]byte, error),
+) ([]byte, error) {
+	totalLength := uintptr(codeSet.codeLength)
+	nextTotalLength := uintptr(valueCodeSet.codeLength)
+
+	curlen := uintptr(len(ctx.ptrs))
+	offsetNum := ptrOffset / uintptrSize
+
+	newLen := offsetNum + totalLength + nextTotalLength
+	if curlen < newLen {
+		ctx.ptrs = append(ctx.ptrs, make([]uintptr, newLen-curlen)...)
+	}
+	oldPtrs := ctx.ptrs
+
+	newPtrs := ctx.ptrs[(ptrOffset+totalLength*uintptrSize)/uintptrSize:]
+	newPtrs[0] = entry.value
+
+	ctx.ptrs = newPtrs
+	b, err := run(ctx, b, valueCodeSet, opt)
+	ctx.ptrs = oldPtrs
+	return b, err
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding"
 	"fmt"
-	"math"
 	"reflect"
 	"runtime"
 	"sort"
@@ -78,13 +77,42 @@ func errMarshaler(code *opcode, err error) *MarshalerError {
 	}
 }
 
+// encodeCustom calls the generated or registered encoder of code with the value at ptr.
+// The errors of a registered encoder are wrapped in a MarshalerError,
+// while a generated encoder returns the errors of the code it replaces.
+func encodeCustom(ctx *encodeRuntimeContext, code *opcode, b []byte, ptr uintptr, opt EncodeOption) ([]byte, error) {
+	if code.generated != nil {
+		p := *(*unsafe.Pointer)(unsafe.Pointer(&ptr))
+		if code.isDirectIface {
+			// ptr is the data word holding the value, whose address is taken by the generated encoder
+			word := new(unsafe.Pointer)
+			*word = p
+			p = unsafe.Pointer(word)
+		}
+		return code.generated(EncodeContext{ctx: ctx, opt: opt}, b, p)
+	}
+	bb, err := code.encoder(b, ptrToInterface(code, ptr))
+	if err != nil {
+		return nil, errMarshaler(code, err)
+	}
+	return bb, nil
+}
+
 func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt EncodeOption) ([]byte, error) {
 	recursiveLevel := 0
 	ptrOffset := uintptr(0)
 	ctxptr := ctx.ptr()
 	code := codeSet.code
+	done := ctx.done
 
 	for {
+		if done != nil {
+			select {
+			case <-done:
+				return nil, ctx.context.Err()
+			default:
+			}
+		}
 		switch code.op {
 		default:
 			return nil, fmt.Errorf("encoder: opcode %s has not been implemented", code.op)
@@ -93,43 +121,43 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			code = code.next
 			store(ctxptr, code.idx, ptrToPtr(ptr))
 		case opInt:
-			b = appendInt(b, int64(ptrToInt(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opInt8:
-			b = appendInt(b, int64(ptrToInt8(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt8(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opInt16:
-			b = appendInt(b, int64(ptrToInt16(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt16(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opInt32:
-			b = appendInt(b, int64(ptrToInt32(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt32(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opInt64:
-			b = appendInt(b, ptrToInt64(load(ctxptr, code.idx)))
+			b = appendIntWithOption(b, ptrToInt64(load(ctxptr, code.idx)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint:
-			b = appendUint(b, uint64(ptrToUint(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint8:
-			b = appendUint(b, uint64(ptrToUint8(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint8(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint16:
-			b = appendUint(b, uint64(ptrToUint16(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint16(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint32:
-			b = appendUint(b, uint64(ptrToUint32(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint32(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint64:
-			b = appendUint(b, ptrToUint64(load(ctxptr, code.idx)))
+			b = appendUintWithOption(b, ptrToUint64(load(ctxptr, code.idx)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opIntString:
@@ -193,19 +221,23 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			b = encodeComma(b)
 			code = code.next
 		case opFloat32:
-			b = encodeFloat32(b, ptrToFloat32(load(ctxptr, code.idx)))
+			v := ptrToFloat32(load(ctxptr, code.idx))
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opFloat64:
 			v := ptrToFloat64(load(ctxptr, code.idx))
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opString:
-			b = encodeNoEscapedString(b, ptrToString(load(ctxptr, code.idx)))
+			b = encodeNoEscapedStringWithOption(b, ptrToString(load(ctxptr, code.idx)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opBool:
@@ -244,7 +276,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				break
 			}
 			ctx.keepRefs = append(ctx.keepRefs, unsafe.Pointer(iface))
-			ifaceCodeSet, err := encodeCompileToGetCodeSet(uintptr(unsafe.Pointer(iface.typ)))
+			ifaceCodeSet, err := ctx.compileToGetCodeSet(uintptr(unsafe.Pointer(iface.typ)), opt, code.fields)
 			if err != nil {
 				return nil, err
 			}
@@ -277,6 +309,31 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 
 			b = bb
 			code = code.next
+		case opCustom:
+			ptr := load(ctxptr, code.idx)
+			i := 0
+			for ; i < code.ptrNum && ptr != 0; i++ {
+				ptr = ptrToPtr(ptr)
+			}
+			// the zero value of a type stored directly in an interface is a nil word,
+			// which is null for a pointer like a nil Marshaler
+			if ptr == 0 && (i < code.ptrNum || !code.isDirectIface || code.typ.Kind() == reflect.Ptr) {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.next
+				break
+			}
+			bb, err := encodeCustom(ctx, code, b, ptr, opt)
+			if err != nil {
+				return nil, err
+			}
+			if opt&EncodeOptionCanonical != 0 {
+				if bb, err = appendCanonical(bb[:len(b)], append([]byte(nil), bb[len(b):]...)); err != nil {
+					return nil, err
+				}
+			}
+			b = encodeComma(bb)
+			code = code.next
 		case opMarshalJSON:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
@@ -286,7 +343,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				break
 			}
 			v := ptrToInterface(code, ptr)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -298,8 +355,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				)
 			}
 			buf := bytes.NewBuffer(b)
-			//TODO: we should validate buffer with `compact`
-			if err := compact(buf, bb, false); err != nil {
+			if err := compactWithOption(buf, bb, opt); err != nil {
 				return nil, err
 			}
 			b = buf.Bytes()
@@ -320,7 +376,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
-				b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -328,8 +384,12 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			slice := ptrToSlice(p)
 			if p == 0 || uintptr(slice.data) == 0 {
-				b = encodeNull(b)
-				b = encodeComma(b)
+				if p != 0 && encodeNilAsEmpty(code, opt) {
+					b = append(b, '[', ']', ',')
+				} else {
+					b = encodeNull(b)
+					b = encodeComma(b)
+				}
 				code = code.end.next
 			} else {
 				store(ctxptr, code.elemIdx, 0)
@@ -349,6 +409,13 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			length := load(ctxptr, code.length)
 			idx++
 			if idx < length {
+				if ctx.w != nil {
+					bb, err := ctx.flush(b)
+					if err != nil {
+						return nil, err
+					}
+					b = bb
+				}
 				store(ctxptr, code.elemIdx, idx)
 				data := load(ctxptr, code.headIdx)
 				size := code.size
@@ -381,6 +448,13 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			idx := load(ctxptr, code.elemIdx)
 			idx++
 			if idx < code.length {
+				if ctx.w != nil {
+					bb, err := ctx.flush(b)
+					if err != nil {
+						return nil, err
+					}
+					b = bb
+				}
 				store(ctxptr, code.elemIdx, idx)
 				p := load(ctxptr, code.headIdx)
 				size := code.size
@@ -395,12 +469,16 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 		case opMapHead:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
-				b = encodeNull(b)
-				b = encodeComma(b)
+				if encodeNilAsEmpty(code, opt) {
+					b = append(b, '{', '}', ',')
+				} else {
+					b = encodeNull(b)
+					b = encodeComma(b)
+				}
 				code = code.end.next
 			} else {
 				uptr := ptrToUnsafePtr(ptr)
-				mlen := maplen(uptr)
+				mlen := encodeMapLen(code, uptr)
 				if mlen > 0 {
 					b = append(b, '{')
 					iter := mapiterinit(code.typ, uptr)
@@ -410,10 +488,12 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					store(ctxptr, code.mapIter, uintptr(iter))
 					if (opt & EncodeOptionUnorderedMap) == 0 {
 						mapCtx := newMapContext(mlen)
+						ctx.sortedMaps++
 						mapCtx.pos = append(mapCtx.pos, len(b))
 						ctx.keepRefs = append(ctx.keepRefs, unsafe.Pointer(mapCtx))
 						store(ctxptr, code.end.mapPos, uintptr(unsafe.Pointer(mapCtx)))
 					}
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					code = code.next
@@ -433,12 +513,16 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				ptr = ptrToPtr(ptr)
 				uptr := ptrToUnsafePtr(ptr)
 				if ptr == 0 {
-					b = encodeNull(b)
-					b = encodeComma(b)
+					if encodeNilAsEmpty(code, opt) {
+						b = append(b, '{', '}', ',')
+					} else {
+						b = encodeNull(b)
+						b = encodeComma(b)
+					}
 					code = code.end.next
 					break
 				}
-				mlen := maplen(uptr)
+				mlen := encodeMapLen(code, uptr)
 				if mlen > 0 {
 					b = append(b, '{')
 					iter := mapiterinit(code.typ, uptr)
@@ -446,10 +530,12 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					store(ctxptr, code.elemIdx, 0)
 					store(ctxptr, code.length, uintptr(mlen))
 					store(ctxptr, code.mapIter, uintptr(iter))
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					if (opt & EncodeOptionUnorderedMap) == 0 {
 						mapCtx := newMapContext(mlen)
+						ctx.sortedMaps++
 						mapCtx.pos = append(mapCtx.pos, len(b))
 						ctx.keepRefs = append(ctx.keepRefs, unsafe.Pointer(mapCtx))
 						store(ctxptr, code.end.mapPos, uintptr(unsafe.Pointer(mapCtx)))
@@ -466,9 +552,17 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			idx++
 			if (opt & EncodeOptionUnorderedMap) != 0 {
 				if idx < length {
+					if ctx.w != nil {
+						bb, err := ctx.flush(b)
+						if err != nil {
+							return nil, err
+						}
+						b = bb
+					}
 					ptr := load(ctxptr, code.mapIter)
 					iter := ptrToUnsafePtr(ptr)
 					store(ctxptr, code.elemIdx, idx)
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					code = code.next
@@ -486,6 +580,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					ptr := load(ctxptr, code.mapIter)
 					iter := ptrToUnsafePtr(ptr)
 					store(ctxptr, code.elemIdx, idx)
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					code = code.next
@@ -528,6 +623,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					value: b[startValue:endValue],
 				})
 			}
+			mapCtx.slice.canonical = opt&EncodeOptionCanonical != 0
 			sort.Sort(mapCtx.slice)
 			buf := mapCtx.buf
 			for _, item := range mapCtx.slice.items {
@@ -541,6 +637,15 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			b = append(b, buf...)
 			mapCtx.buf = buf
 			releaseMapContext(mapCtx)
+			ctx.sortedMaps--
+			code = code.next
+		case opSortedObject:
+			store(ctxptr, code.end.idx, uintptr(len(b)))
+			ctx.sortedMaps++
+			code = code.next
+		case opSortedObjectEnd:
+			b = encodeSortObjectMembers(b, int(load(ctxptr, code.idx)))
+			ctx.sortedMaps--
 			code = code.next
 		case opStructFieldPtrAnonymousHeadRecursive:
 			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
@@ -619,6 +724,37 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			fallthrough
 		case opStructFieldHeadOmitEmpty:
 			ptr := load(ctxptr, code.idx)
+			if code.isDirectIface {
+				// the data word of the root struct is the value of its only field
+				ptr = ctxptr + code.idx
+			}
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p := ptr + code.offset
+				if p == 0 || encodeIsOmitted(code, p) {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					code = code.next
+					store(ctxptr, code.idx, p)
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if code.isDirectIface {
+				// the data word of the root struct is the value of its only field
+				ptr = ctxptr + code.idx
+			}
 			if ptr == 0 {
 				b = encodeNull(b)
 				b = encodeComma(b)
@@ -626,7 +762,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				p := ptr + code.offset
-				if p == 0 || *(*uintptr)(*(*unsafe.Pointer)(unsafe.Pointer(&p))) == 0 {
+				if p == 0 || encodeIsOmitted(code, p) {
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
@@ -656,6 +792,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				}
 			}
+		case opStructFieldHeadOmitEmptyStringTagOnly:
+			ptr := load(ctxptr, code.idx)
+			b = append(b, '{')
+			if !code.anonymousKey {
+				if ptr != 0 {
+					b = append(b, code.key...)
+					p := ptr + code.offset
+					code = code.next
+					store(ctxptr, code.idx, p)
+				} else {
+					code = code.nextField
+				}
+			}
 		case opStructFieldAnonymousHead:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
@@ -676,7 +825,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				p := ptr + code.offset
-				if p == 0 || *(*uintptr)(*(*unsafe.Pointer)(unsafe.Pointer(&p))) == 0 {
+				if p == 0 || encodeIsOmitted(code, p) {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					code = code.next
+					store(ctxptr, code.idx, p)
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				p := ptr + code.offset
+				if p == 0 || encodeIsOmitted(code, p) {
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
@@ -696,7 +865,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -719,7 +888,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -749,7 +944,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt(p)))
+			b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyIntOnly, opStructFieldHeadOmitEmptyIntOnly:
@@ -758,7 +953,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := int64(ptrToInt(p))
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagIntOnly, opStructFieldHeadOmitEmptyStringTagIntOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := int64(ptrToInt(p))
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -788,7 +995,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -807,7 +1014,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagIntPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagIntPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -853,7 +1081,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -872,7 +1100,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagIntPtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -915,7 +1164,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -929,7 +1178,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -949,7 +1198,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -978,7 +1250,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -992,7 +1264,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntOnly, opStructFieldAnonymousHeadOmitEmptyStringTagIntOnly:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1023,7 +1312,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1041,7 +1330,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagIntPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1079,7 +1388,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1097,11 +1406,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
-		case opStructFieldPtrAnonymousHeadStringTagIntPtrOnly:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				code = code.end.next
@@ -1109,17 +1418,37 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagIntPtrOnly:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagIntPtrOnly:
 			p := load(ctxptr, code.idx)
-			b = append(b, code.key...)
 			if p == 0 {
-				b = encodeNull(b)
+				code = code.nextField
 			} else {
+				b = append(b, code.key...)
 				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p+code.offset)))
 				b = append(b, '"')
+				b = encodeComma(b)
+				code = code.next
 			}
-			b = encodeComma(b)
+		case opStructFieldPtrAnonymousHeadStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, code.key...)
+			if p == 0 {
+				b = encodeNull(b)
+			} else {
+				b = append(b, '"')
+				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = append(b, '"')
+			}
+			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadInt8:
 			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
@@ -1133,7 +1462,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1156,7 +1485,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1186,7 +1541,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt8(p)))
+			b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt8Only, opStructFieldHeadOmitEmptyInt8Only:
@@ -1195,7 +1550,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := int64(ptrToInt8(p))
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8Only, opStructFieldHeadOmitEmptyStringTagInt8Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := int64(ptrToInt8(p))
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -1225,7 +1592,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -1244,7 +1611,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt8(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -1290,7 +1678,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1309,7 +1697,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt8PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -1352,7 +1761,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -1366,7 +1775,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1386,7 +1795,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1415,7 +1847,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1429,7 +1861,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt8Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1460,7 +1909,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1478,7 +1927,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1516,7 +1985,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1534,7 +2003,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1570,7 +2059,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1593,7 +2082,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1623,7 +2138,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt16(p)))
+			b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt16Only, opStructFieldHeadOmitEmptyInt16Only:
@@ -1632,7 +2147,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := int64(ptrToInt16(p))
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16Only, opStructFieldHeadOmitEmptyStringTagInt16Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := int64(ptrToInt16(p))
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -1662,7 +2189,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -1681,7 +2208,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt16(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -1727,7 +2275,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1746,7 +2294,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt16PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -1789,7 +2358,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -1803,7 +2372,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1823,36 +2392,59 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
-					b = appendInt(b, int64(v))
+					b = appendIntWithOption(b, int64(v), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
 			}
-		case opStructFieldPtrAnonymousHeadStringTagInt16:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
 				store(ctxptr, code.idx, ptrToPtr(ptr))
 			}
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagInt16:
-			ptr := load(ctxptr, code.idx)
-			if ptr == 0 {
-				code = code.end.next
-			} else {
-				b = append(b, code.key...)
-				b = append(b, '"')
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
-				b = append(b, '"')
-				b = encodeComma(b)
-				code = code.next
-			}
-		case opStructFieldPtrAnonymousHeadInt16Only, opStructFieldAnonymousHeadInt16Only:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
+					b = appendInt(b, int64(v))
+					b = append(b, '"')
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagInt16:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = append(b, '"')
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadInt16Only, opStructFieldAnonymousHeadInt16Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1866,7 +2458,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt16Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1897,7 +2506,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1915,7 +2524,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1953,7 +2582,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1971,7 +2600,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2007,7 +2656,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2030,7 +2679,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2060,7 +2735,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt32(p)))
+			b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt32Only, opStructFieldHeadOmitEmptyInt32Only:
@@ -2069,7 +2744,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := int64(ptrToInt32(p))
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32Only, opStructFieldHeadOmitEmptyStringTagInt32Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := int64(ptrToInt32(p))
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2099,7 +2786,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2118,7 +2805,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt32(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -2164,7 +2872,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2183,7 +2891,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt32PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2226,7 +2955,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2240,7 +2969,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2260,7 +2989,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2289,7 +3041,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2303,7 +3055,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt32Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2334,7 +3103,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2352,7 +3121,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2390,7 +3179,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2408,7 +3197,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2444,7 +3253,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendInt(b, ptrToInt64(ptr+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2467,7 +3276,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2497,7 +3332,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendInt(b, ptrToInt64(p))
+			b = appendIntWithOption(b, ptrToInt64(p), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt64Only, opStructFieldHeadOmitEmptyInt64Only:
@@ -2506,7 +3341,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt64(p)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64Only, opStructFieldHeadOmitEmptyStringTagInt64Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToInt64(p)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2536,7 +3383,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, ptrToInt64(p+code.offset))
+					b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2555,7 +3402,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, ptrToInt64(p), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, ptrToInt64(p))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -2601,7 +3469,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2620,7 +3488,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt64PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2663,7 +3552,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, ptrToInt64(p+code.offset))
+					b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2677,7 +3566,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, ptrToInt64(ptr+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2697,7 +3586,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2726,7 +3638,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendInt(b, ptrToInt64(ptr+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2740,7 +3652,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendIntWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt64Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendInt(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2771,7 +3700,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2789,7 +3718,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2827,7 +3776,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2845,7 +3794,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2881,7 +3850,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2904,7 +3873,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2934,7 +3929,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUintOnly, opStructFieldHeadOmitEmptyUintOnly:
@@ -2943,7 +3938,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := uint64(ptrToUint(p))
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUintOnly, opStructFieldHeadOmitEmptyStringTagUintOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := uint64(ptrToUint(p))
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2973,7 +3980,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2992,7 +3999,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUintPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUintPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -3038,7 +4066,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3057,7 +4085,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUintPtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3100,7 +4149,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3114,7 +4163,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3134,7 +4183,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3163,7 +4235,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3177,7 +4249,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintOnly, opStructFieldAnonymousHeadOmitEmptyStringTagUintOnly:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3208,7 +4297,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3226,7 +4315,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUintPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3264,7 +4373,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3282,11 +4391,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
-		case opStructFieldPtrAnonymousHeadStringTagUintPtrOnly:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				code = code.end.next
@@ -3294,7 +4403,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagUintPtrOnly:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
+				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = append(b, '"')
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagUintPtrOnly:
 			p := load(ctxptr, code.idx)
 			b = append(b, code.key...)
 			if p == 0 {
@@ -3318,7 +4447,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3341,7 +4470,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3371,7 +4526,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint8(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint8Only, opStructFieldHeadOmitEmptyUint8Only:
@@ -3380,7 +4535,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := uint64(ptrToUint8(p))
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8Only, opStructFieldHeadOmitEmptyStringTagUint8Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := uint64(ptrToUint8(p))
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3410,7 +4577,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3429,7 +4596,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint8(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -3475,7 +4663,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3494,7 +4682,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint8PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3537,7 +4746,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3551,7 +4760,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3571,7 +4780,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3600,7 +4832,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3614,7 +4846,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint8Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3645,7 +4894,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3663,7 +4912,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3701,7 +4970,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3719,7 +4988,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3755,7 +5044,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3778,7 +5067,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3808,7 +5123,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint16(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint16Only, opStructFieldHeadOmitEmptyUint16Only:
@@ -3817,7 +5132,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := uint64(ptrToUint16(p))
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16Only, opStructFieldHeadOmitEmptyStringTagUint16Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := uint64(ptrToUint16(p))
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3847,7 +5174,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3866,7 +5193,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint16(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -3912,7 +5260,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3931,7 +5279,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint16PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3974,7 +5343,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3988,7 +5357,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4008,36 +5377,59 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
-					b = appendUint(b, uint64(v))
+					b = appendUintWithOption(b, uint64(v), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
 			}
-		case opStructFieldPtrAnonymousHeadStringTagUint16:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
 				store(ctxptr, code.idx, ptrToPtr(ptr))
 			}
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagUint16:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint16:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
 				code = code.end.next
 			} else {
-				b = append(b, code.key...)
-				b = append(b, '"')
-				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
-				b = append(b, '"')
-				b = encodeComma(b)
-				code = code.next
-			}
-		case opStructFieldPtrAnonymousHeadUint16Only, opStructFieldAnonymousHeadUint16Only:
-			ptr := load(ctxptr, code.idx)
-			if ptr == 0 {
-				code = code.end.next
+				v := ptrToUint16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
+					b = appendUint(b, uint64(v))
+					b = append(b, '"')
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
 			} else {
 				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+				b = append(b, '"')
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadUint16Only, opStructFieldAnonymousHeadUint16Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4051,7 +5443,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint16Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4082,7 +5491,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4100,7 +5509,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4138,7 +5567,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4156,7 +5585,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4192,7 +5641,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4215,7 +5664,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4245,7 +5720,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint32(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint32Only, opStructFieldHeadOmitEmptyUint32Only:
@@ -4254,7 +5729,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := uint64(ptrToUint32(p))
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32Only, opStructFieldHeadOmitEmptyStringTagUint32Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := uint64(ptrToUint32(p))
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -4284,7 +5771,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -4303,7 +5790,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint32(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -4349,7 +5857,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4368,7 +5876,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint32PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -4411,7 +5940,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -4425,7 +5954,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4445,7 +5974,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4474,7 +6026,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4488,7 +6040,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint32Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4519,7 +6088,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4537,7 +6106,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4575,7 +6164,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4593,7 +6182,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4629,7 +6238,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = appendUint(b, ptrToUint64(ptr+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4652,7 +6261,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4682,7 +6317,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = appendUint(b, ptrToUint64(p))
+			b = appendUintWithOption(b, ptrToUint64(p), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint64Only, opStructFieldHeadOmitEmptyUint64Only:
@@ -4691,7 +6326,19 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint64(p)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64Only, opStructFieldHeadOmitEmptyStringTagUint64Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToUint64(p)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -4721,7 +6368,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, ptrToUint64(p+code.offset))
+					b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -4740,7 +6387,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, ptrToUint64(p), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, ptrToUint64(p))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -4786,7 +6454,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4805,7 +6473,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint64PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -4848,7 +6537,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, ptrToUint64(p+code.offset))
+					b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -4862,7 +6551,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, ptrToUint64(ptr+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4882,7 +6571,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4911,7 +6623,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = appendUint(b, ptrToUint64(ptr+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4925,7 +6637,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
+					b = appendUintWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint64Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
 					b = appendUint(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4956,7 +6685,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4974,7 +6703,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5012,7 +6761,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5030,7 +6779,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5066,7 +6835,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5089,7 +6862,37 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
-					b = encodeFloat32(b, v)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToFloat32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5109,9 +6912,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5119,7 +6924,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = encodeFloat32(b, ptrToFloat32(p))
+			v := ptrToFloat32(p)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyFloat32Only, opStructFieldHeadOmitEmptyFloat32Only:
@@ -5128,7 +6937,23 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToFloat32(p)
 			if v != 0 {
 				b = append(b, code.key...)
-				b = encodeFloat32(b, v)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32Only, opStructFieldHeadOmitEmptyStringTagFloat32Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToFloat32(p)
+			if v != 0 {
+				b = append(b, code.key...)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -5136,9 +6961,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = append(b, '"')
-			b = encodeFloat32(b, ptrToFloat32(p))
-			b = append(b, '"')
+			v := ptrToFloat32(p)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32StringWithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadFloat32Ptr:
@@ -5158,7 +6985,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+					v := ptrToFloat32(p + code.offset)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5177,7 +7008,34 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
-					b = encodeFloat32(b, ptrToFloat32(p))
+					v := ptrToFloat32(p)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					v := ptrToFloat32(p)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -5199,9 +7057,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = append(b, '"')
-					b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-					b = append(b, '"')
+					v := ptrToFloat32(p + code.offset)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5223,7 +7083,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5242,7 +7106,34 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat32PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -5263,9 +7154,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5285,7 +7178,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+					v := ptrToFloat32(p + code.offset)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5299,7 +7196,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5319,7 +7220,34 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
-					b = encodeFloat32(b, v)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5336,9 +7264,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5348,7 +7278,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5362,7 +7296,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
-					b = encodeFloat32(b, v)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Only, opStructFieldAnonymousHeadOmitEmptyStringTagFloat32Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5373,9 +7328,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5393,7 +7350,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5411,7 +7372,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5429,9 +7416,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5449,7 +7438,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5467,7 +7460,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5485,9 +7504,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5502,12 +7523,12 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5529,11 +7550,38 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if v == 0 {
 					code = code.nextField
 				} else {
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = append(b, code.key...)
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToFloat64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
 					b = append(b, code.key...)
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5553,13 +7601,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.key...)
-				b = append(b, '"')
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5568,10 +7614,10 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			b = append(b, '{')
 			b = append(b, code.key...)
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyFloat64Only, opStructFieldHeadOmitEmptyFloat64Only:
@@ -5579,11 +7625,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			b = append(b, '{')
 			v := ptrToFloat64(p)
 			if v != 0 {
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = append(b, code.key...)
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64Only, opStructFieldHeadOmitEmptyStringTagFloat64Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToFloat64(p)
+			if v != 0 {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.key...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -5591,13 +7650,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = append(b, '"')
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
-			b = append(b, '"')
+			b = encodeFloat64StringWithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadFloat64Ptr:
@@ -5618,10 +7675,10 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					b = encodeNull(b)
 				} else {
 					v := ptrToFloat64(p + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64WithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5641,10 +7698,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p != 0 {
 					b = append(b, code.key...)
 					v := ptrToFloat64(p + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					v := ptrToFloat64(p + code.offset)
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -5666,13 +7746,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = append(b, '"')
 					v := ptrToFloat64(p + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
-					b = append(b, '"')
+					b = encodeFloat64StringWithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5695,10 +7773,10 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5718,10 +7796,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p != 0 {
 				b = append(b, code.key...)
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat64PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				v := ptrToFloat64(p)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -5742,13 +7843,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5769,10 +7868,10 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					b = encodeNull(b)
 				} else {
 					v := ptrToFloat64(p)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64WithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5786,11 +7885,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.key...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5811,10 +7910,35 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				} else {
 					b = append(b, code.key...)
 					v := ptrToFloat64(ptr + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					v := ptrToFloat64(ptr + code.offset)
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5831,13 +7955,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = append(b, '"')
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5848,10 +7970,10 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, code.key...)
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5866,10 +7988,29 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				} else {
 					b = append(b, code.key...)
 					v := ptrToFloat64(ptr + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Only, opStructFieldAnonymousHeadOmitEmptyStringTagFloat64Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					v := ptrToFloat64(ptr + code.offset)
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5880,13 +8021,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = append(b, '"')
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5905,10 +8044,10 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5927,10 +8066,32 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, code.key...)
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				v := ptrToFloat64(p + code.offset)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5948,13 +8109,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5973,10 +8132,10 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5995,10 +8154,32 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, code.key...)
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				v := ptrToFloat64(p + code.offset)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6016,13 +8197,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -6038,7 +8217,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			} else {
 				b = append(b, '{')
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, ptrToString(ptr+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6061,7 +8240,31 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
-					b = encodeNoEscapedString(b, v)
+					b = encodeNoEscapedStringWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToString(ptr + code.offset)
+				if v == "" {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -6082,7 +8285,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				b = append(b, '{')
 				b = append(b, code.key...)
 				s := ptrToString(ptr + code.offset)
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, s)))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, s, opt)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6090,7 +8293,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = encodeNoEscapedString(b, ptrToString(p))
+			b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyStringOnly, opStructFieldHeadOmitEmptyStringOnly:
@@ -6099,7 +8302,17 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToString(p)
 			if v != "" {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, v)
+				b = encodeNoEscapedStringWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagStringOnly, opStructFieldHeadOmitEmptyStringTagStringOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToString(p)
+			if v != "" {
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -6107,7 +8320,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.key...)
-			b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+			b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadStringPtr:
@@ -6127,7 +8340,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+					b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -6146,7 +8359,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.key...)
-					b = encodeNoEscapedString(b, ptrToString(p))
+					b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagStringPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagStringPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.key...)
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -6168,7 +8400,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -6190,7 +8422,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -6209,11 +8441,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 				b = encodeComma(b)
 			}
 			code = code.next
-		case opStructFieldPtrHeadStringTagStringPtrOnly:
+		case opStructFieldPtrHeadOmitEmptyStringTagStringPtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				b = encodeNull(b)
@@ -6223,14 +8455,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldHeadStringTagStringPtrOnly:
-			p := load(ctxptr, code.idx)
+		case opStructFieldHeadOmitEmptyStringTagStringPtrOnly:
 			b = append(b, '{')
-			b = append(b, code.key...)
-			if p == 0 {
-				b = encodeNull(b)
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p+code.offset), opt)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			b = append(b, code.key...)
+			if p == 0 {
+				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -6250,7 +8501,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+					b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -6264,7 +8515,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, ptrToString(ptr+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6284,7 +8535,28 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
-					b = encodeNoEscapedString(b, v)
+					b = encodeNoEscapedStringWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToString(ptr + code.offset)
+				if v == "" {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -6301,7 +8573,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(ptr))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(ptr), opt)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6311,7 +8583,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, ptrToString(ptr+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6325,7 +8597,22 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					b = append(b, code.key...)
-					b = encodeNoEscapedString(b, v)
+					b = encodeNoEscapedStringWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringOnly, opStructFieldAnonymousHeadOmitEmptyStringTagStringOnly:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToString(ptr + code.offset)
+				if v == "" {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -6336,7 +8623,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(ptr))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(ptr), opt)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6354,7 +8641,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -6372,7 +8659,25 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagStringPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6390,7 +8695,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -6408,7 +8713,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -6426,7 +8731,25 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p+code.offset), opt)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6444,7 +8767,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -6653,7 +8976,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.end
 					break
 				}
-				bb, err := rv.Interface().(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(rv.Interface())
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
@@ -6664,8 +8987,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					)
 				}
 				buf := bytes.NewBuffer(b)
-				//TODO: we should validate buffer with `compact`
-				if err := compact(buf, bb, false); err != nil {
+				if err := compactWithOption(buf, bb, opt); err != nil {
 					return nil, err
 				}
 				b = buf.Bytes()
@@ -6689,7 +9011,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.end.next
 					break
 				}
-				bb, err := rv.Interface().(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(rv.Interface())
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
@@ -6700,8 +9022,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					)
 				}
 				buf := bytes.NewBuffer(b)
-				//TODO: we should validate buffer with `compact`
-				if err := compact(buf, bb, false); err != nil {
+				if err := compactWithOption(buf, bb, opt); err != nil {
 					return nil, err
 				}
 				b = buf.Bytes()
@@ -6740,7 +9061,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
-				b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6766,7 +9087,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
-				b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -6794,6 +9115,32 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.next
 				}
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToBool(ptr + code.offset)
+				if !v {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
+					b = encodeBool(b, v)
+					b = append(b, '"')
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrAnonymousHeadOmitEmptyBool:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6815,6 +9162,29 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.next
 				}
 			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToBool(ptr + code.offset)
+				if !v {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = append(b, '"')
+					b = encodeBool(b, v)
+					b = append(b, '"')
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrHeadOmitEmptyBytes:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6839,6 +9209,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.next
 				}
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToBytes(ptr + code.offset)
+				if len(v) == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = encodeByteSlice(b, v)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrAnonymousHeadOmitEmptyBytes:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6860,6 +9254,27 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.next
 				}
 			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToBytes(ptr + code.offset)
+				if len(v) == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.key...)
+					b = encodeByteSlice(b, v)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrHeadOmitEmptyMarshalJSON:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6881,7 +9296,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
-					bb, err := v.(Marshaler).MarshalJSON()
+					bb, err := ctx.marshalJSON(v)
 					if err != nil {
 						return nil, &MarshalerError{
 							Type: rtype2type(code.typ),
@@ -6899,8 +9314,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					} else {
 						b = append(b, code.key...)
 						buf := bytes.NewBuffer(b)
-						//TODO: we should validate buffer with `compact`
-						if err := compact(buf, bb, false); err != nil {
+						if err := compactWithOption(buf, bb, opt); err != nil {
 							return nil, err
 						}
 						b = buf.Bytes()
@@ -6909,6 +9323,54 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					}
 				}
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				ptr += code.offset
+				p := ptrToUnsafePtr(ptr)
+				isPtr := code.typ.Kind() == reflect.Ptr
+				if p == nil || (!isPtr && *(*unsafe.Pointer)(p) == nil) {
+					code = code.nextField
+				} else {
+					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
+					bb, err := ctx.marshalJSON(v)
+					if err != nil {
+						return nil, &MarshalerError{
+							Type: rtype2type(code.typ),
+							Err:  err,
+						}
+					}
+					if len(bb) == 0 {
+						if isPtr {
+							return nil, errUnexpectedEndOfJSON(
+								fmt.Sprintf("error calling MarshalJSON for type %s", code.typ),
+								0,
+							)
+						}
+						code = code.nextField
+					} else {
+						var buf bytes.Buffer
+						if err := compact(&buf, bb, false); err != nil {
+							return nil, err
+						}
+						b = append(b, code.key...)
+						b = encodeNoEscapedStringWithOption(b, buf.String(), opt)
+						b = encodeComma(b)
+						code = code.next
+					}
+				}
+			}
 		case opStructFieldPtrAnonymousHeadOmitEmptyMarshalJSON:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6927,7 +9389,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
-					bb, err := v.(Marshaler).MarshalJSON()
+					bb, err := ctx.marshalJSON(v)
 					if err != nil {
 						return nil, &MarshalerError{
 							Type: rtype2type(code.typ),
@@ -6945,8 +9407,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					} else {
 						b = append(b, code.key...)
 						buf := bytes.NewBuffer(b)
-						//TODO: we should validate buffer with `compact`
-						if err := compact(buf, bb, false); err != nil {
+						if err := compactWithOption(buf, bb, opt); err != nil {
 							return nil, err
 						}
 						b = buf.Bytes()
@@ -6955,20 +9416,17 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					}
 				}
 			}
-		case opStructFieldPtrHeadOmitEmptyMarshalText:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalJSON:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
 				store(ctxptr, code.idx, ptrToPtr(ptr))
 			}
 			fallthrough
-		case opStructFieldHeadOmitEmptyMarshalText:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagMarshalJSON:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
-				b = encodeNull(b)
-				b = encodeComma(b)
 				code = code.end.next
 			} else {
-				b = append(b, '{')
 				ptr += code.offset
 				p := ptrToUnsafePtr(ptr)
 				isPtr := code.typ.Kind() == reflect.Ptr
@@ -6976,30 +9434,47 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					code = code.nextField
 				} else {
 					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
-					bytes, err := v.(encoding.TextMarshaler).MarshalText()
+					bb, err := ctx.marshalJSON(v)
 					if err != nil {
 						return nil, &MarshalerError{
 							Type: rtype2type(code.typ),
 							Err:  err,
 						}
 					}
-					b = append(b, code.key...)
-					b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
-					b = encodeComma(b)
-					code = code.next
+					if len(bb) == 0 {
+						if isPtr {
+							return nil, errUnexpectedEndOfJSON(
+								fmt.Sprintf("error calling MarshalJSON for type %s", code.typ),
+								0,
+							)
+						}
+						code = code.nextField
+					} else {
+						var buf bytes.Buffer
+						if err := compact(&buf, bb, false); err != nil {
+							return nil, err
+						}
+						b = append(b, code.key...)
+						b = encodeNoEscapedStringWithOption(b, buf.String(), opt)
+						b = encodeComma(b)
+						code = code.next
+					}
 				}
 			}
-		case opStructFieldPtrAnonymousHeadOmitEmptyMarshalText:
+		case opStructFieldPtrHeadOmitEmptyMarshalText:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
 				store(ctxptr, code.idx, ptrToPtr(ptr))
 			}
 			fallthrough
-		case opStructFieldAnonymousHeadOmitEmptyMarshalText:
+		case opStructFieldHeadOmitEmptyMarshalText:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
 				code = code.end.next
 			} else {
+				b = append(b, '{')
 				ptr += code.offset
 				p := ptrToUnsafePtr(ptr)
 				isPtr := code.typ.Kind() == reflect.Ptr
@@ -7015,18 +9490,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 						}
 					}
 					b = append(b, code.key...)
-					b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+					b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
 			}
-		case opStructFieldPtrHeadStringTag:
+		case opStructFieldPtrHeadOmitEmptyStringTagMarshalText:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
 				store(ctxptr, code.idx, ptrToPtr(ptr))
 			}
 			fallthrough
-		case opStructFieldHeadStringTag:
+		case opStructFieldHeadOmitEmptyStringTagMarshalText:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
 				b = encodeNull(b)
@@ -7034,18 +9509,114 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				code = code.end.next
 			} else {
 				b = append(b, '{')
-				p := ptr + code.offset
-				b = append(b, code.key...)
-				code = code.next
-				store(ctxptr, code.idx, p)
-			}
-		case opStructFieldPtrAnonymousHeadStringTag:
-			ptr := load(ctxptr, code.idx)
-			if ptr != 0 {
-				store(ctxptr, code.idx, ptrToPtr(ptr))
-			}
-			fallthrough
-		case opStructFieldAnonymousHeadStringTag:
+				ptr += code.offset
+				p := ptrToUnsafePtr(ptr)
+				isPtr := code.typ.Kind() == reflect.Ptr
+				if p == nil || (!isPtr && *(*unsafe.Pointer)(p) == nil) {
+					code = code.nextField
+				} else {
+					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
+					bytes, err := v.(encoding.TextMarshaler).MarshalText()
+					if err != nil {
+						return nil, &MarshalerError{
+							Type: rtype2type(code.typ),
+							Err:  err,
+						}
+					}
+					b = append(b, code.key...)
+					b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyMarshalText:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyMarshalText:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				ptr += code.offset
+				p := ptrToUnsafePtr(ptr)
+				isPtr := code.typ.Kind() == reflect.Ptr
+				if p == nil || (!isPtr && *(*unsafe.Pointer)(p) == nil) {
+					code = code.nextField
+				} else {
+					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
+					bytes, err := v.(encoding.TextMarshaler).MarshalText()
+					if err != nil {
+						return nil, &MarshalerError{
+							Type: rtype2type(code.typ),
+							Err:  err,
+						}
+					}
+					b = append(b, code.key...)
+					b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalText:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagMarshalText:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				ptr += code.offset
+				p := ptrToUnsafePtr(ptr)
+				isPtr := code.typ.Kind() == reflect.Ptr
+				if p == nil || (!isPtr && *(*unsafe.Pointer)(p) == nil) {
+					code = code.nextField
+				} else {
+					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
+					bytes, err := v.(encoding.TextMarshaler).MarshalText()
+					if err != nil {
+						return nil, &MarshalerError{
+							Type: rtype2type(code.typ),
+							Err:  err,
+						}
+					}
+					b = append(b, code.key...)
+					b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p := ptr + code.offset
+				b = append(b, code.key...)
+				code = code.next
+				store(ctxptr, code.idx, p)
+			}
+		case opStructFieldPtrAnonymousHeadStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadStringTag:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
 				code = code.end.next
@@ -7146,7 +9717,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p := ptrToUnsafePtr(ptr)
 				isPtr := code.typ.Kind() == reflect.Ptr
 				v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
-				bb, err := v.(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(v)
 				if err != nil {
 					return nil, &MarshalerError{
 						Type: rtype2type(code.typ),
@@ -7170,7 +9741,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 						return nil, err
 					}
 					b = append(b, code.key...)
-					b = encodeNoEscapedString(b, buf.String())
+					b = encodeNoEscapedStringWithOption(b, buf.String(), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -7190,7 +9761,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				p := ptrToUnsafePtr(ptr)
 				isPtr := code.typ.Kind() == reflect.Ptr
 				v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
-				bb, err := v.(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(v)
 				if err != nil {
 					return nil, &MarshalerError{
 						Type: rtype2type(code.typ),
@@ -7214,7 +9785,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 						return nil, err
 					}
 					b = append(b, code.key...)
-					b = encodeNoEscapedString(b, buf.String())
+					b = encodeNoEscapedStringWithOption(b, buf.String(), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -7244,7 +9815,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					}
 				}
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -7267,7 +9838,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					return nil, errMarshaler(code, err)
 				}
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -7281,7 +9852,17 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 		case opStructFieldOmitEmpty:
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
-			if p == 0 || **(**uintptr)(unsafe.Pointer(&p)) == 0 {
+			if p == 0 || encodeIsOmitted(code, p) {
+				code = code.nextField
+			} else {
+				b = append(b, code.key...)
+				code = code.next
+				store(ctxptr, code.idx, p)
+			}
+		case opStructFieldOmitEmptyStringTag:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			if p == 0 || encodeIsOmitted(code, p) {
 				code = code.nextField
 			} else {
 				b = append(b, code.key...)
@@ -7297,7 +9878,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 		case opStructFieldInt:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt:
@@ -7305,7 +9886,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7324,7 +9916,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p)))
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -7341,14 +9933,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p)))
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldInt8:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt8:
@@ -7356,7 +9948,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt8(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt8(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7375,14 +9978,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldInt16:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt16:
@@ -7390,7 +9993,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt16(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt16(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7409,14 +10023,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldInt32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt32:
@@ -7424,7 +10038,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7443,14 +10068,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldInt64:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, ptrToInt64(ptr+code.offset))
+			b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt64:
@@ -7458,7 +10083,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt64(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt64(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7477,14 +10113,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p))
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint:
@@ -7492,7 +10128,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7511,14 +10158,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint8:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint8:
@@ -7526,7 +10173,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint8(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint8(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7545,14 +10203,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint16:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint16:
@@ -7560,7 +10218,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint16(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint16(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7579,14 +10248,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint32:
@@ -7594,7 +10263,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7613,14 +10293,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint64:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, ptrToUint64(ptr+code.offset))
+			b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint64:
@@ -7628,7 +10308,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint64(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint64(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7647,14 +10338,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p))
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldFloat32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyFloat32:
@@ -7662,16 +10357,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToFloat32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
-				b = encodeFloat32(b, v)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
 		case opStructFieldStringTagFloat32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = append(b, '"')
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-			b = append(b, '"')
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32StringWithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldFloat32Ptr:
@@ -7681,7 +10393,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -7689,34 +10405,44 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
 			if v != 0 {
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = append(b, code.key...)
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat64(ptr + code.offset)
+			if v != 0 {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.key...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
 		case opStructFieldStringTagFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
 			b = append(b, code.key...)
-			b = append(b, '"')
-			b = encodeFloat64(b, v)
-			b = append(b, '"')
+			b = encodeFloat64StringWithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldFloat64Ptr:
@@ -7730,16 +10456,16 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				break
 			}
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldString:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = encodeNoEscapedString(b, ptrToString(ptr+code.offset))
+			b = encodeNoEscapedStringWithOption(b, ptrToString(ptr+code.offset), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyString:
@@ -7747,7 +10473,16 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToString(ptr + code.offset)
 			if v != "" {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, v)
+				b = encodeNoEscapedStringWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToString(ptr + code.offset)
+			if v != "" {
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7755,7 +10490,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
 			s := ptrToString(ptr + code.offset)
-			b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, s)))
+			b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, s, opt)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldStringPtr:
@@ -7765,7 +10500,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -7774,7 +10509,16 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagStringPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7785,7 +10529,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -7804,6 +10548,17 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				b = encodeComma(b)
 			}
 			code = code.next
+		case opStructFieldOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBool(ptr + code.offset)
+			if v {
+				b = append(b, code.key...)
+				b = append(b, '"')
+				b = encodeBool(b, v)
+				b = append(b, '"')
+				b = encodeComma(b)
+			}
+			code = code.next
 		case opStructFieldStringTagBool:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
@@ -7838,6 +10593,15 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				b = encodeComma(b)
 			}
 			code = code.next
+		case opStructFieldOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBytes(ptr + code.offset)
+			if len(v) > 0 {
+				b = append(b, code.key...)
+				b = encodeByteSlice(b, v)
+				b = encodeComma(b)
+			}
+			code = code.next
 		case opStructFieldStringTagBytes:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToBytes(ptr + code.offset)
@@ -7850,13 +10614,12 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			b = append(b, code.key...)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
 			buf := bytes.NewBuffer(b)
-			//TODO: we should validate buffer with `compact`
-			if err := compact(buf, bb, false); err != nil {
+			if err := compactWithOption(buf, bb, opt); err != nil {
 				return nil, err
 			}
 			b = buf.Bytes()
@@ -7866,7 +10629,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -7875,7 +10638,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				return nil, err
 			}
 			b = append(b, code.key...)
-			b = encodeNoEscapedString(b, buf.String())
+			b = encodeNoEscapedStringWithOption(b, buf.String(), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyMarshalJSON:
@@ -7886,20 +10649,40 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			}
 			v := ptrToInterface(code, p)
 			if v != nil && p != 0 {
-				bb, err := v.(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(v)
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
 				b = append(b, code.key...)
 				buf := bytes.NewBuffer(b)
-				//TODO: we should validate buffer with `compact`
-				if err := compact(buf, bb, false); err != nil {
+				if err := compactWithOption(buf, bb, opt); err != nil {
 					return nil, err
 				}
 				b = buf.Bytes()
 				b = encodeComma(b)
 			}
 			code = code.next
+		case opStructFieldOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			if code.typ.Kind() == reflect.Ptr && code.typ.Elem().Implements(marshalJSONType) {
+				p = ptrToPtr(p)
+			}
+			v := ptrToInterface(code, p)
+			if v != nil && p != 0 {
+				bb, err := ctx.marshalJSON(v)
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				var buf bytes.Buffer
+				if err := compact(&buf, bb, false); err != nil {
+					return nil, err
+				}
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, buf.String(), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
 		case opStructFieldMarshalText:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
@@ -7909,7 +10692,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
-			b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+			b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldStringTagMarshalText:
@@ -7921,7 +10704,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				return nil, errMarshaler(code, err)
 			}
 			b = append(b, code.key...)
-			b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+			b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyMarshalText:
@@ -7934,7 +10717,21 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					return nil, errMarshaler(code, err)
 				}
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagMarshalText:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			if v != nil {
+				bytes, err := v.(encoding.TextMarshaler).MarshalText()
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -8020,6 +10817,25 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptr + code.offset
 			code = code.next
 			store(ctxptr, code.idx, p)
+		case opStructFieldUnknown:
+			entries := encodeUnknownFieldEntries(code, ctxptr, opt)
+			if len(entries) > 0 {
+				valueCodeSet, err := encodeUnknownFieldValueCodeSet(ctx, code, opt)
+				if err != nil {
+					return nil, err
+				}
+				for _, entry := range entries {
+					b = encodeNoEscapedStringWithOption(b, entry.key, opt)
+					b = append(b, ':')
+					bb, err := encodeRunUnknownFieldValue(ctx, b, codeSet, valueCodeSet, ptrOffset, entry, opt, encodeRun)
+					if err != nil {
+						return nil, err
+					}
+					b = bb
+				}
+				ctxptr = ctx.ptr() + ptrOffset
+			}
+			code = code.next
 		case opStructEnd:
 			last := len(b) - 1
 			if b[last] == ',' {
@@ -8034,7 +10850,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 		case opStructEndInt:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt:
@@ -8042,7 +10858,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8069,7 +10904,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p)))
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8078,7 +10913,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagIntPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8116,14 +10970,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p)))
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndInt8:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt8:
@@ -8131,7 +10985,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt8(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt8(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8158,7 +11031,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8167,7 +11040,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt8Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8205,14 +11097,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndInt16:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt16:
@@ -8220,7 +11112,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt16(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt16(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8247,7 +11158,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8256,7 +11167,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt16Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8294,14 +11224,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndInt32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt32:
@@ -8309,7 +11239,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8336,7 +11285,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8345,7 +11294,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8383,14 +11351,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndInt64:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendInt(b, ptrToInt64(ptr+code.offset))
+			b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt64:
@@ -8398,7 +11366,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToInt64(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt64(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8425,7 +11412,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p))
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8434,7 +11421,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt64Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8472,14 +11478,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p))
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint:
@@ -8487,7 +11493,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
-				b = appendUint(b, uint64(v))
+				b = appendUintWithOption(b, uint64(v), opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8499,14 +11505,33 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				}
 			}
 			code = code.next
-		case opStructEndStringTagUint:
+		case opStructEndOmitEmptyStringTagUint:
 			ptr := load(ctxptr, code.headIdx)
-			b = append(b, code.key...)
-			b = append(b, '"')
-			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
-			b = append(b, '"')
-			b = appendStructEnd(b)
-			code = code.next
+			v := ptrToUint(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
+				b = appendUint(b, uint64(v))
+				b = append(b, '"')
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndStringTagUint:
+			ptr := load(ctxptr, code.headIdx)
+			b = append(b, code.key...)
+			b = append(b, '"')
+			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+			b = append(b, '"')
+			b = appendStructEnd(b)
+			code = code.next
 		case opStructEndUintPtr:
 			b = append(b, code.key...)
 			ptr := load(ctxptr, code.headIdx)
@@ -8514,7 +11539,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8523,7 +11548,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUintPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8561,14 +11605,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint8:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint8:
@@ -8576,7 +11620,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint8(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint8(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8603,7 +11666,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8612,7 +11675,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint8Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8650,14 +11732,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint16:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint16:
@@ -8665,7 +11747,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint16(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint16(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8692,7 +11793,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8701,7 +11802,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint16Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8739,14 +11859,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint32:
@@ -8754,7 +11874,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8781,7 +11920,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8790,7 +11929,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8828,14 +11986,14 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint64:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = appendUint(b, ptrToUint64(ptr+code.offset))
+			b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint64:
@@ -8843,7 +12001,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToUint64(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint64(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8870,7 +12047,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p))
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8879,7 +12056,26 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint64Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8917,14 +12113,18 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p))
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndFloat32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyFloat32:
@@ -8932,7 +12132,30 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToFloat32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.key...)
-				b = encodeFloat32(b, v)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.key...)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8947,9 +12170,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 		case opStructEndStringTagFloat32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = append(b, '"')
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-			b = append(b, '"')
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32StringWithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndFloat32Ptr:
@@ -8959,7 +12184,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8968,7 +12197,32 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8987,9 +12241,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p))
-				b = append(b, '"')
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -9006,7 +12262,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -9014,21 +12274,41 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
 			if v != 0 {
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.key...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat64(ptr + code.offset)
+			if v != 0 {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = append(b, code.key...)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -9043,13 +12323,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 		case opStructEndStringTagFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
 			b = append(b, code.key...)
-			b = append(b, '"')
-			b = encodeFloat64(b, v)
-			b = append(b, '"')
+			b = encodeFloat64StringWithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndFloat64Ptr:
@@ -9063,10 +12341,10 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				break
 			}
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyFloat64Ptr:
@@ -9075,10 +12353,31 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p != 0 {
 				b = append(b, code.key...)
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat64Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				v := ptrToFloat64(p)
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -9097,13 +12396,11 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -9121,17 +12418,17 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndString:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
-			b = encodeNoEscapedString(b, ptrToString(ptr+code.offset))
+			b = encodeNoEscapedStringWithOption(b, ptrToString(ptr+code.offset), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyString:
@@ -9139,7 +12436,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			v := ptrToString(ptr + code.offset)
 			if v != "" {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, v)
+				b = encodeNoEscapedStringWithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToString(ptr + code.offset)
+			if v != "" {
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -9155,7 +12469,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
 			s := ptrToString(ptr + code.offset)
-			b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, s)))
+			b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, s, opt)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndStringPtr:
@@ -9165,7 +12479,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -9174,7 +12488,24 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagStringPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -9194,7 +12525,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				b = encodeNull(b)
 			} else {
 				v := ptrToString(p)
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, v)))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -9211,7 +12542,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -9238,6 +12569,25 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				}
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBool(ptr + code.offset)
+			if v {
+				b = append(b, code.key...)
+				b = append(b, '"')
+				b = encodeBool(b, v)
+				b = append(b, '"')
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
 		case opStructEndStringTagBool:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.key...)
@@ -9280,6 +12630,23 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				}
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBytes(ptr + code.offset)
+			if len(v) > 0 {
+				b = append(b, code.key...)
+				b = encodeByteSlice(b, v)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
 		case opStructEndStringTagBytes:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToBytes(ptr + code.offset)
@@ -9292,13 +12659,12 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			b = append(b, code.key...)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
 			buf := bytes.NewBuffer(b)
-			//TODO: we should validate buffer with `compact`
-			if err := compact(buf, bb, false); err != nil {
+			if err := compactWithOption(buf, bb, opt); err != nil {
 				return nil, err
 			}
 			b = buf.Bytes()
@@ -9309,14 +12675,13 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
 			if v != nil && (code.typ.Kind() != reflect.Ptr || ptrToPtr(p) != 0) {
-				bb, err := v.(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(v)
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
 				b = append(b, code.key...)
 				buf := bytes.NewBuffer(b)
-				//TODO: we should validate buffer with `compact`
-				if err := compact(buf, bb, false); err != nil {
+				if err := compactWithOption(buf, bb, opt); err != nil {
 					return nil, err
 				}
 				b = buf.Bytes()
@@ -9331,11 +12696,37 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				}
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			if v != nil && (code.typ.Kind() != reflect.Ptr || ptrToPtr(p) != 0) {
+				bb, err := ctx.marshalJSON(v)
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				var buf bytes.Buffer
+				if err := compact(&buf, bb, false); err != nil {
+					return nil, err
+				}
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, buf.String(), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
 		case opStructEndStringTagMarshalJSON:
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -9344,7 +12735,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				return nil, err
 			}
 			b = append(b, code.key...)
-			b = encodeNoEscapedString(b, buf.String())
+			b = encodeNoEscapedStringWithOption(b, buf.String(), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndMarshalText:
@@ -9356,7 +12747,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
-			b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+			b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyMarshalText:
@@ -9369,7 +12760,29 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 					return nil, errMarshaler(code, err)
 				}
 				b = append(b, code.key...)
-				b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagMarshalText:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			if v != nil {
+				bytes, err := v.(encoding.TextMarshaler).MarshalText()
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				b = append(b, code.key...)
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -9390,7 +12803,7 @@ func encodeRun(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, opt Enco
 				return nil, errMarshaler(code, err)
 			}
 			b = append(b, code.key...)
-			b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+			b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opEnd:
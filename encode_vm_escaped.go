@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding"
 	"fmt"
-	"math"
 	"reflect"
 	"runtime"
 	"sort"
@@ -17,8 +16,16 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 	ctxptr := ctx.ptr()
 
 	code := codeSet.code
+	done := ctx.done
 
 	for {
+		if done != nil {
+			select {
+			case <-done:
+				return nil, ctx.context.Err()
+			default:
+			}
+		}
 		switch code.op {
 		default:
 			return nil, fmt.Errorf("encoder (escaped): opcode %s has not been implemented", code.op)
@@ -27,43 +34,43 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			code = code.next
 			store(ctxptr, code.idx, ptrToPtr(ptr))
 		case opInt:
-			b = appendInt(b, int64(ptrToInt(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opInt8:
-			b = appendInt(b, int64(ptrToInt8(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt8(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opInt16:
-			b = appendInt(b, int64(ptrToInt16(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt16(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opInt32:
-			b = appendInt(b, int64(ptrToInt32(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt32(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opInt64:
-			b = appendInt(b, ptrToInt64(load(ctxptr, code.idx)))
+			b = appendIntWithOption(b, ptrToInt64(load(ctxptr, code.idx)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint:
-			b = appendUint(b, uint64(ptrToUint(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint8:
-			b = appendUint(b, uint64(ptrToUint8(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint8(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint16:
-			b = appendUint(b, uint64(ptrToUint16(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint16(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint32:
-			b = appendUint(b, uint64(ptrToUint32(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint32(load(ctxptr, code.idx))), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opUint64:
-			b = appendUint(b, ptrToUint64(load(ctxptr, code.idx)))
+			b = appendUintWithOption(b, ptrToUint64(load(ctxptr, code.idx)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opIntString:
@@ -127,15 +134,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			b = encodeComma(b)
 			code = code.next
 		case opFloat32:
-			b = encodeFloat32(b, ptrToFloat32(load(ctxptr, code.idx)))
+			v := ptrToFloat32(load(ctxptr, code.idx))
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opFloat64:
 			v := ptrToFloat64(load(ctxptr, code.idx))
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opString:
@@ -178,7 +189,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				break
 			}
 			ctx.keepRefs = append(ctx.keepRefs, unsafe.Pointer(iface))
-			ifaceCodeSet, err := encodeCompileToGetCodeSet(uintptr(unsafe.Pointer(iface.typ)))
+			ifaceCodeSet, err := ctx.compileToGetCodeSet(uintptr(unsafe.Pointer(iface.typ)), opt, code.fields)
 			if err != nil {
 				return nil, err
 			}
@@ -211,6 +222,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 
 			b = bb
 			code = code.next
+		case opCustom:
+			ptr := load(ctxptr, code.idx)
+			i := 0
+			for ; i < code.ptrNum && ptr != 0; i++ {
+				ptr = ptrToPtr(ptr)
+			}
+			// the zero value of a type stored directly in an interface is a nil word,
+			// which is null for a pointer like a nil Marshaler
+			if ptr == 0 && (i < code.ptrNum || !code.isDirectIface || code.typ.Kind() == reflect.Ptr) {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.next
+				break
+			}
+			bb, err := encodeCustom(ctx, code, b, ptr, opt)
+			if err != nil {
+				return nil, err
+			}
+			b = encodeComma(bb)
+			code = code.next
 		case opMarshalJSON:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
@@ -220,7 +251,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				break
 			}
 			v := ptrToInterface(code, ptr)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -261,8 +292,12 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			slice := ptrToSlice(p)
 			if p == 0 || uintptr(slice.data) == 0 {
-				b = encodeNull(b)
-				b = encodeComma(b)
+				if p != 0 && encodeNilAsEmpty(code, opt) {
+					b = append(b, '[', ']', ',')
+				} else {
+					b = encodeNull(b)
+					b = encodeComma(b)
+				}
 				code = code.end.next
 			} else {
 				store(ctxptr, code.elemIdx, 0)
@@ -282,6 +317,13 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			length := load(ctxptr, code.length)
 			idx++
 			if idx < length {
+				if ctx.w != nil {
+					bb, err := ctx.flush(b)
+					if err != nil {
+						return nil, err
+					}
+					b = bb
+				}
 				store(ctxptr, code.elemIdx, idx)
 				data := load(ctxptr, code.headIdx)
 				size := code.size
@@ -314,6 +356,13 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			idx := load(ctxptr, code.elemIdx)
 			idx++
 			if idx < code.length {
+				if ctx.w != nil {
+					bb, err := ctx.flush(b)
+					if err != nil {
+						return nil, err
+					}
+					b = bb
+				}
 				store(ctxptr, code.elemIdx, idx)
 				p := load(ctxptr, code.headIdx)
 				size := code.size
@@ -328,12 +377,16 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 		case opMapHead:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
-				b = encodeNull(b)
-				b = encodeComma(b)
+				if encodeNilAsEmpty(code, opt) {
+					b = append(b, '{', '}', ',')
+				} else {
+					b = encodeNull(b)
+					b = encodeComma(b)
+				}
 				code = code.end.next
 			} else {
 				uptr := ptrToUnsafePtr(ptr)
-				mlen := maplen(uptr)
+				mlen := encodeMapLen(code, uptr)
 				if mlen > 0 {
 					b = append(b, '{')
 					iter := mapiterinit(code.typ, uptr)
@@ -343,10 +396,12 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					store(ctxptr, code.mapIter, uintptr(iter))
 					if (opt & EncodeOptionUnorderedMap) == 0 {
 						mapCtx := newMapContext(mlen)
+						ctx.sortedMaps++
 						mapCtx.pos = append(mapCtx.pos, len(b))
 						ctx.keepRefs = append(ctx.keepRefs, unsafe.Pointer(mapCtx))
 						store(ctxptr, code.end.mapPos, uintptr(unsafe.Pointer(mapCtx)))
 					}
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					code = code.next
@@ -366,12 +421,16 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				ptr = ptrToPtr(ptr)
 				uptr := ptrToUnsafePtr(ptr)
 				if ptr == 0 {
-					b = encodeNull(b)
-					b = encodeComma(b)
+					if encodeNilAsEmpty(code, opt) {
+						b = append(b, '{', '}', ',')
+					} else {
+						b = encodeNull(b)
+						b = encodeComma(b)
+					}
 					code = code.end.next
 					break
 				}
-				mlen := maplen(uptr)
+				mlen := encodeMapLen(code, uptr)
 				if mlen > 0 {
 					b = append(b, '{')
 					iter := mapiterinit(code.typ, uptr)
@@ -379,10 +438,12 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					store(ctxptr, code.elemIdx, 0)
 					store(ctxptr, code.length, uintptr(mlen))
 					store(ctxptr, code.mapIter, uintptr(iter))
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					if (opt & EncodeOptionUnorderedMap) == 0 {
 						mapCtx := newMapContext(mlen)
+						ctx.sortedMaps++
 						mapCtx.pos = append(mapCtx.pos, len(b))
 						ctx.keepRefs = append(ctx.keepRefs, unsafe.Pointer(mapCtx))
 						store(ctxptr, code.end.mapPos, uintptr(unsafe.Pointer(mapCtx)))
@@ -399,9 +460,17 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			idx++
 			if (opt & EncodeOptionUnorderedMap) != 0 {
 				if idx < length {
+					if ctx.w != nil {
+						bb, err := ctx.flush(b)
+						if err != nil {
+							return nil, err
+						}
+						b = bb
+					}
 					ptr := load(ctxptr, code.mapIter)
 					iter := ptrToUnsafePtr(ptr)
 					store(ctxptr, code.elemIdx, idx)
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					code = code.next
@@ -419,6 +488,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					ptr := load(ctxptr, code.mapIter)
 					iter := ptrToUnsafePtr(ptr)
 					store(ctxptr, code.elemIdx, idx)
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					code = code.next
@@ -474,6 +544,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			b = append(b, buf...)
 			mapCtx.buf = buf
 			releaseMapContext(mapCtx)
+			ctx.sortedMaps--
 			code = code.next
 		case opStructFieldPtrAnonymousHeadRecursive:
 			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
@@ -552,6 +623,37 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			fallthrough
 		case opStructFieldHeadOmitEmpty:
 			ptr := load(ctxptr, code.idx)
+			if code.isDirectIface {
+				// the data word of the root struct is the value of its only field
+				ptr = ctxptr + code.idx
+			}
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p := ptr + code.offset
+				if p == 0 || encodeIsOmitted(code, p) {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					code = code.next
+					store(ctxptr, code.idx, p)
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if code.isDirectIface {
+				// the data word of the root struct is the value of its only field
+				ptr = ctxptr + code.idx
+			}
 			if ptr == 0 {
 				b = encodeNull(b)
 				b = encodeComma(b)
@@ -559,7 +661,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				p := ptr + code.offset
-				if p == 0 || *(*uintptr)(*(*unsafe.Pointer)(unsafe.Pointer(&p))) == 0 {
+				if p == 0 || encodeIsOmitted(code, p) {
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
@@ -587,6 +689,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				}
 			}
+		case opStructFieldHeadOmitEmptyStringTagOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			if !code.anonymousKey {
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					code = code.next
+					store(ctxptr, code.idx, p)
+				} else {
+					code = code.nextField
+				}
+			}
 		case opStructFieldAnonymousHead:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
@@ -607,7 +721,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				p := ptr + code.offset
-				if p == 0 || *(*uintptr)(*(*unsafe.Pointer)(unsafe.Pointer(&p))) == 0 {
+				if p == 0 || encodeIsOmitted(code, p) {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					code = code.next
+					store(ctxptr, code.idx, p)
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				p := ptr + code.offset
+				if p == 0 || encodeIsOmitted(code, p) {
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
@@ -627,7 +761,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -650,7 +784,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -680,7 +840,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt(p)))
+			b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyIntOnly, opStructFieldHeadOmitEmptyIntOnly:
@@ -689,7 +849,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := int64(ptrToInt(p))
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagIntOnly, opStructFieldHeadOmitEmptyStringTagIntOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := int64(ptrToInt(p))
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -719,7 +891,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -738,7 +910,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagIntPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagIntPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -784,7 +977,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -803,7 +996,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagIntPtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -846,7 +1060,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -860,7 +1074,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -880,7 +1094,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -909,7 +1146,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -923,7 +1160,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntOnly, opStructFieldAnonymousHeadOmitEmptyStringTagIntOnly:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -954,7 +1208,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -972,7 +1226,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagIntPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1010,7 +1284,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1028,7 +1302,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1064,7 +1358,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1087,18 +1381,44 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
-					b = appendInt(b, int64(v))
+					b = appendIntWithOption(b, int64(v), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
 			}
-		case opStructFieldPtrHeadStringTagInt8:
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
 				store(ctxptr, code.idx, ptrToPtr(ptr))
 			}
 			fallthrough
-		case opStructFieldHeadStringTagInt8:
+		case opStructFieldHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
+					b = appendInt(b, int64(v))
+					b = append(b, '"')
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadStringTagInt8:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
 				b = encodeNull(b)
@@ -1117,7 +1437,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt8(p)))
+			b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt8Only, opStructFieldHeadOmitEmptyInt8Only:
@@ -1126,7 +1446,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := int64(ptrToInt8(p))
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8Only, opStructFieldHeadOmitEmptyStringTagInt8Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := int64(ptrToInt8(p))
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -1156,7 +1488,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -1175,7 +1507,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt8(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -1221,7 +1574,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1240,7 +1593,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt8PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -1283,7 +1657,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -1297,7 +1671,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1317,7 +1691,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1346,7 +1743,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1360,7 +1757,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt8Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1391,7 +1805,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1409,7 +1823,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1447,7 +1881,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1465,7 +1899,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1501,7 +1955,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1524,7 +1978,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1554,7 +2034,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt16(p)))
+			b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt16Only, opStructFieldHeadOmitEmptyInt16Only:
@@ -1563,7 +2043,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := int64(ptrToInt16(p))
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16Only, opStructFieldHeadOmitEmptyStringTagInt16Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := int64(ptrToInt16(p))
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -1593,7 +2085,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -1612,7 +2104,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt16(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -1658,7 +2171,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1677,7 +2190,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt16PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -1720,7 +2254,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -1734,7 +2268,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1754,7 +2288,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1783,7 +2340,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1797,25 +2354,42 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
-					b = appendInt(b, int64(v))
+					b = appendIntWithOption(b, int64(v), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
 			}
-		case opStructFieldPtrAnonymousHeadStringTagInt16Only, opStructFieldAnonymousHeadStringTagInt16Only:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt16Only:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
 				code = code.end.next
 			} else {
-				b = append(b, code.escapedKey...)
-				b = append(b, '"')
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
-				b = append(b, '"')
-				b = encodeComma(b)
-				code = code.next
-			}
-		case opStructFieldPtrAnonymousHeadInt16Ptr:
-			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+				v := ptrToInt16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
+					b = appendInt(b, int64(v))
+					b = append(b, '"')
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadStringTagInt16Only, opStructFieldAnonymousHeadStringTagInt16Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
+				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = append(b, '"')
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadInt16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
 			fallthrough
 		case opStructFieldAnonymousHeadInt16Ptr:
 			p := load(ctxptr, code.idx)
@@ -1828,7 +2402,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1846,7 +2420,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1884,7 +2478,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -1902,7 +2496,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1938,7 +2552,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -1961,7 +2575,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -1991,7 +2631,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt32(p)))
+			b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt32Only, opStructFieldHeadOmitEmptyInt32Only:
@@ -2000,7 +2640,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := int64(ptrToInt32(p))
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32Only, opStructFieldHeadOmitEmptyStringTagInt32Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := int64(ptrToInt32(p))
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2030,7 +2682,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2049,7 +2701,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt32(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -2095,7 +2768,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2114,7 +2787,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt32PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2157,7 +2851,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2171,7 +2865,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2191,7 +2885,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2220,7 +2937,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2234,7 +2951,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt32Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2265,7 +2999,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2283,7 +3017,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2321,7 +3075,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2339,7 +3093,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2375,7 +3149,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, ptrToInt64(ptr+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2398,7 +3172,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToInt64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2428,7 +3228,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, ptrToInt64(p))
+			b = appendIntWithOption(b, ptrToInt64(p), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt64Only, opStructFieldHeadOmitEmptyInt64Only:
@@ -2437,7 +3237,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt64(p)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64Only, opStructFieldHeadOmitEmptyStringTagInt64Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToInt64(p)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2467,7 +3279,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, ptrToInt64(p+code.offset))
+					b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2486,30 +3298,51 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
-					b = appendInt(b, ptrToInt64(p))
+					b = appendIntWithOption(b, ptrToInt64(p), opt)
 					b = encodeComma(b)
 				}
 				code = code.next
 			}
-		case opStructFieldPtrHeadStringTagInt64Ptr:
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64Ptr:
 			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
 			fallthrough
-		case opStructFieldHeadStringTagInt64Ptr:
+		case opStructFieldHeadOmitEmptyStringTagInt64Ptr:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				b = encodeNull(b)
 				b = encodeComma(b)
 				code = code.end.next
-				break
 			} else {
 				b = append(b, '{')
-				b = append(b, code.escapedKey...)
 				p = ptrToPtr(p)
-				if p == 0 {
-					b = encodeNull(b)
-				} else {
+				if p != 0 {
+					b = append(b, code.escapedKey...)
 					b = append(b, '"')
-					b = appendInt(b, ptrToInt64(p+code.offset))
+					b = appendInt(b, ptrToInt64(p))
+					b = append(b, '"')
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadStringTagInt64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadStringTagInt64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			} else {
+				b = append(b, '{')
+				b = append(b, code.escapedKey...)
+				p = ptrToPtr(p)
+				if p == 0 {
+					b = encodeNull(b)
+				} else {
+					b = append(b, '"')
+					b = appendInt(b, ptrToInt64(p+code.offset))
 					b = append(b, '"')
 				}
 			}
@@ -2532,7 +3365,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2551,7 +3384,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt64PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2594,7 +3448,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, ptrToInt64(p+code.offset))
+					b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2608,7 +3462,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, ptrToInt64(ptr+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2628,7 +3482,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2657,7 +3534,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendInt(b, ptrToInt64(ptr+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2671,7 +3548,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendIntWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt64Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendInt(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2702,7 +3596,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2720,7 +3614,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2758,7 +3672,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2776,7 +3690,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2812,7 +3746,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -2835,7 +3769,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -2865,7 +3825,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUintOnly, opStructFieldHeadOmitEmptyUintOnly:
@@ -2874,7 +3834,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := uint64(ptrToUint(p))
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUintOnly, opStructFieldHeadOmitEmptyStringTagUintOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := uint64(ptrToUint(p))
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -2904,7 +3876,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -2923,7 +3895,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUintPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUintPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -2969,7 +3962,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -2988,7 +3981,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUintPtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3031,7 +4045,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3045,7 +4059,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3065,7 +4079,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3094,7 +4131,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3108,7 +4145,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintOnly, opStructFieldAnonymousHeadOmitEmptyStringTagUintOnly:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3139,7 +4193,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3157,7 +4211,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUintPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3195,7 +4269,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3213,11 +4287,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
-		case opStructFieldPtrAnonymousHeadStringTagUintPtrOnly:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				code = code.end.next
@@ -3225,16 +4299,36 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagUintPtrOnly:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUintPtrOnly:
 			p := load(ctxptr, code.idx)
-			b = append(b, code.escapedKey...)
 			if p == 0 {
-				b = encodeNull(b)
+				code = code.nextField
 			} else {
+				b = append(b, code.escapedKey...)
 				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
 				b = append(b, '"')
-			}
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, code.escapedKey...)
+			if p == 0 {
+				b = encodeNull(b)
+			} else {
+				b = append(b, '"')
+				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = append(b, '"')
+			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadUint8:
@@ -3249,7 +4343,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3272,7 +4366,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3302,7 +4422,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint8(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint8Only, opStructFieldHeadOmitEmptyUint8Only:
@@ -3311,7 +4431,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := uint64(ptrToUint8(p))
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8Only, opStructFieldHeadOmitEmptyStringTagUint8Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := uint64(ptrToUint8(p))
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3341,7 +4473,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3360,7 +4492,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint8(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -3406,7 +4559,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3425,7 +4578,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint8PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3468,7 +4642,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3482,7 +4656,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3502,7 +4676,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3531,7 +4728,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3545,7 +4742,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint8Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3576,7 +4790,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3594,7 +4808,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3632,7 +4866,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3650,7 +4884,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3686,7 +4940,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3709,7 +4963,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -3739,7 +5019,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint16(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint16Only, opStructFieldHeadOmitEmptyUint16Only:
@@ -3748,7 +5028,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := uint64(ptrToUint16(p))
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16Only, opStructFieldHeadOmitEmptyStringTagUint16Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := uint64(ptrToUint16(p))
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3778,7 +5070,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3797,7 +5089,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint16(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -3843,7 +5156,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -3862,7 +5175,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint16PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -3905,7 +5239,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -3919,7 +5253,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3939,36 +5273,59 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
-					b = appendUint(b, uint64(v))
+					b = appendUintWithOption(b, uint64(v), opt)
 					b = encodeComma(b)
 					code = code.next
 				}
 			}
-		case opStructFieldPtrAnonymousHeadStringTagUint16:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
 				store(ctxptr, code.idx, ptrToPtr(ptr))
 			}
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagUint16:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint16:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
 				code = code.end.next
 			} else {
-				b = append(b, code.escapedKey...)
-				b = append(b, '"')
-				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
-				b = append(b, '"')
-				b = encodeComma(b)
-				code = code.next
-			}
-		case opStructFieldPtrAnonymousHeadUint16Only, opStructFieldAnonymousHeadUint16Only:
-			ptr := load(ctxptr, code.idx)
-			if ptr == 0 {
+				v := ptrToUint16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
+					b = appendUint(b, uint64(v))
+					b = append(b, '"')
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+				b = append(b, '"')
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadUint16Only, opStructFieldAnonymousHeadUint16Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -3982,7 +5339,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint16Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4013,7 +5387,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4031,7 +5405,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4069,7 +5463,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4087,7 +5481,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4123,7 +5537,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4146,7 +5560,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4176,7 +5616,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint32(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint32Only, opStructFieldHeadOmitEmptyUint32Only:
@@ -4185,7 +5625,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := uint64(ptrToUint32(p))
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32Only, opStructFieldHeadOmitEmptyStringTagUint32Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := uint64(ptrToUint32(p))
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -4215,7 +5667,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -4234,7 +5686,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint32(p)))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -4280,7 +5753,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4299,7 +5772,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint32PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -4342,7 +5836,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -4356,7 +5850,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4376,7 +5870,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4405,7 +5922,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4419,7 +5936,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint32Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4450,7 +5984,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4468,7 +6002,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4506,7 +6060,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4524,7 +6078,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4560,7 +6134,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, ptrToUint64(ptr+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4583,7 +6157,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToUint64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4613,7 +6213,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, ptrToUint64(p))
+			b = appendUintWithOption(b, ptrToUint64(p), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint64Only, opStructFieldHeadOmitEmptyUint64Only:
@@ -4622,7 +6222,19 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint64(p)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, v)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64Only, opStructFieldHeadOmitEmptyStringTagUint64Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToUint64(p)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
+				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -4652,7 +6264,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, ptrToUint64(p+code.offset))
+					b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -4671,7 +6283,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, ptrToUint64(p), opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, ptrToUint64(p))
+					b = append(b, '"')
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -4717,7 +6350,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4736,7 +6369,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint64PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -4779,7 +6433,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, ptrToUint64(p+code.offset))
+					b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 				}
 			}
 			b = encodeComma(b)
@@ -4793,7 +6447,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, ptrToUint64(ptr+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4813,7 +6467,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4842,7 +6519,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, ptrToUint64(ptr+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4856,7 +6533,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
+					b = appendUintWithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint64Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
 					b = appendUint(b, v)
+					b = append(b, '"')
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -4887,7 +6581,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4905,7 +6599,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4943,7 +6657,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -4961,7 +6675,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = append(b, '"')
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -4997,7 +6731,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5020,7 +6758,37 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
-					b = encodeFloat32(b, v)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToFloat32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5040,9 +6808,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5050,7 +6820,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = encodeFloat32(b, ptrToFloat32(p))
+			v := ptrToFloat32(p)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyFloat32Only, opStructFieldHeadOmitEmptyFloat32Only:
@@ -5059,7 +6833,23 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToFloat32(p)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, v)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32Only, opStructFieldHeadOmitEmptyStringTagFloat32Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToFloat32(p)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -5067,9 +6857,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = append(b, '"')
-			b = encodeFloat32(b, ptrToFloat32(p))
-			b = append(b, '"')
+			v := ptrToFloat32(p)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32StringWithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadFloat32Ptr:
@@ -5089,7 +6881,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+					v := ptrToFloat32(p + code.offset)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5108,7 +6904,34 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p = ptrToPtr(p)
 				if p != 0 {
 					b = append(b, code.escapedKey...)
-					b = encodeFloat32(b, ptrToFloat32(p))
+					v := ptrToFloat32(p)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					v := ptrToFloat32(p)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -5130,9 +6953,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = append(b, '"')
-					b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-					b = append(b, '"')
+					v := ptrToFloat32(p + code.offset)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5154,7 +6979,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5173,7 +7002,34 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat32PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -5194,9 +7050,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5216,7 +7074,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+					v := ptrToFloat32(p + code.offset)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5230,7 +7092,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5250,7 +7116,34 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
-					b = encodeFloat32(b, v)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5267,9 +7160,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5279,7 +7174,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5293,7 +7192,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					b = append(b, code.escapedKey...)
-					b = encodeFloat32(b, v)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Only, opStructFieldAnonymousHeadOmitEmptyStringTagFloat32Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5304,9 +7224,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5324,7 +7246,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5342,7 +7268,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5360,9 +7312,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5380,7 +7334,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5398,7 +7356,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5416,9 +7400,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5433,12 +7419,12 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, '{')
 				b = append(b, code.escapedKey...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5460,11 +7446,38 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if v == 0 {
 					code = code.nextField
 				} else {
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = append(b, code.escapedKey...)
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToFloat64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
 					b = append(b, code.escapedKey...)
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5484,13 +7497,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, '{')
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.escapedKey...)
-				b = append(b, '"')
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5499,10 +7510,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyFloat64Only, opStructFieldHeadOmitEmptyFloat64Only:
@@ -5510,11 +7521,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			b = append(b, '{')
 			v := ptrToFloat64(p)
 			if v != 0 {
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = append(b, code.escapedKey...)
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64Only, opStructFieldHeadOmitEmptyStringTagFloat64Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToFloat64(p)
+			if v != 0 {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.escapedKey...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -5522,13 +7546,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
-			b = append(b, '"')
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
-			b = append(b, '"')
+			b = encodeFloat64StringWithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldPtrHeadFloat64Ptr:
@@ -5549,10 +7571,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					b = encodeNull(b)
 				} else {
 					v := ptrToFloat64(p + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64WithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5572,10 +7594,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p != 0 {
 					b = append(b, code.escapedKey...)
 					v := ptrToFloat64(p + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					v := ptrToFloat64(p + code.offset)
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeComma(b)
 				}
 				code = code.next
@@ -5597,13 +7642,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = append(b, '"')
 					v := ptrToFloat64(p + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
-					b = append(b, '"')
+					b = encodeFloat64StringWithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5626,10 +7669,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5649,10 +7692,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p != 0 {
 				b = append(b, code.escapedKey...)
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat64PtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				v := ptrToFloat64(p)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -5673,13 +7739,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5700,10 +7764,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					b = encodeNull(b)
 				} else {
 					v := ptrToFloat64(p)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64WithOption(b, v, opt)
 				}
 			}
 			b = encodeComma(b)
@@ -5717,11 +7781,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.escapedKey...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5742,10 +7806,35 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				} else {
 					b = append(b, code.escapedKey...)
 					v := ptrToFloat64(ptr + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					v := ptrToFloat64(ptr + code.offset)
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5762,13 +7851,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = append(b, '"')
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5779,10 +7866,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, code.escapedKey...)
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5797,10 +7884,29 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				} else {
 					b = append(b, code.escapedKey...)
 					v := ptrToFloat64(ptr + code.offset)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Only, opStructFieldAnonymousHeadOmitEmptyStringTagFloat64Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					v := ptrToFloat64(ptr + code.offset)
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeComma(b)
 					code = code.next
 				}
@@ -5811,13 +7917,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				code = code.end.next
 			} else {
 				b = append(b, code.escapedKey...)
-				b = append(b, '"')
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5836,10 +7940,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5858,10 +7962,32 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, code.escapedKey...)
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				v := ptrToFloat64(p + code.offset)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5879,13 +8005,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5904,10 +8028,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -5926,10 +8050,32 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			} else {
 				b = append(b, code.escapedKey...)
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				v := ptrToFloat64(p + code.offset)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 				code = code.next
 			}
@@ -5947,13 +8093,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -6004,6 +8148,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.next
 				}
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToString(ptr + code.offset)
+				if v == "" {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, v)))
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrHeadStringTagString:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6041,6 +8209,16 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeComma(b)
 			}
 			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagStringOnly, opStructFieldHeadOmitEmptyStringTagStringOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{')
+			v := ptrToString(p)
+			if v != "" {
+				b = append(b, code.escapedKey...)
+				b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, v)))
+				b = encodeComma(b)
+			}
+			code = code.next
 		case opStructFieldPtrHeadStringTagStringOnly, opStructFieldHeadStringTagStringOnly:
 			p := load(ctxptr, code.idx)
 			b = append(b, '{')
@@ -6089,6 +8267,25 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				}
 				code = code.next
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagStringPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagStringPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = append(b, code.escapedKey...)
+					b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, ptrToString(p))))
+					b = encodeComma(b)
+				}
+				code = code.next
+			}
 		case opStructFieldPtrHeadStringTagStringPtr:
 			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
 			fallthrough
@@ -6151,7 +8348,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeComma(b)
 			}
 			code = code.next
-		case opStructFieldPtrHeadStringTagStringPtrOnly:
+		case opStructFieldPtrHeadOmitEmptyStringTagStringPtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				b = encodeNull(b)
@@ -6161,8 +8358,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldHeadStringTagStringPtrOnly:
-			p := load(ctxptr, code.idx)
+		case opStructFieldHeadOmitEmptyStringTagStringPtrOnly:
+			b = append(b, '{')
+			p := load(ctxptr, code.idx)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, ptrToString(p+code.offset))))
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
 			b = append(b, '{')
 			b = append(b, code.escapedKey...)
 			if p == 0 {
@@ -6227,6 +8443,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.next
 				}
 			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToString(ptr + code.offset)
+				if v == "" {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, v)))
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrAnonymousHeadStringTagString:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6268,6 +8505,21 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.next
 				}
 			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringOnly, opStructFieldAnonymousHeadOmitEmptyStringTagStringOnly:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToString(ptr + code.offset)
+				if v == "" {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, v)))
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrAnonymousHeadStringTagStringOnly, opStructFieldAnonymousHeadStringTagStringOnly:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
@@ -6314,6 +8566,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeComma(b)
 				code = code.next
 			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagStringPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, ptrToString(p))))
+				b = encodeComma(b)
+				code = code.next
+			}
 		case opStructFieldPtrAnonymousHeadStringTagStringPtr:
 			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
 			fallthrough
@@ -6368,6 +8638,24 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeComma(b)
 				code = code.next
 			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, ptrToString(p+code.offset))))
+				b = encodeComma(b)
+				code = code.next
+			}
 		case opStructFieldPtrAnonymousHeadStringTagStringPtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
@@ -6591,7 +8879,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.end
 					break
 				}
-				bb, err := rv.Interface().(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(rv.Interface())
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
@@ -6626,7 +8914,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.end.next
 					break
 				}
-				bb, err := rv.Interface().(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(rv.Interface())
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
@@ -6730,6 +9018,32 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.next
 				}
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToBool(ptr + code.offset)
+				if !v {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
+					b = encodeBool(b, v)
+					b = append(b, '"')
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrAnonymousHeadOmitEmptyBool:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6751,6 +9065,29 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.next
 				}
 			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToBool(ptr + code.offset)
+				if !v {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = append(b, '"')
+					b = encodeBool(b, v)
+					b = append(b, '"')
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrHeadOmitEmptyBytes:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6775,6 +9112,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.next
 				}
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				v := ptrToBytes(ptr + code.offset)
+				if len(v) == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = encodeByteSlice(b, v)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrAnonymousHeadOmitEmptyBytes:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6796,6 +9157,27 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.next
 				}
 			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToBytes(ptr + code.offset)
+				if len(v) == 0 {
+					code = code.nextField
+				} else {
+					b = append(b, code.escapedKey...)
+					b = encodeByteSlice(b, v)
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrHeadOmitEmptyMarshalJSON:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6817,7 +9199,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
-					bb, err := v.(Marshaler).MarshalJSON()
+					bb, err := ctx.marshalJSON(v)
 					if err != nil {
 						return nil, &MarshalerError{
 							Type: rtype2type(code.typ),
@@ -6844,6 +9226,54 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					}
 				}
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{')
+				ptr += code.offset
+				p := ptrToUnsafePtr(ptr)
+				isPtr := code.typ.Kind() == reflect.Ptr
+				if p == nil || (!isPtr && *(*unsafe.Pointer)(p) == nil) {
+					code = code.nextField
+				} else {
+					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
+					bb, err := ctx.marshalJSON(v)
+					if err != nil {
+						return nil, &MarshalerError{
+							Type: rtype2type(code.typ),
+							Err:  err,
+						}
+					}
+					if len(bb) == 0 {
+						if isPtr {
+							return nil, errUnexpectedEndOfJSON(
+								fmt.Sprintf("error calling MarshalJSON for type %s", code.typ),
+								0,
+							)
+						}
+						code = code.nextField
+					} else {
+						var buf bytes.Buffer
+						if err := compact(&buf, bb, true); err != nil {
+							return nil, err
+						}
+						b = append(b, code.escapedKey...)
+						b = encodeEscapedString(b, buf.String())
+						b = encodeComma(b)
+						code = code.next
+					}
+				}
+			}
 		case opStructFieldPtrAnonymousHeadOmitEmptyMarshalJSON:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6862,7 +9292,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.nextField
 				} else {
 					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
-					bb, err := v.(Marshaler).MarshalJSON()
+					bb, err := ctx.marshalJSON(v)
 					if err != nil {
 						return nil, &MarshalerError{
 							Type: rtype2type(code.typ),
@@ -6889,6 +9319,51 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					}
 				}
 			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				ptr += code.offset
+				p := ptrToUnsafePtr(ptr)
+				isPtr := code.typ.Kind() == reflect.Ptr
+				if p == nil || (!isPtr && *(*unsafe.Pointer)(p) == nil) {
+					code = code.nextField
+				} else {
+					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
+					bb, err := ctx.marshalJSON(v)
+					if err != nil {
+						return nil, &MarshalerError{
+							Type: rtype2type(code.typ),
+							Err:  err,
+						}
+					}
+					if len(bb) == 0 {
+						if isPtr {
+							return nil, errUnexpectedEndOfJSON(
+								fmt.Sprintf("error calling MarshalJSON for type %s", code.typ),
+								0,
+							)
+						}
+						code = code.nextField
+					} else {
+						var buf bytes.Buffer
+						if err := compact(&buf, bb, true); err != nil {
+							return nil, err
+						}
+						b = append(b, code.escapedKey...)
+						b = encodeEscapedString(b, buf.String())
+						b = encodeComma(b)
+						code = code.next
+					}
+				}
+			}
 		case opStructFieldPtrHeadOmitEmptyMarshalText:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -6923,17 +9398,20 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.next
 				}
 			}
-		case opStructFieldPtrAnonymousHeadOmitEmptyMarshalText:
+		case opStructFieldPtrHeadOmitEmptyStringTagMarshalText:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
 				store(ctxptr, code.idx, ptrToPtr(ptr))
 			}
 			fallthrough
-		case opStructFieldAnonymousHeadOmitEmptyMarshalText:
+		case opStructFieldHeadOmitEmptyStringTagMarshalText:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeComma(b)
 				code = code.end.next
 			} else {
+				b = append(b, '{')
 				ptr += code.offset
 				p := ptrToUnsafePtr(ptr)
 				isPtr := code.typ.Kind() == reflect.Ptr
@@ -6954,13 +9432,75 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 					code = code.next
 				}
 			}
-		case opStructFieldPtrHeadStringTag:
+		case opStructFieldPtrAnonymousHeadOmitEmptyMarshalText:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
 				store(ctxptr, code.idx, ptrToPtr(ptr))
 			}
 			fallthrough
-		case opStructFieldHeadStringTag:
+		case opStructFieldAnonymousHeadOmitEmptyMarshalText:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				ptr += code.offset
+				p := ptrToUnsafePtr(ptr)
+				isPtr := code.typ.Kind() == reflect.Ptr
+				if p == nil || (!isPtr && *(*unsafe.Pointer)(p) == nil) {
+					code = code.nextField
+				} else {
+					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
+					bytes, err := v.(encoding.TextMarshaler).MarshalText()
+					if err != nil {
+						return nil, &MarshalerError{
+							Type: rtype2type(code.typ),
+							Err:  err,
+						}
+					}
+					b = append(b, code.escapedKey...)
+					b = encodeEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagMarshalText:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagMarshalText:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				ptr += code.offset
+				p := ptrToUnsafePtr(ptr)
+				isPtr := code.typ.Kind() == reflect.Ptr
+				if p == nil || (!isPtr && *(*unsafe.Pointer)(p) == nil) {
+					code = code.nextField
+				} else {
+					v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
+					bytes, err := v.(encoding.TextMarshaler).MarshalText()
+					if err != nil {
+						return nil, &MarshalerError{
+							Type: rtype2type(code.typ),
+							Err:  err,
+						}
+					}
+					b = append(b, code.escapedKey...)
+					b = encodeEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+					b = encodeComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadStringTag:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
 				b = encodeNull(b)
@@ -7098,7 +9638,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p := ptrToUnsafePtr(ptr)
 				isPtr := code.typ.Kind() == reflect.Ptr
 				v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
-				bb, err := v.(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(v)
 				if err != nil {
 					return nil, &MarshalerError{
 						Type: rtype2type(code.typ),
@@ -7142,7 +9682,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				p := ptrToUnsafePtr(ptr)
 				isPtr := code.typ.Kind() == reflect.Ptr
 				v := *(*interface{})(unsafe.Pointer(&interfaceHeader{typ: code.typ, ptr: p}))
-				bb, err := v.(Marshaler).MarshalJSON()
+				bb, err := ctx.marshalJSON(v)
 				if err != nil {
 					return nil, &MarshalerError{
 						Type: rtype2type(code.typ),
@@ -7233,7 +9773,17 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 		case opStructFieldOmitEmpty:
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
-			if p == 0 || **(**uintptr)(unsafe.Pointer(&p)) == 0 {
+			if p == 0 || encodeIsOmitted(code, p) {
+				code = code.nextField
+			} else {
+				b = append(b, code.escapedKey...)
+				code = code.next
+				store(ctxptr, code.idx, p)
+			}
+		case opStructFieldOmitEmptyStringTag:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			if p == 0 || encodeIsOmitted(code, p) {
 				code = code.nextField
 			} else {
 				b = append(b, code.escapedKey...)
@@ -7249,7 +9799,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 		case opStructFieldInt:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt:
@@ -7257,7 +9807,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7276,7 +9837,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p)))
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -7293,14 +9854,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p)))
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldInt8:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt8:
@@ -7308,7 +9869,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt8(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt8(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7327,14 +9899,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldInt16:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt16:
@@ -7342,7 +9914,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt16(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt16(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7361,14 +9944,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldInt32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt32:
@@ -7376,7 +9959,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7395,14 +9989,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldInt64:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, ptrToInt64(ptr+code.offset))
+			b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt64:
@@ -7410,7 +10004,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt64(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt64(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7429,14 +10034,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p))
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint:
@@ -7444,7 +10049,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7463,14 +10079,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint8:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint8:
@@ -7478,7 +10094,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint8(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint8(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7497,14 +10124,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint16:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint16:
@@ -7512,7 +10139,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint16(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint16(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7531,14 +10169,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint32:
@@ -7546,7 +10184,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7565,14 +10214,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldUint64:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, ptrToUint64(ptr+code.offset))
+			b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint64:
@@ -7580,7 +10229,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint64(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint64(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeComma(b)
 			}
 			code = code.next
@@ -7599,14 +10259,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p))
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
 			}
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldFloat32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyFloat32:
@@ -7614,16 +10278,33 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToFloat32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, v)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
 		case opStructFieldStringTagFloat32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = append(b, '"')
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-			b = append(b, '"')
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32StringWithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldFloat32Ptr:
@@ -7633,7 +10314,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeComma(b)
 			code = code.next
@@ -7641,34 +10326,44 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
 			if v != 0 {
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = append(b, code.escapedKey...)
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat64(ptr + code.offset)
+			if v != 0 {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.escapedKey...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeComma(b)
 			}
 			code = code.next
 		case opStructFieldStringTagFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
 			b = append(b, code.escapedKey...)
-			b = append(b, '"')
-			b = encodeFloat64(b, v)
-			b = append(b, '"')
+			b = encodeFloat64StringWithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldFloat64Ptr:
@@ -7682,10 +10377,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				break
 			}
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeComma(b)
 			code = code.next
 		case opStructFieldString:
@@ -7703,6 +10398,15 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeComma(b)
 			}
 			code = code.next
+		case opStructFieldOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToString(ptr + code.offset)
+			if v != "" {
+				b = append(b, code.escapedKey...)
+				b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, v)))
+				b = encodeComma(b)
+			}
+			code = code.next
 		case opStructFieldStringTagString:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
@@ -7730,6 +10434,15 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeComma(b)
 			}
 			code = code.next
+		case opStructFieldOmitEmptyStringTagStringPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+				b = encodeComma(b)
+			}
+			code = code.next
 		case opStructFieldStringTagStringPtr:
 			b = append(b, code.escapedKey...)
 			ptr := load(ctxptr, code.headIdx)
@@ -7756,6 +10469,17 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeComma(b)
 			}
 			code = code.next
+		case opStructFieldOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBool(ptr + code.offset)
+			if v {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
+				b = encodeBool(b, v)
+				b = append(b, '"')
+				b = encodeComma(b)
+			}
+			code = code.next
 		case opStructFieldStringTagBool:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
@@ -7790,6 +10514,15 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeComma(b)
 			}
 			code = code.next
+		case opStructFieldOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBytes(ptr + code.offset)
+			if len(v) > 0 {
+				b = append(b, code.escapedKey...)
+				b = encodeByteSlice(b, v)
+				b = encodeComma(b)
+			}
+			code = code.next
 		case opStructFieldStringTagBytes:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToBytes(ptr + code.offset)
@@ -7811,7 +10544,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				break
 			}
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -7833,7 +10566,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				break
 			}
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -7845,11 +10578,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			b = buf.Bytes()
 			b = encodeComma(b)
 			code = code.next
-		case opStructFieldStringTagMarshalJSON:
+		case opStructFieldOmitEmptyStringTagMarshalJSON:
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
+			if code.typ.Kind() == reflect.Ptr {
+				p = ptrToPtr(p)
+			}
+			if p == 0 {
+				code = code.next
+				break
+			}
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -7861,19 +10601,49 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			b = encodeEscapedString(b, buf.String())
 			b = encodeComma(b)
 			code = code.next
-		case opStructFieldMarshalText:
+		case opStructFieldStringTagMarshalJSON:
 			ptr := load(ctxptr, code.headIdx)
-			b = append(b, code.escapedKey...)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bytes, err := v.(encoding.TextMarshaler).MarshalText()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
-			b = encodeEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
-			b = encodeComma(b)
-			code = code.next
-		case opStructFieldOmitEmptyMarshalText:
+			var buf bytes.Buffer
+			if err := compact(&buf, bb, true); err != nil {
+				return nil, err
+			}
+			b = append(b, code.escapedKey...)
+			b = encodeEscapedString(b, buf.String())
+			b = encodeComma(b)
+			code = code.next
+		case opStructFieldMarshalText:
+			ptr := load(ctxptr, code.headIdx)
+			b = append(b, code.escapedKey...)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			bytes, err := v.(encoding.TextMarshaler).MarshalText()
+			if err != nil {
+				return nil, errMarshaler(code, err)
+			}
+			b = encodeEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+			b = encodeComma(b)
+			code = code.next
+		case opStructFieldOmitEmptyMarshalText:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			if v != nil {
+				bytes, err := v.(encoding.TextMarshaler).MarshalText()
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				b = append(b, code.escapedKey...)
+				b = encodeEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = encodeComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagMarshalText:
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
@@ -7981,6 +10751,25 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptr + code.offset
 			code = code.next
 			store(ctxptr, code.idx, p)
+		case opStructFieldUnknown:
+			entries := encodeUnknownFieldEntries(code, ctxptr, opt)
+			if len(entries) > 0 {
+				valueCodeSet, err := encodeUnknownFieldValueCodeSet(ctx, code, opt)
+				if err != nil {
+					return nil, err
+				}
+				for _, entry := range entries {
+					b = encodeEscapedString(b, entry.key)
+					b = append(b, ':')
+					bb, err := encodeRunUnknownFieldValue(ctx, b, codeSet, valueCodeSet, ptrOffset, entry, opt, encodeRunEscaped)
+					if err != nil {
+						return nil, err
+					}
+					b = bb
+				}
+				ctxptr = ctx.ptr() + ptrOffset
+			}
+			code = code.next
 		case opStructEnd:
 			last := len(b) - 1
 			if b[last] == ',' {
@@ -7995,7 +10784,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 		case opStructEndInt:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt:
@@ -8003,7 +10792,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8030,7 +10838,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p)))
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8039,7 +10847,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagIntPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8077,14 +10904,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p)))
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndInt8:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt8:
@@ -8092,7 +10919,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt8(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt8(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8119,7 +10965,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8128,7 +10974,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt8Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8166,14 +11031,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndInt16:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt16:
@@ -8181,7 +11046,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt16(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt16(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8208,7 +11092,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8217,7 +11101,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt16Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8255,14 +11158,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndInt32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt32:
@@ -8270,7 +11173,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8297,7 +11219,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8306,7 +11228,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8344,14 +11285,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndInt64:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendInt(b, ptrToInt64(ptr+code.offset))
+			b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyInt64:
@@ -8359,7 +11300,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToInt64(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt64(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8386,7 +11346,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p))
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8395,7 +11355,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt64Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8433,14 +11412,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p))
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint:
@@ -8448,7 +11427,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8475,7 +11473,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8484,7 +11482,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
-				b = appendUint(b, uint64(ptrToUint(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8496,17 +11494,36 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				}
 			}
 			code = code.next
-		case opStructEndStringTagUintPtr:
-			b = append(b, code.escapedKey...)
+		case opStructEndOmitEmptyStringTagUintPtr:
 			ptr := load(ctxptr, code.headIdx)
 			p := ptrToPtr(ptr + code.offset)
-			if p == 0 {
-				b = encodeNull(b)
-			} else {
+			if p != 0 {
+				b = append(b, code.escapedKey...)
 				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p)))
 				b = append(b, '"')
-			}
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndStringTagUintPtr:
+			b = append(b, code.escapedKey...)
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p == 0 {
+				b = encodeNull(b)
+			} else {
+				b = append(b, '"')
+				b = appendUint(b, uint64(ptrToUint(p)))
+				b = append(b, '"')
+			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUintNPtr:
@@ -8522,14 +11539,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint8:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint8:
@@ -8537,7 +11554,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint8(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint8(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8564,7 +11600,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8573,7 +11609,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint8Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8611,14 +11666,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint16:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint16:
@@ -8626,7 +11681,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint16(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint16(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8653,7 +11727,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8662,7 +11736,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint16Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8700,14 +11793,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint32:
@@ -8715,7 +11808,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8742,7 +11854,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8751,7 +11863,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8789,14 +11920,14 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndUint64:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = appendUint(b, ptrToUint64(ptr+code.offset))
+			b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyUint64:
@@ -8804,7 +11935,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToUint64(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint64(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8831,7 +11981,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p))
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8840,7 +11990,26 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint64Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p))
+				b = append(b, '"')
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8878,14 +12047,18 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p))
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndFloat32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyFloat32:
@@ -8893,7 +12066,30 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			v := ptrToFloat32(ptr + code.offset)
 			if v != 0 {
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, v)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat32(ptr + code.offset)
+			if v != 0 {
+				b = append(b, code.escapedKey...)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8908,9 +12104,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 		case opStructEndStringTagFloat32:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
-			b = append(b, '"')
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-			b = append(b, '"')
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32StringWithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndFloat32Ptr:
@@ -8920,7 +12118,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8929,7 +12131,32 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = append(b, code.escapedKey...)
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -8948,9 +12175,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p))
-				b = append(b, '"')
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8967,7 +12196,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -8975,21 +12208,41 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
 			if v != 0 {
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, code.escapedKey...)
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat64(ptr + code.offset)
+			if v != 0 {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = append(b, code.escapedKey...)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -9004,13 +12257,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 		case opStructEndStringTagFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
 			b = append(b, code.escapedKey...)
-			b = append(b, '"')
-			b = encodeFloat64(b, v)
-			b = append(b, '"')
+			b = encodeFloat64StringWithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndFloat64Ptr:
@@ -9024,10 +12275,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				break
 			}
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = appendStructEnd(b)
 			code = code.next
 		case opStructEndOmitEmptyFloat64Ptr:
@@ -9036,10 +12287,31 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p != 0 {
 				b = append(b, code.escapedKey...)
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat64Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				v := ptrToFloat64(p)
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = appendStructEnd(b)
 			} else {
 				last := len(b) - 1
@@ -9058,13 +12330,11 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -9082,10 +12352,10 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = appendStructEnd(b)
 			code = code.next
@@ -9112,6 +12382,23 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				}
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToString(ptr + code.offset)
+			if v != "" {
+				b = append(b, code.escapedKey...)
+				b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, v)))
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
 		case opStructEndStringTagString:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
@@ -9147,6 +12434,23 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				}
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagStringPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = append(b, code.escapedKey...)
+				b = encodeEscapedString(b, string(encodeEscapedString([]byte{}, ptrToString(p))))
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
 		case opStructEndStringTagStringPtr:
 			b = append(b, code.escapedKey...)
 			ptr := load(ctxptr, code.headIdx)
@@ -9199,6 +12503,25 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				}
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBool(ptr + code.offset)
+			if v {
+				b = append(b, code.escapedKey...)
+				b = append(b, '"')
+				b = encodeBool(b, v)
+				b = append(b, '"')
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
 		case opStructEndStringTagBool:
 			ptr := load(ctxptr, code.headIdx)
 			b = append(b, code.escapedKey...)
@@ -9241,6 +12564,23 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				}
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBytes(ptr + code.offset)
+			if len(v) > 0 {
+				b = append(b, code.escapedKey...)
+				b = encodeByteSlice(b, v)
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
 		case opStructEndStringTagBytes:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToBytes(ptr + code.offset)
@@ -9253,7 +12593,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			b = append(b, code.escapedKey...)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -9282,7 +12622,7 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				break
 			}
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -9294,11 +12634,41 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 			b = buf.Bytes()
 			b = appendStructEnd(b)
 			code = code.next
+		case opStructEndOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			if code.typ.Kind() == reflect.Ptr {
+				p = ptrToPtr(p)
+			}
+			if p == 0 {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+				code = code.next
+				break
+			}
+			v := ptrToInterface(code, p)
+			bb, err := ctx.marshalJSON(v)
+			if err != nil {
+				return nil, errMarshaler(code, err)
+			}
+			var buf bytes.Buffer
+			if err := compact(&buf, bb, true); err != nil {
+				return nil, err
+			}
+			b = append(b, code.escapedKey...)
+			b = encodeEscapedString(b, buf.String())
+			b = appendStructEnd(b)
+			code = code.next
 		case opStructEndStringTagMarshalJSON:
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -9344,6 +12714,28 @@ func encodeRunEscaped(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, o
 				}
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagMarshalText:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			if v != nil {
+				bytes, err := v.(encoding.TextMarshaler).MarshalText()
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				b = append(b, code.escapedKey...)
+				b = encodeEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = appendStructEnd(b)
+			} else {
+				last := len(b) - 1
+				if b[last] == ',' {
+					b[last] = '}'
+					b = encodeComma(b)
+				} else {
+					b = appendStructEnd(b)
+				}
+			}
+			code = code.next
 		case opStructEndStringTagMarshalText:
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
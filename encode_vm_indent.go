@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding"
 	"fmt"
-	"math"
 	"reflect"
 	"runtime"
 	"sort"
@@ -16,8 +15,16 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 	ptrOffset := uintptr(0)
 	ctxptr := ctx.ptr()
 	code := codeSet.code
+	done := ctx.done
 
 	for {
+		if done != nil {
+			select {
+			case <-done:
+				return nil, ctx.context.Err()
+			default:
+			}
+		}
 		switch code.op {
 		default:
 			return nil, fmt.Errorf("encoder (indent): opcode %s has not been implemented", code.op)
@@ -26,43 +33,43 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			code = code.next
 			store(ctxptr, code.idx, ptrToPtr(ptr))
 		case opInt:
-			b = appendInt(b, int64(ptrToInt(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt(load(ctxptr, code.idx))), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opInt8:
-			b = appendInt(b, int64(ptrToInt8(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt8(load(ctxptr, code.idx))), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opInt16:
-			b = appendInt(b, int64(ptrToInt16(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt16(load(ctxptr, code.idx))), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opInt32:
-			b = appendInt(b, int64(ptrToInt32(load(ctxptr, code.idx))))
+			b = appendIntWithOption(b, int64(ptrToInt32(load(ctxptr, code.idx))), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opInt64:
-			b = appendInt(b, ptrToInt64(load(ctxptr, code.idx)))
+			b = appendIntWithOption(b, ptrToInt64(load(ctxptr, code.idx)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opUint:
-			b = appendUint(b, uint64(ptrToUint(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint(load(ctxptr, code.idx))), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opUint8:
-			b = appendUint(b, uint64(ptrToUint8(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint8(load(ctxptr, code.idx))), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opUint16:
-			b = appendUint(b, uint64(ptrToUint16(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint16(load(ctxptr, code.idx))), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opUint32:
-			b = appendUint(b, uint64(ptrToUint32(load(ctxptr, code.idx))))
+			b = appendUintWithOption(b, uint64(ptrToUint32(load(ctxptr, code.idx))), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opUint64:
-			b = appendUint(b, ptrToUint64(load(ctxptr, code.idx)))
+			b = appendUintWithOption(b, ptrToUint64(load(ctxptr, code.idx)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opIntString:
@@ -126,19 +133,23 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = encodeIndentComma(b)
 			code = code.next
 		case opFloat32:
-			b = encodeFloat32(b, ptrToFloat32(load(ctxptr, code.idx)))
+			v := ptrToFloat32(load(ctxptr, code.idx))
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opFloat64:
 			v := ptrToFloat64(load(ctxptr, code.idx))
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opString:
-			b = encodeNoEscapedString(b, ptrToString(load(ctxptr, code.idx)))
+			b = encodeNoEscapedStringWithOption(b, ptrToString(load(ctxptr, code.idx)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opBool:
@@ -177,7 +188,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				break
 			}
 			ctx.keepRefs = append(ctx.keepRefs, unsafe.Pointer(iface))
-			ifaceCodeSet, err := encodeCompileToGetCodeSet(uintptr(unsafe.Pointer(iface.typ)))
+			ifaceCodeSet, err := ctx.compileToGetCodeSet(uintptr(unsafe.Pointer(iface.typ)), opt, code.fields)
 			if err != nil {
 				return nil, err
 			}
@@ -200,7 +211,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			ctx.ptrs = newPtrs
 
 			oldBaseIndent := ctx.baseIndent
-			ctx.baseIndent = code.indent
+			ctx.baseIndent += code.indent
 			bb, err := encodeRunIndent(ctx, b, ifaceCodeSet, opt)
 			if err != nil {
 				return nil, err
@@ -213,6 +224,40 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 
 			b = bb
 			code = code.next
+		case opCustom:
+			ptr := load(ctxptr, code.idx)
+			i := 0
+			for ; i < code.ptrNum && ptr != 0; i++ {
+				ptr = ptrToPtr(ptr)
+			}
+			// the zero value of a type stored directly in an interface is a nil word,
+			// which is null for a pointer like a nil Marshaler
+			if ptr == 0 && (i < code.ptrNum || !code.isDirectIface || code.typ.Kind() == reflect.Ptr) {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.next
+				break
+			}
+			bb, err := encodeCustom(ctx, code, nil, ptr, opt)
+			if err != nil {
+				return nil, err
+			}
+			var compactBuf bytes.Buffer
+			if err := compact(&compactBuf, bb, false); err != nil {
+				return nil, err
+			}
+			var indentBuf bytes.Buffer
+			if err := encodeWithIndent(
+				&indentBuf,
+				compactBuf.Bytes(),
+				string(ctx.prefix)+strings.Repeat(string(ctx.indentStr), ctx.baseIndent+code.indent),
+				string(ctx.indentStr),
+			); err != nil {
+				return nil, err
+			}
+			b = append(b, indentBuf.Bytes()...)
+			b = encodeIndentComma(b)
+			code = code.next
 		case opMarshalJSON:
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
@@ -222,7 +267,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				break
 			}
 			v := ptrToInterface(code, ptr)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -270,7 +315,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if err != nil {
 					return nil, errMarshaler(code, err)
 				}
-				b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+				b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -283,6 +328,13 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				code = code.end.next
 			} else {
 				slice := ptrToSlice(p)
+				if uintptr(slice.data) == 0 && !encodeNilAsEmpty(code, opt) {
+					b = appendIndent(ctx, b, code.indent)
+					b = encodeNull(b)
+					b = encodeIndentComma(b)
+					code = code.end.next
+					break
+				}
 				store(ctxptr, code.elemIdx, 0)
 				store(ctxptr, code.length, uintptr(slice.len))
 				store(ctxptr, code.idx, uintptr(slice.data))
@@ -292,8 +344,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					code = code.next
 					store(ctxptr, code.idx, uintptr(slice.data))
 				} else {
-					b = appendIndent(ctx, b, code.indent)
-					b = append(b, '[', ']', '\n')
+					b = append(b, '[', ']', ',', '\n')
 					code = code.end.next
 				}
 			}
@@ -306,6 +357,13 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				code = code.end.next
 			} else {
 				slice := ptrToSlice(p)
+				if uintptr(slice.data) == 0 && !encodeNilAsEmpty(code, opt) {
+					b = appendIndent(ctx, b, code.indent)
+					b = encodeNull(b)
+					b = encodeIndentComma(b)
+					code = code.end.next
+					break
+				}
 				store(ctxptr, code.elemIdx, 0)
 				store(ctxptr, code.length, uintptr(slice.len))
 				store(ctxptr, code.idx, uintptr(slice.data))
@@ -325,6 +383,13 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			length := load(ctxptr, code.length)
 			idx++
 			if idx < length {
+				if ctx.w != nil {
+					bb, err := ctx.flush(b)
+					if err != nil {
+						return nil, err
+					}
+					b = bb
+				}
 				b = appendIndent(ctx, b, code.indent+1)
 				store(ctxptr, code.elemIdx, idx)
 				data := load(ctxptr, code.headIdx)
@@ -343,6 +408,13 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			length := load(ctxptr, code.length)
 			idx++
 			if idx < length {
+				if ctx.w != nil {
+					bb, err := ctx.flush(b)
+					if err != nil {
+						return nil, err
+					}
+					b = bb
+				}
 				b = appendIndent(ctx, b, code.indent+1)
 				store(ctxptr, code.elemIdx, idx)
 				code = code.next
@@ -378,6 +450,13 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			idx := load(ctxptr, code.elemIdx)
 			idx++
 			if idx < code.length {
+				if ctx.w != nil {
+					bb, err := ctx.flush(b)
+					if err != nil {
+						return nil, err
+					}
+					b = bb
+				}
 				b = appendIndent(ctx, b, code.indent+1)
 				store(ctxptr, code.elemIdx, idx)
 				p := load(ctxptr, code.headIdx)
@@ -395,12 +474,16 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			ptr := load(ctxptr, code.idx)
 			if ptr == 0 {
 				b = appendIndent(ctx, b, code.indent)
-				b = encodeNull(b)
-				b = encodeIndentComma(b)
+				if encodeNilAsEmpty(code, opt) {
+					b = append(b, '{', '}', ',', '\n')
+				} else {
+					b = encodeNull(b)
+					b = encodeIndentComma(b)
+				}
 				code = code.end.next
 			} else {
 				uptr := ptrToUnsafePtr(ptr)
-				mlen := maplen(uptr)
+				mlen := encodeMapLen(code, uptr)
 				if mlen > 0 {
 					b = append(b, '{', '\n')
 					iter := mapiterinit(code.typ, uptr)
@@ -411,6 +494,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 
 					if (opt & EncodeOptionUnorderedMap) == 0 {
 						mapCtx := newMapContext(mlen)
+						ctx.sortedMaps++
 						mapCtx.pos = append(mapCtx.pos, len(b))
 						ctx.keepRefs = append(ctx.keepRefs, unsafe.Pointer(mapCtx))
 						store(ctxptr, code.end.mapPos, uintptr(unsafe.Pointer(mapCtx)))
@@ -418,11 +502,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 						b = appendIndent(ctx, b, code.next.indent)
 					}
 
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					code = code.next
 				} else {
-					b = appendIndent(ctx, b, code.indent)
 					b = append(b, '{', '}', ',', '\n')
 					code = code.end.next
 				}
@@ -438,13 +522,16 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				ptr = ptrToPtr(ptr)
 				uptr := ptrToUnsafePtr(ptr)
 				if uintptr(uptr) == 0 {
-					b = appendIndent(ctx, b, code.indent)
-					b = encodeNull(b)
-					b = encodeIndentComma(b)
+					if encodeNilAsEmpty(code, opt) {
+						b = append(b, '{', '}', ',', '\n')
+					} else {
+						b = encodeNull(b)
+						b = encodeIndentComma(b)
+					}
 					code = code.end.next
 					break
 				}
-				mlen := maplen(uptr)
+				mlen := encodeMapLen(code, uptr)
 				if mlen > 0 {
 					b = append(b, '{', '\n')
 					iter := mapiterinit(code.typ, uptr)
@@ -452,11 +539,13 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					store(ctxptr, code.elemIdx, 0)
 					store(ctxptr, code.length, uintptr(mlen))
 					store(ctxptr, code.mapIter, uintptr(iter))
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 
 					if (opt & EncodeOptionUnorderedMap) == 0 {
 						mapCtx := newMapContext(mlen)
+						ctx.sortedMaps++
 						mapCtx.pos = append(mapCtx.pos, len(b))
 						ctx.keepRefs = append(ctx.keepRefs, unsafe.Pointer(mapCtx))
 						store(ctxptr, code.end.mapPos, uintptr(unsafe.Pointer(mapCtx)))
@@ -466,7 +555,6 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 
 					code = code.next
 				} else {
-					b = appendIndent(ctx, b, code.indent)
 					b = append(b, '{', '}', ',', '\n')
 					code = code.end.next
 				}
@@ -477,10 +565,18 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			idx++
 			if (opt & EncodeOptionUnorderedMap) != 0 {
 				if idx < length {
+					if ctx.w != nil {
+						bb, err := ctx.flush(b)
+						if err != nil {
+							return nil, err
+						}
+						b = bb
+					}
 					b = appendIndent(ctx, b, code.indent)
 					store(ctxptr, code.elemIdx, idx)
 					ptr := load(ctxptr, code.mapIter)
 					iter := ptrToUnsafePtr(ptr)
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					code = code.next
@@ -499,6 +595,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					ptr := load(ctxptr, code.mapIter)
 					iter := ptrToUnsafePtr(ptr)
 					store(ctxptr, code.elemIdx, idx)
+					encodeMapIterSkip(code, iter)
 					key := mapiterkey(iter)
 					store(ctxptr, code.next.idx, uintptr(key))
 					code = code.next
@@ -560,6 +657,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, buf...)
 			mapCtx.buf = buf
 			releaseMapContext(mapCtx)
+			ctx.sortedMaps--
 			code = code.next
 		case opStructFieldPtrHead:
 			p := load(ctxptr, code.idx)
@@ -602,16 +700,49 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			fallthrough
 		case opStructFieldHeadOmitEmpty:
 			ptr := load(ctxptr, code.idx)
+			if code.isDirectIface {
+				// the data word of the root struct is the value of its only field
+				ptr = ctxptr + code.idx
+			}
 			if ptr == 0 {
 				b = appendIndent(ctx, b, code.indent)
 				b = encodeNull(b)
 				b = encodeIndentComma(b)
 				code = code.end.next
 			} else {
+				b = append(b, '{', '\n')
+				p := ptr + code.offset
+				if p == 0 || encodeIsOmitted(code, p) {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					code = code.next
+					store(ctxptr, code.idx, p)
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTag:
+			ptr := load(ctxptr, code.idx)
+			if code.isDirectIface {
+				// the data word of the root struct is the value of its only field
+				ptr = ctxptr + code.idx
+			}
+			if ptr == 0 {
 				b = appendIndent(ctx, b, code.indent)
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
 				b = append(b, '{', '\n')
 				p := ptr + code.offset
-				if p == 0 || *(*uintptr)(*(*unsafe.Pointer)(unsafe.Pointer(&p))) == 0 {
+				if p == 0 || encodeIsOmitted(code, p) {
 					code = code.nextField
 				} else {
 					b = appendIndent(ctx, b, code.indent+1)
@@ -645,6 +776,19 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			} else {
 				code = code.nextField
 			}
+		case opStructFieldHeadOmitEmptyStringTagOnly:
+			ptr := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if !code.anonymousKey && ptr != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				p := ptr + code.offset
+				code = code.next
+				store(ctxptr, code.idx, p)
+			} else {
+				code = code.nextField
+			}
 		case opStructFieldPtrHeadInt:
 			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
 			fallthrough
@@ -659,7 +803,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -684,7 +828,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToInt(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -706,7 +878,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -717,7 +889,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendInt(b, int64(ptrToInt(p)))
+			b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyIntOnly, opStructFieldHeadOmitEmptyIntOnly:
@@ -728,7 +900,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagIntOnly, opStructFieldHeadOmitEmptyStringTagIntOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := int64(ptrToInt(p))
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -738,7 +924,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt(p)))
+			b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -761,7 +947,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -782,7 +968,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagIntPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagIntPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt(p)))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -832,7 +1041,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -853,7 +1062,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -900,7 +1132,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -916,7 +1148,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -935,7 +1167,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -965,7 +1219,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -981,7 +1235,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntOnly, opStructFieldAnonymousHeadOmitEmptyStringTagIntOnly:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -1016,7 +1289,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -1036,7 +1309,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagIntPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -1078,7 +1373,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -1098,11 +1393,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt(p+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
-		case opStructFieldPtrAnonymousHeadStringTagIntPtrOnly:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagIntPtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				code = code.end.next
@@ -1110,9 +1405,31 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagIntPtrOnly:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagIntPtrOnly:
 			p := load(ctxptr, code.idx)
-			b = appendIndent(ctx, b, code.indent)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
+				b = appendInt(b, int64(ptrToInt(p+code.offset)))
+				b = append(b, '"')
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagIntPtrOnly:
+			p := load(ctxptr, code.idx)
+			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			if p == 0 {
@@ -1138,7 +1455,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt8(ptr)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -1163,7 +1480,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToInt8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -1185,7 +1530,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -1196,7 +1541,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendInt(b, int64(ptrToInt8(p)))
+			b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt8Only, opStructFieldHeadOmitEmptyInt8Only:
@@ -1207,7 +1552,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8Only, opStructFieldHeadOmitEmptyStringTagInt8Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := int64(ptrToInt8(p))
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -1217,7 +1576,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt8(p)))
+			b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -1240,7 +1599,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -1261,7 +1620,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt8(p)))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -1311,7 +1693,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -1332,7 +1714,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -1379,7 +1784,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -1395,7 +1800,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -1414,7 +1819,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -1444,7 +1871,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -1460,7 +1887,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt8Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -1495,7 +1941,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -1515,7 +1961,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -1557,7 +2025,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -1577,7 +2045,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt8(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -1617,7 +2107,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt16(ptr)))
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -1642,7 +2132,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToInt16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -1664,7 +2182,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -1675,7 +2193,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendInt(b, int64(ptrToInt16(p)))
+			b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt16Only, opStructFieldHeadOmitEmptyInt16Only:
@@ -1686,7 +2204,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16Only, opStructFieldHeadOmitEmptyStringTagInt16Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := int64(ptrToInt16(p))
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -1696,7 +2228,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt16(p)))
+			b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -1719,7 +2251,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -1740,7 +2272,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt16(p)))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -1790,7 +2345,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -1811,11 +2366,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
-		case opStructFieldPtrHeadStringTagInt16PtrOnly:
+		case opStructFieldPtrHeadOmitEmptyStringTagInt16PtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				b = encodeNull(b)
@@ -1825,15 +2380,38 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldHeadStringTagInt16PtrOnly:
+		case opStructFieldHeadOmitEmptyStringTagInt16PtrOnly:
 			p := load(ctxptr, code.idx)
 			b = append(b, '{', '\n')
-			b = appendIndent(ctx, b, code.indent+1)
-			b = append(b, code.key...)
-			b = append(b, ' ')
-			if p == 0 {
-				b = encodeNull(b)
-			} else {
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
+				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = append(b, '"')
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			b = appendIndent(ctx, b, code.indent+1)
+			b = append(b, code.key...)
+			b = append(b, ' ')
+			if p == 0 {
+				b = encodeNull(b)
+			} else {
 				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
 				b = append(b, '"')
@@ -1858,7 +2436,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -1874,7 +2452,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -1893,7 +2471,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -1923,7 +2523,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -1939,7 +2539,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt16Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -1974,7 +2593,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -1994,7 +2613,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2036,7 +2677,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -2056,7 +2697,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt16(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2096,7 +2759,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt32(ptr)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2121,7 +2784,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToInt32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -2143,7 +2834,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -2154,7 +2845,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendInt(b, int64(ptrToInt32(p)))
+			b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt32Only, opStructFieldHeadOmitEmptyInt32Only:
@@ -2165,7 +2856,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32Only, opStructFieldHeadOmitEmptyStringTagInt32Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := int64(ptrToInt32(p))
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -2175,7 +2880,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt32(p)))
+			b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -2198,7 +2903,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -2219,7 +2924,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(ptrToInt32(p)))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -2269,7 +2997,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -2290,7 +3018,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -2337,7 +3088,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+					b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -2353,7 +3104,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2372,7 +3123,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -2402,7 +3175,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2418,7 +3191,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, int64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt32Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, int64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -2453,7 +3245,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -2473,7 +3265,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2515,7 +3329,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -2535,11 +3349,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = appendIntWithOption(b, int64(ptrToInt32(p+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
-		case opStructFieldPtrAnonymousHeadStringTagInt32PtrOnly:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt32PtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				code = code.end.next
@@ -2547,13 +3361,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagInt32PtrOnly:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt32PtrOnly:
 			p := load(ctxptr, code.idx)
-			b = appendIndent(ctx, b, code.indent)
-			b = append(b, code.key...)
-			b = append(b, ' ')
 			if p == 0 {
-				b = encodeNull(b)
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
+				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
+				b = append(b, '"')
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagInt32PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = appendIndent(ctx, b, code.indent)
+			b = append(b, code.key...)
+			b = append(b, ' ')
+			if p == 0 {
+				b = encodeNull(b)
 			} else {
 				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p+code.offset)))
@@ -2575,7 +3411,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, ptrToInt64(ptr))
+				b = appendIntWithOption(b, ptrToInt64(ptr), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2600,7 +3436,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToInt64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, v)
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -2622,7 +3486,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendInt(b, ptrToInt64(ptr+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -2633,7 +3497,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendInt(b, ptrToInt64(p))
+			b = appendIntWithOption(b, ptrToInt64(p), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyInt64Only, opStructFieldHeadOmitEmptyInt64Only:
@@ -2644,7 +3508,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64Only, opStructFieldHeadOmitEmptyStringTagInt64Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := ptrToInt64(p)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -2654,7 +3532,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, ptrToInt64(p))
+			b = appendIntWithOption(b, ptrToInt64(p), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -2677,7 +3555,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, ptrToInt64(p+code.offset))
+					b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -2698,7 +3576,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, ptrToInt64(p), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, ptrToInt64(p))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -2748,7 +3649,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -2769,7 +3670,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -2816,7 +3740,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendInt(b, ptrToInt64(p+code.offset))
+					b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -2832,7 +3756,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, ptrToInt64(ptr+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2851,7 +3775,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, v)
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -2881,7 +3827,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, ptrToInt64(ptr+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2897,7 +3843,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendIntWithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Only, opStructFieldAnonymousHeadOmitEmptyStringTagInt64Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToInt64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendInt(b, v)
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -2932,7 +3897,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -2952,7 +3917,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -2994,7 +3981,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -3014,7 +4001,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, ptrToInt64(p+code.offset), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagInt64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p+code.offset))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3054,7 +4063,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3079,7 +4088,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToUint(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -3101,7 +4138,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -3112,7 +4149,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendUint(b, uint64(ptrToUint(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUintOnly, opStructFieldHeadOmitEmptyUintOnly:
@@ -3123,7 +4160,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUintOnly, opStructFieldHeadOmitEmptyStringTagUintOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := uint64(ptrToUint(p))
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -3133,7 +4184,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -3156,7 +4207,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -3177,7 +4228,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUintPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUintPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint(p)))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -3227,7 +4301,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -3248,11 +4322,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
-		case opStructFieldPtrHeadStringTagUintPtrOnly:
+		case opStructFieldPtrHeadOmitEmptyStringTagUintPtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				b = encodeNull(b)
@@ -3262,22 +4336,45 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldHeadStringTagUintPtrOnly:
+		case opStructFieldHeadOmitEmptyStringTagUintPtrOnly:
 			p := load(ctxptr, code.idx)
 			b = append(b, '{', '\n')
-			b = appendIndent(ctx, b, code.indent+1)
-			b = append(b, code.key...)
-			b = append(b, ' ')
-			if p == 0 {
-				b = encodeNull(b)
-			} else {
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
 				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
 				b = append(b, '"')
+				b = encodeIndentComma(b)
 			}
-			b = encodeIndentComma(b)
 			code = code.next
-		case opStructFieldHeadUintNPtr:
+		case opStructFieldPtrHeadStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			b = appendIndent(ctx, b, code.indent+1)
+			b = append(b, code.key...)
+			b = append(b, ' ')
+			if p == 0 {
+				b = encodeNull(b)
+			} else {
+				b = append(b, '"')
+				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = append(b, '"')
+			}
+			b = encodeIndentComma(b)
+			code = code.next
+		case opStructFieldHeadUintNPtr:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				b = encodeNull(b)
@@ -3295,7 +4392,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -3311,7 +4408,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3330,7 +4427,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -3360,7 +4479,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3376,7 +4495,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintOnly, opStructFieldAnonymousHeadOmitEmptyStringTagUintOnly:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -3411,7 +4549,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -3431,7 +4569,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUintPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3473,7 +4633,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -3493,7 +4653,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUintPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3533,7 +4715,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint8(ptr)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3558,7 +4740,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToUint8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -3580,7 +4790,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -3591,7 +4801,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendUint(b, uint64(ptrToUint8(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint8Only, opStructFieldHeadOmitEmptyUint8Only:
@@ -3602,7 +4812,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8Only, opStructFieldHeadOmitEmptyStringTagUint8Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := uint64(ptrToUint8(p))
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -3612,7 +4836,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint8(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -3635,7 +4859,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -3656,7 +4880,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint8(p)))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -3706,7 +4953,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -3727,7 +4974,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -3774,7 +5044,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -3790,7 +5060,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3809,7 +5079,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -3839,7 +5131,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3855,7 +5147,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint8Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint8(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -3890,7 +5201,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -3910,7 +5221,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint8Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -3952,7 +5285,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -3972,11 +5305,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
-		case opStructFieldPtrAnonymousHeadStringTagUint8PtrOnly:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint8PtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				code = code.end.next
@@ -3984,13 +5317,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagUint8PtrOnly:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint8PtrOnly:
 			p := load(ctxptr, code.idx)
-			b = appendIndent(ctx, b, code.indent)
-			b = append(b, code.key...)
-			b = append(b, ' ')
 			if p == 0 {
-				b = encodeNull(b)
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
+				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
+				b = append(b, '"')
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagUint8PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = appendIndent(ctx, b, code.indent)
+			b = append(b, code.key...)
+			b = append(b, ' ')
+			if p == 0 {
+				b = encodeNull(b)
 			} else {
 				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p+code.offset)))
@@ -4012,7 +5367,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint16(ptr)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4037,7 +5392,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToUint16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -4059,7 +5442,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -4070,7 +5453,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendUint(b, uint64(ptrToUint16(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint16Only, opStructFieldHeadOmitEmptyUint16Only:
@@ -4081,7 +5464,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16Only, opStructFieldHeadOmitEmptyStringTagUint16Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := uint64(ptrToUint16(p))
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -4091,7 +5488,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint16(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -4114,7 +5511,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -4135,7 +5532,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint16(p)))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -4185,7 +5605,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -4206,7 +5626,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -4253,7 +5696,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -4269,7 +5712,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4288,7 +5731,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -4318,7 +5783,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4334,7 +5799,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint16Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint16(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -4369,7 +5853,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -4389,7 +5873,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint16Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4431,7 +5937,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -4451,7 +5957,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint16(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint16PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint16(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4491,7 +6019,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint32(ptr)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4516,7 +6044,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToUint32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -4538,7 +6094,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -4549,7 +6105,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendUint(b, uint64(ptrToUint32(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint32Only, opStructFieldHeadOmitEmptyUint32Only:
@@ -4560,7 +6116,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32Only, opStructFieldHeadOmitEmptyStringTagUint32Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := uint64(ptrToUint32(p))
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -4570,7 +6140,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint32(p)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -4593,7 +6163,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -4614,7 +6184,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(ptrToUint32(p)))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -4664,7 +6257,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -4685,11 +6278,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
-		case opStructFieldPtrHeadStringTagUint32PtrOnly:
+		case opStructFieldPtrHeadOmitEmptyStringTagUint32PtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				b = encodeNull(b)
@@ -4699,20 +6292,43 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldHeadStringTagUint32PtrOnly:
+		case opStructFieldHeadOmitEmptyStringTagUint32PtrOnly:
 			p := load(ctxptr, code.idx)
 			b = append(b, '{', '\n')
-			b = appendIndent(ctx, b, code.indent+1)
-			b = append(b, code.key...)
-			b = append(b, ' ')
-			if p == 0 {
-				b = encodeNull(b)
-			} else {
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
 				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
 				b = append(b, '"')
+				b = encodeIndentComma(b)
 			}
-			b = encodeIndentComma(b)
+			code = code.next
+		case opStructFieldPtrHeadStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			b = appendIndent(ctx, b, code.indent+1)
+			b = append(b, code.key...)
+			b = append(b, ' ')
+			if p == 0 {
+				b = encodeNull(b)
+			} else {
+				b = append(b, '"')
+				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = append(b, '"')
+			}
+			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldHeadUint32NPtr:
 			p := load(ctxptr, code.idx)
@@ -4732,7 +6348,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+					b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -4748,7 +6364,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4767,7 +6383,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -4797,7 +6435,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4813,7 +6451,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, uint64(v), opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint32Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, uint64(v))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -4848,7 +6505,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -4868,7 +6525,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4910,7 +6589,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -4930,7 +6609,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint32(p+code.offset)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p+code.offset)))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4970,7 +6671,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, ptrToUint64(ptr))
+				b = appendUintWithOption(b, ptrToUint64(ptr), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -4995,7 +6696,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToUint64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, v)
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -5017,7 +6746,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ', '"')
-				b = appendUint(b, ptrToUint64(ptr+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
@@ -5028,7 +6757,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = appendUint(b, ptrToUint64(p))
+			b = appendUintWithOption(b, ptrToUint64(p), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyUint64Only, opStructFieldHeadOmitEmptyUint64Only:
@@ -5039,7 +6768,21 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64Only, opStructFieldHeadOmitEmptyStringTagUint64Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := ptrToUint64(p)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -5049,7 +6792,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, ptrToUint64(p))
+			b = appendUintWithOption(b, ptrToUint64(p), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5072,7 +6815,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, ptrToUint64(p+code.offset))
+					b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -5093,7 +6836,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, ptrToUint64(p), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, ptrToUint64(p))
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -5143,7 +6909,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5164,7 +6930,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -5211,7 +7000,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = appendUint(b, ptrToUint64(p+code.offset))
+					b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -5227,7 +7016,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, ptrToUint64(ptr+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5246,7 +7035,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, v)
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -5276,7 +7087,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, ptrToUint64(ptr+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5292,7 +7103,26 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
+					b = appendUintWithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Only, opStructFieldAnonymousHeadOmitEmptyStringTagUint64Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToUint64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
 					b = appendUint(b, v)
+					b = append(b, '"')
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -5327,7 +7157,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5347,7 +7177,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5389,7 +7241,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5409,11 +7261,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = appendUintWithOption(b, ptrToUint64(p+code.offset), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
-		case opStructFieldPtrAnonymousHeadStringTagUint64PtrOnly:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagUint64PtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				code = code.end.next
@@ -5421,18 +7273,40 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagUint64PtrOnly:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagUint64PtrOnly:
 			p := load(ctxptr, code.idx)
-			b = appendIndent(ctx, b, code.indent)
-			b = append(b, code.key...)
-			b = append(b, ' ')
 			if p == 0 {
-				b = encodeNull(b)
+				code = code.nextField
 			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
 				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p+code.offset))
 				b = append(b, '"')
-			}
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagUint64PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = appendIndent(ctx, b, code.indent)
+			b = append(b, code.key...)
+			b = append(b, ' ')
+			if p == 0 {
+				b = encodeNull(b)
+			} else {
+				b = append(b, '"')
+				b = appendUint(b, ptrToUint64(p+code.offset))
+				b = append(b, '"')
+			}
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadFloat32:
@@ -5449,7 +7323,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, ptrToFloat32(ptr))
+				v := ptrToFloat32(ptr)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5474,7 +7352,39 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeFloat32(b, v)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToFloat32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -5495,9 +7405,12 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = append(b, '{', '\n')
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
-				b = append(b, ' ', '"')
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-				b = append(b, '"')
+				b = append(b, ' ')
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5507,7 +7420,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = encodeFloat32(b, ptrToFloat32(p))
+			v := ptrToFloat32(p)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyFloat32Only, opStructFieldHeadOmitEmptyFloat32Only:
@@ -5518,7 +7435,25 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, v)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32Only, opStructFieldHeadOmitEmptyStringTagFloat32Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := ptrToFloat32(p)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -5527,9 +7462,12 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, '{', '\n')
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
-			b = append(b, ' ', '"')
-			b = encodeFloat32(b, ptrToFloat32(p))
-			b = append(b, '"')
+			b = append(b, ' ')
+			v := ptrToFloat32(p)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32StringWithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadFloat32Ptr:
@@ -5551,7 +7489,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeFloat32(b, ptrToFloat32(p))
+					v := ptrToFloat32(p)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -5572,7 +7514,36 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeFloat32(b, ptrToFloat32(p))
+					v := ptrToFloat32(p)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					v := ptrToFloat32(p)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -5596,9 +7567,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = append(b, '"')
-					b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-					b = append(b, '"')
+					v := ptrToFloat32(p + code.offset)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -5622,7 +7595,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5643,7 +7620,36 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -5666,9 +7672,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5690,7 +7698,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeFloat32(b, ptrToFloat32(p))
+					v := ptrToFloat32(p)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -5706,7 +7718,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, ptrToFloat32(ptr))
+				v := ptrToFloat32(ptr)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5725,7 +7741,33 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeFloat32(b, v)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -5741,9 +7783,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5755,7 +7799,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, ptrToFloat32(ptr))
+				v := ptrToFloat32(ptr)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5771,7 +7819,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeFloat32(b, v)
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32WithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Only, opStructFieldAnonymousHeadOmitEmptyStringTagFloat32Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat32(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					if encodeIsUnsupportedFloat(float64(v), opt) {
+						return nil, errUnsupportedFloat(float64(v))
+					}
+					b = encodeFloat32StringWithOption(b, v, opt)
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -5784,9 +7855,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(ptr + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5806,7 +7879,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5826,7 +7903,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat32Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5846,9 +7951,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5868,7 +7975,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5888,7 +7999,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat32PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5908,9 +8047,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p+code.offset))
-				b = append(b, '"')
+				v := ptrToFloat32(p + code.offset)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -5925,14 +8066,14 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				code = code.end.next
 			} else {
 				v := ptrToFloat64(ptr)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = append(b, '{', '\n')
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5951,7 +8092,36 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			} else {
 				b = append(b, '{', '\n')
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToFloat64(ptr + code.offset)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				if v == 0 {
@@ -5960,7 +8130,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -5981,13 +8151,12 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = append(b, '{', '\n')
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
-				b = append(b, ' ', '"')
+				b = append(b, ' ')
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -5998,24 +8167,39 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyFloat64Only, opStructFieldHeadOmitEmptyFloat64Only:
 			p := load(ctxptr, code.idx)
 			b = append(b, '{', '\n')
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
+				return nil, errUnsupportedFloat(v)
+			}
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64Only, opStructFieldHeadOmitEmptyStringTagFloat64Only:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := ptrToFloat64(p)
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
 			if v != 0 {
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -6024,13 +8208,12 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, '{', '\n')
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
-			b = append(b, ' ', '"')
+			b = append(b, ' ')
 			v := ptrToFloat64(p)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
-			b = append(b, '"')
+			b = encodeFloat64StringWithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadFloat64Ptr:
@@ -6053,10 +8236,10 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = encodeNull(b)
 				} else {
 					v := ptrToFloat64(p)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64WithOption(b, v, opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -6078,10 +8261,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = append(b, code.key...)
 					b = append(b, ' ')
 					v := ptrToFloat64(p)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					v := ptrToFloat64(p)
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -6105,13 +8313,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = append(b, '"')
 					v := ptrToFloat64(p)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
-					b = append(b, '"')
+					b = encodeFloat64StringWithOption(b, v, opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -6136,10 +8342,10 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6161,10 +8367,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = append(b, code.key...)
 				b = append(b, ' ')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				v := ptrToFloat64(p)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -6187,13 +8418,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6216,10 +8445,10 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = encodeNull(b)
 				} else {
 					v := ptrToFloat64(p)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64WithOption(b, v, opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -6236,10 +8465,10 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = append(b, code.key...)
 				b = append(b, ' ')
 				v := ptrToFloat64(ptr)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6252,7 +8481,30 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				code = code.end.next
 			} else {
 				v := ptrToFloat64(ptr + code.offset)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat64(ptr + code.offset)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				if v == 0 {
@@ -6261,7 +8513,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -6277,13 +8529,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = append(b, '"')
 				v := ptrToFloat64(ptr)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6296,10 +8546,10 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = append(b, code.key...)
 				b = append(b, ' ')
 				v := ptrToFloat64(ptr)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6316,10 +8566,31 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = append(b, code.key...)
 					b = append(b, ' ')
 					v := ptrToFloat64(ptr)
-					if math.IsInf(v, 0) || math.IsNaN(v) {
+					if encodeIsUnsupportedFloat(v, opt) {
+						return nil, errUnsupportedFloat(v)
+					}
+					b = encodeFloat64WithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Only, opStructFieldAnonymousHeadOmitEmptyStringTagFloat64Only:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToFloat64(ptr + code.offset)
+				if v == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					v := ptrToFloat64(ptr)
+					if encodeIsUnsupportedFloat(v, opt) {
 						return nil, errUnsupportedFloat(v)
 					}
-					b = encodeFloat64(b, v)
+					b = encodeFloat64StringWithOption(b, v, opt)
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -6332,13 +8603,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = append(b, '"')
 				v := ptrToFloat64(ptr)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6359,10 +8628,10 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6383,10 +8652,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = append(b, code.key...)
 				b = append(b, ' ')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64Ptr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat64Ptr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				v := ptrToFloat64(p)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6406,13 +8699,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6433,10 +8724,10 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6457,10 +8748,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = append(b, code.key...)
 				b = append(b, ' ')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagFloat64PtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				v := ptrToFloat64(p)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6480,13 +8795,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6504,7 +8817,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, ptrToString(ptr))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(ptr), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6529,7 +8842,33 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeNoEscapedString(b, v)
+					b = encodeNoEscapedStringWithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				v := ptrToString(ptr + code.offset)
+				if v == "" {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -6552,7 +8891,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = append(b, code.key...)
 				b = append(b, ' ')
 				v := ptrToString(ptr + code.offset)
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, v)))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6562,7 +8901,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = encodeNoEscapedString(b, ptrToString(p))
+			b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadOmitEmptyStringOnly, opStructFieldHeadOmitEmptyStringOnly:
@@ -6573,7 +8912,19 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, v)
+				b = encodeNoEscapedStringWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagStringOnly, opStructFieldHeadOmitEmptyStringTagStringOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			v := ptrToString(p)
+			if v != "" {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -6583,7 +8934,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent+1)
 			b = append(b, code.key...)
 			b = append(b, ' ')
-			b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+			b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldPtrHeadStringPtr:
@@ -6605,7 +8956,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeNoEscapedString(b, ptrToString(p))
+					b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -6626,7 +8977,28 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent+1)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeNoEscapedString(b, ptrToString(p))
+					b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
+					b = encodeIndentComma(b)
+				}
+				code = code.next
+			}
+		case opStructFieldPtrHeadOmitEmptyStringTagStringPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagStringPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = append(b, '{', '\n')
+				p = ptrToPtr(p)
+				if p != 0 {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 					b = encodeIndentComma(b)
 				}
 				code = code.next
@@ -6650,7 +9022,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -6674,7 +9046,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6695,7 +9067,28 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent+1)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldPtrHeadOmitEmptyStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			b = append(b, '{', '\n')
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent+1)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p+code.offset), opt)), opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -6718,7 +9111,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p+code.offset))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p+code.offset), opt)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6740,7 +9133,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				if p == 0 {
 					b = encodeNull(b)
 				} else {
-					b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+					b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 				}
 			}
 			b = encodeIndentComma(b)
@@ -6756,7 +9149,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, ptrToString(ptr+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(ptr+code.offset), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6775,7 +9168,27 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeNoEscapedString(b, v)
+					b = encodeNoEscapedStringWithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagString:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToString(ptr + code.offset)
+				if v == "" {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -6791,7 +9204,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(ptr+code.offset))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(ptr+code.offset), opt)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6803,7 +9216,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, ptrToString(ptr+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(ptr+code.offset), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6819,7 +9232,24 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = appendIndent(ctx, b, code.indent)
 					b = append(b, code.key...)
 					b = append(b, ' ')
-					b = encodeNoEscapedString(b, v)
+					b = encodeNoEscapedStringWithOption(b, v, opt)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringOnly, opStructFieldAnonymousHeadOmitEmptyStringTagStringOnly:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				code = code.end.next
+			} else {
+				v := ptrToString(ptr + code.offset)
+				if v == "" {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 					b = encodeIndentComma(b)
 					code = code.next
 				}
@@ -6832,7 +9262,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(ptr+code.offset))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(ptr+code.offset), opt)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6852,7 +9282,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6872,7 +9302,27 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtr:
+			store(ctxptr, code.idx, ptrToPtr(load(ctxptr, code.idx)))
+			fallthrough
+		case opStructFieldAnonymousHeadOmitEmptyStringTagStringPtr:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			p = ptrToPtr(p)
+			if p == 0 {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
@@ -6892,7 +9342,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p+code.offset))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p+code.offset), opt)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6912,7 +9362,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -6932,11 +9382,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, ptrToString(p+code.offset))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p+code.offset), opt)
 				b = encodeIndentComma(b)
 				code = code.next
 			}
-		case opStructFieldPtrAnonymousHeadStringTagStringPtrOnly:
+		case opStructFieldPtrAnonymousHeadOmitEmptyStringTagStringPtrOnly:
 			p := load(ctxptr, code.idx)
 			if p == 0 {
 				code = code.end.next
@@ -6944,15 +9394,35 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			}
 			store(ctxptr, code.idx, ptrToPtr(p))
 			fallthrough
-		case opStructFieldAnonymousHeadStringTagStringPtrOnly:
+		case opStructFieldAnonymousHeadOmitEmptyStringTagStringPtrOnly:
 			p := load(ctxptr, code.idx)
-			b = appendIndent(ctx, b, code.indent)
-			b = append(b, code.key...)
-			b = append(b, ' ')
 			if p == 0 {
-				b = encodeNull(b)
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p+code.offset), opt)), opt)
+				b = encodeIndentComma(b)
+				code = code.next
+			}
+		case opStructFieldPtrAnonymousHeadStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			if p == 0 {
+				code = code.end.next
+				break
+			}
+			store(ctxptr, code.idx, ptrToPtr(p))
+			fallthrough
+		case opStructFieldAnonymousHeadStringTagStringPtrOnly:
+			p := load(ctxptr, code.idx)
+			b = appendIndent(ctx, b, code.indent)
+			b = append(b, code.key...)
+			b = append(b, ' ')
+			if p == 0 {
+				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p+code.offset))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p+code.offset), opt)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7024,6 +9494,36 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					code = code.next
 				}
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, '{', '\n')
+				v := ptrToBool(ptr + code.offset)
+				if !v {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = append(b, '"')
+					b = encodeBool(b, v)
+					b = append(b, '"')
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrHeadOmitEmptyBytes:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -7052,6 +9552,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					code = code.next
 				}
 			}
+		case opStructFieldPtrHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr != 0 {
+				store(ctxptr, code.idx, ptrToPtr(ptr))
+			}
+			fallthrough
+		case opStructFieldHeadOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.idx)
+			if ptr == 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = encodeNull(b)
+				b = encodeIndentComma(b)
+				code = code.end.next
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, '{', '\n')
+				v := ptrToBytes(ptr + code.offset)
+				if len(v) == 0 {
+					code = code.nextField
+				} else {
+					b = appendIndent(ctx, b, code.indent+1)
+					b = append(b, code.key...)
+					b = append(b, ' ')
+					b = encodeByteSlice(b, v)
+					b = encodeIndentComma(b)
+					code = code.next
+				}
+			}
 		case opStructFieldPtrHeadStringTag:
 			ptr := load(ctxptr, code.idx)
 			if ptr != 0 {
@@ -7119,9 +9647,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				code = code.next
 			}
 		case opStructField:
-			b = appendIndent(ctx, b, code.indent)
-			b = append(b, code.key...)
-			b = append(b, ' ')
+			if !code.anonymousKey {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+			}
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
 			code = code.next
@@ -7129,7 +9659,19 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 		case opStructFieldOmitEmpty:
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
-			if p == 0 || **(**uintptr)(unsafe.Pointer(&p)) == 0 {
+			if p == 0 || encodeIsOmitted(code, p) {
+				code = code.nextField
+			} else {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				code = code.next
+				store(ctxptr, code.idx, p)
+			}
+		case opStructFieldOmitEmptyStringTag:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			if p == 0 || encodeIsOmitted(code, p) {
 				code = code.nextField
 			} else {
 				b = appendIndent(ctx, b, code.indent)
@@ -7151,7 +9693,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt:
@@ -7161,7 +9703,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7170,7 +9725,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7179,7 +9734,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt8:
@@ -7189,7 +9744,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt8(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7198,7 +9766,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7207,7 +9775,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt16:
@@ -7217,7 +9785,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt16(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7226,7 +9807,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7235,7 +9816,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt32:
@@ -7245,7 +9826,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(v), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt32(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7254,7 +9848,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7263,7 +9857,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, ptrToInt64(ptr+code.offset))
+			b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyInt64:
@@ -7273,7 +9867,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt64(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7282,7 +9889,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, ptrToInt64(ptr+code.offset))
+			b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7291,7 +9898,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint:
@@ -7301,7 +9908,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7310,7 +9930,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7319,7 +9939,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint8:
@@ -7329,7 +9949,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint8(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7338,7 +9971,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7347,7 +9980,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint16:
@@ -7357,7 +9990,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint16(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7366,7 +10012,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7375,7 +10021,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint32:
@@ -7385,7 +10031,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint32(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7394,7 +10053,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7403,7 +10062,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, ptrToUint64(ptr+code.offset))
+			b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyUint64:
@@ -7413,7 +10072,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint64(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7422,7 +10094,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, ptrToUint64(ptr+code.offset))
+			b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 			b = append(b, '"')
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7431,7 +10103,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyFloat32:
@@ -7441,7 +10117,24 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, v)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat32(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7449,9 +10142,12 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			ptr := load(ctxptr, code.headIdx)
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
-			b = append(b, ' ', '"')
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-			b = append(b, '"')
+			b = append(b, ' ')
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32StringWithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldFloat64:
@@ -7460,37 +10156,50 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
 			if v != 0 {
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeFloat64WithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat64(ptr + code.offset)
+			if v != 0 {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
 		case opStructFieldStringTagFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
-			b = append(b, ' ', '"')
-			b = encodeFloat64(b, v)
-			b = append(b, '"')
+			b = append(b, ' ')
+			b = encodeFloat64StringWithOption(b, v, opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldString:
@@ -7498,7 +10207,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = encodeNoEscapedString(b, ptrToString(ptr+code.offset))
+			b = encodeNoEscapedStringWithOption(b, ptrToString(ptr+code.offset), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldOmitEmptyString:
@@ -7508,7 +10217,18 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, v)
+				b = encodeNoEscapedStringWithOption(b, v, opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToString(ptr + code.offset)
+			if v != "" {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7518,7 +10238,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			s := ptrToString(ptr + code.offset)
-			b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, s)))
+			b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, s, opt)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldStringPtr:
@@ -7530,7 +10250,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7541,7 +10261,18 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagStringPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 				b = encodeIndentComma(b)
 			}
 			code = code.next
@@ -7554,7 +10285,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 			}
 			b = encodeIndentComma(b)
 			code = code.next
@@ -7577,7 +10308,20 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeIndentComma(b)
 			}
 			code = code.next
-		case opStructFieldStringTagBool:
+		case opStructFieldOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBool(ptr + code.offset)
+			if v {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
+				b = encodeBool(b, v)
+				b = append(b, '"')
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldStringTagBool:
 			ptr := load(ctxptr, code.headIdx)
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
@@ -7605,6 +10349,17 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeIndentComma(b)
 			}
 			code = code.next
+		case opStructFieldOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBytes(ptr + code.offset)
+			if len(v) > 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeByteSlice(b, v)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
 		case opStructFieldStringTagBytes:
 			ptr := load(ctxptr, code.headIdx)
 			b = appendIndent(ctx, b, code.indent)
@@ -7620,7 +10375,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -7640,6 +10395,64 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, indentBuf.Bytes()...)
 			b = encodeIndentComma(b)
 			code = code.next
+		case opStructFieldOmitEmptyMarshalJSON:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			if v != nil && (code.typ.Kind() != reflect.Ptr || ptrToPtr(p) != 0) {
+				bb, err := ctx.marshalJSON(v)
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				var compactBuf bytes.Buffer
+				if err := compact(&compactBuf, bb, false); err != nil {
+					return nil, err
+				}
+				var indentBuf bytes.Buffer
+				if err := encodeWithIndent(
+					&indentBuf,
+					compactBuf.Bytes(),
+					string(ctx.prefix)+strings.Repeat(string(ctx.indentStr), ctx.baseIndent+code.indent),
+					string(ctx.indentStr),
+				); err != nil {
+					return nil, err
+				}
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, indentBuf.Bytes()...)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructFieldOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			if v != nil && (code.typ.Kind() != reflect.Ptr || ptrToPtr(p) != 0) {
+				bb, err := ctx.marshalJSON(v)
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				var compactBuf bytes.Buffer
+				if err := compact(&compactBuf, bb, false); err != nil {
+					return nil, err
+				}
+				var indentBuf bytes.Buffer
+				if err := encodeWithIndent(
+					&indentBuf,
+					compactBuf.Bytes(),
+					string(ctx.prefix)+strings.Repeat(string(ctx.indentStr), ctx.baseIndent+code.indent),
+					string(ctx.indentStr),
+				); err != nil {
+					return nil, err
+				}
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, indentBuf.String(), opt)
+				b = encodeIndentComma(b)
+			}
+			code = code.next
 		case opStructFieldStringTagMarshalJSON:
 			ptr := load(ctxptr, code.headIdx)
 			b = appendIndent(ctx, b, code.indent)
@@ -7647,7 +10460,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, ' ')
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -7664,7 +10477,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			); err != nil {
 				return nil, err
 			}
-			b = encodeNoEscapedString(b, indentBuf.String())
+			b = encodeNoEscapedStringWithOption(b, indentBuf.String(), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldStringTagMarshalText:
@@ -7678,7 +10491,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
-			b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+			b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 			b = encodeIndentComma(b)
 			code = code.next
 		case opStructFieldArray:
@@ -7715,11 +10528,16 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			p := ptr + code.offset
 			slice := ptrToSlice(p)
 			if p == 0 || uintptr(slice.data) == 0 {
-				b = encodeNull(b)
-				b = encodeIndentComma(b)
+				if p != 0 && encodeNilAsEmpty(code.next, opt) {
+					b = append(b, '[', ']', ',', '\n')
+				} else {
+					b = encodeNull(b)
+					b = encodeIndentComma(b)
+				}
 				code = code.nextField
 			} else {
 				code = code.next
+				store(ctxptr, code.idx, p)
 			}
 		case opStructFieldOmitEmptySlice:
 			ptr := load(ctxptr, code.headIdx)
@@ -7744,6 +10562,12 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				code = code.nextField
 			} else {
 				p = ptrToPtr(p)
+				if p == 0 && !encodeNilAsEmpty(code.next, opt) {
+					b = encodeNull(b)
+					b = encodeIndentComma(b)
+					code = code.nextField
+					break
+				}
 				mlen := maplen(ptrToUnsafePtr(p))
 				if mlen == 0 {
 					b = append(b, '{', '}', ',', '\n')
@@ -7751,6 +10575,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					code = mapCode.end.next
 				} else {
 					code = code.next
+					store(ctxptr, code.idx, p)
 				}
 			}
 		case opStructFieldOmitEmptyMap:
@@ -7767,6 +10592,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = append(b, code.key...)
 					b = append(b, ' ')
 					code = code.next
+					store(ctxptr, code.idx, p)
 				}
 			}
 		case opStructFieldMapLoad:
@@ -7780,11 +10606,18 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				code = code.nextField
 			} else {
 				p = ptrToPtr(p)
+				if p == 0 && !encodeNilAsEmpty(code.next, opt) {
+					b = encodeNull(b)
+					b = encodeIndentComma(b)
+					code = code.nextField
+					break
+				}
 				mlen := maplen(ptrToUnsafePtr(p))
 				if mlen == 0 {
 					b = append(b, '{', '}', ',', '\n')
 					code = code.nextField
 				} else {
+					store(ctxptr, code.next.idx, ptr+code.offset)
 					code = code.next
 				}
 			}
@@ -7802,6 +10635,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 					b = append(b, code.key...)
 					b = append(b, ' ')
 					code = code.next
+					store(ctxptr, code.idx, p)
 				}
 			}
 		case opStructFieldStruct:
@@ -7845,6 +10679,29 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			}
 		case opStructAnonymousEnd:
 			code = code.next
+		case opStructFieldUnknown:
+			entries := encodeUnknownFieldEntries(code, ctxptr, opt)
+			if len(entries) > 0 {
+				valueCodeSet, err := encodeUnknownFieldValueCodeSet(ctx, code, opt)
+				if err != nil {
+					return nil, err
+				}
+				for _, entry := range entries {
+					b = appendIndent(ctx, b, code.indent)
+					b = encodeNoEscapedStringWithOption(b, entry.key, opt)
+					b = append(b, ':', ' ')
+					oldBaseIndent := ctx.baseIndent
+					ctx.baseIndent += code.indent
+					bb, err := encodeRunUnknownFieldValue(ctx, b, codeSet, valueCodeSet, ptrOffset, entry, opt, encodeRunIndent)
+					if err != nil {
+						return nil, err
+					}
+					ctx.baseIndent = oldBaseIndent
+					b = bb
+				}
+				ctxptr = ctx.ptr() + ptrOffset
+			}
+			code = code.next
 		case opStructEnd:
 			last := len(b) - 1
 			if b[last-1] == '{' {
@@ -7867,7 +10724,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyInt:
@@ -7877,7 +10734,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -7900,7 +10784,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -7913,7 +10797,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt(p)))
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -7924,7 +10808,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt(p)), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagIntPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt(p)))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -7962,7 +10873,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyInt8:
@@ -7972,7 +10883,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt8(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -7995,7 +10933,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt8(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt8(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8008,7 +10946,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt8(p)))
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8019,7 +10957,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt8(p)), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt8Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt8(p)))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8057,7 +11022,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyInt16:
@@ -8067,7 +11032,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(v), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt16(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(v))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8090,7 +11082,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt16(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt16(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8103,7 +11095,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, int64(ptrToInt16(p)))
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8114,7 +11106,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt16(p)), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt16Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt16(p)))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8152,7 +11171,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
+			b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyInt32:
@@ -8162,7 +11181,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendInt(b, int64(v))
+				b = appendIntWithOption(b, int64(v), opt)
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8180,36 +11199,90 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeIndentComma(b)
 			}
 			code = code.next
-		case opStructEndStringTagInt32:
-			ptr := load(ctxptr, code.headIdx)
-			b = appendIndent(ctx, b, code.indent)
-			b = append(b, code.key...)
-			b = append(b, ' ', '"')
-			b = appendInt(b, int64(ptrToInt32(ptr+code.offset)))
-			b = append(b, '"')
-			b = appendStructEndIndent(ctx, b, code.indent-1)
-			code = code.next
-		case opStructEndInt32Ptr:
-			b = appendIndent(ctx, b, code.indent)
-			b = append(b, code.key...)
-			b = append(b, ' ')
-			ptr := load(ctxptr, code.headIdx)
-			p := ptrToPtr(ptr + code.offset)
-			if p == 0 {
-				b = encodeNull(b)
-			} else {
-				b = appendInt(b, int64(ptrToInt32(p)))
-			}
-			b = appendStructEndIndent(ctx, b, code.indent-1)
-			code = code.next
-		case opStructEndOmitEmptyInt32Ptr:
+		case opStructEndOmitEmptyStringTagInt32:
 			ptr := load(ctxptr, code.headIdx)
-			p := ptrToPtr(ptr + code.offset)
+			v := ptrToInt32(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
+				b = appendInt(b, int64(v))
+				b = append(b, '"')
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndStringTagInt32:
+			ptr := load(ctxptr, code.headIdx)
+			b = appendIndent(ctx, b, code.indent)
+			b = append(b, code.key...)
+			b = append(b, ' ', '"')
+			b = appendIntWithOption(b, int64(ptrToInt32(ptr+code.offset)), opt)
+			b = append(b, '"')
+			b = appendStructEndIndent(ctx, b, code.indent-1)
+			code = code.next
+		case opStructEndInt32Ptr:
+			b = appendIndent(ctx, b, code.indent)
+			b = append(b, code.key...)
+			b = append(b, ' ')
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p == 0 {
+				b = encodeNull(b)
+			} else {
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+			}
+			b = appendStructEndIndent(ctx, b, code.indent-1)
+			code = code.next
+		case opStructEndOmitEmptyInt32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = appendIntWithOption(b, int64(ptrToInt32(p)), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
 			if p != 0 {
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, int64(ptrToInt32(p)))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8247,7 +11320,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendInt(b, ptrToInt64(ptr+code.offset))
+			b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyInt64:
@@ -8257,7 +11330,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, v, opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToInt64(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, v)
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8280,7 +11380,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendInt(b, ptrToInt64(ptr+code.offset))
+			b = appendIntWithOption(b, ptrToInt64(ptr+code.offset), opt)
 			b = append(b, '"')
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8293,7 +11393,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendInt(b, ptrToInt64(p))
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8304,7 +11404,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendIntWithOption(b, ptrToInt64(p), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagInt64Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendInt(b, ptrToInt64(p))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8342,7 +11469,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyUint:
@@ -8352,7 +11479,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8375,7 +11529,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8388,7 +11542,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8399,7 +11553,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint(p)), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUintPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint(p)))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8437,7 +11618,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyUint8:
@@ -8447,7 +11628,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint8:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint8(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8470,7 +11678,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint8(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint8(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8483,7 +11691,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8494,7 +11702,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint8(p)), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint8Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint8(p)))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8532,7 +11767,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyUint16:
@@ -8542,7 +11777,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint16:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint16(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8565,7 +11827,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint16(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint16(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8578,7 +11840,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8589,7 +11851,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint16(p)), opt)
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8607,9 +11869,36 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeIndentComma(b)
 			}
 			code = code.next
-		case opStructEndStringTagUint16Ptr:
-			b = appendIndent(ctx, b, code.indent)
-			b = append(b, code.key...)
+		case opStructEndOmitEmptyStringTagUint16Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
+				b = appendUint(b, uint64(ptrToUint16(p)))
+				b = append(b, '"')
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndStringTagUint16Ptr:
+			b = appendIndent(ctx, b, code.indent)
+			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
 			p := ptrToPtr(ptr + code.offset)
@@ -8627,7 +11916,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyUint32:
@@ -8637,7 +11926,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(v), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint32(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(v))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8660,7 +11976,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, uint64(ptrToUint32(ptr+code.offset)))
+			b = appendUintWithOption(b, uint64(ptrToUint32(ptr+code.offset)), opt)
 			b = append(b, '"')
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8673,7 +11989,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8684,7 +12000,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, uint64(ptrToUint32(p)), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, uint64(ptrToUint32(p)))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8722,7 +12065,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = appendUint(b, ptrToUint64(ptr+code.offset))
+			b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyUint64:
@@ -8732,7 +12075,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, v, opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToUint64(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, v)
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8755,7 +12125,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
 			b = append(b, ' ', '"')
-			b = appendUint(b, ptrToUint64(ptr+code.offset))
+			b = appendUintWithOption(b, ptrToUint64(ptr+code.offset), opt)
 			b = append(b, '"')
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8768,7 +12138,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = appendUint(b, ptrToUint64(p))
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8779,7 +12149,34 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
+				b = appendUintWithOption(b, ptrToUint64(p), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagUint64Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
 				b = appendUint(b, ptrToUint64(p))
+				b = append(b, '"')
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8817,7 +12214,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32WithOption(b, v, opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyFloat32:
@@ -8827,7 +12228,38 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, v)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat32:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat32(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8849,9 +12281,12 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			ptr := load(ctxptr, code.headIdx)
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
-			b = append(b, ' ', '"')
-			b = encodeFloat32(b, ptrToFloat32(ptr+code.offset))
-			b = append(b, '"')
+			b = append(b, ' ')
+			v := ptrToFloat32(ptr + code.offset)
+			if encodeIsUnsupportedFloat(float64(v), opt) {
+				return nil, errUnsupportedFloat(float64(v))
+			}
+			b = encodeFloat32StringWithOption(b, v, opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndFloat32Ptr:
@@ -8863,7 +12298,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8874,7 +12313,40 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeFloat32(b, ptrToFloat32(p))
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32WithOption(b, v, opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat32Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8901,9 +12373,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
-				b = encodeFloat32(b, ptrToFloat32(p))
-				b = append(b, '"')
+				v := ptrToFloat32(p)
+				if encodeIsUnsupportedFloat(float64(v), opt) {
+					return nil, errUnsupportedFloat(float64(v))
+				}
+				b = encodeFloat32StringWithOption(b, v, opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8913,10 +12387,10 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
-			b = encodeFloat64(b, v)
+			b = encodeFloat64WithOption(b, v, opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyFloat64:
@@ -8926,10 +12400,38 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat64:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToFloat64(ptr + code.offset)
+			if v != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -8950,14 +12452,13 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 		case opStructEndStringTagFloat64:
 			ptr := load(ctxptr, code.headIdx)
 			v := ptrToFloat64(ptr + code.offset)
-			if math.IsInf(v, 0) || math.IsNaN(v) {
+			if encodeIsUnsupportedFloat(v, opt) {
 				return nil, errUnsupportedFloat(v)
 			}
 			b = appendIndent(ctx, b, code.indent)
 			b = append(b, code.key...)
-			b = append(b, ' ', '"')
-			b = encodeFloat64(b, v)
-			b = append(b, '"')
+			b = append(b, ' ')
+			b = encodeFloat64StringWithOption(b, v, opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndFloat64Ptr:
@@ -8970,10 +12471,10 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeNull(b)
 			} else {
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64WithOption(b, v, opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -8985,10 +12486,39 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = append(b, code.key...)
 				b = append(b, ' ')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
+					return nil, errUnsupportedFloat(v)
+				}
+				b = encodeFloat64WithOption(b, v, opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagFloat64Ptr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				v := ptrToFloat64(p)
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
+				b = encodeFloat64StringWithOption(b, v, opt)
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -9015,13 +12545,11 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = append(b, '"')
 				v := ptrToFloat64(p)
-				if math.IsInf(v, 0) || math.IsNaN(v) {
+				if encodeIsUnsupportedFloat(v, opt) {
 					return nil, errUnsupportedFloat(v)
 				}
-				b = encodeFloat64(b, v)
-				b = append(b, '"')
+				b = encodeFloat64StringWithOption(b, v, opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -9030,7 +12558,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			ptr := load(ctxptr, code.headIdx)
-			b = encodeNoEscapedString(b, ptrToString(ptr+code.offset))
+			b = encodeNoEscapedStringWithOption(b, ptrToString(ptr+code.offset), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndOmitEmptyString:
@@ -9040,7 +12568,32 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, v)
+				b = encodeNoEscapedStringWithOption(b, v, opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagString:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToString(ptr + code.offset)
+			if v != "" {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, v, opt)), opt)
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -9064,7 +12617,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, code.key...)
 			b = append(b, ' ')
 			s := ptrToString(ptr + code.offset)
-			b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, s)))
+			b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, s, opt)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndStringPtr:
@@ -9076,7 +12629,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -9087,7 +12640,32 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = appendIndent(ctx, b, code.indent)
 				b = append(b, code.key...)
 				b = append(b, ' ')
-				b = encodeNoEscapedString(b, ptrToString(p))
+				b = encodeNoEscapedStringWithOption(b, ptrToString(p), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagStringPtr:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptrToPtr(ptr + code.offset)
+			if p != 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 				b = appendStructEndIndent(ctx, b, code.indent-1)
 			} else {
 				last := len(b) - 1
@@ -9114,7 +12692,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if p == 0 {
 				b = encodeNull(b)
 			} else {
-				b = encodeNoEscapedString(b, string(encodeNoEscapedString([]byte{}, ptrToString(p))))
+				b = encodeNoEscapedStringWithOption(b, string(encodeNoEscapedStringWithOption([]byte{}, ptrToString(p), opt)), opt)
 			}
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
@@ -9151,6 +12729,33 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeIndentComma(b)
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagBool:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBool(ptr + code.offset)
+			if v {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, '"')
+				b = encodeBool(b, v)
+				b = append(b, '"')
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
 		case opStructEndStringTagBool:
 			ptr := load(ctxptr, code.headIdx)
 			b = appendIndent(ctx, b, code.indent)
@@ -9193,6 +12798,31 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 				b = encodeIndentComma(b)
 			}
 			code = code.next
+		case opStructEndOmitEmptyStringTagBytes:
+			ptr := load(ctxptr, code.headIdx)
+			v := ptrToBytes(ptr + code.offset)
+			if len(v) > 0 {
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeByteSlice(b, v)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
 		case opStructEndStringTagBytes:
 			ptr := load(ctxptr, code.headIdx)
 			b = appendIndent(ctx, b, code.indent)
@@ -9208,7 +12838,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			ptr := load(ctxptr, code.headIdx)
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -9228,6 +12858,92 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, indentBuf.Bytes()...)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
+		case opStructEndOmitEmptyMarshalJSON:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			if v != nil && (code.typ.Kind() != reflect.Ptr || ptrToPtr(p) != 0) {
+				bb, err := ctx.marshalJSON(v)
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				var compactBuf bytes.Buffer
+				if err := compact(&compactBuf, bb, false); err != nil {
+					return nil, err
+				}
+				var indentBuf bytes.Buffer
+				if err := encodeWithIndent(
+					&indentBuf,
+					compactBuf.Bytes(),
+					string(ctx.prefix)+strings.Repeat(string(ctx.indentStr), ctx.baseIndent+code.indent),
+					string(ctx.indentStr),
+				); err != nil {
+					return nil, err
+				}
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = append(b, indentBuf.Bytes()...)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent-1)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
+		case opStructEndOmitEmptyStringTagMarshalJSON:
+			ptr := load(ctxptr, code.headIdx)
+			p := ptr + code.offset
+			v := ptrToInterface(code, p)
+			if v != nil && (code.typ.Kind() != reflect.Ptr || ptrToPtr(p) != 0) {
+				bb, err := ctx.marshalJSON(v)
+				if err != nil {
+					return nil, errMarshaler(code, err)
+				}
+				var compactBuf bytes.Buffer
+				if err := compact(&compactBuf, bb, false); err != nil {
+					return nil, err
+				}
+				var indentBuf bytes.Buffer
+				if err := encodeWithIndent(
+					&indentBuf,
+					compactBuf.Bytes(),
+					string(ctx.prefix)+strings.Repeat(string(ctx.indentStr), ctx.baseIndent+code.indent),
+					string(ctx.indentStr),
+				); err != nil {
+					return nil, err
+				}
+				b = appendIndent(ctx, b, code.indent)
+				b = append(b, code.key...)
+				b = append(b, ' ')
+				b = encodeNoEscapedStringWithOption(b, indentBuf.String(), opt)
+				b = appendStructEndIndent(ctx, b, code.indent-1)
+			} else {
+				last := len(b) - 1
+				if b[last-1] == '{' {
+					b[last] = '}'
+				} else {
+					if b[last] == '\n' {
+						// to remove ',' and '\n' characters
+						b = b[:len(b)-2]
+					}
+					b = append(b, '\n')
+					b = appendIndent(ctx, b, code.indent-1)
+					b = append(b, '}')
+				}
+				b = encodeIndentComma(b)
+			}
+			code = code.next
 		case opStructEndStringTagMarshalJSON:
 			ptr := load(ctxptr, code.headIdx)
 			b = appendIndent(ctx, b, code.indent)
@@ -9235,7 +12951,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			b = append(b, ' ')
 			p := ptr + code.offset
 			v := ptrToInterface(code, p)
-			bb, err := v.(Marshaler).MarshalJSON()
+			bb, err := ctx.marshalJSON(v)
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
@@ -9252,7 +12968,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			); err != nil {
 				return nil, err
 			}
-			b = encodeNoEscapedString(b, indentBuf.String())
+			b = encodeNoEscapedStringWithOption(b, indentBuf.String(), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opStructEndStringTagMarshalText:
@@ -9266,7 +12982,7 @@ func encodeRunIndent(ctx *encodeRuntimeContext, b []byte, codeSet *opcodeSet, op
 			if err != nil {
 				return nil, errMarshaler(code, err)
 			}
-			b = encodeNoEscapedString(b, *(*string)(unsafe.Pointer(&bytes)))
+			b = encodeNoEscapedStringWithOption(b, *(*string)(unsafe.Pointer(&bytes)), opt)
 			b = appendStructEndIndent(ctx, b, code.indent-1)
 			code = code.next
 		case opEnd:
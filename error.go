@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 // Before Go 1.2, an InvalidUTF8Error was returned by Marshal when
@@ -117,6 +118,86 @@ func (e *UnsupportedValueError) Error() string {
 	return fmt.Sprintf("json: unsupported value: %s", e.Str)
 }
 
+// A LimitError is returned when the input exceeds one of the limits
+// configured by DecodeOption.
+type LimitError struct {
+	Limit  string // name of the exceeded limit (e.g. "depth")
+	Max    int64  // configured maximum
+	Offset int64  // error occurred after reading Offset bytes
+	Path   string // JSON Pointer to the value that exceeded the limit
+}
+
+func (e *LimitError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("json: exceeded max %s %d at offset %d", e.Limit, e.Max, e.Offset)
+	}
+	return fmt.Sprintf("json: exceeded max %s %d at offset %d in %s", e.Limit, e.Max, e.Offset, e.Path)
+}
+
+// annotateErrorPath prepends token to the paths of err if err is a LimitError or a MissingFieldsError.
+func annotateErrorPath(err error, token string) error {
+	switch e := err.(type) {
+	case *LimitError:
+		e.Path = "/" + jsonPointerEscaper.Replace(token) + e.Path
+	case *MissingFieldsError:
+		prefix := "/" + jsonPointerEscaper.Replace(token)
+		for i, path := range e.Paths {
+			e.Paths[i] = prefix + path
+		}
+	}
+	return err
+}
+
+// A MissingFieldsError is returned when required struct fields are missing from the input.
+// Decoding goes on after a missing field is found, so Paths lists every missing field of the input value.
+type MissingFieldsError struct {
+	Paths []string // JSON Pointers to the missing fields
+}
+
+func (e *MissingFieldsError) Error() string {
+	return fmt.Sprintf("json: missing required fields %s", strings.Join(e.Paths, ", "))
+}
+
+// collectMissingFields adds the missing fields of err, found in the value addressed by token, to missing.
+// The decoder which returned a MissingFieldsError has consumed the whole value,
+// so the caller can go on decoding. Other errors are returned as is.
+func collectMissingFields(missing *MissingFieldsError, err error, token string) (*MissingFieldsError, error) {
+	e, ok := err.(*MissingFieldsError)
+	if !ok {
+		return missing, err
+	}
+	annotateErrorPath(e, token)
+	if missing == nil {
+		return e, nil
+	}
+	missing.Paths = append(missing.Paths, e.Paths...)
+	return missing, nil
+}
+
+// missingFieldsError converts missing to an error without a typed nil.
+func missingFieldsError(missing *MissingFieldsError) error {
+	if missing == nil {
+		return nil
+	}
+	return missing
+}
+
+// A PathError is returned by UnmarshalPath and Decoder.DecodePath when
+// the JSON Pointer is malformed or does not address a value in the input.
+type PathError struct {
+	Path   string // JSON Pointer passed by the caller
+	Offset int64  // offset of the container in which the lookup failed
+	msg    string
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("json: %s for path %q", e.msg, e.Path)
+}
+
+func errExceededLimit(limit string, max int64, cursor int64) *LimitError {
+	return &LimitError{Limit: limit, Max: max, Offset: cursor}
+}
+
 func errNotAtBeginningOfValue(cursor int64) *SyntaxError {
 	return &SyntaxError{msg: "not at beginning of value", Offset: cursor}
 }
@@ -132,6 +213,21 @@ func errExpected(msg string, cursor int64) *SyntaxError {
 	return &SyntaxError{msg: fmt.Sprintf("expected %s", msg), Offset: cursor}
 }
 
+// quoteChar formats c as a quoted character literal.
+func quoteChar(c byte) string {
+	// special cases - different from quoted strings
+	if c == '\'' {
+		return `'\''`
+	}
+	if c == '"' {
+		return `'"'`
+	}
+
+	// use quoted string with different quotation marks
+	s := strconv.Quote(string(c))
+	return "'" + s[1:len(s)-1] + "'"
+}
+
 func errInvalidCharacter(c byte, context string, cursor int64) *SyntaxError {
 	if c == 0 {
 		return &SyntaxError{
@@ -0,0 +1,234 @@
+package json_test
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/goccy/go-json/internal/gentest"
+)
+
+func TestGeneratedCode(t *testing.T) {
+	zip := 12345
+	note := "<note>"
+	users := []gentest.User{
+		{},
+		{
+			ID:      1,
+			Name:    "Alice & Bob",
+			Email:   "alice@example.com",
+			Score:   1.5e-7,
+			Ratio:   0.25,
+			Active:  true,
+			Age:     30,
+			Tags:    []string{"a", "b"},
+			Aliases: []string{},
+			Home:    gentest.Address{Street: "Main St", Zip: &zip},
+			Work:    &gentest.Address{Street: "2nd St"},
+			Others:  []*gentest.Address{nil, {Street: "3rd St"}},
+			Attrs:   map[string]string{"b": "2", "a": "1"},
+			Extra:   []interface{}{1, "x", nil},
+			Created: time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC),
+			Note:    &note,
+			Raw:     []byte("raw"),
+			Label:   `"quoted"`,
+			Next:    &gentest.User{ID: 2},
+			Skip:    3,
+		},
+	}
+	users = append(users, gentest.User{Score: math.NaN(), Ratio: float32(math.Inf(-1)), Tags: []string{"<a&b>"}})
+	marshalers := []struct {
+		name    string
+		marshal func(v interface{}) ([]byte, error)
+	}{
+		{"marshal", json.Marshal},
+		{"indent", func(v interface{}) ([]byte, error) {
+			return json.MarshalIndent(v, "", "  ")
+		}},
+		{"no escape", func(v interface{}) ([]byte, error) {
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(false)
+			err := enc.Encode(v)
+			return buf.Bytes(), err
+		}},
+		{"nil as empty", func(v interface{}) ([]byte, error) {
+			return json.MarshalWithOption(v, json.NilAsEmpty())
+		}},
+		{"non-finite float", func(v interface{}) ([]byte, error) {
+			return json.MarshalWithOption(v, json.NonFiniteFloatAsString())
+		}},
+		{"snake case", func(v interface{}) ([]byte, error) {
+			return json.MarshalWithOption(v, json.SnakeCaseKeys())
+		}},
+		{"field mask", func(v interface{}) ([]byte, error) {
+			return json.MarshalWithFieldMask(v, json.NewFieldMask("id", "tags", "home.street", "others"))
+		}},
+	}
+	for _, user := range users {
+		for _, m := range marshalers {
+			expected, expectedErr := m.marshal(gentest.UserRuntime(user))
+			got, err := m.marshal(user)
+			if (expectedErr == nil) != (err == nil) {
+				t.Fatalf("%s: expected error %v but got %v", m.name, expectedErr, err)
+			}
+			assertEq(t, m.name, string(expected), string(got))
+			if expectedErr != nil {
+				assertEq(t, m.name+" error", expectedErr.Error(), err.Error())
+			}
+		}
+
+		got, err := json.Marshal(user)
+		if err != nil {
+			continue
+		}
+		var (
+			decoded        gentest.User
+			decodedRuntime gentest.UserRuntime
+		)
+		assertErr(t, json.Unmarshal(got, &decoded))
+		assertErr(t, json.Unmarshal(got, &decodedRuntime))
+		if !reflect.DeepEqual(gentest.User(decodedRuntime), decoded) {
+			t.Fatalf("failed to decode: expected %+v but got %+v", decodedRuntime, decoded)
+		}
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := json.Marshal(gentest.User{Score: math.Inf(1)})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		inputs := []string{
+			`{}`,
+			`null`,
+			` { "ID" : 1 , "NAME":"x", "unknown":[1,{"a":2}], "home":{"STREET":"s","zip":null}, "next":{"id":2} } `,
+			`{"ratio":"0.5","label":"\"x\"","others":[null,{"street":"a"}],"extra":{"a":[1]}}`,
+			`{"id":"x"}`,
+			`{"age":300}`,
+			`{"home":{"zip":1.5}}`,
+			`{"id":1,`,
+			`{"id" 1}`,
+			`{"id":1 "name":"x"}`,
+			`[]`,
+		}
+		options := []struct {
+			name string
+			opts []json.DecodeOptionFunc
+		}{
+			{"default", nil},
+			{"disallow unknown fields", []json.DecodeOptionFunc{json.DecodeDisallowUnknownFields()}},
+			{"require fields", []json.DecodeOptionFunc{json.DecodeRequireFields()}},
+			{"case sensitive", []json.DecodeOptionFunc{json.DecodeCaseSensitive()}},
+			{"max object keys", []json.DecodeOptionFunc{json.DecodeMaxObjectKeys(2)}},
+		}
+		for _, input := range inputs {
+			for _, opt := range options {
+				var (
+					decoded        gentest.User
+					decodedRuntime gentest.UserRuntime
+				)
+				expectedErr := json.UnmarshalWithOption([]byte(input), &decodedRuntime, opt.opts...)
+				err := json.UnmarshalWithOption([]byte(input), &decoded, opt.opts...)
+				if (expectedErr == nil) != (err == nil) {
+					t.Fatalf("%s %s: expected error %v but got %v", opt.name, input, expectedErr, err)
+				}
+				if expectedErr != nil {
+					expected := strings.Replace(expectedErr.Error(), "UserRuntime", "User", -1)
+					assertEq(t, opt.name+" "+input, expected, err.Error())
+					continue
+				}
+				if !reflect.DeepEqual(gentest.User(decodedRuntime), decoded) {
+					t.Fatalf("%s %s: expected %+v but got %+v", opt.name, input, decodedRuntime, decoded)
+				}
+
+				var streamed gentest.User
+				dec := json.NewDecoder(strings.NewReader(input))
+				assertErr(t, dec.DecodeWithOption(&streamed, opt.opts...))
+				if !reflect.DeepEqual(decoded, streamed) {
+					t.Fatalf("%s %s: expected %+v but got %+v", opt.name, input, decoded, streamed)
+				}
+			}
+		}
+	})
+}
+
+func TestGeneratedCodeRegisteredEncoder(t *testing.T) {
+	json.RegisterEncoder(reflect.TypeOf(gentest.Level(0)), func(b []byte, v interface{}) ([]byte, error) {
+		return append(b, `"L`+strconv.Itoa(int(v.(gentest.Level)))+`"`...), nil
+	})
+	got, err := json.Marshal(gentest.Event{Name: "x", Level: 2})
+	assertErr(t, err)
+	assertEq(t, "event", `{"name":"x","level":"L2"}`, string(got))
+}
+
+func BenchmarkGeneratedCode(b *testing.B) {
+	zip := 12345
+	user := gentest.User{
+		ID:      1,
+		Name:    "Alice",
+		Email:   "alice@example.com",
+		Score:   98.5,
+		Ratio:   0.25,
+		Active:  true,
+		Age:     30,
+		Tags:    []string{"admin", "staff", "remote"},
+		Home:    gentest.Address{Street: "Main St", Zip: &zip},
+		Work:    &gentest.Address{Street: "2nd St"},
+		Others:  []*gentest.Address{{Street: "3rd St"}, {Street: "4th St", Zip: &zip}},
+		Created: time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC),
+		Label:   "user",
+	}
+	data, err := json.Marshal(user)
+	if err != nil {
+		b.Fatal(err)
+	}
+	values := []struct {
+		name  string
+		value interface{}
+		new   func() interface{}
+	}{
+		{"generated", user, func() interface{} { return &gentest.User{} }},
+		{"runtime", gentest.UserRuntime(user), func() interface{} { return &gentest.UserRuntime{} }},
+	}
+	for _, v := range values {
+		v := v
+		b.Run("marshal/"+v.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := json.Marshal(v.value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+	for _, v := range values {
+		v := v
+		b.Run("unmarshal/"+v.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := json.Unmarshal(data, v.new()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+	for _, v := range values {
+		v := v
+		b.Run("stream/"+v.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := json.NewDecoder(bytes.NewReader(data)).Decode(v.new()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
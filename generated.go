@@ -0,0 +1,578 @@
+package json
+
+import (
+	"fmt"
+	"strconv"
+	"unsafe"
+)
+
+// The types of this file are used by the code generated by cmd/generator.
+// They encode and decode values exactly like Marshal and Unmarshal do with the active options,
+// so the generated code stays compatible with the runtime path.
+
+// GeneratedEncoderFunc appends the JSON encoding of the value pointed to by v to b like EncoderFunc.
+// v holds a pointer to a value of the type the function is registered for, which is the value being encoded
+// and must not be modified or retained.
+// It is generated by cmd/generator and registered with RegisterGeneratedEncoder.
+type GeneratedEncoderFunc func(e EncodeContext, b []byte, v interface{}) ([]byte, error)
+
+// EncodeContext holds the options and the context of the encoding calling a generated encoder.
+type EncodeContext struct {
+	ctx *encodeRuntimeContext
+	opt EncodeOption
+}
+
+// EscapeHTML reports whether the HTML characters in strings are escaped.
+func (e EncodeContext) EscapeHTML() bool {
+	return e.opt&EncodeOptionHTMLEscape != 0
+}
+
+// AppendString appends the JSON string of s to b, escaped like the runtime path does.
+func (e EncodeContext) AppendString(b []byte, s string) []byte {
+	if e.EscapeHTML() {
+		return encodeEscapedString(b, s)
+	}
+	return encodeNoEscapedStringWithOption(b, s, e.opt)
+}
+
+// AppendQuotedString appends the JSON string of the JSON string of s to b like the string option of a field does.
+func (e EncodeContext) AppendQuotedString(b []byte, s string) []byte {
+	var buf [64]byte
+	quoted := e.AppendString(buf[:0], s)
+	return e.AppendString(b, *(*string)(unsafe.Pointer(&quoted)))
+}
+
+// AppendFloat32 appends the JSON number of v to b.
+// It returns an UnsupportedValueError for NaN and ±Inf unless an option encodes them.
+func (e EncodeContext) AppendFloat32(b []byte, v float32) ([]byte, error) {
+	if encodeIsUnsupportedFloat(float64(v), e.opt) {
+		return nil, errUnsupportedFloat(float64(v))
+	}
+	return encodeFloat32WithOption(b, v, e.opt), nil
+}
+
+// AppendFloat64 appends the JSON number of v to b like AppendFloat32.
+func (e EncodeContext) AppendFloat64(b []byte, v float64) ([]byte, error) {
+	if encodeIsUnsupportedFloat(v, e.opt) {
+		return nil, errUnsupportedFloat(v)
+	}
+	return encodeFloat64WithOption(b, v, e.opt), nil
+}
+
+// AppendQuotedFloat32 appends v to b like AppendFloat32 but quoted like the string option of a field does.
+func (e EncodeContext) AppendQuotedFloat32(b []byte, v float32) ([]byte, error) {
+	if encodeIsUnsupportedFloat(float64(v), e.opt) {
+		return nil, errUnsupportedFloat(float64(v))
+	}
+	return encodeFloat32StringWithOption(b, v, e.opt), nil
+}
+
+// AppendQuotedFloat64 appends v to b like AppendFloat64 but quoted like the string option of a field does.
+func (e EncodeContext) AppendQuotedFloat64(b []byte, v float64) ([]byte, error) {
+	if encodeIsUnsupportedFloat(v, e.opt) {
+		return nil, errUnsupportedFloat(v)
+	}
+	return encodeFloat64StringWithOption(b, v, e.opt), nil
+}
+
+// AppendNilSlice appends the encoding of a nil slice to b, which is [] with NilAsEmpty and null otherwise.
+func (e EncodeContext) AppendNilSlice(b []byte) []byte {
+	if e.opt&EncodeOptionNilAsEmpty != 0 {
+		return append(b, '[', ']')
+	}
+	return encodeNull(b)
+}
+
+// AppendValue appends the JSON encoding of v to b by the runtime path with the options and the context of e.
+func (e EncodeContext) AppendValue(b []byte, v interface{}) ([]byte, error) {
+	if v == nil {
+		return encodeNull(b), nil
+	}
+	ctx := takeEncodeRuntimeContext()
+	if e.ctx != nil && e.ctx.context != nil {
+		ctx.setContext(e.ctx.context)
+	}
+	header := (*interfaceHeader)(unsafe.Pointer(&v))
+	codeSet, err := ctx.compileToGetCodeSet(uintptr(unsafe.Pointer(header.typ)), e.opt, nil)
+	if err != nil {
+		releaseEncodeRuntimeContext(ctx)
+		return nil, err
+	}
+	// v is encoded right after b, which saves copying it out of the buffer of ctx
+	ctx.init(uintptr(header.ptr), codeSet.codeLength)
+	b, err = encodeRunCode(ctx, b, codeSet, e.opt)
+	releaseEncodeRuntimeContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// drop the trailing comma
+	return b[:len(b)-1], nil
+}
+
+// GeneratedDecoderFunc decodes the JSON value read by d into v,
+// which holds a pointer to a value of the type the function is registered for.
+// d must not be retained after the function returns.
+// It is generated by cmd/generator and registered with RegisterGeneratedDecoder.
+type GeneratedDecoderFunc func(d *DecodeContext, v interface{}) error
+
+// DecodeContext reads a JSON object for a generated decoder.
+// Field advances to the next member of the object matching a struct field,
+// whose value is then decoded into the field by Decode or by the method of its kind:
+//
+//	for field := d.Field(); field >= 0; field = d.Field() {
+//		switch field {
+//		case 0:
+//			d.Decode(&v.A)
+//		case 1:
+//			d.DecodeInt64(&v.B)
+//		}
+//	}
+//	return d.Err()
+type DecodeContext struct {
+	buf     []byte
+	cursor  int64
+	depth   int64
+	opt     *DecodeOption
+	dec     *structDecoder
+	started bool
+	done    bool
+	track   bool
+	keys    int
+	found   fieldMask
+	missing *MissingFieldsError
+	field   int // index in dec.fields of the member whose value is at the cursor, or -1
+	err     error
+}
+
+// Field returns the position of the struct field of the next member of the object
+// among the decodable fields of the struct in declaration order.
+// The members of unknown keys are skipped.
+// It returns -1 at the end of the object, for null and on error.
+func (d *DecodeContext) Field() int {
+	if d.err != nil || d.done {
+		return -1
+	}
+	if d.field >= 0 {
+		// the value of the previous field wasn't decoded
+		field := d.dec.fields[d.field]
+		d.field = -1
+		if d.cursor, d.err = skipValue(d.buf, d.cursor, d.depth, d.opt); d.err != nil {
+			d.err = annotateErrorPath(d.err, field.key)
+			return -1
+		}
+	}
+	if !d.started {
+		d.started = true
+		if !d.beginObject() {
+			return -1
+		}
+	} else if !d.nextMember() {
+		return -1
+	}
+	for {
+		field, err := d.objectKey()
+		if err != nil {
+			d.err = err
+			return -1
+		}
+		if field != nil {
+			d.field = field.fieldIdx
+			return d.field
+		}
+		if !d.nextMember() {
+			return -1
+		}
+	}
+}
+
+// Decode decodes the value of the member found by Field into v, which holds a pointer to the struct field.
+func (d *DecodeContext) Decode(v interface{}) {
+	d.decode((*interfaceHeader)(unsafe.Pointer(&v)).ptr)
+}
+
+func (d *DecodeContext) decode(p unsafe.Pointer) {
+	if d.err != nil || d.field < 0 {
+		return
+	}
+	field := d.dec.fields[d.field]
+	d.field = -1
+	c, err := field.dec.decode(d.buf, d.cursor, d.depth, d.opt, p)
+	if err != nil {
+		if d.missing, err = collectMissingFields(d.missing, err, field.key); err != nil {
+			d.err = annotateErrorPath(err, field.key)
+			return
+		}
+	}
+	d.cursor = c
+}
+
+// The DecodeBool, DecodeString, DecodeIntN, DecodeUintN and DecodeFloatN methods decode the value
+// of the member found by Field into the field p like Decode. The plain literals, numbers and strings
+// without escapes are decoded inline, the other values and the fields of types with a registered decoder,
+// an unmarshaler or the string option by the decoder of the field.
+
+// DecodeBool decodes the value of the member found by Field into the bool field p.
+func (d *DecodeContext) DecodeBool(p *bool) {
+	if dec := d.inlineDecoder(); dec != nil {
+		if _, ok := dec.(*boolDecoder); ok {
+			buf := d.buf
+			cursor := skipWhiteSpace(buf, d.cursor)
+			switch {
+			case buf[cursor] == 't' && buf[cursor+1] == 'r' && buf[cursor+2] == 'u' && buf[cursor+3] == 'e':
+				*p = true
+				d.inlined(cursor + 4)
+				return
+			case buf[cursor] == 'f' && buf[cursor+1] == 'a' && buf[cursor+2] == 'l' && buf[cursor+3] == 's' && buf[cursor+4] == 'e':
+				*p = false
+				d.inlined(cursor + 5)
+				return
+			}
+		}
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeString decodes the value of the member found by Field into the string field p.
+func (d *DecodeContext) DecodeString(p *string) {
+	if dec := d.inlineDecoder(); dec != nil {
+		if _, ok := dec.(*stringDecoder); ok {
+			buf := d.buf
+			cursor := skipWhiteSpace(buf, d.cursor)
+			if buf[cursor] == '"' {
+				start := cursor + 1
+				end := start
+				for buf[end] != '"' && buf[end] != '\\' && buf[end] != nul {
+					end++
+				}
+				if buf[end] == '"' && d.opt.checkStringLength(int(end-start), start-1) == nil {
+					literal := buf[start:end]
+					*p = *(*string)(unsafe.Pointer(&literal))
+					d.inlined(end + 1)
+					return
+				}
+			}
+		}
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeInt decodes the value of the member found by Field into the int field p.
+func (d *DecodeContext) DecodeInt(p *int) {
+	if v, ok := d.inlineInt(64); ok {
+		*p = int(v)
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeInt8 decodes the value of the member found by Field into the int8 field p.
+func (d *DecodeContext) DecodeInt8(p *int8) {
+	if v, ok := d.inlineInt(8); ok {
+		*p = int8(v)
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeInt16 decodes the value of the member found by Field into the int16 field p.
+func (d *DecodeContext) DecodeInt16(p *int16) {
+	if v, ok := d.inlineInt(16); ok {
+		*p = int16(v)
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeInt32 decodes the value of the member found by Field into the int32 field p.
+func (d *DecodeContext) DecodeInt32(p *int32) {
+	if v, ok := d.inlineInt(32); ok {
+		*p = int32(v)
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeInt64 decodes the value of the member found by Field into the int64 field p.
+func (d *DecodeContext) DecodeInt64(p *int64) {
+	if v, ok := d.inlineInt(64); ok {
+		*p = v
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeUint decodes the value of the member found by Field into the uint field p.
+func (d *DecodeContext) DecodeUint(p *uint) {
+	if v, ok := d.inlineUint(64); ok {
+		*p = uint(v)
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeUint8 decodes the value of the member found by Field into the uint8 field p.
+func (d *DecodeContext) DecodeUint8(p *uint8) {
+	if v, ok := d.inlineUint(8); ok {
+		*p = uint8(v)
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeUint16 decodes the value of the member found by Field into the uint16 field p.
+func (d *DecodeContext) DecodeUint16(p *uint16) {
+	if v, ok := d.inlineUint(16); ok {
+		*p = uint16(v)
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeUint32 decodes the value of the member found by Field into the uint32 field p.
+func (d *DecodeContext) DecodeUint32(p *uint32) {
+	if v, ok := d.inlineUint(32); ok {
+		*p = uint32(v)
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeUint64 decodes the value of the member found by Field into the uint64 field p.
+func (d *DecodeContext) DecodeUint64(p *uint64) {
+	if v, ok := d.inlineUint(64); ok {
+		*p = v
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeFloat32 decodes the value of the member found by Field into the float32 field p.
+func (d *DecodeContext) DecodeFloat32(p *float32) {
+	if v, ok := d.inlineFloat(); ok {
+		*p = float32(v)
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// DecodeFloat64 decodes the value of the member found by Field into the float64 field p.
+func (d *DecodeContext) DecodeFloat64(p *float64) {
+	if v, ok := d.inlineFloat(); ok {
+		*p = v
+		return
+	}
+	d.decode(unsafe.Pointer(p))
+}
+
+// inlineDecoder returns the decoder of the member found by Field whose value may be decoded inline, or nil.
+func (d *DecodeContext) inlineDecoder() decoder {
+	if d.err != nil || d.field < 0 {
+		return nil
+	}
+	return d.dec.fields[d.field].dec
+}
+
+// inlined moves the cursor after the value decoded inline, which ends at cursor.
+func (d *DecodeContext) inlined(cursor int64) {
+	d.field = -1
+	d.cursor = cursor
+}
+
+// inlineInt returns the integer of at most 18 digits at the cursor if intDecoder accepts it for bits,
+// and reports whether it was decoded inline.
+func (d *DecodeContext) inlineInt(bits uint) (int64, bool) {
+	if _, ok := d.inlineDecoder().(*intDecoder); !ok {
+		return 0, false
+	}
+	buf := d.buf
+	cursor := skipWhiteSpace(buf, d.cursor)
+	negative := buf[cursor] == '-'
+	if negative {
+		cursor++
+	}
+	start := cursor
+	var v int64
+	for numTable[buf[cursor]] {
+		v = v*10 + int64(buf[cursor]-'0')
+		cursor++
+	}
+	if n := cursor - start; n == 0 || n > 18 {
+		return 0, false
+	}
+	if negative {
+		v = -v
+	}
+	// like intDecoder, the minimum values of the smaller integers are rejected
+	if bits < 64 && (v <= -(1<<(bits-1)) || 1<<(bits-1) <= v) {
+		return 0, false
+	}
+	d.inlined(cursor)
+	return v, true
+}
+
+// inlineUint returns the integer of at most 18 digits at the cursor if uintDecoder accepts it for bits,
+// and reports whether it was decoded inline.
+func (d *DecodeContext) inlineUint(bits uint) (uint64, bool) {
+	if _, ok := d.inlineDecoder().(*uintDecoder); !ok {
+		return 0, false
+	}
+	buf := d.buf
+	start := skipWhiteSpace(buf, d.cursor)
+	cursor := start
+	var v uint64
+	for numTable[buf[cursor]] {
+		v = v*10 + uint64(buf[cursor]-'0')
+		cursor++
+	}
+	if n := cursor - start; n == 0 || n > 18 {
+		return 0, false
+	}
+	if bits < 64 && 1<<bits <= v {
+		return 0, false
+	}
+	d.inlined(cursor)
+	return v, true
+}
+
+// inlineFloat returns the number at the cursor if floatDecoder accepts it and reports whether it was decoded inline.
+func (d *DecodeContext) inlineFloat() (float64, bool) {
+	if _, ok := d.inlineDecoder().(*floatDecoder); !ok {
+		return 0, false
+	}
+	buf := d.buf
+	start := skipWhiteSpace(buf, d.cursor)
+	if buf[start] != '-' && !numTable[buf[start]] {
+		return 0, false
+	}
+	cursor := start + 1
+	for floatTable[buf[cursor]] {
+		cursor++
+	}
+	if !validEndNumberChar[buf[cursor]] {
+		return 0, false
+	}
+	number := buf[start:cursor]
+	v, err := strconv.ParseFloat(*(*string)(unsafe.Pointer(&number)), 64)
+	if err != nil {
+		return 0, false
+	}
+	d.inlined(cursor)
+	return v, true
+}
+
+// Err returns the error which ended the object.
+func (d *DecodeContext) Err() error {
+	return d.err
+}
+
+// beginObject reads null or the start of the object and reports whether a member follows.
+func (d *DecodeContext) beginObject() bool {
+	buf := d.buf
+	buflen := int64(len(buf))
+	cursor := skipWhiteSpace(buf, d.cursor)
+	switch buf[cursor] {
+	case 'n':
+		if cursor+3 >= buflen {
+			d.err = errUnexpectedEndOfJSON("null", cursor)
+			return false
+		}
+		if buf[cursor+1] != 'u' {
+			d.err = errInvalidCharacter(buf[cursor+1], "null", cursor)
+			return false
+		}
+		if buf[cursor+2] != 'l' {
+			d.err = errInvalidCharacter(buf[cursor+2], "null", cursor)
+			return false
+		}
+		if buf[cursor+3] != 'l' {
+			d.err = errInvalidCharacter(buf[cursor+3], "null", cursor)
+			return false
+		}
+		d.cursor = cursor + 4
+		d.done = true
+		return false
+	case '{':
+	default:
+		d.err = errNotAtBeginningOfValue(cursor)
+		return false
+	}
+	if buflen < 2 {
+		d.err = errUnexpectedEndOfJSON("object", cursor)
+		return false
+	}
+	d.depth++
+	if d.err = d.opt.checkDepth(d.depth, cursor); d.err != nil {
+		return false
+	}
+	d.track = d.dec.tracksRequiredFields(d.opt)
+	cursor = skipWhiteSpace(buf, cursor+1)
+	if buf[cursor] == '}' {
+		d.cursor = cursor + 1
+		d.done = true
+		if d.track {
+			d.err = d.dec.missingFields(&d.found, d.opt, nil)
+		}
+		return false
+	}
+	d.cursor = cursor
+	return true
+}
+
+// objectKey reads the key of the next member and returns its field.
+// The value of an unknown key is skipped.
+func (d *DecodeContext) objectKey() (*structFieldSet, error) {
+	buf := d.buf
+	d.keys++
+	if err := d.opt.checkObjectKeys(d.keys, d.cursor); err != nil {
+		return nil, err
+	}
+	keyStart := skipWhiteSpace(buf, d.cursor)
+	c, field, err := d.dec.keyDecoder(d.dec, buf, d.cursor)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.opt.checkStringLength(int(c-keyStart)-2, keyStart); err != nil {
+		return nil, err
+	}
+	cursor := skipWhiteSpace(buf, c)
+	if buf[cursor] != ':' {
+		return nil, errExpected("colon after object key", cursor)
+	}
+	cursor++
+	if cursor >= int64(len(buf)) {
+		return nil, errExpected("object value after colon", cursor)
+	}
+	d.cursor = cursor
+	if field != nil {
+		if d.track {
+			d.found.set(field.fieldIdx)
+		}
+		return field, nil
+	}
+	if (d.opt.Flags & DecodeOptionDisallowUnknownFields) != 0 {
+		return nil, fmt.Errorf("json: unknown field %q", buf[keyStart+1:c-1])
+	}
+	if d.cursor, err = skipValue(buf, cursor, d.depth, d.opt); err != nil {
+		return nil, d.dec.skippedValueError(err, buf[keyStart:c])
+	}
+	return nil, nil
+}
+
+// nextMember reads the separator after a member and reports whether another member follows.
+func (d *DecodeContext) nextMember() bool {
+	cursor := skipWhiteSpace(d.buf, d.cursor)
+	switch d.buf[cursor] {
+	case '}':
+		d.cursor = cursor + 1
+		d.done = true
+		if d.track || d.missing != nil {
+			d.err = d.dec.missingFields(&d.found, d.opt, d.missing)
+		}
+		return false
+	case ',':
+		d.cursor = cursor + 1
+		return true
+	}
+	d.err = errExpected("comma after object element", cursor)
+	return false
+}
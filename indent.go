@@ -3,6 +3,9 @@ package json
 import "bytes"
 
 func encodeWithIndent(dst *bytes.Buffer, src []byte, prefix, indentStr string) error {
+	if err := validate(src); err != nil {
+		return err
+	}
 	length := int64(len(src))
 	indentNum := 0
 	indentBytes := []byte(indentStr)
@@ -0,0 +1,453 @@
+// Package gen generates the encoders and decoders of cmd/generator.
+// It works on the descriptions of the types printed by the temporary program of the command,
+// so the code generation isn't linked into the programs using github.com/goccy/go-json.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goccy/go-json"
+)
+
+// Type describes a type for the generator as reflect finds it.
+type Type struct {
+	Name        string       // name of the type if it's a named type of the generated package
+	String      string       // reflect.Type.String of the type
+	Kind        reflect.Kind // kind of the type
+	Elem        *Type        // element of a pointer or a slice, nil if it isn't described
+	Fields      []*Field     // fields of the struct types code is generated for
+	MarshalJSON bool         // the type or its pointer implements json.Marshaler or json.MarshalerContext
+	MarshalText bool         // the type or its pointer implements encoding.TextMarshaler
+	Unmarshaler bool         // the pointer implements json.Unmarshaler, json.UnmarshalerContext or encoding.TextUnmarshaler
+	Zeroer      bool         // the type has an IsZero() bool method
+	PtrZeroer   bool         // the pointer has an IsZero() bool method
+}
+
+// Field describes a struct field.
+type Field struct {
+	Name      string
+	Tag       string // json tag of the field
+	Exported  bool
+	Anonymous bool
+	Type      *Type
+}
+
+func (t *Type) marshaler() bool {
+	return t.MarshalJSON || t.MarshalText
+}
+
+func (t *Type) isBytes() bool {
+	return t.Kind == reflect.Slice && t.Elem != nil && t.Elem.Kind == reflect.Uint8 && !t.Elem.marshaler()
+}
+
+// Generate writes to w the Go source of package pkgName with encoders and decoders
+// specialized for types, which must be named struct types declared in that package.
+// The source registers them with json.RegisterGeneratedEncoder and json.RegisterGeneratedDecoder
+// from an init function, so Marshal, Unmarshal, Encoder and Decoder pick them up.
+//
+// The encoders write the same bytes as the runtime path with the options passed in their EncodeContext.
+// Booleans, integers, floats, strings, the other generated types and pointers and slices of them
+// are encoded by the generated code, the values of the rest of the fields by EncodeContext.AppendValue.
+// The decoders decode the objects in place and pass the address of each field to DecodeContext.Decode,
+// or to the DecodeContext method of its kind for booleans, integers, floats and strings.
+//
+// Embedded struct fields, fields with the unknown or inline option and types implementing
+// a marshaler or an unmarshaler are not supported.
+func Generate(w io.Writer, pkgName string, types []*Type) error {
+	g := &generator{
+		types:   map[string]bool{},
+		imports: map[string]bool{"reflect": true},
+	}
+	for _, typ := range types {
+		if typ.Kind != reflect.Struct || typ.Name == "" {
+			return fmt.Errorf("json: cannot generate code for %s: not a named struct type", typ.String)
+		}
+		if typ.marshaler() || typ.Unmarshaler {
+			return fmt.Errorf("json: cannot generate code for %s: it implements a marshaler or an unmarshaler", typ.String)
+		}
+		g.types[typ.Name] = true
+	}
+	for _, typ := range types {
+		fields, err := g.structFields(typ)
+		if err != nil {
+			return err
+		}
+		if err := g.generateEncoder(typ, fields); err != nil {
+			return err
+		}
+		g.generateDecoder(typ, fields)
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by github.com/goccy/go-json/cmd/generator. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\nimport (\n", pkgName)
+	for _, path := range []string{"reflect", "strconv"} {
+		if g.imports[path] {
+			fmt.Fprintf(&src, "\t%q\n", path)
+		}
+	}
+	src.WriteString("\n\tjson \"github.com/goccy/go-json\"\n)\n\nfunc init() {\n")
+	for _, typ := range types {
+		fmt.Fprintf(&src, "\tjson.RegisterGeneratedEncoder(reflect.TypeOf((*%[1]s)(nil)).Elem(), func(e json.EncodeContext, b []byte, v interface{}) ([]byte, error) {\n", typ.Name)
+		fmt.Fprintf(&src, "\t\treturn %s(e, b, v.(*%s))\n\t})\n", funcName("jsonEncode", typ), typ.Name)
+		fmt.Fprintf(&src, "\tjson.RegisterGeneratedDecoder(reflect.TypeOf((*%[1]s)(nil)).Elem(), func(d *json.DecodeContext, v interface{}) error {\n", typ.Name)
+		fmt.Fprintf(&src, "\t\treturn %s(d, v.(*%s))\n\t})\n", funcName("jsonDecode", typ), typ.Name)
+	}
+	src.WriteString("}\n")
+	src.Write(g.buf.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(formatted)
+	return err
+}
+
+type generator struct {
+	types   map[string]bool // the names of the types code is generated for
+	imports map[string]bool
+	buf     bytes.Buffer
+}
+
+// generatedField is a struct field with the options of its tag.
+type generatedField struct {
+	*Field
+	key       []byte // escaped key with the colon
+	rawKey    []byte // key with the colon written without HTML escaping
+	omitEmpty bool
+	omitZero  bool
+	nonil     bool
+	stringTag bool
+}
+
+func funcName(prefix string, typ *Type) string {
+	r, size := utf8.DecodeRuneInString(typ.Name)
+	return prefix + string(unicode.ToUpper(r)) + typ.Name[size:]
+}
+
+// isValidTag reports whether s can be the key of a tag like the runtime path does.
+func isValidTag(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:<=>?@[]^_{|}~ ", c):
+			// Backslash and quote chars are reserved, but
+			// otherwise any punctuation chars are allowed
+			// in a tag name.
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+	return true
+}
+
+// isIgnored reports whether field is neither encoded nor decoded.
+func isIgnored(field *Field) bool {
+	if !field.Exported {
+		if !field.Anonymous {
+			return true
+		}
+		// the fields of an unexported embedded struct are promoted
+		typ := field.Type
+		if typ.Kind == reflect.Ptr && typ.Elem != nil {
+			typ = typ.Elem
+		}
+		if typ.Kind != reflect.Struct {
+			return true
+		}
+	}
+	return field.Tag == "-"
+}
+
+// structFields returns the fields of typ in the order they are encoded,
+// which is also the order of the positions returned by DecodeContext.Field.
+func (g *generator) structFields(typ *Type) ([]*generatedField, error) {
+	var fields []*generatedField
+	for _, field := range typ.Fields {
+		if isIgnored(field) {
+			continue
+		}
+		if field.Anonymous {
+			return nil, fmt.Errorf("json: cannot generate code for %s: embedded field %s is not supported", typ.String, field.Name)
+		}
+		opts := strings.Split(field.Tag, ",")
+		key := field.Name
+		if isValidTag(opts[0]) {
+			key = opts[0]
+		}
+		f := &generatedField{Field: field}
+		for _, opt := range opts[1:] {
+			switch opt {
+			case "omitempty":
+				f.omitEmpty = true
+			case "omitzero":
+				f.omitZero = true
+			case "nonil":
+				f.nonil = true
+			case "string":
+				f.stringTag = true
+			case "inline":
+				return nil, fmt.Errorf("json: cannot generate code for %s: field %s with the inline option is not supported", typ.String, field.Name)
+			case "unknown":
+				return nil, fmt.Errorf("json: cannot generate code for %s: field %s with the unknown option is not supported", typ.String, field.Name)
+			}
+		}
+		escapedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		f.key = append(escapedKey, ':')
+		f.rawKey = []byte(`"` + key + `":`)
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+func (g *generator) generateEncoder(typ *Type, fields []*generatedField) error {
+	var body bytes.Buffer
+	for _, field := range fields {
+		expr := "v." + field.Name
+		cond, err := g.omitCond(expr, field)
+		if err != nil {
+			return fmt.Errorf("json: cannot generate code for field %s of %s: %s", field.Name, typ.String, err)
+		}
+		value, err := g.encodeValue(expr, field.Type, &encodeValueOption{
+			stringTag: field.stringTag,
+			nonil:     field.nonil,
+			notNil:    cond == expr+" != nil",
+		})
+		if err != nil {
+			return fmt.Errorf("json: cannot generate code for field %s of %s: %s", field.Name, typ.String, err)
+		}
+		if cond != "" {
+			fmt.Fprintf(&body, "if %s {\n", cond)
+		}
+		if bytes.Equal(field.key, field.rawKey) {
+			fmt.Fprintf(&body, "b = append(b, %s...)\n", strconv.Quote(string(field.key)))
+		} else {
+			fmt.Fprintf(&body, "if e.EscapeHTML() {\nb = append(b, %s...)\n} else {\nb = append(b, %s...)\n}\n",
+				strconv.Quote(string(field.key)), strconv.Quote(string(field.rawKey)))
+		}
+		fmt.Fprintf(&body, "%sb = append(b, ',')\n", value)
+		if cond != "" {
+			body.WriteString("}\n")
+		}
+	}
+
+	fmt.Fprintf(&g.buf, "\nfunc %s(e json.EncodeContext, b []byte, v *%s) ([]byte, error) {\n", funcName("jsonEncode", typ), typ.Name)
+	if strings.Contains(body.String(), "err != nil") {
+		g.buf.WriteString("var err error\n")
+	}
+	g.buf.WriteString("b = append(b, '{')\n")
+	g.buf.Write(body.Bytes())
+	g.buf.WriteString("if last := len(b) - 1; b[last] == ',' {\nb[last] = '}'\n} else {\nb = append(b, '}')\n}\nreturn b, nil\n}\n")
+	return nil
+}
+
+func (g *generator) generateDecoder(typ *Type, fields []*generatedField) {
+	fmt.Fprintf(&g.buf, "\nfunc %s(d *json.DecodeContext, v *%s) error {\n", funcName("jsonDecode", typ), typ.Name)
+	if len(fields) != 0 {
+		g.buf.WriteString("for field := d.Field(); field >= 0; field = d.Field() {\nswitch field {\n")
+		for i, field := range fields {
+			fmt.Fprintf(&g.buf, "case %d:\n%s", i, decodeValue("v."+field.Name, field))
+		}
+		g.buf.WriteString("}\n}\n")
+	} else {
+		g.buf.WriteString("for d.Field() >= 0 {\n}\n")
+	}
+	g.buf.WriteString("return d.Err()\n}\n")
+}
+
+// decodeValue returns the statement decoding the value of field into expr.
+// The scalar values are decoded by the DecodeContext method of their kind, the others by Decode.
+func decodeValue(expr string, field *generatedField) string {
+	typ := field.Type
+	if !field.stringTag && !typ.Unmarshaler {
+		switch typ.Kind {
+		case reflect.Bool, reflect.String,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			kind := typ.Kind.String()
+			ptr := "&" + expr
+			if typ.String != kind {
+				// the pointer to a named type is converted to the pointer to its underlying type
+				ptr = fmt.Sprintf("(*%s)(%s)", kind, ptr)
+			}
+			return fmt.Sprintf("d.Decode%s(%s)\n", strings.ToUpper(kind[:1])+kind[1:], ptr)
+		}
+	}
+	return fmt.Sprintf("d.Decode(&%s)\n", expr)
+}
+
+// omitCond returns the condition on which the field of expr is encoded, mirroring the omitempty
+// operations of the runtime path. It is empty if the field is always encoded.
+func (g *generator) omitCond(expr string, field *generatedField) (string, error) {
+	typ := field.Type
+	if field.omitZero {
+		switch {
+		case typ.Zeroer && typ.Kind == reflect.Ptr:
+			return fmt.Sprintf("%[1]s != nil && !%[1]s.IsZero()", expr), nil
+		case typ.Zeroer:
+			return fmt.Sprintf("!%s.IsZero()", expr), nil
+		case typ.PtrZeroer:
+			return fmt.Sprintf("!(&%s).IsZero()", expr), nil
+		}
+		switch typ.Kind {
+		case reflect.Slice, reflect.Map:
+			return expr + " != nil", nil
+		case reflect.Struct, reflect.Array:
+			return "", fmt.Errorf("omitzero is not supported for %s", typ.String)
+		}
+		// the zero values are the empty values
+	} else if !field.omitEmpty {
+		return "", nil
+	}
+	switch {
+	case typ.Kind == reflect.Struct && !typ.marshaler():
+		// like encoding/json, structs are never empty
+		return "", nil
+	case typ.MarshalJSON:
+		if typ.Kind == reflect.Ptr {
+			return expr + " != nil", nil
+		}
+		return "", nil
+	case typ.MarshalText:
+		return "", nil
+	}
+	switch typ.Kind {
+	case reflect.Bool:
+		return expr, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return expr + " != 0", nil
+	case reflect.String:
+		return expr + ` != ""`, nil
+	case reflect.Ptr, reflect.Interface:
+		return expr + " != nil", nil
+	case reflect.Slice:
+		if typ.isBytes() {
+			return "len(" + expr + ") > 0", nil
+		}
+		return expr + " != nil", nil
+	case reflect.Map:
+		return "len(" + expr + ") != 0", nil
+	}
+	return "", fmt.Errorf("omitempty is not supported for %s", typ.String)
+}
+
+// encodeValueOption is the setting of a value encoded by the generated code.
+type encodeValueOption struct {
+	stringTag bool // the value is quoted by the string option
+	nonil     bool // nil slices and maps are encoded as empty by the nonil option
+	notNil    bool // the pointer or slice is known not to be nil
+}
+
+// addr returns the expression of the address of expr.
+func addr(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return expr[1:]
+	}
+	return "&" + expr
+}
+
+// encodeValue returns the statements appending the encoding of expr of typ to b.
+func (g *generator) encodeValue(expr string, typ *Type, opt *encodeValueOption) (string, error) {
+	if typ.Kind == reflect.Struct && g.types[typ.Name] {
+		return fmt.Sprintf("if b, err = %s(e, b, %s); err != nil {\nreturn nil, err\n}\n", funcName("jsonEncode", typ), addr(expr)), nil
+	}
+	stringTag := opt.stringTag
+	quote := func(s string) string {
+		if !stringTag {
+			return s
+		}
+		return "b = append(b, '\"')\n" + s + "b = append(b, '\"')\n"
+	}
+	if !typ.marshaler() {
+		switch typ.Kind {
+		case reflect.Bool:
+			g.imports["strconv"] = true
+			return quote(fmt.Sprintf("b = strconv.AppendBool(b, bool(%s))\n", expr)), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			g.imports["strconv"] = true
+			return quote(fmt.Sprintf("b = strconv.AppendInt(b, int64(%s), 10)\n", expr)), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			g.imports["strconv"] = true
+			return quote(fmt.Sprintf("b = strconv.AppendUint(b, uint64(%s), 10)\n", expr)), nil
+		case reflect.Float32, reflect.Float64:
+			kind := typ.Kind.String()
+			name := "F" + kind[1:]
+			if stringTag {
+				// the null or string of a non-finite float isn't quoted again
+				name = "Quoted" + name
+			}
+			return fmt.Sprintf("if b, err = e.Append%s(b, %s(%s)); err != nil {\nreturn nil, err\n}\n", name, kind, expr), nil
+		case reflect.String:
+			if stringTag {
+				return fmt.Sprintf("b = e.AppendQuotedString(b, string(%s))\n", expr), nil
+			}
+			return fmt.Sprintf("b = e.AppendString(b, string(%s))\n", expr), nil
+		case reflect.Ptr:
+			if stringTag || typ.Elem == nil {
+				break
+			}
+			elem, err := g.encodeValue("*"+expr, typ.Elem, &encodeValueOption{})
+			if err != nil {
+				return "", err
+			}
+			if strings.Contains(elem, "e.AppendValue") {
+				break
+			}
+			if opt.notNil {
+				return elem, nil
+			}
+			return fmt.Sprintf("if %s == nil {\nb = append(b, \"null\"...)\n} else {\n%s}\n", expr, elem), nil
+		case reflect.Slice:
+			if typ.isBytes() || typ.Elem == nil {
+				break
+			}
+			elem, err := g.encodeValue(expr+"[i]", typ.Elem, &encodeValueOption{})
+			if err != nil {
+				return "", err
+			}
+			if !strings.Contains(elem, "e.AppendValue") {
+				empty := "b = e.AppendNilSlice(b)\n"
+				if opt.nonil {
+					empty = "b = append(b, \"[]\"...)\n"
+				}
+				loop := fmt.Sprintf("b = append(b, '[')\nfor i := range %s {\nif i != 0 {\nb = append(b, ',')\n}\n%s}\nb = append(b, ']')\n", expr, elem)
+				if opt.notNil {
+					return loop, nil
+				}
+				return fmt.Sprintf("if %s == nil {\n%s} else {\n%s}\n", expr, empty, loop), nil
+			}
+		}
+	}
+	value := addr(expr)
+	if typ.Kind == reflect.Interface && !typ.marshaler() {
+		// the dynamic value is encoded like the runtime path does, and a nil interface as null
+		value = expr
+	}
+	value = fmt.Sprintf("if b, err = e.AppendValue(b, %s); err != nil {\nreturn nil, err\n}\n", value)
+	if opt.nonil && !typ.marshaler() {
+		switch {
+		case typ.Kind == reflect.Map:
+			return fmt.Sprintf("if %s == nil {\nb = append(b, \"{}\"...)\n} else {\n%s}\n", expr, value), nil
+		case typ.Kind == reflect.Slice && !typ.isBytes():
+			return fmt.Sprintf("if %s == nil {\nb = append(b, \"[]\"...)\n} else {\n%s}\n", expr, value), nil
+		}
+	}
+	return value, nil
+}
@@ -0,0 +1,81 @@
+package gen
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenerateUnsupported(t *testing.T) {
+	str := &Type{String: "string", Kind: reflect.String}
+	tests := []struct {
+		name string
+		typ  *Type
+		err  string
+	}{
+		{
+			name: "not a struct",
+			typ:  &Type{Name: "Number", String: "p.Number", Kind: reflect.Int},
+			err:  "not a named struct type",
+		},
+		{
+			name: "marshaler",
+			typ:  &Type{Name: "Time", String: "p.Time", Kind: reflect.Struct, MarshalJSON: true},
+			err:  "implements a marshaler or an unmarshaler",
+		},
+		{
+			name: "embedded field",
+			typ: &Type{Name: "Outer", String: "p.Outer", Kind: reflect.Struct, Fields: []*Field{
+				{Name: "Inner", Exported: true, Anonymous: true, Type: &Type{Name: "Inner", String: "p.Inner", Kind: reflect.Struct}},
+			}},
+			err: "embedded field Inner is not supported",
+		},
+		{
+			name: "inline field",
+			typ: &Type{Name: "Outer", String: "p.Outer", Kind: reflect.Struct, Fields: []*Field{
+				{Name: "Inner", Tag: ",inline", Exported: true, Type: &Type{Name: "Inner", String: "p.Inner", Kind: reflect.Struct}},
+			}},
+			err: "field Inner with the inline option is not supported",
+		},
+		{
+			name: "omitzero struct",
+			typ: &Type{Name: "Outer", String: "p.Outer", Kind: reflect.Struct, Fields: []*Field{
+				{Name: "Inner", Tag: ",omitzero", Exported: true, Type: &Type{Name: "Inner", String: "p.Inner", Kind: reflect.Struct}},
+				{Name: "S", Exported: true, Type: str},
+			}},
+			err: "omitzero is not supported for p.Inner",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Generate(&buf, "p", []*Type{test.typ})
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("expected error containing %q but got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestGenerateIgnoredFields(t *testing.T) {
+	typ := &Type{Name: "T", String: "p.T", Kind: reflect.Struct, Fields: []*Field{
+		{Name: "skip", Type: &Type{String: "int", Kind: reflect.Int}},
+		{Name: "Skip", Tag: "-", Exported: true, Type: &Type{String: "int", Kind: reflect.Int}},
+		{Name: "number", Anonymous: true, Type: &Type{Name: "number", String: "p.number", Kind: reflect.Int}},
+		{Name: "A", Tag: "a,omitempty", Exported: true, Type: &Type{String: "int", Kind: reflect.Int}},
+	}}
+	var buf bytes.Buffer
+	if err := Generate(&buf, "p", []*Type{typ}); err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	for _, s := range []string{`if v.A != 0 {`, `"\"a\":"`, "case 0:\n\t\t\td.DecodeInt(&v.A)"} {
+		if !strings.Contains(src, s) {
+			t.Fatalf("expected %q in\n%s", s, src)
+		}
+	}
+	if strings.Contains(src, "Skip") || strings.Contains(src, "number") || strings.Contains(src, "case 1:") {
+		t.Fatalf("unexpected ignored field in\n%s", src)
+	}
+}
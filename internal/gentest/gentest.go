@@ -0,0 +1,48 @@
+// Package gentest holds the types of the tests of the code generated by cmd/generator.
+package gentest
+
+import (
+	"time"
+)
+
+//go:generate go run ../../cmd/generator -type Address,User,Event
+
+type Address struct {
+	Street string `json:"street"`
+	Zip    *int   `json:"zip,omitempty"`
+}
+
+type User struct {
+	ID      int64             `json:"id"`
+	Name    string            `json:"name"`
+	Email   string            `json:"email,omitempty"`
+	Score   float64           `json:"score"`
+	Ratio   float32           `json:"ratio,string"`
+	Active  bool              `json:"active"`
+	Age     uint8             `json:"age,omitempty"`
+	Tags    []string          `json:"tags"`
+	Aliases []string          `json:"aliases,nonil"`
+	Home    Address           `json:"home"`
+	Work    *Address          `json:"work,omitempty"`
+	Others  []*Address        `json:"others"`
+	Attrs   map[string]string `json:"attrs,omitempty"`
+	Extra   interface{}       `json:"extra"`
+	Created time.Time         `json:"created"`
+	Note    *string           `json:"note"`
+	Raw     []byte            `json:"raw,omitempty"`
+	Label   string            `json:"label,string"`
+	Next    *User             `json:"next,omitempty"`
+	Skip    int               `json:"-"`
+	hidden  int
+}
+
+// UserRuntime has the fields of User without the generated code.
+type UserRuntime User
+
+// Level is encoded by the generated code of Event unless an encoder is registered for it.
+type Level int
+
+type Event struct {
+	Name  string `json:"name"`
+	Level Level  `json:"level"`
+}
@@ -0,0 +1,276 @@
+// Code generated by github.com/goccy/go-json/cmd/generator. DO NOT EDIT.
+
+package gentest
+
+import (
+	"reflect"
+	"strconv"
+
+	json "github.com/goccy/go-json"
+)
+
+func init() {
+	json.RegisterGeneratedEncoder(reflect.TypeOf((*Address)(nil)).Elem(), func(e json.EncodeContext, b []byte, v interface{}) ([]byte, error) {
+		return jsonEncodeAddress(e, b, v.(*Address))
+	})
+	json.RegisterGeneratedDecoder(reflect.TypeOf((*Address)(nil)).Elem(), func(d *json.DecodeContext, v interface{}) error {
+		return jsonDecodeAddress(d, v.(*Address))
+	})
+	json.RegisterGeneratedEncoder(reflect.TypeOf((*User)(nil)).Elem(), func(e json.EncodeContext, b []byte, v interface{}) ([]byte, error) {
+		return jsonEncodeUser(e, b, v.(*User))
+	})
+	json.RegisterGeneratedDecoder(reflect.TypeOf((*User)(nil)).Elem(), func(d *json.DecodeContext, v interface{}) error {
+		return jsonDecodeUser(d, v.(*User))
+	})
+	json.RegisterGeneratedEncoder(reflect.TypeOf((*Event)(nil)).Elem(), func(e json.EncodeContext, b []byte, v interface{}) ([]byte, error) {
+		return jsonEncodeEvent(e, b, v.(*Event))
+	})
+	json.RegisterGeneratedDecoder(reflect.TypeOf((*Event)(nil)).Elem(), func(d *json.DecodeContext, v interface{}) error {
+		return jsonDecodeEvent(d, v.(*Event))
+	})
+}
+
+func jsonEncodeAddress(e json.EncodeContext, b []byte, v *Address) ([]byte, error) {
+	b = append(b, '{')
+	b = append(b, "\"street\":"...)
+	b = e.AppendString(b, string(v.Street))
+	b = append(b, ',')
+	if v.Zip != nil {
+		b = append(b, "\"zip\":"...)
+		b = strconv.AppendInt(b, int64(*v.Zip), 10)
+		b = append(b, ',')
+	}
+	if last := len(b) - 1; b[last] == ',' {
+		b[last] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+func jsonDecodeAddress(d *json.DecodeContext, v *Address) error {
+	for field := d.Field(); field >= 0; field = d.Field() {
+		switch field {
+		case 0:
+			d.DecodeString(&v.Street)
+		case 1:
+			d.Decode(&v.Zip)
+		}
+	}
+	return d.Err()
+}
+
+func jsonEncodeUser(e json.EncodeContext, b []byte, v *User) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"id\":"...)
+	b = strconv.AppendInt(b, int64(v.ID), 10)
+	b = append(b, ',')
+	b = append(b, "\"name\":"...)
+	b = e.AppendString(b, string(v.Name))
+	b = append(b, ',')
+	if v.Email != "" {
+		b = append(b, "\"email\":"...)
+		b = e.AppendString(b, string(v.Email))
+		b = append(b, ',')
+	}
+	b = append(b, "\"score\":"...)
+	if b, err = e.AppendFloat64(b, float64(v.Score)); err != nil {
+		return nil, err
+	}
+	b = append(b, ',')
+	b = append(b, "\"ratio\":"...)
+	if b, err = e.AppendQuotedFloat32(b, float32(v.Ratio)); err != nil {
+		return nil, err
+	}
+	b = append(b, ',')
+	b = append(b, "\"active\":"...)
+	b = strconv.AppendBool(b, bool(v.Active))
+	b = append(b, ',')
+	if v.Age != 0 {
+		b = append(b, "\"age\":"...)
+		b = strconv.AppendUint(b, uint64(v.Age), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"tags\":"...)
+	if v.Tags == nil {
+		b = e.AppendNilSlice(b)
+	} else {
+		b = append(b, '[')
+		for i := range v.Tags {
+			if i != 0 {
+				b = append(b, ',')
+			}
+			b = e.AppendString(b, string(v.Tags[i]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, ',')
+	b = append(b, "\"aliases\":"...)
+	if v.Aliases == nil {
+		b = append(b, "[]"...)
+	} else {
+		b = append(b, '[')
+		for i := range v.Aliases {
+			if i != 0 {
+				b = append(b, ',')
+			}
+			b = e.AppendString(b, string(v.Aliases[i]))
+		}
+		b = append(b, ']')
+	}
+	b = append(b, ',')
+	b = append(b, "\"home\":"...)
+	if b, err = jsonEncodeAddress(e, b, &v.Home); err != nil {
+		return nil, err
+	}
+	b = append(b, ',')
+	if v.Work != nil {
+		b = append(b, "\"work\":"...)
+		if b, err = jsonEncodeAddress(e, b, v.Work); err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"others\":"...)
+	if v.Others == nil {
+		b = e.AppendNilSlice(b)
+	} else {
+		b = append(b, '[')
+		for i := range v.Others {
+			if i != 0 {
+				b = append(b, ',')
+			}
+			if v.Others[i] == nil {
+				b = append(b, "null"...)
+			} else {
+				if b, err = jsonEncodeAddress(e, b, v.Others[i]); err != nil {
+					return nil, err
+				}
+			}
+		}
+		b = append(b, ']')
+	}
+	b = append(b, ',')
+	if len(v.Attrs) != 0 {
+		b = append(b, "\"attrs\":"...)
+		if b, err = e.AppendValue(b, &v.Attrs); err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"extra\":"...)
+	if b, err = e.AppendValue(b, v.Extra); err != nil {
+		return nil, err
+	}
+	b = append(b, ',')
+	b = append(b, "\"created\":"...)
+	if b, err = e.AppendValue(b, &v.Created); err != nil {
+		return nil, err
+	}
+	b = append(b, ',')
+	b = append(b, "\"note\":"...)
+	if v.Note == nil {
+		b = append(b, "null"...)
+	} else {
+		b = e.AppendString(b, string(*v.Note))
+	}
+	b = append(b, ',')
+	if len(v.Raw) > 0 {
+		b = append(b, "\"raw\":"...)
+		if b, err = e.AppendValue(b, &v.Raw); err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"label\":"...)
+	b = e.AppendQuotedString(b, string(v.Label))
+	b = append(b, ',')
+	if v.Next != nil {
+		b = append(b, "\"next\":"...)
+		if b, err = jsonEncodeUser(e, b, v.Next); err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if last := len(b) - 1; b[last] == ',' {
+		b[last] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+func jsonDecodeUser(d *json.DecodeContext, v *User) error {
+	for field := d.Field(); field >= 0; field = d.Field() {
+		switch field {
+		case 0:
+			d.DecodeInt64(&v.ID)
+		case 1:
+			d.DecodeString(&v.Name)
+		case 2:
+			d.DecodeString(&v.Email)
+		case 3:
+			d.DecodeFloat64(&v.Score)
+		case 4:
+			d.Decode(&v.Ratio)
+		case 5:
+			d.DecodeBool(&v.Active)
+		case 6:
+			d.DecodeUint8(&v.Age)
+		case 7:
+			d.Decode(&v.Tags)
+		case 8:
+			d.Decode(&v.Aliases)
+		case 9:
+			d.Decode(&v.Home)
+		case 10:
+			d.Decode(&v.Work)
+		case 11:
+			d.Decode(&v.Others)
+		case 12:
+			d.Decode(&v.Attrs)
+		case 13:
+			d.Decode(&v.Extra)
+		case 14:
+			d.Decode(&v.Created)
+		case 15:
+			d.Decode(&v.Note)
+		case 16:
+			d.Decode(&v.Raw)
+		case 17:
+			d.Decode(&v.Label)
+		case 18:
+			d.Decode(&v.Next)
+		}
+	}
+	return d.Err()
+}
+
+func jsonEncodeEvent(e json.EncodeContext, b []byte, v *Event) ([]byte, error) {
+	b = append(b, '{')
+	b = append(b, "\"name\":"...)
+	b = e.AppendString(b, string(v.Name))
+	b = append(b, ',')
+	b = append(b, "\"level\":"...)
+	b = strconv.AppendInt(b, int64(v.Level), 10)
+	b = append(b, ',')
+	if last := len(b) - 1; b[last] == ',' {
+		b[last] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, nil
+}
+
+func jsonDecodeEvent(d *json.DecodeContext, v *Event) error {
+	for field := d.Field(); field >= 0; field = d.Field() {
+		switch field {
+		case 0:
+			d.DecodeString(&v.Name)
+		case 1:
+			d.DecodeInt((*int)(&v.Level))
+		}
+	}
+	return d.Err()
+}
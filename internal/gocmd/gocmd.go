@@ -0,0 +1,76 @@
+// Package gocmd runs the go command for the commands of this module.
+package gocmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Package is a package listed by the go command.
+type Package struct {
+	ImportPath string
+	Name       string
+	Dir        string
+}
+
+// LoadPackage lists the package of path.
+func LoadPackage(path string) (*Package, error) {
+	out, err := exec.Command("go", "list", "-f", "{{.ImportPath}}\n{{.Name}}\n{{.Dir}}", path).Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("failed to load package %s: %s", path, exitErr.Stderr)
+		}
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 3 {
+		return nil, fmt.Errorf("failed to load package %s", path)
+	}
+	return &Package{ImportPath: lines[0], Name: lines[1], Dir: lines[2]}, nil
+}
+
+// Run runs the main package src, which imports p, writing its output to stdout and stderr.
+// The program is built as if it were in a subdirectory of p, with the module of p and access to its
+// internal packages, but it is written to a temporary directory and passed to the go command by an overlay,
+// so nothing is created in the source tree. The files named by hidden, relative to the directory of p
+// unless absolute, are left out of the build.
+// The overlay requires Go 1.16 or later.
+func (p *Package) Run(src []byte, stdout, stderr io.Writer, hidden ...string) error {
+	tmpDir, err := ioutil.TempDir("", "gojson")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	mainFile := filepath.Join(tmpDir, "main.go")
+	if err := ioutil.WriteFile(mainFile, src, 0644); err != nil {
+		return err
+	}
+	mainPath := filepath.Join(p.Dir, filepath.Base(tmpDir), "main.go")
+	replace := map[string]string{mainPath: mainFile}
+	for _, name := range hidden {
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(p.Dir, name)
+		}
+		// an empty replacement deletes the file
+		replace[name] = ""
+	}
+	overlay, err := json.Marshal(struct{ Replace map[string]string }{Replace: replace})
+	if err != nil {
+		return err
+	}
+	overlayFile := filepath.Join(tmpDir, "overlay.json")
+	if err := ioutil.WriteFile(overlayFile, overlay, 0644); err != nil {
+		return err
+	}
+	cmd := exec.Command("go", "run", "-overlay", overlayFile, mainPath)
+	cmd.Dir = p.Dir
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	return cmd.Run()
+}
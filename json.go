@@ -2,8 +2,11 @@ package json
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"strconv"
+	"strings"
+	"unsafe"
 )
 
 // Marshaler is the interface implemented by types that
@@ -12,6 +15,12 @@ type Marshaler interface {
 	MarshalJSON() ([]byte, error)
 }
 
+// MarshalerContext is the interface implemented by types that
+// can marshal themselves into valid JSON with context.Context.
+type MarshalerContext interface {
+	MarshalJSONContext(context.Context) ([]byte, error)
+}
+
 // Unmarshaler is the interface implemented by types
 // that can unmarshal a JSON description of themselves.
 // The input can be assumed to be a valid encoding of
@@ -24,6 +33,12 @@ type Unmarshaler interface {
 	UnmarshalJSON([]byte) error
 }
 
+// UnmarshalerContext is the interface implemented by types
+// that can unmarshal with context.Context a JSON description of themselves.
+type UnmarshalerContext interface {
+	UnmarshalJSONContext(context.Context, []byte) error
+}
+
 // Marshal returns the JSON encoding of v.
 //
 // Marshal traverses the value v recursively.
@@ -70,6 +85,30 @@ type Unmarshaler interface {
 // false, 0, a nil pointer, a nil interface value, and any empty array,
 // slice, map, or string.
 //
+// The "omitzero" option specifies that the field should be omitted
+// from the encoding if the field has the zero value of its type, or if
+// the type implements an IsZero() bool method which reports true.
+// Unlike "omitempty", it omits zero structs such as time.Time{} and arrays,
+// while empty but non-nil slices and maps are kept.
+//
+// The "nonil" option specifies that a nil slice or map field should be
+// encoded as an empty array or object instead of null.
+//
+// The "unknown" option marks a map field with string keys, such as
+// map[string]RawMessage or map[string]interface{}, which holds the object
+// members that have no corresponding struct field. Instead of the field
+// itself, its entries are encoded as members after the other fields,
+// so Unmarshal and Marshal round-trip the members a struct doesn't model.
+// A struct can have at most one such field.
+//
+// The "inline" option flattens the field into the enclosing object.
+// The fields of an inlined struct, or pointer to struct, are encoded and
+// decoded as if the struct were embedded, following the same rules for
+// conflicting keys, and the name of the field is not used.
+// An inlined map with string keys is treated like a field with the
+// "unknown" option: its entries are encoded after the other fields and
+// Unmarshal stores the keys left over by the other fields in it.
+//
 // As a special case, if the field tag is "-", the field is always omitted.
 // Note that a field with name "-" can still be generated using the tag "-,".
 //
@@ -157,18 +196,34 @@ func Marshal(v interface{}) ([]byte, error) {
 	return MarshalWithOption(v)
 }
 
-// MarshalNoEscape
-func MarshalNoEscape(v interface{}) ([]byte, error) {
-	return marshalNoEscape(v, EncodeOptionHTMLEscape)
+// MarshalContext returns the JSON encoding of v with context.Context and EncodeOption.
+// The ctx is passed to the MarshalJSONContext method of values implementing MarshalerContext,
+// and encoding stops with ctx.Err() once ctx is done.
+func MarshalContext(ctx context.Context, v interface{}, optFuncs ...EncodeOptionFunc) ([]byte, error) {
+	opt := encodeOptionWithFuncs(EncodeOptionHTMLEscape, optFuncs)
+	return marshalContext(ctx, v, opt, nil)
+}
+
+// MarshalNoEscape returns the JSON encoding of v with EncodeOption like MarshalWithOption.
+func MarshalNoEscape(v interface{}, optFuncs ...EncodeOptionFunc) ([]byte, error) {
+	opt := encodeOptionWithFuncs(EncodeOptionHTMLEscape, optFuncs)
+	return marshalNoEscape(v, opt, nil)
 }
 
 // MarshalWithOption returns the JSON encoding of v with EncodeOption.
 func MarshalWithOption(v interface{}, optFuncs ...EncodeOptionFunc) ([]byte, error) {
-	opt := EncodeOptionHTMLEscape
-	for _, optFunc := range optFuncs {
-		opt = optFunc(opt)
-	}
-	return marshal(v, opt)
+	opt := encodeOptionWithFuncs(EncodeOptionHTMLEscape, optFuncs)
+	return marshal(v, opt, nil)
+}
+
+// MarshalWithFieldMask is like MarshalWithOption but writes only the struct fields
+// and map entries selected by m.
+// The mask is passed here instead of by a Fields(paths...) EncodeOptionFunc
+// because EncodeOption is a set of flags which can't carry it:
+// MarshalWithFieldMask(v, NewFieldMask(paths...)) is the equivalent of such an option.
+func MarshalWithFieldMask(v interface{}, m *FieldMask, optFuncs ...EncodeOptionFunc) ([]byte, error) {
+	opt := encodeOptionWithFuncs(EncodeOptionHTMLEscape, optFuncs)
+	return marshal(v, opt, m)
 }
 
 // MarshalIndent is like Marshal but applies Indent to format the output.
@@ -180,11 +235,8 @@ func MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
 
 // MarshalIndentWithOption is like Marshal but applies Indent to format the output with EncodeOption.
 func MarshalIndentWithOption(v interface{}, prefix, indent string, optFuncs ...EncodeOptionFunc) ([]byte, error) {
-	opt := EncodeOptionHTMLEscape | EncodeOptionIndent
-	for _, optFunc := range optFuncs {
-		opt = optFunc(opt)
-	}
-	return marshalIndent(v, prefix, indent, opt)
+	opt := encodeOptionWithFuncs(EncodeOptionHTMLEscape|EncodeOptionIndent, optFuncs)
+	return marshalIndent(v, prefix, indent, opt, nil)
 }
 
 // Unmarshal parses the JSON-encoded data and stores the result
@@ -212,7 +264,9 @@ func MarshalIndentWithOption(v interface{}, prefix, indent string, optFuncs ...E
 // keys to the keys used by Marshal (either the struct field name or its tag),
 // preferring an exact match but also accepting a case-insensitive match. By
 // default, object keys which don't have a corresponding struct field are
-// ignored (see Decoder.DisallowUnknownFields for an alternative).
+// ignored (see Decoder.DisallowUnknownFields for an alternative), unless the
+// struct or an embedded struct has a field with the "unknown" option, in
+// whose map Unmarshal stores them.
 //
 // To unmarshal JSON into an interface value,
 // Unmarshal stores one of these in the interface value:
@@ -268,6 +322,43 @@ func Unmarshal(data []byte, v interface{}) error {
 	return dec.decodeForUnmarshal(src, v)
 }
 
+// UnmarshalWithOption is like Unmarshal but decodes with DecodeOption.
+func UnmarshalWithOption(data []byte, v interface{}, optFuncs ...DecodeOptionFunc) error {
+	src := make([]byte, len(data)+1) // append nul byte to end
+	copy(src, data)
+	var dec Decoder
+	for _, optFunc := range optFuncs {
+		dec.opt = optFunc(dec.opt)
+	}
+	return dec.decodeForUnmarshal(src, v)
+}
+
+// UnmarshalContext is like UnmarshalWithOption but passes ctx to the
+// UnmarshalJSONContext method of values implementing UnmarshalerContext.
+func UnmarshalContext(ctx context.Context, data []byte, v interface{}, optFuncs ...DecodeOptionFunc) error {
+	src := make([]byte, len(data)+1) // append nul byte to end
+	copy(src, data)
+	var dec Decoder
+	dec.opt.Context = ctx
+	for _, optFunc := range optFuncs {
+		dec.opt = optFunc(dec.opt)
+	}
+	return dec.decodeForUnmarshal(src, v)
+}
+
+// UnmarshalPath is like UnmarshalWithOption but stores only the value addressed by path,
+// a JSON Pointer ( RFC 6901 ) such as "/items/3/name", in the value pointed to by v.
+// The rest of data is skipped without being decoded.
+func UnmarshalPath(data []byte, path string, v interface{}, optFuncs ...DecodeOptionFunc) error {
+	src := make([]byte, len(data)+1) // append nul byte to end
+	copy(src, data)
+	var dec Decoder
+	for _, optFunc := range optFuncs {
+		dec.opt = optFunc(dec.opt)
+	}
+	return dec.decodePathForUnmarshal(src, path, v)
+}
+
 func UnmarshalNoEscape(data []byte, v interface{}) error {
 	src := make([]byte, len(data)+1) // append nul byte to end
 	copy(src, data)
@@ -370,22 +461,57 @@ func Indent(dst *bytes.Buffer, src []byte, prefix, indent string) error {
 // For historical reasons, web browsers don't honor standard HTML
 // escaping within <script> tags, so an alternative JSON encoding must
 // be used.
+// The other bytes of src are appended as they are.
 func HTMLEscape(dst *bytes.Buffer, src []byte) {
-	var v interface{}
-	dec := NewDecoder(bytes.NewBuffer(src))
-	dec.UseNumber()
-	if err := dec.Decode(&v); err != nil {
-		return
+	s := *(*string)(unsafe.Pointer(&src))
+	start := 0 // beginning of the bytes which are not written yet
+	inString := false
+	for i := 0; i < len(s); {
+		if !inString {
+			j := strings.IndexByte(s[i:], '"')
+			if j < 0 {
+				break
+			}
+			i += j + 1
+			inString = true
+			continue
+		}
+		j := htmlEscapeIndex(s[i:])
+		if j < 0 {
+			break
+		}
+		i += j
+		switch c := s[i]; c {
+		case '"':
+			inString = false
+			i++
+		case '\\':
+			i += 2
+		case '<', '>', '&':
+			dst.WriteString(s[start:i])
+			dst.WriteString(`\u00`)
+			dst.WriteByte(hex[c>>4])
+			dst.WriteByte(hex[c&0xF])
+			i++
+			start = i
+		default:
+			// U+2028 is E2 80 A8 and U+2029 is E2 80 A9 in UTF-8
+			if i+2 < len(s) && s[i+1] == 0x80 && (s[i+2] == 0xA8 || s[i+2] == 0xA9) {
+				dst.WriteString(s[start:i])
+				dst.WriteString(`\u202`)
+				dst.WriteByte(hex[s[i+2]&0xF])
+				i += 3
+				start = i
+				continue
+			}
+			i++
+		}
 	}
-	buf, _ := marshal(v, EncodeOptionHTMLEscape)
-	dst.Write(buf)
+	dst.WriteString(s[start:])
 }
 
 // Valid reports whether data is a valid JSON encoding.
 func Valid(data []byte) bool {
-	var v interface{}
-	if err := Unmarshal(data, &v); err != nil {
-		return false
-	}
-	return true
+	s := newScanner(data)
+	return s.valid()
 }
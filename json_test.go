@@ -5,6 +5,7 @@ import (
 	"math"
 	"math/rand"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -20,6 +21,18 @@ var validTests = []struct {
 	{`{}`, true},
 	{`{"foo":"bar"}`, true},
 	{`{"foo":"bar","bar":{"baz":["qux"]}}`, true},
+	{``, false},
+	{` [ 1 , -0.5e+3 , "\\\u00e9" , true , false , null ] `, true},
+	{`[1,]`, false},
+	{`{"a":1,}`, false},
+	{`01`, false},
+	{`1.`, false},
+	{`"\x"`, false},
+	{"\"\x01\"", false},
+	{`"a`, false},
+	{`tru`, false},
+	{strings.Repeat("[", 100) + strings.Repeat("]", 100), true},
+	{strings.Repeat("[", 100) + strings.Repeat("]", 99), false},
 }
 
 func TestValid(t *testing.T) {
@@ -30,6 +43,15 @@ func TestValid(t *testing.T) {
 	}
 }
 
+func TestValidAllocs(t *testing.T) {
+	data := []byte(`{"a":[1,{"b":null}],"c":"\u00e9","d":-1.5e3}`)
+	for _, src := range [][]byte{data, data[:20]} {
+		if allocs := testing.AllocsPerRun(10, func() { json.Valid(src) }); allocs != 0 {
+			t.Errorf("Valid(%#q) allocates %v times", src, allocs)
+		}
+	}
+}
+
 type example struct {
 	compact string
 	indent  string
@@ -197,6 +219,41 @@ func TestIndentErrors(t *testing.T) {
 	}
 }
 
+var syntaxErrorTests = []indentErrorTest{
+	{``, json.NewSyntaxError("unexpected end of JSON input", 0)},
+	{`{"a":"b`, json.NewSyntaxError("unexpected end of JSON input", 7)},
+	{`[1 2]`, json.NewSyntaxError("invalid character '2' after array element", 4)},
+	{`[1,]`, json.NewSyntaxError("invalid character ']' looking for beginning of value", 4)},
+	{`{"a":1,}`, json.NewSyntaxError("invalid character '}' looking for beginning of object key string", 8)},
+	{`{"a":1]`, json.NewSyntaxError("invalid character ']' after object key:value pair", 7)},
+	{`"\q"`, json.NewSyntaxError("invalid character 'q' in string escape code", 3)},
+	{`"\u00zz"`, json.NewSyntaxError("invalid character 'z' in \\u hexadecimal character escape", 6)},
+	{"\"\x01\"", json.NewSyntaxError("invalid character '\\x01' in string literal", 2)},
+	{`-a`, json.NewSyntaxError("invalid character 'a' in numeric literal", 2)},
+	{`1.e1`, json.NewSyntaxError("invalid character 'e' after decimal point in numeric literal", 3)},
+	{`1e+]`, json.NewSyntaxError("invalid character ']' in exponent of numeric literal", 4)},
+	{`trux`, json.NewSyntaxError("invalid character 'x' in literal true (expecting 'e')", 4)},
+	{`{} {}`, json.NewSyntaxError("invalid character '{' after top-level value", 4)},
+}
+
+func TestSyntaxErrors(t *testing.T) {
+	for _, tt := range syntaxErrorTests {
+		if json.Valid([]byte(tt.in)) {
+			t.Errorf("Valid(%#q) = true, want false", tt.in)
+		}
+		buf := bytes.NewBufferString("x")
+		if err := json.Compact(buf, []byte(tt.in)); !reflect.DeepEqual(err, tt.err) {
+			t.Errorf("Compact(%#q): %#v, want %#v", tt.in, err, tt.err)
+		}
+		if err := json.Indent(buf, []byte(tt.in), "", "\t"); !reflect.DeepEqual(err, tt.err) {
+			t.Errorf("Indent(%#q): %#v, want %#v", tt.in, err, tt.err)
+		}
+		if buf.String() != "x" {
+			t.Errorf("Compact and Indent of %#q write %#q", tt.in, buf.String())
+		}
+	}
+}
+
 func diff(t *testing.T, a, b []byte) {
 	t.Helper()
 	for i := 0; ; i++ {
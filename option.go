@@ -7,3 +7,190 @@ func UnorderedMap() func(EncodeOption) EncodeOption {
 		return opt | EncodeOptionUnorderedMap
 	}
 }
+
+// NonFiniteFloatAsNull causes NaN and ±Inf floats to be encoded as null
+// instead of failing with an UnsupportedValueError.
+func NonFiniteFloatAsNull() func(EncodeOption) EncodeOption {
+	return func(opt EncodeOption) EncodeOption {
+		return opt&^encodeOptionNonFiniteFloat | EncodeOptionNonFiniteFloatNull
+	}
+}
+
+// NonFiniteFloatAsString causes NaN and ±Inf floats to be encoded as the strings
+// "NaN", "Infinity" and "-Infinity" instead of failing with an UnsupportedValueError.
+// DecodeNonFiniteFloatString accepts them back.
+func NonFiniteFloatAsString() func(EncodeOption) EncodeOption {
+	return func(opt EncodeOption) EncodeOption {
+		return opt&^encodeOptionNonFiniteFloat | EncodeOptionNonFiniteFloatString
+	}
+}
+
+// ClampNonFiniteFloat causes ±Inf floats to be encoded as the largest finite value
+// of their type with the same sign and NaN as 0 instead of failing with an UnsupportedValueError.
+func ClampNonFiniteFloat() func(EncodeOption) EncodeOption {
+	return func(opt EncodeOption) EncodeOption {
+		return opt&^encodeOptionNonFiniteFloat | EncodeOptionNonFiniteFloatClamp
+	}
+}
+
+// SnakeCaseKeys causes untagged struct fields to be encoded with snake_case keys
+// derived from the field names, e.g. "user_id" for UserID.
+func SnakeCaseKeys() func(EncodeOption) EncodeOption {
+	return func(opt EncodeOption) EncodeOption {
+		return opt&^encodeOptionKeyNaming | EncodeOptionSnakeCaseKeys
+	}
+}
+
+// CamelCaseKeys causes untagged struct fields to be encoded with camelCase keys
+// derived from the field names, e.g. "userId" for UserID.
+func CamelCaseKeys() func(EncodeOption) EncodeOption {
+	return func(opt EncodeOption) EncodeOption {
+		return opt&^encodeOptionKeyNaming | EncodeOptionCamelCaseKeys
+	}
+}
+
+// KebabCaseKeys causes untagged struct fields to be encoded with kebab-case keys
+// derived from the field names, e.g. "user-id" for UserID.
+func KebabCaseKeys() func(EncodeOption) EncodeOption {
+	return func(opt EncodeOption) EncodeOption {
+		return opt&^encodeOptionKeyNaming | EncodeOptionKebabCaseKeys
+	}
+}
+
+// Canonical causes the output to be the canonical form of RFC 8785 (JSON Canonicalization Scheme)
+// as returned by Canonicalize: the members of structs and maps sorted by key, numbers serialized
+// like ECMAScript does, minimal string escaping and no indentation.
+func Canonical() func(EncodeOption) EncodeOption {
+	return func(opt EncodeOption) EncodeOption {
+		return opt | EncodeOptionCanonical
+	}
+}
+
+// NilAsEmpty causes nil slices to be encoded as [] and nil maps as {} instead of null,
+// like the "nonil" option of a struct field tag does for a single field.
+// Nil []byte values are still encoded as null.
+func NilAsEmpty() func(EncodeOption) EncodeOption {
+	return func(opt EncodeOption) EncodeOption {
+		return opt | EncodeOptionNilAsEmpty
+	}
+}
+
+type DecodeOptionFunc func(DecodeOption) DecodeOption
+
+// DecodeUseNumber causes a number to be unmarshaled into an interface{}
+// as a Number instead of as a float64.
+func DecodeUseNumber() func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.Flags |= DecodeOptionUseNumber
+		return opt
+	}
+}
+
+// DecodeDisallowUnknownFields causes an error to be returned when the destination
+// is a struct and the input contains object keys which do not match any
+// non-ignored, exported fields in the destination.
+func DecodeDisallowUnknownFields() func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.Flags |= DecodeOptionDisallowUnknownFields
+		return opt
+	}
+}
+
+// DecodeRequireFields treats every non-pointer field of a struct as if it had the "required" tag option.
+// Decoding an object which lacks any of those keys fails with a MissingFieldsError.
+func DecodeRequireFields() func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.Flags |= DecodeOptionRequireFields
+		return opt
+	}
+}
+
+// DecodeCaseSensitive causes object keys to be matched to struct fields
+// only if they are exactly equal instead of equal under case folding.
+func DecodeCaseSensitive() func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.Flags |= DecodeOptionCaseSensitive
+		return opt
+	}
+}
+
+// DecodeNonFiniteFloatString causes the strings "NaN", "Infinity" and "-Infinity"
+// to be decoded into float32 and float64 values as NaN and ±Inf.
+func DecodeNonFiniteFloatString() func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.Flags |= DecodeOptionNonFiniteFloatString
+		return opt
+	}
+}
+
+// DecodeSnakeCaseKeys causes untagged struct fields to be matched by snake_case keys
+// derived from the field names like SnakeCaseKeys.
+func DecodeSnakeCaseKeys() func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.Flags = opt.Flags&^decodeOptionKeyNaming | DecodeOptionSnakeCaseKeys
+		return opt
+	}
+}
+
+// DecodeCamelCaseKeys causes untagged struct fields to be matched by camelCase keys
+// derived from the field names like CamelCaseKeys.
+func DecodeCamelCaseKeys() func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.Flags = opt.Flags&^decodeOptionKeyNaming | DecodeOptionCamelCaseKeys
+		return opt
+	}
+}
+
+// DecodeKebabCaseKeys causes untagged struct fields to be matched by kebab-case keys
+// derived from the field names like KebabCaseKeys.
+func DecodeKebabCaseKeys() func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.Flags = opt.Flags&^decodeOptionKeyNaming | DecodeOptionKebabCaseKeys
+		return opt
+	}
+}
+
+// DecodeMaxDepth limits the nesting depth of objects and arrays.
+// Exceeding the limit makes decoding fail with a LimitError.
+func DecodeMaxDepth(n int64) func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.MaxDepth = n
+		return opt
+	}
+}
+
+// DecodeMaxInputBytes limits the size in bytes of the input of a single value.
+// Exceeding the limit makes decoding fail with a LimitError.
+func DecodeMaxInputBytes(n int64) func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.MaxInputBytes = n
+		return opt
+	}
+}
+
+// DecodeMaxStringLength limits the length in bytes of a string value or an object key.
+// Exceeding the limit makes decoding fail with a LimitError.
+func DecodeMaxStringLength(n int64) func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.MaxStringLength = n
+		return opt
+	}
+}
+
+// DecodeMaxElements limits the number of elements in an array or entries in a map.
+// Exceeding the limit makes decoding fail with a LimitError.
+func DecodeMaxElements(n int64) func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.MaxElements = n
+		return opt
+	}
+}
+
+// DecodeMaxObjectKeys limits the number of keys in an object decoded into a struct.
+// Exceeding the limit makes decoding fail with a LimitError.
+func DecodeMaxObjectKeys(n int64) func(DecodeOption) DecodeOption {
+	return func(opt DecodeOption) DecodeOption {
+		opt.MaxObjectKeys = n
+		return opt
+	}
+}
@@ -0,0 +1,158 @@
+package json
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// EncoderFunc appends the JSON encoding of v to b and returns the extended buffer.
+// v holds a value of the type the function is registered for.
+//
+// The appended bytes must be a single valid JSON value.
+// They are written as is, without validation or HTML escaping.
+type EncoderFunc func(b []byte, v interface{}) ([]byte, error)
+
+// DecoderFunc decodes the JSON value data into v,
+// which holds a pointer to a value of the type the function is registered for.
+//
+// data is only valid until the function returns and must not be retained.
+type DecoderFunc func(data []byte, v interface{}) error
+
+var (
+	registeredEncoders          sync.Map // map[uintptr]EncoderFunc
+	registeredDecoders          sync.Map // map[uintptr]DecoderFunc
+	registeredGeneratedEncoders sync.Map // map[uintptr]GeneratedEncoderFunc
+	registeredGeneratedDecoders sync.Map // map[uintptr]GeneratedDecoderFunc
+)
+
+// RegisterEncoder registers fn to encode the values of typ for every Marshal call and Encoder.
+// fn takes precedence over the MarshalJSON and MarshalText methods of typ,
+// so the encoding of types from other packages can be customized without wrapping them.
+//
+// The compiled encoding of a type is cached on first use, so RegisterEncoder should be called
+// before values of typ, or of types containing it, are encoded, typically from an init function.
+func RegisterEncoder(typ reflect.Type, fn EncoderFunc) {
+	registeredEncoders.Store(uintptr(unsafe.Pointer(type2rtype(typ))), fn)
+}
+
+// RegisterDecoder registers fn to decode the values of typ for every Unmarshal call and Decoder.
+// fn takes precedence over the UnmarshalJSON and UnmarshalText methods of typ.
+//
+// Like RegisterEncoder, it should be called before values of typ are decoded.
+func RegisterDecoder(typ reflect.Type, fn DecoderFunc) {
+	registeredDecoders.Store(uintptr(unsafe.Pointer(type2rtype(typ))), fn)
+}
+
+// RegisterGeneratedEncoder registers fn generated by cmd/generator to encode the values of the struct type typ.
+// Unlike a function registered by RegisterEncoder, fn only replaces the code compiled for typ
+// where it writes the same bytes: it isn't used with key naming, Canonical or a FieldMask selecting fields of typ,
+// with the encoders registered on an Encoder, for embedded fields, if typ implements a marshaler,
+// or if the values of typ can hold a value of a type with an encoder registered by RegisterEncoder.
+// The other options are passed to fn with its EncodeContext.
+func RegisterGeneratedEncoder(typ reflect.Type, fn GeneratedEncoderFunc) {
+	registeredGeneratedEncoders.Store(uintptr(unsafe.Pointer(type2rtype(typ))), fn)
+}
+
+// RegisterGeneratedDecoder registers fn generated by cmd/generator to decode the values of the struct type typ.
+// fn decodes the objects in place, matching their keys with the decoder compiled for typ,
+// so it accepts the same input and returns the same errors.
+// Like the generated encoders, it isn't used for embedded fields or if typ implements an unmarshaler,
+// and the functions registered by RegisterDecoder take precedence over it.
+func RegisterGeneratedDecoder(typ reflect.Type, fn GeneratedDecoderFunc) {
+	registeredGeneratedDecoders.Store(uintptr(unsafe.Pointer(type2rtype(typ))), fn)
+}
+
+func loadRegisteredEncoder(typeptr uintptr) EncoderFunc {
+	if fn, exists := registeredEncoders.Load(typeptr); exists {
+		return fn.(EncoderFunc)
+	}
+	return nil
+}
+
+func loadRegisteredDecoder(typeptr uintptr) DecoderFunc {
+	if fn, exists := registeredDecoders.Load(typeptr); exists {
+		return fn.(DecoderFunc)
+	}
+	return nil
+}
+
+func loadGeneratedEncoder(typeptr uintptr) GeneratedEncoderFunc {
+	if fn, exists := registeredGeneratedEncoders.Load(typeptr); exists {
+		return fn.(GeneratedEncoderFunc)
+	}
+	return nil
+}
+
+func loadGeneratedDecoder(typeptr uintptr) GeneratedDecoderFunc {
+	if fn, exists := registeredGeneratedDecoders.Load(typeptr); exists {
+		return fn.(GeneratedDecoderFunc)
+	}
+	return nil
+}
+
+// encoderSet holds the encoders registered on an Encoder and the code compiled with them.
+type encoderSet struct {
+	funcs    map[uintptr]EncoderFunc
+	codeSets map[opcodeSetCacheKey]*opcodeSet
+}
+
+func (s *encoderSet) compileToGetCodeSet(typeptr uintptr, flags EncodeOption) (*opcodeSet, error) {
+	key := opcodeSetCacheKey{typeptr: typeptr, flags: flags}
+	if codeSet, exists := s.codeSets[key]; exists {
+		return codeSet, nil
+	}
+
+	// noescape trick for header.typ ( reflect.*rtype )
+	copiedType := *(**rtype)(unsafe.Pointer(&typeptr))
+
+	code, err := encodeCompileHead(&encodeCompileContext{
+		typ:                      copiedType,
+		root:                     true,
+		structTypeToCompiledCode: map[uintptr]*compiledCode{},
+		encoders:                 s.funcs,
+		keyNaming:                encodeKeyNaming(flags),
+		canonical:                flags&EncodeOptionCanonical != 0,
+	})
+	if err != nil {
+		return nil, err
+	}
+	code = copyOpcode(code)
+	codeLength := code.totalLength()
+	codeSet := &opcodeSet{
+		code:       code,
+		codeLength: codeLength,
+	}
+	s.codeSets[key] = codeSet
+	return codeSet, nil
+}
+
+// RegisterEncoder registers fn to encode the values of typ written by this Encoder.
+// fn takes precedence over the function registered for typ by the package-level RegisterEncoder.
+func (e *Encoder) RegisterEncoder(typ reflect.Type, fn EncoderFunc) {
+	if e.encoders == nil {
+		e.encoders = &encoderSet{funcs: map[uintptr]EncoderFunc{}}
+	}
+	e.encoders.funcs[uintptr(unsafe.Pointer(type2rtype(typ)))] = fn
+	// the code compiled so far may embed the previous encoder of typ
+	e.encoders.codeSets = map[opcodeSetCacheKey]*opcodeSet{}
+}
+
+// RegisterDecoder registers fn to decode the values of typ read by this Decoder.
+// fn takes precedence over the function registered for typ by the package-level RegisterDecoder.
+func (d *Decoder) RegisterDecoder(typ reflect.Type, fn DecoderFunc) {
+	if d.decoderFuncs == nil {
+		d.decoderFuncs = map[uintptr]DecoderFunc{}
+	}
+	d.decoderFuncs[uintptr(unsafe.Pointer(type2rtype(typ)))] = fn
+	// the decoders compiled so far may embed the previous decoder of typ
+	d.decoders = map[decoderCacheKey]decoder{}
+}
+
+func (d *Decoder) decoderFunc(typ *rtype) DecoderFunc {
+	typeptr := uintptr(unsafe.Pointer(typ))
+	if fn, exists := d.decoderFuncs[typeptr]; exists {
+		return fn
+	}
+	return loadRegisteredDecoder(typeptr)
+}
@@ -0,0 +1,416 @@
+package json
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// scanner validates JSON text in a single pass without allocating.
+// On failure, it keeps the position and the context of the invalid byte
+// so that the SyntaxError is only built when it's needed.
+type scanner struct {
+	src     []byte
+	cursor  int64  // position of the invalid byte. it's len(src) at the unexpected end of the input
+	context string // what was expected at cursor
+	literal string // literal in which the invalid byte is
+	expect  byte   // byte of literal expected at cursor
+
+	depth           int64       // nesting depth of the arrays and the objects around the scanned value
+	maxDepth        int64       // nesting depth beyond which scanning fails, zero means no limit
+	maxStringLength int64       // length of the strings beyond which scanning fails, zero means no limit
+	limitErr        *LimitError // set if scanning failed by exceeding maxDepth or maxStringLength
+}
+
+// scannerStackSize is the nesting depth which is scanned without allocating.
+const scannerStackSize = 64
+
+func newScanner(src []byte) scanner {
+	return scanner{src: src}
+}
+
+// newBufferScanner returns a scanner of the nul terminated buffer of the decoder.
+func newBufferScanner(buf []byte) scanner {
+	if n := len(buf); n > 0 && buf[n-1] == nul {
+		buf = buf[:n-1]
+	}
+	return scanner{src: buf}
+}
+
+// newLimitedBufferScanner is like newBufferScanner but fails at the arrays and the objects nested deeper
+// than opt.MaxDepth, given that the scanned value is at depth, and at the strings longer than opt.MaxStringLength.
+func newLimitedBufferScanner(buf []byte, depth int64, opt *DecodeOption) scanner {
+	s := newBufferScanner(buf)
+	s.depth = depth
+	s.maxDepth = opt.MaxDepth
+	s.maxStringLength = opt.MaxStringLength
+	return s
+}
+
+func (s *scanner) char(cursor int64) byte {
+	if cursor < int64(len(s.src)) {
+		return s.src[cursor]
+	}
+	return nul
+}
+
+func (s *scanner) skipWhiteSpace(cursor int64) int64 {
+	for cursor < int64(len(s.src)) && isWhiteSpace[s.src[cursor]] {
+		cursor++
+	}
+	return cursor
+}
+
+// enter reports whether an array or an object can be opened at cursor inside stack without exceeding maxDepth.
+func (s *scanner) enter(stack []byte, members []int64, cursor int64) bool {
+	if s.maxDepth > 0 && s.depth+int64(len(stack))+1 > s.maxDepth {
+		s.limitErr = errExceededLimit("depth", s.maxDepth, cursor)
+		return s.limitFailed(stack, members)
+	}
+	return true
+}
+
+// limitFailed sets the path of the value being scanned inside stack to limitErr if scanning failed by a limit.
+// It returns false.
+func (s *scanner) limitFailed(stack []byte, members []int64) bool {
+	if s.limitErr != nil {
+		s.limitErr.Path = skippedValuePath(s.src, stack, members)
+	}
+	return false
+}
+
+func (s *scanner) fail(cursor int64, context string) bool {
+	s.cursor = cursor
+	s.context = context
+	return false
+}
+
+// err returns the SyntaxError of the last failure.
+func (s *scanner) err() *SyntaxError {
+	if s.cursor >= int64(len(s.src)) {
+		return errSyntaxEnd(int64(len(s.src)))
+	}
+	context := s.context
+	if s.literal != "" {
+		context = errLiteralContext(s.literal, s.expect)
+	}
+	return errSyntax(s.src[s.cursor], context, s.cursor)
+}
+
+// errSyntax returns the SyntaxError of the invalid byte c at cursor in the format of encoding/json.
+// Offset is the number of bytes read including the invalid byte.
+func errSyntax(c byte, context string, cursor int64) *SyntaxError {
+	return &SyntaxError{
+		msg:    "invalid character " + quoteChar(c) + " " + context,
+		Offset: cursor + 1,
+	}
+}
+
+func errSyntaxEnd(cursor int64) *SyntaxError {
+	return &SyntaxError{msg: "unexpected end of JSON input", Offset: cursor}
+}
+
+func errLiteralContext(literal string, expect byte) string {
+	return "in literal " + literal + " (expecting " + quoteChar(expect) + ")"
+}
+
+// validate returns the SyntaxError of the first invalid byte of src.
+func validate(src []byte) error {
+	s := newScanner(src)
+	if !s.valid() {
+		return s.err()
+	}
+	return nil
+}
+
+// valid reports whether the source is exactly one JSON value surrounded by white spaces.
+func (s *scanner) valid() bool {
+	cursor, ok := s.scanValue(0)
+	if !ok {
+		return false
+	}
+	cursor = s.skipWhiteSpace(cursor)
+	if cursor != int64(len(s.src)) {
+		return s.fail(cursor, "after top-level value")
+	}
+	return true
+}
+
+// scanValue validates the JSON value after the white spaces from cursor and returns the cursor after it.
+func (s *scanner) scanValue(cursor int64) (int64, bool) {
+	var (
+		stackBuf   [scannerStackSize]byte
+		stack      = stackBuf[:0] // opening brackets of the arrays and the objects being scanned
+		membersBuf [scannerStackSize]int64
+		members    = membersBuf[:0] // current element of each array and position of the current key of each object in stack
+		ok         bool
+	)
+	cursor = s.skipWhiteSpace(cursor)
+VALUE:
+	switch s.char(cursor) {
+	case '{':
+		if !s.enter(stack, members, cursor) {
+			return 0, false
+		}
+		cursor = s.skipWhiteSpace(cursor + 1)
+		if s.char(cursor) == '}' {
+			cursor++
+			goto END_VALUE
+		}
+		stack = append(stack, '{')
+		members = append(members, -1)
+		goto KEY
+	case '[':
+		if !s.enter(stack, members, cursor) {
+			return 0, false
+		}
+		cursor = s.skipWhiteSpace(cursor + 1)
+		if s.char(cursor) == ']' {
+			cursor++
+			goto END_VALUE
+		}
+		stack = append(stack, '[')
+		members = append(members, 0)
+		goto VALUE
+	case '"':
+		if cursor, ok = s.scanString(cursor); !ok {
+			return 0, s.limitFailed(stack, members)
+		}
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		if cursor, ok = s.scanNumber(cursor); !ok {
+			return 0, false
+		}
+	case 't':
+		if cursor, ok = s.scanLiteral(cursor, "true"); !ok {
+			return 0, false
+		}
+	case 'f':
+		if cursor, ok = s.scanLiteral(cursor, "false"); !ok {
+			return 0, false
+		}
+	case 'n':
+		if cursor, ok = s.scanLiteral(cursor, "null"); !ok {
+			return 0, false
+		}
+	default:
+		return 0, s.fail(cursor, "looking for beginning of value")
+	}
+END_VALUE:
+	if len(stack) == 0 {
+		return cursor, true
+	}
+	cursor = s.skipWhiteSpace(cursor)
+	if stack[len(stack)-1] == '{' {
+		switch s.char(cursor) {
+		case ',':
+			cursor = s.skipWhiteSpace(cursor + 1)
+			goto KEY
+		case '}':
+			stack = stack[:len(stack)-1]
+			members = members[:len(members)-1]
+			cursor++
+			goto END_VALUE
+		}
+		return 0, s.fail(cursor, "after object key:value pair")
+	}
+	switch s.char(cursor) {
+	case ',':
+		members[len(members)-1]++
+		cursor = s.skipWhiteSpace(cursor + 1)
+		goto VALUE
+	case ']':
+		stack = stack[:len(stack)-1]
+		members = members[:len(members)-1]
+		cursor++
+		goto END_VALUE
+	}
+	return 0, s.fail(cursor, "after array element")
+KEY:
+	if s.char(cursor) != '"' {
+		return 0, s.fail(cursor, "looking for beginning of object key string")
+	}
+	members[len(members)-1] = -1
+	keyStart := cursor
+	if cursor, ok = s.scanString(cursor); !ok {
+		return 0, s.limitFailed(stack, members)
+	}
+	// like the struct decoder, the length of a key is the one in the input
+	if s.maxStringLength > 0 && cursor-keyStart-2 > s.maxStringLength {
+		s.limitErr = errExceededLimit("string length", s.maxStringLength, keyStart)
+		return 0, s.limitFailed(stack, members)
+	}
+	members[len(members)-1] = keyStart
+	cursor = s.skipWhiteSpace(cursor)
+	if s.char(cursor) != ':' {
+		return 0, s.fail(cursor, "after object key")
+	}
+	cursor = s.skipWhiteSpace(cursor + 1)
+	goto VALUE
+}
+
+// isStringStop reports whether the bulk scan of a string stops at a byte,
+// which is the case for quotes, backslashes and control characters.
+var isStringStop = func() (t [256]bool) {
+	for c := 0; c < 0x20; c++ {
+		t[c] = true
+	}
+	t['"'] = true
+	t['\\'] = true
+	return t
+}()
+
+// stringStopMask returns a mask having the MSB set for some bytes of n if any of them stops the bulk scan of a string.
+// Unlike below and contains, it's valid for any bytes of n,
+// and the lowest bit set is the one of the first of these bytes in little endian order.
+func stringStopMask(n uint64) uint64 {
+	quote := n ^ expand('"')
+	backslash := n ^ expand('\\')
+	return ((quote-lsb)&^quote | (backslash-lsb)&^backslash | (n-expand(0x20))&^n) & msb
+}
+
+// scanString validates the string at cursor and returns the cursor after it.
+// It fails with limitErr if the string is unescaped to more than maxStringLength bytes.
+func (s *scanner) scanString(cursor int64) (int64, bool) {
+	var (
+		length = int64(len(s.src))
+		start  = cursor
+		saved  int64 // bytes saved by unescaping the escape sequences
+	)
+	for cursor++; cursor < length; {
+		// skip 8 bytes at a time up to the first quote, backslash or control character
+		for cursor+8 <= length {
+			if m := stringStopMask(binary.LittleEndian.Uint64(s.src[cursor:])); m != 0 {
+				cursor += int64(bits.TrailingZeros64(m) >> 3)
+				goto STOP
+			}
+			cursor += 8
+		}
+		for cursor < length && !isStringStop[s.src[cursor]] {
+			cursor++
+		}
+		if cursor >= length {
+			break
+		}
+	STOP:
+		switch c := s.src[cursor]; c {
+		case '"':
+			if s.maxStringLength > 0 && cursor-start-1-saved > s.maxStringLength {
+				s.limitErr = errExceededLimit("string length", s.maxStringLength, start)
+				return 0, false
+			}
+			return cursor + 1, true
+		case '\\':
+			cursor++
+			switch s.char(cursor) {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				saved++
+				cursor++
+			case 'u':
+				for i := int64(1); i <= 4; i++ {
+					if !isHexChar(s.char(cursor + i)) {
+						return 0, s.fail(cursor+i, "in \\u hexadecimal character escape")
+					}
+				}
+				saved += 6 - unescapedRuneLength(s.src[cursor+1:cursor+5])
+				cursor += 5
+			default:
+				return 0, s.fail(cursor, "in string escape code")
+			}
+		default:
+			return 0, s.fail(cursor, "in string literal")
+		}
+	}
+	return 0, s.fail(cursor, "in string literal")
+}
+
+// unescapedRuneLength returns the number of bytes of the UTF-8 encoding of the \u escape sequence of hex.
+// A surrogate counts for half of the encoding of a pair.
+func unescapedRuneLength(hex []byte) int64 {
+	r := rune(hexToInt[hex[0]]<<12 | hexToInt[hex[1]]<<8 | hexToInt[hex[2]]<<4 | hexToInt[hex[3]])
+	if utf16.IsSurrogate(r) {
+		return 2
+	}
+	return int64(utf8.RuneLen(r))
+}
+
+// skippedValuePath returns the JSON Pointer of the value being scanned or skipped inside stack,
+// relative to the value whose scan started the stack.
+// members holds the current element of each array and the position in src of the current key of each object,
+// which is negative while the key is read.
+func skippedValuePath(src []byte, stack []byte, members []int64) string {
+	var path []byte
+	for i, c := range stack {
+		if c == '[' {
+			path = append(path, '/')
+			path = strconv.AppendInt(path, members[i], 10)
+			continue
+		}
+		if members[i] < 0 {
+			break
+		}
+		key, _ := (&canonicalizer{src: src, cursor: members[i]}).string()
+		path = append(path, '/')
+		path = append(path, jsonPointerEscaper.Replace(key)...)
+	}
+	return string(path)
+}
+
+func (s *scanner) scanDigits(cursor int64) int64 {
+	for isDigitChar(s.char(cursor)) {
+		cursor++
+	}
+	return cursor
+}
+
+func (s *scanner) scanNumber(cursor int64) (int64, bool) {
+	if s.char(cursor) == '-' {
+		cursor++
+	}
+	switch c := s.char(cursor); {
+	case c == '0':
+		cursor++
+	case '1' <= c && c <= '9':
+		cursor = s.scanDigits(cursor + 1)
+	default:
+		return 0, s.fail(cursor, "in numeric literal")
+	}
+	if s.char(cursor) == '.' {
+		cursor++
+		if !isDigitChar(s.char(cursor)) {
+			return 0, s.fail(cursor, "after decimal point in numeric literal")
+		}
+		cursor = s.scanDigits(cursor)
+	}
+	if c := s.char(cursor); c == 'e' || c == 'E' {
+		cursor++
+		if c := s.char(cursor); c == '+' || c == '-' {
+			cursor++
+		}
+		if !isDigitChar(s.char(cursor)) {
+			return 0, s.fail(cursor, "in exponent of numeric literal")
+		}
+		cursor = s.scanDigits(cursor)
+	}
+	return cursor, true
+}
+
+func (s *scanner) scanLiteral(cursor int64, literal string) (int64, bool) {
+	for i := 1; i < len(literal); i++ {
+		if s.char(cursor+int64(i)) != literal[i] {
+			s.literal = literal
+			s.expect = literal[i]
+			return 0, s.fail(cursor+int64(i), "in literal")
+		}
+	}
+	return cursor + int64(len(literal)), true
+}
+
+func isDigitChar(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHexChar(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
@@ -6,6 +6,7 @@ package json_test
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
@@ -391,9 +392,23 @@ var tokenStreamCases = []tokenStreamCase{
 	{json: ` \a`, expTokens: []interface{}{
 		json.NewSyntaxError("invalid character '\\\\' looking for beginning of value", 1),
 	}},
+	{json: `1.`, expTokens: []interface{}{io.ErrUnexpectedEOF}},
+	{json: `01`, expTokens: []interface{}{float64(0), float64(1)}},
+	{json: `[01]`, expTokens: []interface{}{
+		json.Delim('['), float64(0),
+		json.NewSyntaxError("invalid character '1' after array element", 2),
+	}},
+	{json: ` [1.x]`, expTokens: []interface{}{
+		json.Delim('['),
+		json.NewSyntaxError("invalid character 'x' after decimal point in numeric literal", 3),
+	}},
+	{json: `[-]`, expTokens: []interface{}{
+		json.Delim('['),
+		json.NewSyntaxError("invalid character ']' in numeric literal", 2),
+	}},
+	{json: `1.5e+`, expTokens: []interface{}{io.ErrUnexpectedEOF}},
 }
 
-/*
 func TestDecodeInStream(t *testing.T) {
 	for ci, tcase := range tokenStreamCases {
 
@@ -428,7 +443,57 @@ func TestDecodeInStream(t *testing.T) {
 		}
 	}
 }
-*/
+
+func TestDecoderTokenInvalidDelimiter(t *testing.T) {
+	tests := []struct {
+		in     string
+		tokens int
+		err    error
+	}{
+		{in: `[1 2]`, tokens: 2, err: json.NewSyntaxError("invalid character '2' after array element", 3)},
+		{in: `{"a" 1}`, tokens: 2, err: json.NewSyntaxError("invalid character '1' after object key", 5)},
+		{in: `[1,,2]`, tokens: 2, err: json.NewSyntaxError("invalid character ',' looking for beginning of value", 3)},
+		{in: `{"a":1 "b":2}`, tokens: 3, err: json.NewSyntaxError("invalid character '\"' after object key:value pair", 7)},
+		{in: `{1:2}`, tokens: 1, err: json.NewSyntaxError("invalid character '1' looking for beginning of object key string", 1)},
+		{in: `[1}`, tokens: 2, err: json.NewSyntaxError("invalid character '}' after array element", 2)},
+		{in: `:1`, tokens: 0, err: json.NewSyntaxError("invalid character ':' looking for beginning of value", 0)},
+	}
+	for _, test := range tests {
+		dec := json.NewDecoder(strings.NewReader(test.in))
+		for i := 0; i < test.tokens; i++ {
+			if _, err := dec.Token(); err != nil {
+				t.Fatalf("%s: unexpected error at token %d: %v", test.in, i, err)
+			}
+		}
+		_, err := dec.Token()
+		if !reflect.DeepEqual(err, test.err) {
+			t.Errorf("%s: expected error %#v but got %#v", test.in, test.err, err)
+		}
+	}
+}
+
+func TestDecoderTokenUseNumber(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`{"a": [1.5, 20]} 3`))
+	dec.UseNumber()
+	var tokens []json.Token
+	for {
+		tk, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens = append(tokens, tk)
+	}
+	expected := []json.Token{
+		json.Delim('{'), "a", json.Delim('['), json.Number("1.5"), json.Number("20"), json.Delim(']'), json.Delim('}'),
+		json.Number("3"),
+	}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Fatalf("expected %v but got %v", expected, tokens)
+	}
+}
 
 // Test from golang.org/issue/11893
 func TestHTTPDecoding(t *testing.T) {
@@ -463,3 +528,176 @@ func TestHTTPDecoding(t *testing.T) {
 		t.Errorf("err = %v; want io.EOF", err)
 	}
 }
+
+func TestEncoderTokens(t *testing.T) {
+	write := func(enc *json.Encoder) error {
+		steps := []func() error{
+			enc.BeginObject,
+			func() error { return enc.WriteKey("a") },
+			enc.BeginArray,
+			func() error { return enc.WriteValue(1) },
+			func() error { return enc.WriteToken("<b>") },
+			func() error { return enc.WriteValue(map[string]int{"c": 2}) },
+			enc.BeginArray,
+			enc.EndArray,
+			enc.EndArray,
+			func() error { return enc.WriteToken("d") },
+			func() error { return enc.WriteToken(json.Number("3.5")) },
+			func() error { return enc.WriteToken(json.Delim('}')) },
+			func() error { return enc.WriteToken(nil) },
+		}
+		for i, step := range steps {
+			if err := step(); err != nil {
+				return fmt.Errorf("step #%d: %v", i, err)
+			}
+		}
+		return nil
+	}
+	t.Run("compact", func(t *testing.T) {
+		var buf bytes.Buffer
+		assertErr(t, write(json.NewEncoder(&buf)))
+		assertEq(t, "tokens", `{"a":[1,"\u003cb\u003e",{"c":2},[]],"d":3.5}`+"\nnull\n", buf.String())
+	})
+	t.Run("indent", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent(">", ".")
+		enc.SetEscapeHTML(false)
+		assertErr(t, write(enc))
+		expected := `{
+>."a": [
+>..1,
+>.."<b>",
+>..{
+>..."c": 2
+>..},
+>..[]
+>.],
+>."d": 3.5
+>}
+null
+`
+		if have := buf.String(); have != expected {
+			t.Error("indented token encoding mismatch")
+			diff(t, []byte(have), []byte(expected))
+		}
+	})
+	t.Run("invalid sequence", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			steps func(enc *json.Encoder) error
+		}{
+			{"key outside object", func(enc *json.Encoder) error { return enc.WriteKey("a") }},
+			{"key in array", func(enc *json.Encoder) error {
+				enc.BeginArray()
+				return enc.WriteKey("a")
+			}},
+			{"value without key", func(enc *json.Encoder) error {
+				enc.BeginObject()
+				return enc.WriteValue(1)
+			}},
+			{"two keys", func(enc *json.Encoder) error {
+				enc.BeginObject()
+				enc.WriteKey("a")
+				return enc.WriteKey("b")
+			}},
+			{"end without value", func(enc *json.Encoder) error {
+				enc.BeginObject()
+				enc.WriteKey("a")
+				return enc.EndObject()
+			}},
+			{"mismatched end", func(enc *json.Encoder) error {
+				enc.BeginObject()
+				return enc.EndArray()
+			}},
+			{"end without begin", func(enc *json.Encoder) error { return enc.EndArray() }},
+			{"encode inside array", func(enc *json.Encoder) error {
+				enc.BeginArray()
+				return enc.Encode(1)
+			}},
+			{"unsupported token", func(enc *json.Encoder) error { return enc.WriteToken(1) }},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				if err := test.steps(json.NewEncoder(ioutil.Discard)); err == nil {
+					t.Fatal("expected error")
+				}
+			})
+		}
+	})
+}
+
+type chunkWriter struct {
+	buf    bytes.Buffer
+	writes int
+	err    error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.err != nil {
+		return 0, w.err
+	}
+	return w.buf.Write(p)
+}
+
+func TestEncoderChunkSize(t *testing.T) {
+	type item struct {
+		ID   int               `json:"id"`
+		Name string            `json:"name"`
+		Tags map[string][]int  `json:"tags"`
+		Any  interface{}       `json:"any"`
+		Arr  [2]string         `json:"arr"`
+		Sub  map[string]string `json:"sub"`
+	}
+	v := make([]item, 100)
+	for i := range v {
+		v[i] = item{
+			ID:   i,
+			Name: strings.Repeat("x", i%7),
+			Tags: map[string][]int{"b": {i, i + 1}, "a": {i}},
+			Any:  []int{i, i, i},
+			Arr:  [2]string{"p", "q"},
+			Sub:  map[string]string{"k": "v"},
+		}
+	}
+	for _, indent := range []bool{false, true} {
+		t.Run(fmt.Sprintf("indent %v", indent), func(t *testing.T) {
+			var expected bytes.Buffer
+			enc := json.NewEncoder(&expected)
+			if indent {
+				enc.SetIndent(">", "  ")
+			}
+			assertErr(t, enc.Encode(v))
+
+			var w chunkWriter
+			enc = json.NewEncoder(&w)
+			if indent {
+				enc.SetIndent(">", "  ")
+			}
+			enc.SetChunkSize(256)
+			assertErr(t, enc.Encode(v))
+			assertEq(t, "chunked", expected.String(), w.buf.String())
+			if w.writes < expected.Len()/512 {
+				t.Fatalf("expected the encoding to be written in chunks but got %d writes", w.writes)
+			}
+
+			w = chunkWriter{}
+			enc = json.NewEncoder(&w)
+			enc.SetChunkSize(256)
+			assertErr(t, enc.EncodeWithOption(v, json.UnorderedMap()))
+			var got []item
+			assertErr(t, json.Unmarshal(w.buf.Bytes(), &got))
+			assertEq(t, "unordered map", len(v), len(got))
+		})
+	}
+	t.Run("write error", func(t *testing.T) {
+		w := chunkWriter{err: fmt.Errorf("closed")}
+		enc := json.NewEncoder(&w)
+		enc.SetChunkSize(256)
+		if err := enc.Encode(v); err != w.err {
+			t.Fatalf("expected the write error but got %v", err)
+		}
+		assertEq(t, "writes", 1, w.writes)
+	})
+}
@@ -1,9 +1,11 @@
 package json
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 func getTag(field reflect.StructField) string {
@@ -29,7 +31,12 @@ type structTag struct {
 	key         string
 	isTaggedKey bool
 	isOmitEmpty bool
+	isOmitZero  bool
+	isNoNil     bool
 	isString    bool
+	isRequired  bool
+	isUnknown   bool
+	isInline    bool
 	field       reflect.StructField
 }
 
@@ -61,8 +68,8 @@ func isValidTag(s string) bool {
 	return true
 }
 
-func structTagFromField(field reflect.StructField) *structTag {
-	keyName := field.Name
+func structTagFromField(field reflect.StructField, naming keyNaming) *structTag {
+	keyName := naming.key(field.Name)
 	tag := getTag(field)
 	st := &structTag{field: field}
 	opts := strings.Split(tag, ",")
@@ -73,9 +80,116 @@ func structTagFromField(field reflect.StructField) *structTag {
 		}
 	}
 	st.key = keyName
-	if len(opts) > 1 {
-		st.isOmitEmpty = opts[1] == "omitempty"
-		st.isString = opts[1] == "string"
+	for _, opt := range opts[1:] {
+		switch opt {
+		case "omitempty":
+			st.isOmitEmpty = true
+		case "omitzero":
+			st.isOmitZero = true
+		case "nonil":
+			st.isNoNil = true
+		case "string":
+			st.isString = true
+		case "required":
+			st.isRequired = true
+		case "unknown":
+			st.isUnknown = true
+		case "inline":
+			st.isInline = true
+		}
+	}
+	if st.isInline {
+		typ := field.Type
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			// an inlined map holds the members without a field like the unknown option
+			st.isUnknown = true
+		}
 	}
 	return st
 }
+
+// checkUnknownField returns an error if tag is not the only field of typ with the unknown option,
+// which is given as unknown if any, or its field can't hold the members of an object.
+// An inlined field which is not a struct is checked like the one with the unknown option.
+func checkUnknownField(typ reflect.Type, unknown, tag *structTag) error {
+	if unknown != nil {
+		return fmt.Errorf("json: %s has more than one field with the unknown or inline option", typ)
+	}
+	fieldType := tag.field.Type
+	if fieldType.Kind() == reflect.Map && fieldType.Key().Kind() == reflect.String {
+		return nil
+	}
+	if tag.isInline {
+		return fmt.Errorf("json: field %s of %s with the inline option must be a struct or a map with string keys", tag.field.Name, typ)
+	}
+	return fmt.Errorf("json: field %s of %s with the unknown option must be a map with string keys", tag.field.Name, typ)
+}
+
+// isPromoted reports whether the fields of the struct of the field are promoted to the outer object
+// as the ones of an embedded struct without a tagged key or of a struct with the inline option.
+func (t *structTag) isPromoted() bool {
+	return (t.field.Anonymous && !t.isTaggedKey) || (t.isInline && !t.isUnknown)
+}
+
+// keyNaming is a strategy to derive the object key of an untagged struct field from the field name.
+type keyNaming int
+
+const (
+	keyNamingFieldName keyNaming = iota // the field name as is
+	keyNamingSnakeCase                  // e.g. "user_id" for UserID
+	keyNamingCamelCase                  // e.g. "userId" for UserID
+	keyNamingKebabCase                  // e.g. "user-id" for UserID
+)
+
+func (n keyNaming) key(name string) string {
+	switch n {
+	case keyNamingSnakeCase:
+		return strings.ToLower(strings.Join(splitFieldName(name), "_"))
+	case keyNamingCamelCase:
+		words := splitFieldName(name)
+		for i, word := range words {
+			word = strings.ToLower(word)
+			if i > 0 {
+				r, size := utf8.DecodeRuneInString(word)
+				word = string(unicode.ToUpper(r)) + word[size:]
+			}
+			words[i] = word
+		}
+		return strings.Join(words, "")
+	case keyNamingKebabCase:
+		return strings.ToLower(strings.Join(splitFieldName(name), "-"))
+	}
+	return name
+}
+
+// splitFieldName splits name into words at underscores and at the start of each upper case word.
+// A run of upper case letters is kept as one word like "HTTP" in "HTTPServer".
+func splitFieldName(name string) []string {
+	runes := []rune(name)
+	words := []string{}
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '_' {
+			if start < i {
+				words = append(words, string(runes[start:i]))
+			}
+			start = i + 1
+			continue
+		}
+		if i == start || !unicode.IsUpper(runes[i]) {
+			continue
+		}
+		prev := runes[i-1]
+		if !unicode.IsUpper(prev) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
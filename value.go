@@ -0,0 +1,397 @@
+package json
+
+import (
+	"reflect"
+	"strconv"
+	"unsafe"
+)
+
+// ValueKind is the kind of JSON value held by a Value.
+type ValueKind int
+
+const (
+	// ValueInvalid is the kind of the zero Value, returned when a lookup fails.
+	ValueInvalid ValueKind = iota
+	ValueNull
+	ValueBool
+	ValueNumber
+	ValueString
+	ValueArray
+	ValueObject
+)
+
+func (k ValueKind) String() string {
+	switch k {
+	case ValueNull:
+		return "null"
+	case ValueBool:
+		return "bool"
+	case ValueNumber:
+		return "number"
+	case ValueString:
+		return "string"
+	case ValueArray:
+		return "array"
+	case ValueObject:
+		return "object"
+	}
+	return "invalid"
+}
+
+// document is a JSON text indexed by Parse.
+// The tape holds one entry per value in document order.
+// The members of an object are stored as a key entry followed by the value entries.
+// parseOption is the DecodeOption of Parse, which doesn't limit the input.
+var parseOption DecodeOption
+
+type document struct {
+	buf  []byte
+	tape []tapeEntry
+}
+
+type tapeEntry struct {
+	kind  ValueKind
+	start int64 // offset of the first byte of the value
+	end   int64 // offset just after the last byte of the value
+	next  int   // tape index of the entry following the value and all of its children
+	n     int   // number of elements of an array or members of an object
+}
+
+// Value is a JSON value inside a document indexed by Parse.
+// Looking up and iterating values only walks the tape of offsets built by Parse,
+// so it neither allocates nor decodes values which are not accessed.
+//
+// The zero Value is returned when a lookup fails; its Kind is ValueInvalid.
+type Value struct {
+	doc *document
+	idx int
+}
+
+// Parse indexes the JSON-encoded data once and returns its top-level Value.
+//
+// Parse copies data once. Raw returns sub slices of that copy,
+// so data may be modified after Parse returns.
+func Parse(data []byte) (Value, error) {
+	buf := make([]byte, len(data)+1) // append nul byte to end
+	copy(buf, data)
+	doc := &document{buf: buf}
+	cursor, err := doc.index()
+	if err != nil {
+		return Value{}, err
+	}
+	cursor = skipWhiteSpace(buf, cursor)
+	if buf[cursor] != nul {
+		return Value{}, errInvalidCharacter(buf[cursor], "after top-level value", cursor)
+	}
+	return Value{doc: doc}, nil
+}
+
+func (d *document) index() (int64, error) {
+	buf := d.buf
+	stack := []int{}
+	cursor := skipWhiteSpace(buf, 0)
+	for {
+		idx := len(d.tape)
+		switch c := buf[cursor]; c {
+		case '{', '[':
+			kind := ValueArray
+			if c == '{' {
+				kind = ValueObject
+			}
+			d.tape = append(d.tape, tapeEntry{kind: kind, start: cursor})
+			stack = append(stack, idx)
+			cursor = skipWhiteSpace(buf, cursor+1)
+			if (c == '{' && buf[cursor] == '}') || (c == '[' && buf[cursor] == ']') {
+				break
+			}
+			if kind == ValueObject {
+				c, err := d.indexKey(cursor)
+				if err != nil {
+					return 0, err
+				}
+				cursor = c
+			}
+			continue
+		case nul:
+			return 0, errUnexpectedEndOfJSON("value", cursor)
+		default:
+			kind := scalarKind(c)
+			if kind == ValueInvalid {
+				return 0, errInvalidCharacter(c, "value", cursor)
+			}
+			end, err := skipValue(buf, cursor, 0, &parseOption)
+			if err != nil {
+				return 0, err
+			}
+			d.tape = append(d.tape, tapeEntry{kind: kind, start: cursor, end: end, next: idx + 1})
+			cursor = end
+		}
+
+		// the value is complete. close the containers which end here.
+		for {
+			if len(stack) == 0 {
+				return cursor, nil
+			}
+			parent := &d.tape[stack[len(stack)-1]]
+			cursor = skipWhiteSpace(buf, cursor)
+			if (buf[cursor] == ']' && parent.kind == ValueArray) || (buf[cursor] == '}' && parent.kind == ValueObject) {
+				if d.tape[len(d.tape)-1].start != parent.start {
+					parent.n++
+				}
+				cursor++
+				parent.end = cursor
+				parent.next = len(d.tape)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			parent.n++
+			if buf[cursor] != ',' {
+				if parent.kind == ValueObject {
+					return 0, errExpected("comma after object element", cursor)
+				}
+				return 0, errExpected("comma after array element", cursor)
+			}
+			cursor = skipWhiteSpace(buf, cursor+1)
+			if parent.kind == ValueObject {
+				c, err := d.indexKey(cursor)
+				if err != nil {
+					return 0, err
+				}
+				cursor = c
+			}
+			break
+		}
+	}
+}
+
+// indexKey appends the key of an object member and skips the following colon.
+func (d *document) indexKey(cursor int64) (int64, error) {
+	buf := d.buf
+	if buf[cursor] != '"' {
+		return 0, errInvalidCharacter(buf[cursor], "object key", cursor)
+	}
+	end, err := skipValue(buf, cursor, 0, &parseOption)
+	if err != nil {
+		return 0, err
+	}
+	idx := len(d.tape)
+	d.tape = append(d.tape, tapeEntry{kind: ValueString, start: cursor, end: end, next: idx + 1})
+	cursor = skipWhiteSpace(buf, end)
+	if buf[cursor] != ':' {
+		return 0, errExpected("colon after object key", cursor)
+	}
+	return skipWhiteSpace(buf, cursor+1), nil
+}
+
+func scalarKind(c byte) ValueKind {
+	switch c {
+	case '"':
+		return ValueString
+	case 't', 'f':
+		return ValueBool
+	case 'n':
+		return ValueNull
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return ValueNumber
+	}
+	return ValueInvalid
+}
+
+func (v Value) entry() *tapeEntry {
+	return &v.doc.tape[v.idx]
+}
+
+// Kind returns the kind of v.
+func (v Value) Kind() ValueKind {
+	if v.doc == nil {
+		return ValueInvalid
+	}
+	return v.entry().kind
+}
+
+// Exists reports whether v refers to a value, that is whether the lookup which returned v succeeded.
+func (v Value) Exists() bool {
+	return v.doc != nil
+}
+
+// Raw returns the JSON encoding of v without surrounding white space.
+// The returned slice shares memory with the document and must not be modified.
+func (v Value) Raw() []byte {
+	if v.doc == nil {
+		return nil
+	}
+	e := v.entry()
+	return v.doc.buf[e.start:e.end:e.end]
+}
+
+// Len returns the number of elements of an array or the number of members of an object.
+// It returns 0 for other kinds.
+func (v Value) Len() int {
+	if v.doc == nil {
+		return 0
+	}
+	return v.entry().n
+}
+
+// Get returns the value addressed by path, where each element is an object key
+// or, for arrays, a decimal index.
+// It returns the zero Value if there is no such value.
+func (v Value) Get(path ...string) Value {
+	for _, key := range path {
+		switch v.Kind() {
+		case ValueObject:
+			v = v.member(key)
+		case ValueArray:
+			idx, ok := parseJSONPointerIndex(key)
+			if !ok {
+				return Value{}
+			}
+			v = v.Index(idx)
+		default:
+			return Value{}
+		}
+	}
+	return v
+}
+
+func (v Value) member(key string) Value {
+	tape := v.doc.tape
+	e := v.entry()
+	for i := v.idx + 1; i < e.next; i = tape[i+1].next {
+		if v.doc.keyEquals(&tape[i], key) {
+			return Value{doc: v.doc, idx: i + 1}
+		}
+	}
+	return Value{}
+}
+
+func (d *document) keyEquals(e *tapeEntry, key string) bool {
+	raw := d.buf[e.start+1 : e.end-1]
+	for _, c := range raw {
+		if c == '\\' {
+			s, err := d.unquote(e)
+			return err == nil && s == key
+		}
+	}
+	return *(*string)(unsafe.Pointer(&raw)) == key
+}
+
+// Index returns the i'th element of an array.
+// It returns the zero Value if v is not an array or i is out of range.
+func (v Value) Index(i int) Value {
+	if v.Kind() != ValueArray || i < 0 || i >= v.entry().n {
+		return Value{}
+	}
+	tape := v.doc.tape
+	idx := v.idx + 1
+	for ; i > 0; i-- {
+		idx = tape[idx].next
+	}
+	return Value{doc: v.doc, idx: idx}
+}
+
+// ForEach calls fn for each element of an array or member of an object in order
+// until fn returns false. The key passed for array elements is the zero Value.
+func (v Value) ForEach(fn func(key, value Value) bool) {
+	switch v.Kind() {
+	case ValueArray:
+		tape := v.doc.tape
+		for i := v.idx + 1; i < v.entry().next; i = tape[i].next {
+			if !fn(Value{}, Value{doc: v.doc, idx: i}) {
+				return
+			}
+		}
+	case ValueObject:
+		tape := v.doc.tape
+		for i := v.idx + 1; i < v.entry().next; i = tape[i+1].next {
+			if !fn(Value{doc: v.doc, idx: i}, Value{doc: v.doc, idx: i + 1}) {
+				return
+			}
+		}
+	}
+}
+
+// Bool returns the value of a JSON boolean.
+func (v Value) Bool() (bool, error) {
+	if v.Kind() != ValueBool {
+		return false, v.errUnmarshalType(reflect.TypeOf(false))
+	}
+	return v.doc.buf[v.entry().start] == 't', nil
+}
+
+// Int returns the value of a JSON number as int64.
+func (v Value) Int() (int64, error) {
+	if v.Kind() != ValueNumber {
+		return 0, v.errUnmarshalType(reflect.TypeOf(int64(0)))
+	}
+	raw := v.Raw()
+	i, err := strconv.ParseInt(*(*string)(unsafe.Pointer(&raw)), 10, 64)
+	if err != nil {
+		return 0, &UnmarshalTypeError{
+			Value:  "number " + string(raw),
+			Type:   reflect.TypeOf(int64(0)),
+			Offset: v.entry().start,
+		}
+	}
+	return i, nil
+}
+
+// Float returns the value of a JSON number as float64.
+func (v Value) Float() (float64, error) {
+	if v.Kind() != ValueNumber {
+		return 0, v.errUnmarshalType(reflect.TypeOf(float64(0)))
+	}
+	raw := v.Raw()
+	f, err := strconv.ParseFloat(*(*string)(unsafe.Pointer(&raw)), 64)
+	if err != nil {
+		return 0, &UnmarshalTypeError{
+			Value:  "number " + string(raw),
+			Type:   reflect.TypeOf(float64(0)),
+			Offset: v.entry().start,
+		}
+	}
+	return f, nil
+}
+
+// String returns the unescaped content of a JSON string.
+func (v Value) String() (string, error) {
+	if v.Kind() != ValueString {
+		return "", v.errUnmarshalType(reflect.TypeOf(""))
+	}
+	return v.doc.unquote(v.entry())
+}
+
+func (d *document) unquote(e *tapeEntry) (string, error) {
+	raw := d.buf[e.start:e.end]
+	for _, c := range raw[1 : len(raw)-1] {
+		if c != '\\' {
+			continue
+		}
+		// stringDecoder unescapes in place, so work on a copy of the string.
+		src := make([]byte, len(raw)+1) // append nul byte to end
+		copy(src, raw)
+		b, _, err := pathKeyDecoder.decodeByte(src, 0)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+	return string(raw[1 : len(raw)-1]), nil
+}
+
+// Decode decodes v into the value pointed to by x like Unmarshal.
+func (v Value) Decode(x interface{}) error {
+	return Unmarshal(v.Raw(), x)
+}
+
+func (v Value) errUnmarshalType(typ reflect.Type) *UnmarshalTypeError {
+	var offset int64
+	if v.doc != nil {
+		offset = v.entry().start
+	}
+	return &UnmarshalTypeError{
+		Value:  v.Kind().String(),
+		Type:   typ,
+		Offset: offset,
+	}
+}